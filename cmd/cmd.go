@@ -403,12 +403,24 @@ func StartNode(workingDir string, passwordFetcher func(*wallet.Wallet) (string,
 		return nil, nil, err
 	}
 
-	valKeys, err := MakeValidatorKey(walletInstance, valAddrsInfo, passwordFetcher)
+	valAddrs := make([]crypto.Address, len(valAddrsInfo))
+	for i, info := range valAddrsInfo {
+		valAddr, _ := crypto.AddressFromString(info.Address)
+		if !valAddr.IsValidatorAddress() {
+			return nil, nil, fmt.Errorf("invalid validator address: %s", info.Address)
+		}
+		valAddrs[i] = valAddr
+	}
+
+	// With a remote signer, the validator keys stay on the signer and are never loaded here.
+	signers, err := node.MakeSigners(conf.Signer, valAddrs, func() ([]*bls.ValidatorKey, error) {
+		return MakeValidatorKey(walletInstance, valAddrsInfo, passwordFetcher)
+	})
 	if err != nil {
 		return nil, nil, err
 	}
 
-	nd, err := node.NewNode(gen, conf, valKeys, rewardAddrs)
+	nd, err := node.NewNode(gen, conf, signers, rewardAddrs)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pactus-project/pactus/cmd"
+	"github.com/pactus-project/pactus/sync/bundle"
+	"github.com/pactus-project/pactus/sync/bundle/codec"
+	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/sync/capture"
+	"github.com/pactus-project/pactus/util"
+	"github.com/spf13/cobra"
+)
+
+// invalidTypeName is used in the type filter to select the bundles that can't be decoded.
+const invalidTypeName = "invalid"
+
+func buildInspectCaptureCmd(parentCmd *cobra.Command) {
+	inspectCmd := &cobra.Command{
+		Use:   "inspect-capture [capture files]",
+		Short: "decode the captured bundles and print them in a human-readable format",
+		Long: "The inspect-capture command decodes the bundles that are captured by enabling " +
+			"`sync.capture` in the config. The bundles that can't be decoded are printed in hex.",
+		Args: cobra.MinimumNArgs(1),
+	}
+	parentCmd.AddCommand(inspectCmd)
+
+	typesOpt := inspectCmd.Flags().StringSlice("type", nil,
+		"only print the messages of these types, like \"blocks-response\", or \"invalid\" for the malformed bundles")
+	peersOpt := inspectCmd.Flags().StringSlice("peer", nil,
+		"only print the bundles that are exchanged with these peers")
+	dictOpt := inspectCmd.Flags().String("dictionary", "",
+		"the compression dictionary of the node, to decode the bundles that are compressed with it")
+	hexOpt := inspectCmd.Flags().Bool("hex", false, "print the raw bytes of all bundles in hex")
+
+	inspectCmd.Run = func(_ *cobra.Command, args []string) {
+		for _, name := range *typesOpt {
+			if name == invalidTypeName {
+				continue
+			}
+			_, err := message.ParseType(name)
+			cmd.FatalErrorCheck(err)
+		}
+
+		codecs := bundle.NewCodecs()
+		if *dictOpt != "" {
+			dict, err := util.ReadFile(*dictOpt)
+			cmd.FatalErrorCheck(err)
+
+			zstd, err := codec.NewZstd(dict)
+			cmd.FatalErrorCheck(err)
+
+			codecs.SetCodec(bundle.BundleFlagCompressedZstd, zstd)
+		}
+
+		printed := 0
+		for _, path := range args {
+			file, err := os.Open(path)
+			cmd.FatalErrorCheck(err)
+
+			reader := capture.NewReader(file)
+			for {
+				rec, err := reader.Next()
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				if err != nil {
+					// The last record might be partially written.
+					cmd.PrintWarnMsgf("Unable to read the capture file '%s': %s", path, err)
+
+					break
+				}
+
+				if len(*peersOpt) > 0 && !slices.Contains(*peersOpt, rec.PeerID.String()) {
+					continue
+				}
+
+				bdl := new(bundle.Bundle)
+				_, decodeErr := bdl.DecodeWith(bytes.NewReader(rec.Data), codecs)
+
+				typeName := invalidTypeName
+				if decodeErr == nil {
+					typeName = bdl.Message.Type().String()
+				}
+				if len(*typesOpt) > 0 && !slices.Contains(*typesOpt, typeName) {
+					continue
+				}
+
+				printRecord(rec, bdl, decodeErr, *hexOpt)
+				printed++
+			}
+			_ = file.Close()
+		}
+
+		cmd.PrintLine()
+		cmd.PrintInfoMsgf("%d bundles printed.", printed)
+	}
+}
+
+func printRecord(rec *capture.Record, bdl *bundle.Bundle, decodeErr error, printHex bool) {
+	peerID := "broadcast"
+	if rec.PeerID != "" {
+		peerID = rec.PeerID.String()
+	}
+
+	cmd.PrintInfoMsgBoldf("%s %-8s peer: %s, topic: %s, size: %d",
+		rec.Time.Format(time.RFC3339Nano), rec.Direction, peerID, rec.TopicID, len(rec.Data))
+
+	if decodeErr != nil {
+		cmd.PrintWarnMsgf("  unable to decode the bundle: %s", decodeErr)
+		cmd.PrintInfoMsgf("%s", strings.TrimSuffix(hex.Dump(rec.Data), "\n"))
+
+		return
+	}
+
+	cmd.PrintInfoMsgf("  %s", bdl)
+	cmd.PrintInfoMsgf("  flags: 0x%04x, sequence: %d", bdl.Flags, bdl.SequenceNo)
+	if printHex {
+		cmd.PrintInfoMsgf("%s", strings.TrimSuffix(hex.Dump(rec.Data), "\n"))
+	}
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/gofrs/flock"
+	"github.com/pactus-project/pactus/cmd"
+	"github.com/pactus-project/pactus/store"
+	"github.com/pactus-project/pactus/sync/bundle/codec"
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/logger"
+	"github.com/spf13/cobra"
+)
+
+func buildTrainDictionaryCmd(parentCmd *cobra.Command) {
+	trainCmd := &cobra.Command{
+		Use:   "train-dictionary [output file]",
+		Short: "train a zstd dictionary on the stored blocks for compressing the bundles",
+		Long: "The train-dictionary command trains a zstd dictionary on the most recent stored blocks. " +
+			"Nodes that set the same dictionary as `compression_dictionary` in their config " +
+			"compress the bundles between themselves with it. The node should be stopped.",
+		Args: cobra.ExactArgs(1),
+	}
+	parentCmd.AddCommand(trainCmd)
+
+	workingDirOpt := addWorkingDirOption(trainCmd)
+	blocksOpt := trainCmd.Flags().Uint32("blocks", 1000, "number of the recent blocks to train the dictionary on")
+	idOpt := trainCmd.Flags().Uint32("id", 1, "ID of the dictionary, it should not be zero")
+
+	trainCmd.Run = func(_ *cobra.Command, args []string) {
+		if *idOpt == 0 {
+			cmd.PrintWarnMsgf("The dictionary ID should not be zero.")
+
+			return
+		}
+
+		outputPath, err := filepath.Abs(args[0])
+		cmd.FatalErrorCheck(err)
+
+		workingDir, err := filepath.Abs(*workingDirOpt)
+		cmd.FatalErrorCheck(err)
+
+		err = os.Chdir(workingDir)
+		cmd.FatalErrorCheck(err)
+
+		lockFilePath := filepath.Join(workingDir, ".pactus.lock")
+		fileLock := flock.New(lockFilePath)
+
+		locked, err := fileLock.TryLock()
+		cmd.FatalErrorCheck(err)
+
+		if !locked {
+			cmd.PrintWarnMsgf("Could not lock '%s', another instance is running?", lockFilePath)
+
+			return
+		}
+		defer func() { _ = fileLock.Unlock() }()
+
+		conf, _, err := cmd.MakeConfig(workingDir)
+		cmd.FatalErrorCheck(err)
+
+		// Disable logger
+		conf.Logger.Targets = []string{}
+		logger.InitGlobalLogger(conf.Logger)
+
+		str, err := store.NewStore(conf.Store)
+		cmd.FatalErrorCheck(err)
+		defer str.Close()
+
+		if str.LastCertificate() == nil {
+			cmd.PrintWarnMsgf("The store has no block.")
+
+			return
+		}
+
+		lastHeight := str.LastCertificate().Height()
+		samples := make([][]byte, 0, *blocksOpt)
+		for height := lastHeight; height > 0 && len(samples) < int(*blocksOpt); height-- {
+			cb, err := str.Block(height)
+			if err != nil {
+				// The blocks before are pruned.
+				break
+			}
+			samples = append(samples, cb.Data)
+		}
+		// The samples should be ordered from the oldest to the most recent.
+		slices.Reverse(samples)
+
+		cmd.PrintInfoMsgf("Training the dictionary on %d blocks...", len(samples))
+		dict, err := codec.TrainDictionary(*idOpt, samples)
+		cmd.FatalErrorCheck(err)
+
+		err = util.WriteFile(outputPath, dict)
+		cmd.FatalErrorCheck(err)
+
+		cmd.PrintSuccessMsgf("The dictionary is saved at '%s'.", outputPath)
+	}
+}
@@ -25,6 +25,10 @@ func main() {
 	buildStartCmd(rootCmd)
 	buildPruneCmd(rootCmd)
 	buildImportCmd(rootCmd)
+	buildCheckTraceCmd(rootCmd)
+	buildReplayConsensusCmd(rootCmd)
+	buildTrainDictionaryCmd(rootCmd)
+	buildInspectCaptureCmd(rootCmd)
 
 	err := rootCmd.Execute()
 	if err != nil {
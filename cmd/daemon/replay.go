@@ -0,0 +1,244 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/gofrs/flock"
+	"github.com/pactus-project/pactus/cmd"
+	"github.com/pactus-project/pactus/consensus"
+	"github.com/pactus-project/pactus/consensus/record"
+	"github.com/pactus-project/pactus/evidencepool"
+	"github.com/pactus-project/pactus/signer"
+	"github.com/pactus-project/pactus/state"
+	"github.com/pactus-project/pactus/store"
+	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/sync/statesync"
+	"github.com/pactus-project/pactus/txpool"
+	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/types/certificate"
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/logger"
+	"github.com/spf13/cobra"
+)
+
+func buildReplayConsensusCmd(parentCmd *cobra.Command) {
+	replayCmd := &cobra.Command{
+		Use:   "replay-consensus [record file]",
+		Short: "replay the recorded consensus messages and report any divergence",
+		Long: "The replay-consensus command builds the state at the height before the first recorded height " +
+			"in a temporary directory, feeds the recorded messages and timeouts into new consensus instances " +
+			"and reports any divergence from the recorded outcome. " +
+			"If the stored state is at that height, it is restored directly. Otherwise, the state is rebuilt " +
+			"by executing the stored blocks from the genesis, which needs a full node. The node should be stopped.",
+		Args: cobra.ExactArgs(1),
+	}
+	parentCmd.AddCommand(replayCmd)
+
+	workingDirOpt := addWorkingDirOption(replayCmd)
+
+	replayCmd.Run = func(_ *cobra.Command, args []string) {
+		entries, err := record.ReadFile(args[0])
+		cmd.FatalErrorCheck(err)
+
+		startHeight := uint32(0)
+		for _, e := range entries {
+			if e.Kind != record.KindInstance {
+				startHeight = e.Height
+
+				break
+			}
+		}
+		if startHeight == 0 {
+			cmd.PrintWarnMsgf("No consensus message is recorded in '%s'.", args[0])
+
+			return
+		}
+
+		workingDir, err := filepath.Abs(*workingDirOpt)
+		cmd.FatalErrorCheck(err)
+
+		err = os.Chdir(workingDir)
+		cmd.FatalErrorCheck(err)
+
+		lockFilePath := filepath.Join(workingDir, ".pactus.lock")
+		fileLock := flock.New(lockFilePath)
+
+		locked, err := fileLock.TryLock()
+		cmd.FatalErrorCheck(err)
+
+		if !locked {
+			cmd.PrintWarnMsgf("Could not lock '%s', another instance is running?", lockFilePath)
+
+			return
+		}
+		defer func() { _ = fileLock.Unlock() }()
+
+		conf, gen, err := cmd.MakeConfig(workingDir)
+		cmd.FatalErrorCheck(err)
+
+		// Disable logger
+		conf.Logger.Targets = []string{}
+		logger.InitGlobalLogger(conf.Logger)
+
+		srcStore, err := store.NewStore(conf.Store)
+		cmd.FatalErrorCheck(err)
+		defer srcStore.Close()
+
+		if srcStore.LastCertificate() == nil || srcStore.LastCertificate().Height()+1 < startHeight {
+			cmd.PrintWarnMsgf("The store has no block before height %d.", startHeight)
+
+			return
+		}
+
+		tempDir := util.TempDirPath()
+		defer func() { _ = os.RemoveAll(tempDir) }()
+
+		replayStoreConf := *conf.Store
+		replayStoreConf.Path = tempDir
+		replayStore, err := store.NewStore(&replayStoreConf)
+		cmd.FatalErrorCheck(err)
+		defer replayStore.Close()
+
+		broadcastCh := make(chan message.Message, 100)
+		go func() {
+			for range broadcastCh {
+			}
+		}()
+
+		txPool := txpool.NewTxPool(conf.TxPool, broadcastCh)
+		st, err := state.LoadOrNewState(gen, []signer.Signer{}, replayStore, txPool, nil)
+		cmd.FatalErrorCheck(err)
+
+		storedHeight := srcStore.LastCertificate().Height()
+		if storedHeight+1 == startHeight {
+			// Fast path: the stored state is at the height before the recorded heights.
+			cmd.PrintInfoMsgf("Restoring the stored state at height %d...", storedHeight)
+			snapshot, err := storedStateSnapshot(srcStore, gen.Params().TransactionToLiveInterval)
+			cmd.FatalErrorCheck(err)
+
+			err = st.RestoreStateSnapshot(snapshot)
+			cmd.FatalErrorCheck(err)
+		} else {
+			cmd.PrintInfoMsgf("Rebuilding the state up to height %d...", startHeight-1)
+			err = rebuildState(st, srcStore, startHeight-1)
+			cmd.FatalErrorCheck(err)
+		}
+
+		evdPool := evidencepool.NewEvidencePool(st, broadcastCh, nil)
+		report, err := consensus.Replay(conf.Consensus, st, evdPool, entries)
+		cmd.FatalErrorCheck(err)
+
+		cmd.PrintLine()
+		cmd.PrintInfoMsgf("Replayed %d entries from height %d to %d, %d commits matched.",
+			report.Replayed, report.StartHeight, report.LastHeight, report.Commits)
+
+		if len(report.Divergences) == 0 {
+			cmd.PrintSuccessMsgf("No divergence from the recorded outcome found.")
+
+			return
+		}
+
+		for _, d := range report.Divergences {
+			cmd.PrintWarnMsgf("%s", d.String())
+		}
+		cmd.PrintErrorMsgf("%d divergences found.", len(report.Divergences))
+	}
+}
+
+// rebuildState commits the stored blocks into the state, up to the given height.
+// The blocks should be stored from the last height of the state, so the store should not be pruned.
+func rebuildState(st state.Facade, str store.Store, toHeight uint32) error {
+	for height := st.LastBlockHeight() + 1; height <= toHeight; height++ {
+		blk, err := storedBlock(str, height)
+		if err != nil {
+			return err
+		}
+
+		var cert *certificate.BlockCertificate
+		if height == str.LastCertificate().Height() {
+			cert = str.LastCertificate()
+		} else {
+			nextBlk, err := storedBlock(str, height+1)
+			if err != nil {
+				return err
+			}
+			cert = nextBlk.PrevCertificate()
+		}
+
+		if err := st.CommitBlock(blk, cert); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func storedBlock(str store.Store, height uint32) (*block.Block, error) {
+	cb, err := str.Block(height)
+	if err != nil {
+		return nil, fmt.Errorf("block %d is not stored, the store might be pruned: %w", height, err)
+	}
+
+	return cb.ToBlock()
+}
+
+// storedStateSnapshot builds a snapshot of the stored state, the same way it is served
+// to the state syncing nodes, so the state can be restored without executing the blocks.
+// The recent blocks within the transaction-to-live interval should be stored.
+func storedStateSnapshot(str store.Store, ttl uint32) (*state.Snapshot, error) {
+	storeSnap, err := str.StateSnapshot()
+	if err != nil {
+		return nil, err
+	}
+	defer storeSnap.Release()
+
+	snap, err := statesync.NewSnapshot(storeSnap)
+	if err != nil {
+		return nil, err
+	}
+
+	height := snap.Height()
+	restorer := statesync.NewRestorer(height, snap.Manifest())
+	for _, chunk := range restorer.MissingChunks(message.StatePartAccounts) {
+		accs, err := snap.AccountChunk(chunk)
+		if err != nil {
+			return nil, err
+		}
+		if err := restorer.AddAccountChunk(chunk, accs); err != nil {
+			return nil, err
+		}
+	}
+	for _, chunk := range restorer.MissingChunks(message.StatePartValidators) {
+		vals, err := snap.ValidatorChunk(chunk)
+		if err != nil {
+			return nil, err
+		}
+		if err := restorer.AddValidatorChunk(chunk, vals); err != nil {
+			return nil, err
+		}
+	}
+
+	firstHeight := uint32(1)
+	if height > ttl {
+		firstHeight = height - ttl
+	}
+	blocks := make([]*block.Block, 0, height-firstHeight+1)
+	for h := firstHeight; h <= height; h++ {
+		blk, err := storedBlock(str, h)
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, blk)
+	}
+
+	return &state.Snapshot{
+		Blocks:      blocks,
+		Certificate: storeSnap.LastCertificate(),
+		StateRoot:   statesync.StateRoot(snap.Manifest()),
+		Accounts:    restorer.Accounts(),
+		Validators:  restorer.Validators(),
+		PublicKeys:  restorer.PublicKeys(),
+	}, nil
+}
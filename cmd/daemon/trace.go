@@ -0,0 +1,73 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/pactus-project/pactus/cmd"
+	"github.com/pactus-project/pactus/consensus/trace"
+	"github.com/spf13/cobra"
+)
+
+func buildCheckTraceCmd(parentCmd *cobra.Command) {
+	checkTraceCmd := &cobra.Command{
+		Use:   "check-trace [trace files]",
+		Short: "check the consensus traces against the consensus specification",
+		Long: "The check-trace command validates the consensus trace files against the state machine " +
+			"of the consensus specification. Traces of several validators can be checked together " +
+			"to check the agreement between them.",
+		Args: cobra.MinimumNArgs(1),
+	}
+	parentCmd.AddCommand(checkTraceCmd)
+
+	tlaDirOpt := checkTraceCmd.Flags().String("tla-dir", "",
+		"the directory to export the traces as TLA+ behaviors, one module per height")
+	heightOpt := checkTraceCmd.Flags().Uint32("height", 0,
+		"the height to export as a TLA+ behavior, zero means all traced heights")
+
+	checkTraceCmd.Run = func(_ *cobra.Command, args []string) {
+		events, err := trace.ReadFile(args...)
+		cmd.FatalErrorCheck(err)
+
+		err = trace.Check(events)
+		cmd.FatalErrorCheck(err)
+
+		cmd.PrintSuccessMsgf("%d events checked, no drift from the specification found.", len(events))
+
+		if *tlaDirOpt == "" {
+			return
+		}
+
+		heights := []uint32{*heightOpt}
+		if *heightOpt == 0 {
+			heights = tracedHeights(events)
+		}
+
+		for _, height := range heights {
+			tla, err := trace.ExportTLA(events, height, fmt.Sprintf("PactusTrace_%d", height))
+			if err != nil {
+				cmd.PrintWarnMsgf("Unable to export height %d: %s", height, err)
+
+				continue
+			}
+
+			err = tla.WriteFiles(*tlaDirOpt)
+			cmd.FatalErrorCheck(err)
+
+			cmd.PrintInfoMsgf("Height %d exported as %s.", height, tla.Name)
+		}
+	}
+}
+
+// tracedHeights returns the heights that the committed blocks are traced for.
+func tracedHeights(events []*trace.Event) []uint32 {
+	heights := make([]uint32, 0)
+	seen := make(map[uint32]bool)
+	for _, e := range events {
+		if e.Kind == trace.KindState && e.State == trace.StateCommit && !seen[e.Height] {
+			seen[e.Height] = true
+			heights = append(heights, e.Height)
+		}
+	}
+
+	return heights
+}
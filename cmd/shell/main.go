@@ -87,6 +87,7 @@ func main() {
 	rootCmd.AddCommand(changeDefaultParameters(pb.NetworkClientCommand()))
 	rootCmd.AddCommand(changeDefaultParameters(pb.TransactionClientCommand()))
 	rootCmd.AddCommand(changeDefaultParameters(pb.WalletClientCommand()))
+	rootCmd.AddCommand(changeDefaultParameters(pb.AdminClientCommand()))
 	rootCmd.AddCommand(clearScreen())
 	rootCmd.AddCommand(sh)
 
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"github.com/pactus-project/pactus/cmd"
+	"github.com/pactus-project/pactus/version"
+	"github.com/spf13/cobra"
+)
+
+func main() {
+	rootCmd := &cobra.Command{
+		Use:               "pactus-signer",
+		Short:             "Pactus remote signer for validator keys",
+		CompletionOptions: cobra.CompletionOptions{HiddenDefaultCmd: true},
+	}
+
+	// Hide the "help" sub-command
+	rootCmd.SetHelpCommand(&cobra.Command{Hidden: true})
+
+	buildVersionCmd(rootCmd)
+	buildStartCmd(rootCmd)
+
+	err := rootCmd.Execute()
+	if err != nil {
+		cmd.PrintErrorMsgf("%s", err)
+	}
+}
+
+// buildVersionCmd builds a sub-command that prints the version of the Pactus signer.
+func buildVersionCmd(parentCmd *cobra.Command) {
+	versionCmd := &cobra.Command{
+		Use:   "version",
+		Short: "prints the Pactus version",
+	}
+	parentCmd.AddCommand(versionCmd)
+	versionCmd.Run = func(c *cobra.Command, _ []string) {
+		c.Printf("Pactus version: %s\n", version.NodeVersion.StringWithAlias())
+	}
+}
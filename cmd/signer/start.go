@@ -0,0 +1,110 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/pactus-project/pactus/cmd"
+	"github.com/pactus-project/pactus/signer"
+	"github.com/pactus-project/pactus/wallet"
+	"github.com/spf13/cobra"
+)
+
+// buildStartCmd builds a sub-command that starts the remote signer.
+// The signer loads the validator keys from the wallet and serves the signing requests of the node.
+func buildStartCmd(parentCmd *cobra.Command) {
+	startCmd := &cobra.Command{
+		Use:   "start",
+		Short: "start the remote signer",
+	}
+
+	parentCmd.AddCommand(startCmd)
+
+	workingDirOpt := startCmd.Flags().StringP("working-dir", "w", cmd.PactusDefaultHomeDir(),
+		"the path to the working directory that keeps the wallet and the signer state")
+
+	walletPathOpt := startCmd.Flags().String("wallet", "",
+		"the path to the wallet file, default is the default wallet inside the working directory")
+
+	passwordOpt := startCmd.Flags().StringP("password", "p", "",
+		"the wallet password")
+
+	listenOpt := startCmd.Flags().String("listen", "",
+		"the address to listen on, like `unix:///path/to/signer.sock` or `tcp://127.0.0.1:50055`."+
+			" Default is a Unix socket inside the working directory")
+
+	authKeyOpt := startCmd.Flags().String("auth-key", "",
+		"the shared secret to authenticate the node")
+
+	startCmd.Run = func(_ *cobra.Command, _ []string) {
+		workingDir, err := filepath.Abs(*workingDirOpt)
+		cmd.FatalErrorCheck(err)
+
+		walletPath := *walletPathOpt
+		if walletPath == "" {
+			walletPath = cmd.PactusDefaultWalletPath(workingDir)
+		}
+
+		listen := *listenOpt
+		if listen == "" {
+			listen = "unix://" + filepath.Join(workingDir, "signer.sock")
+		}
+
+		authKey := *authKeyOpt
+		if authKey == "" {
+			authKey = cmd.PromptPassword("Auth key", false)
+		}
+		if len(authKey) < signer.MinAuthKeyLength {
+			cmd.FatalErrorCheck(fmt.Errorf("auth key should be at least %d characters",
+				signer.MinAuthKeyLength))
+		}
+
+		walletInstance, err := wallet.Open(walletPath, true)
+		cmd.FatalErrorCheck(err)
+
+		valAddrsInfo := walletInstance.AllValidatorAddresses()
+		if len(valAddrsInfo) == 0 {
+			cmd.FatalErrorCheck(fmt.Errorf("no validator addresses found in the wallet"))
+		}
+
+		passwordFetcher := func(wlt *wallet.Wallet) (string, bool) {
+			if !wlt.IsEncrypted() {
+				return "", true
+			}
+
+			if *passwordOpt != "" {
+				return *passwordOpt, true
+			}
+
+			return cmd.PromptPassword("Wallet password", false), true
+		}
+		valKeys, err := cmd.MakeValidatorKey(walletInstance, valAddrsInfo, passwordFetcher)
+		cmd.FatalErrorCheck(err)
+
+		signers := make([]*signer.LocalSigner, len(valKeys))
+		for i, key := range valKeys {
+			hwmPath := filepath.Join(workingDir, "signer", key.Address().String()+".hwm")
+			signers[i], err = signer.LoadLocalSigner(key, hwmPath)
+			cmd.FatalErrorCheck(err)
+
+			height, round := signers[i].HighWaterMark()
+			cmd.PrintInfoMsgf("Validator %s, high-water mark: %d/%d",
+				key.Address().String(), height, round)
+		}
+
+		server := signer.NewServer(listen, authKey, signers)
+		err = server.StartServer()
+		cmd.FatalErrorCheck(err)
+
+		cmd.PrintSuccessMsgf("Signer is listening on %s", server.Address())
+
+		cmd.TrapSignal(func() {
+			cmd.PrintInfoMsgf("Exiting...")
+
+			server.StopServer()
+		})
+
+		// run forever
+		select {}
+	}
+}
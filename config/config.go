@@ -6,16 +6,19 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/pactus-project/pactus/consensus"
 	"github.com/pactus-project/pactus/crypto"
 	"github.com/pactus-project/pactus/network"
+	"github.com/pactus-project/pactus/signer"
 	"github.com/pactus-project/pactus/store"
 	"github.com/pactus-project/pactus/sync"
 	"github.com/pactus-project/pactus/txpool"
 	"github.com/pactus-project/pactus/util"
 	"github.com/pactus-project/pactus/util/logger"
 	"github.com/pactus-project/pactus/wallet"
+	"github.com/pactus-project/pactus/watchdog"
 	"github.com/pactus-project/pactus/www/grpc"
 	"github.com/pactus-project/pactus/www/http"
 	"github.com/pactus-project/pactus/www/jsonrpc"
@@ -40,13 +43,15 @@ type Config struct {
 	Network       *network.Config   `toml:"network"`
 	Sync          *sync.Config      `toml:"sync"`
 	TxPool        *txpool.Config    `toml:"tx_pool"`
-	Consensus     *consensus.Config `toml:"-"`
+	Consensus     *consensus.Config `toml:"consensus"`
+	Watchdog      *watchdog.Config  `toml:"watchdog"`
 	Logger        *logger.Config    `toml:"logger"`
 	GRPC          *grpc.Config      `toml:"grpc"`
 	JSONRPC       *jsonrpc.Config   `toml:"jsonrpc"`
 	HTTP          *http.Config      `toml:"http"`
 	WalletManager *wallet.Config    `toml:"-"`
 	Nanomsg       *nanomsg.Config   `toml:"nanomsg"`
+	Signer        *signer.Config    `toml:"signer"`
 }
 
 type BootstrapInfo struct {
@@ -94,12 +99,14 @@ func defaultConfig() *Config {
 		Sync:          sync.DefaultConfig(),
 		TxPool:        txpool.DefaultConfig(),
 		Consensus:     consensus.DefaultConfig(),
+		Watchdog:      watchdog.DefaultConfig(),
 		Logger:        logger.DefaultConfig(),
 		GRPC:          grpc.DefaultConfig(),
 		JSONRPC:       jsonrpc.DefaultConfig(),
 		HTTP:          http.DefaultConfig(),
 		Nanomsg:       nanomsg.DefaultConfig(),
 		WalletManager: wallet.DefaultConfig(),
+		Signer:        signer.DefaultConfig(),
 	}
 
 	return conf
@@ -179,6 +186,8 @@ func DefaultConfigTestnet() *Config {
 	conf.Network.EnableRelay = true
 	conf.Network.NetworkName = "pactus-testnet"
 	conf.Network.DefaultPort = 21777
+	conf.Consensus.ChangeProposerTimeout = 8 * time.Second
+	conf.Consensus.QueryVoteTimeout = 8 * time.Second
 	conf.GRPC.Enable = true
 	conf.GRPC.Listen = "[::]:50052"
 	conf.GRPC.Gateway.Enable = true
@@ -204,6 +213,9 @@ func DefaultConfigLocalnet() *Config {
 	conf.Network.DefaultPort = 0
 	conf.Network.ForcePrivateNetwork = true
 	conf.Network.EnableMdns = true
+	conf.Consensus.ChangeProposerTimeout = 10 * time.Second
+	conf.Consensus.ChangeProposerDelta = 10 * time.Second
+	conf.Consensus.QueryVoteTimeout = 10 * time.Second
 	conf.Sync.Moniker = "localnet-1"
 	conf.GRPC.Enable = true
 	conf.GRPC.EnableWallet = true
@@ -273,6 +285,9 @@ func (conf *Config) BasicCheck() error {
 	if err := conf.Consensus.BasicCheck(); err != nil {
 		return err
 	}
+	if err := conf.Watchdog.BasicCheck(); err != nil {
+		return err
+	}
 	if err := conf.Network.BasicCheck(); err != nil {
 		return err
 	}
@@ -291,6 +306,9 @@ func (conf *Config) BasicCheck() error {
 	if err := conf.GRPC.BasicCheck(); err != nil {
 		return err
 	}
+	if err := conf.Signer.BasicCheck(); err != nil {
+		return err
+	}
 
 	return conf.HTTP.BasicCheck()
 }
@@ -0,0 +1,20 @@
+package consensus
+
+import "time"
+
+// clock provides the current time and schedules the timers of the consensus.
+// It can be replaced by a virtual clock to run the consensus deterministically.
+type clock interface {
+	Now() time.Time
+	AfterFunc(d time.Duration, f func())
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (systemClock) AfterFunc(d time.Duration, f func()) {
+	time.AfterFunc(d, f)
+}
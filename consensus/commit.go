@@ -24,6 +24,7 @@ func (s *commitState) decide() {
 		s.logger.Error("committing block failed", "block", certBlock, "error", err)
 	} else {
 		s.logger.Info("block committed, schedule new height", "hash", certBlock.Hash())
+		s.recordCommit(certBlock.Hash())
 	}
 
 	// Now we can announce the committed block and certificate
@@ -1,12 +1,39 @@
 package consensus
 
-import "time"
+import (
+	"path/filepath"
+	"time"
+
+	"github.com/pactus-project/pactus/crypto"
+)
 
 type Config struct {
-	ChangeProposerTimeout    time.Duration `toml:"-"`
-	ChangeProposerDelta      time.Duration `toml:"-"`
-	QueryVoteTimeout         time.Duration `toml:"-"`
+	ChangeProposerTimeout    time.Duration `toml:"change_proposer_timeout"`
+	ChangeProposerDelta      time.Duration `toml:"change_proposer_delta"`
+	QueryVoteTimeout         time.Duration `toml:"query_vote_timeout"`
 	MinimumAvailabilityScore float64       `toml:"-"`
+
+	// AdaptiveTimeout adjusts the change-proposer timeout based on the proposal arrival times
+	// of the recent heights, within the minimum and maximum bounds.
+	AdaptiveTimeout          bool          `toml:"adaptive_timeout"`
+	MinChangeProposerTimeout time.Duration `toml:"min_change_proposer_timeout"`
+	MaxChangeProposerTimeout time.Duration `toml:"max_change_proposer_timeout"`
+
+	// WALPath is the directory where the write-ahead logs of the validators are kept.
+	// If it is empty, the write-ahead logs are kept only in memory.
+	WALPath string `toml:"-"`
+
+	// Trace enables recording the consensus events as a structured trace.
+	// The trace files are kept next to the write-ahead logs.
+	Trace bool `toml:"trace"`
+
+	// Record enables recording the consensus inputs, such as the received proposals, votes
+	// and queries, and the local timeouts, to replay them later for debugging.
+	// The record file is kept next to the write-ahead logs.
+	Record bool `toml:"record"`
+
+	// EnableMetrics provides the consensus metrics for the Prometheus software.
+	EnableMetrics bool `toml:"enable_metrics"`
 }
 
 func DefaultConfig() *Config {
@@ -15,6 +42,9 @@ func DefaultConfig() *Config {
 		ChangeProposerDelta:      5 * time.Second,
 		QueryVoteTimeout:         5 * time.Second,
 		MinimumAvailabilityScore: 0.666667,
+		AdaptiveTimeout:          false,
+		MinChangeProposerTimeout: 3 * time.Second,
+		MaxChangeProposerTimeout: 30 * time.Second,
 	}
 }
 
@@ -30,6 +60,23 @@ func (conf *Config) BasicCheck() error {
 			Reason: "change proposer delta must be greater than zero",
 		}
 	}
+	if conf.QueryVoteTimeout <= 0 {
+		return ConfigError{
+			Reason: "query vote timeout must be greater than zero",
+		}
+	}
+	if conf.AdaptiveTimeout {
+		if conf.MinChangeProposerTimeout <= 0 {
+			return ConfigError{
+				Reason: "minimum change proposer timeout must be greater than zero",
+			}
+		}
+		if conf.MaxChangeProposerTimeout < conf.MinChangeProposerTimeout {
+			return ConfigError{
+				Reason: "maximum change proposer timeout can't be less than the minimum",
+			}
+		}
+	}
 	if conf.MinimumAvailabilityScore < 0 || conf.MinimumAvailabilityScore > 1 {
 		return ConfigError{
 			Reason: "minimum availability score can't be negative or more than 1",
@@ -43,3 +90,33 @@ func (conf *Config) CalculateChangeProposerTimeout(round int16) time.Duration {
 	return conf.ChangeProposerTimeout +
 		conf.ChangeProposerDelta*time.Duration(round)
 }
+
+// WALFilePath returns the path of the write-ahead log file for the given validator,
+// or an empty string if the write-ahead log is kept in memory.
+func (conf *Config) WALFilePath(valAddr crypto.Address) string {
+	if conf.WALPath == "" {
+		return ""
+	}
+
+	return filepath.Join(conf.WALPath, valAddr.String()+".wal")
+}
+
+// TraceFilePath returns the path of the trace file for the given validator,
+// or an empty string if tracing is disabled.
+func (conf *Config) TraceFilePath(valAddr crypto.Address) string {
+	if !conf.Trace || conf.WALPath == "" {
+		return ""
+	}
+
+	return filepath.Join(conf.WALPath, valAddr.String()+".trace")
+}
+
+// RecordFilePath returns the path of the record file,
+// or an empty string if recording is disabled.
+func (conf *Config) RecordFilePath() string {
+	if !conf.Record || conf.WALPath == "" {
+		return ""
+	}
+
+	return filepath.Join(conf.WALPath, "consensus.rec")
+}
@@ -1,9 +1,11 @@
 package consensus
 
 import (
+	"path/filepath"
 	"testing"
 	"time"
 
+	"github.com/pactus-project/pactus/util/testsuite"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -31,6 +33,42 @@ func TestConfigBasicCheck(t *testing.T) {
 				c.ChangeProposerTimeout = -1 * time.Second
 			},
 		},
+		{
+			name: "Invalid QueryVoteTimeout",
+			expectedErr: ConfigError{
+				Reason: "query vote timeout must be greater than zero",
+			},
+			updateFn: func(c *Config) {
+				c.QueryVoteTimeout = 0
+			},
+		},
+		{
+			name: "Invalid MinChangeProposerTimeout",
+			expectedErr: ConfigError{
+				Reason: "minimum change proposer timeout must be greater than zero",
+			},
+			updateFn: func(c *Config) {
+				c.AdaptiveTimeout = true
+				c.MinChangeProposerTimeout = 0
+			},
+		},
+		{
+			name: "Invalid MaxChangeProposerTimeout",
+			expectedErr: ConfigError{
+				Reason: "maximum change proposer timeout can't be less than the minimum",
+			},
+			updateFn: func(c *Config) {
+				c.AdaptiveTimeout = true
+				c.MaxChangeProposerTimeout = 2 * time.Second
+			},
+		},
+		{
+			name: "Unchecked bounds when adaptive timeout is disabled",
+			updateFn: func(c *Config) {
+				c.MinChangeProposerTimeout = 0
+				c.MaxChangeProposerTimeout = 0
+			},
+		},
 		{
 			name: "Invalid MinimumAvailabilityScore",
 			expectedErr: ConfigError{
@@ -78,3 +116,41 @@ func TestCalculateChangeProposerTimeout(t *testing.T) {
 	assert.Equal(t, c.ChangeProposerTimeout+c.ChangeProposerDelta, c.CalculateChangeProposerTimeout(1))
 	assert.Equal(t, c.ChangeProposerTimeout+(4*c.ChangeProposerDelta), c.CalculateChangeProposerTimeout(4))
 }
+
+func TestWALFilePath(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	c := DefaultConfig()
+	valAddr := ts.RandValAddress()
+	assert.Empty(t, c.WALFilePath(valAddr))
+
+	c.WALPath = "/tmp/consensus"
+	assert.Equal(t, filepath.Join("/tmp/consensus", valAddr.String()+".wal"), c.WALFilePath(valAddr))
+}
+
+func TestTraceFilePath(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	c := DefaultConfig()
+	valAddr := ts.RandValAddress()
+	c.WALPath = "/tmp/consensus"
+	assert.Empty(t, c.TraceFilePath(valAddr))
+
+	c.Trace = true
+	assert.Equal(t, filepath.Join("/tmp/consensus", valAddr.String()+".trace"), c.TraceFilePath(valAddr))
+
+	c.WALPath = ""
+	assert.Empty(t, c.TraceFilePath(valAddr))
+}
+
+func TestRecordFilePath(t *testing.T) {
+	c := DefaultConfig()
+	c.WALPath = "/tmp/consensus"
+	assert.Empty(t, c.RecordFilePath())
+
+	c.Record = true
+	assert.Equal(t, filepath.Join("/tmp/consensus", "consensus.rec"), c.RecordFilePath())
+
+	c.WALPath = ""
+	assert.Empty(t, c.RecordFilePath())
+}
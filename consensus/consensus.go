@@ -1,18 +1,26 @@
 package consensus
 
 import (
+	"errors"
 	"fmt"
 	"sync"
 	"time"
 
 	"github.com/pactus-project/pactus/consensus/log"
+	"github.com/pactus-project/pactus/consensus/record"
+	"github.com/pactus-project/pactus/consensus/trace"
+	"github.com/pactus-project/pactus/consensus/voteset"
+	"github.com/pactus-project/pactus/consensus/wal"
 	"github.com/pactus-project/pactus/crypto"
 	"github.com/pactus-project/pactus/crypto/bls"
 	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/evidencepool"
+	"github.com/pactus-project/pactus/signer"
 	"github.com/pactus-project/pactus/state"
 	"github.com/pactus-project/pactus/sync/bundle/message"
 	"github.com/pactus-project/pactus/types/block"
 	"github.com/pactus-project/pactus/types/certificate"
+	"github.com/pactus-project/pactus/types/evidence"
 	"github.com/pactus-project/pactus/types/proposal"
 	"github.com/pactus-project/pactus/types/validator"
 	"github.com/pactus-project/pactus/types/vote"
@@ -26,17 +34,25 @@ type consensus struct {
 	lk sync.RWMutex
 
 	config          *Config
+	clock           clock
 	logger          *logger.SubLogger
 	log             *log.Log
+	wal             *wal.WAL
+	tracer          trace.Tracer
+	traceFile       *trace.FileWriter
+	recorder        record.Recorder
+	history         *history
+	adaptiveTimeout *adaptiveTimeout
 	validators      []*validator.Validator
 	cpWeakValidity  *hash.Hash // The change proposer's weak validity that is a prepared block hash
 	cpDecided       int
 	height          uint32
 	round           int16
 	cpRound         int16
-	valKey          *bls.ValidatorKey
+	signer          signer.Signer
 	rewardAddr      crypto.Address
 	bcState         state.Facade // Blockchain state
+	evdPool         evidencepool.EvidencePool
 	changeProposer  *changeProposer
 	newHeightState  consState
 	proposeState    consState
@@ -50,12 +66,14 @@ type consensus struct {
 	broadcaster     broadcaster
 	mediator        mediator
 	active          bool
+	lastVoteTime    time.Time
 }
 
 func NewConsensus(
 	conf *Config,
 	bcState state.Facade,
-	valKey *bls.ValidatorKey,
+	evdPool evidencepool.EvidencePool,
+	valSigner signer.Signer,
 	rewardAddr crypto.Address,
 	broadcastCh chan message.Message,
 	mediator mediator,
@@ -64,28 +82,34 @@ func NewConsensus(
 		broadcastCh <- msg
 	}
 
-	return makeConsensus(conf, bcState,
-		valKey, rewardAddr, broadcaster, mediator)
+	return makeConsensus(conf, bcState, evdPool,
+		valSigner, rewardAddr, broadcaster, mediator)
 }
 
 func makeConsensus(
 	conf *Config,
 	bcState state.Facade,
-	valKey *bls.ValidatorKey,
+	evdPool evidencepool.EvidencePool,
+	valSigner signer.Signer,
 	rewardAddr crypto.Address,
 	broadcaster broadcaster,
 	mediator mediator,
 ) *consensus {
 	cs := &consensus{
 		config:      conf,
+		clock:       systemClock{},
 		bcState:     bcState,
+		evdPool:     evdPool,
 		broadcaster: broadcaster,
-		valKey:      valKey,
+		signer:      valSigner,
 	}
 
 	// Update height later, See enterNewHeight.
 	cs.log = log.NewLog()
+	cs.wal = wal.NewWAL(conf.WALFilePath(valSigner.Address()))
 	cs.logger = logger.NewSubLogger("_consensus", cs)
+	cs.history = newHistory(valSigner.Address().String())
+	cs.adaptiveTimeout = newAdaptiveTimeout()
 	cs.rewardAddr = rewardAddr
 
 	cs.changeProposer = &changeProposer{cs}
@@ -108,22 +132,51 @@ func makeConsensus(
 	mediator.Register(cs)
 
 	logger.Info("consensus instance created",
-		"validator address", valKey.Address().String(),
+		"validator address", valSigner.Address().String(),
 		"reward address", rewardAddr.String())
 
 	return cs
 }
 
-func (cs *consensus) Start() {
+// Start loads the write-ahead log, opens the trace file if tracing is enabled,
+// and moves the consensus to the next height.
+func (cs *consensus) Start() error {
 	cs.lk.Lock()
 	defer cs.lk.Unlock()
 
+	if err := cs.wal.Load(); err != nil {
+		return err
+	}
+
+	if path := cs.config.TraceFilePath(cs.signer.Address()); path != "" && cs.traceFile == nil {
+		traceFile, err := trace.OpenFile(path)
+		if err != nil {
+			return err
+		}
+		cs.traceFile = traceFile
+		cs.tracer = traceFile
+	}
+
 	cs.moveToNewHeight()
+
+	return nil
+}
+
+// Stop closes the trace file.
+func (cs *consensus) Stop() {
+	cs.lk.Lock()
+	defer cs.lk.Unlock()
+
+	if cs.traceFile != nil {
+		_ = cs.traceFile.Close()
+		cs.traceFile = nil
+		cs.tracer = nil
+	}
 }
 
 func (cs *consensus) String() string {
 	return fmt.Sprintf("{%s %d/%d/%s/%d}",
-		cs.valKey.Address().ShortString(),
+		cs.signer.Address().ShortString(),
 		cs.height, cs.round, cs.currentState.name(), cs.cpRound)
 }
 
@@ -131,7 +184,7 @@ func (cs *consensus) ConsensusKey() *bls.PublicKey {
 	cs.lk.RLock()
 	defer cs.lk.RUnlock()
 
-	return cs.valKey.PublicKey()
+	return cs.signer.PublicKey()
 }
 
 func (cs *consensus) HeightRound() (uint32, int16) {
@@ -141,6 +194,20 @@ func (cs *consensus) HeightRound() (uint32, int16) {
 	return cs.height, cs.round
 }
 
+// LastVoteTime returns the time that the last vote is signed by this instance,
+// or zero if it hasn't signed any vote since it started.
+func (cs *consensus) LastVoteTime() time.Time {
+	cs.lk.RLock()
+	defer cs.lk.RUnlock()
+
+	return cs.lastVoteTime
+}
+
+// History returns the consensus statistics of the last count heights, including the current height.
+func (cs *consensus) History(count int) []*HeightStats {
+	return cs.history.recent(count, cs.clock.Now())
+}
+
 func (cs *consensus) HasVote(h hash.Hash) bool {
 	cs.lk.RLock()
 	defer cs.lk.RUnlock()
@@ -166,6 +233,8 @@ func (cs *consensus) AllVotes() []*vote.Vote {
 
 func (cs *consensus) enterNewState(s consState) {
 	cs.currentState = s
+	cs.history.enterState(s.name(), cs.round, cs.clock.Now())
+	cs.traceState()
 	cs.currentState.enter()
 }
 
@@ -185,13 +254,11 @@ func (cs *consensus) moveToNewHeight() {
 
 func (cs *consensus) scheduleTimeout(duration time.Duration, height uint32, round int16, target tickerTarget) {
 	ti := &ticker{duration, height, round, target}
-	timer := time.NewTimer(duration)
 	cs.logger.Trace("new timer scheduled ⏱️", "duration", duration, "height", height, "round", round, "target", target)
 
-	go func() {
-		<-timer.C
+	cs.clock.AfterFunc(duration, func() {
 		cs.handleTimeout(ti)
-	}()
+	})
 }
 
 func (cs *consensus) handleTimeout(t *ticker) {
@@ -208,6 +275,8 @@ func (cs *consensus) handleTimeout(t *ticker) {
 	}
 
 	cs.logger.Debug("timer expired", "ticker", t)
+	cs.recordTimeout(t)
+	cs.history.timeout(t.Target)
 	cs.currentState.onTimeout(t)
 }
 
@@ -270,6 +339,8 @@ func (cs *consensus) SetProposal(p *proposal.Proposal) {
 
 	cs.logger.Info("proposal set", "proposal", p)
 	cs.log.SetRoundProposal(p.Round(), p)
+	cs.traceProposal(p)
+	cs.adaptiveTimeout.proposalReceived(p.Height(), p.Round(), cs.clock.Now())
 
 	cs.currentState.onSetProposal(p)
 }
@@ -306,9 +377,16 @@ func (cs *consensus) AddVote(v *vote.Vote) {
 	added, err := cs.log.AddVote(v)
 	if err != nil {
 		cs.logger.Error("error on adding a vote", "vote", v, "error", err)
+
+		var dupErr voteset.DuplicateVoteError
+		if errors.As(err, &dupErr) {
+			cs.reportEvidence(dupErr.Evidence)
+		}
 	}
 	if added {
 		cs.logger.Info("new vote added", "vote", v)
+		cs.traceVote(v)
+		cs.history.voteReceived(v.Type())
 
 		cs.currentState.onAddVote(v)
 
@@ -332,14 +410,14 @@ func (cs *consensus) IsProposer() bool {
 }
 
 func (cs *consensus) isProposer() bool {
-	return cs.proposer(cs.round).Address() == cs.valKey.Address()
+	return cs.proposer(cs.round).Address() == cs.signer.Address()
 }
 
 func (cs *consensus) signAddCPPreVote(h hash.Hash,
 	cpRound int16, cpValue vote.CPValue, just vote.Just,
 ) {
 	v := vote.NewCPPreVote(h, cs.height,
-		cs.round, cpRound, cpValue, just, cs.valKey.Address())
+		cs.round, cpRound, cpValue, just, cs.signer.Address())
 	cs.signAddVote(v)
 }
 
@@ -347,7 +425,7 @@ func (cs *consensus) signAddCPMainVote(h hash.Hash,
 	cpRound int16, cpValue vote.CPValue, just vote.Just,
 ) {
 	v := vote.NewCPMainVote(h, cs.height, cs.round,
-		cpRound, cpValue, just, cs.valKey.Address())
+		cpRound, cpValue, just, cs.signer.Address())
 	cs.signAddVote(v)
 }
 
@@ -355,59 +433,84 @@ func (cs *consensus) signAddCPDecidedVote(h hash.Hash,
 	cpRound int16, cpValue vote.CPValue, just vote.Just,
 ) {
 	v := vote.NewCPDecidedVote(h, cs.height, cs.round,
-		cpRound, cpValue, just, cs.valKey.Address())
+		cpRound, cpValue, just, cs.signer.Address())
 	cs.signAddVote(v)
 }
 
 func (cs *consensus) signAddPrepareVote(h hash.Hash) {
-	v := vote.NewPrepareVote(h, cs.height, cs.round, cs.valKey.Address())
+	v := vote.NewPrepareVote(h, cs.height, cs.round, cs.signer.Address())
 	cs.signAddVote(v)
 }
 
 func (cs *consensus) signAddPrecommitVote(h hash.Hash) {
-	v := vote.NewPrecommitVote(h, cs.height, cs.round, cs.valKey.Address())
+	v := vote.NewPrecommitVote(h, cs.height, cs.round, cs.signer.Address())
 	cs.signAddVote(v)
 }
 
 func (cs *consensus) signAddVote(v *vote.Vote) {
-	sig := cs.valKey.Sign(v.SignBytes())
-	v.SetSignature(sig)
+	// The vote should be recorded durably before signing it,
+	// otherwise we may sign a conflicting vote after a restart.
+	if err := cs.wal.Write(v); err != nil {
+		cs.logger.Error("refused to sign the vote", "error", err, "vote", v)
+
+		return
+	}
+
+	if err := cs.signer.SignVote(v); err != nil {
+		cs.logger.Error("unable to sign the vote", "error", err, "vote", v)
+
+		return
+	}
 	cs.logger.Info("our vote signed and broadcasted", "vote", v)
+	cs.lastVoteTime = cs.clock.Now()
+	cs.recordOwnVote(v)
 
 	_, err := cs.log.AddVote(v)
 	if err != nil {
 		cs.logger.Warn("error on adding our vote", "error", err, "vote", v)
 	}
+	cs.traceVote(v)
 	cs.broadcastVote(v)
 }
 
 // queryProposal requests any missing proposal from other validators.
 func (cs *consensus) queryProposal() {
-	cs.broadcaster(cs.valKey.Address(),
-		message.NewQueryProposalMessage(cs.height, cs.round, cs.valKey.Address()))
+	cs.history.querySent(queryProposal)
+	cs.broadcaster(cs.signer.Address(),
+		message.NewQueryProposalMessage(cs.height, cs.round, cs.signer.Address()))
 }
 
 // queryVote requests any missing votes from other validators.
 func (cs *consensus) queryVote() {
-	cs.broadcaster(cs.valKey.Address(),
-		message.NewQueryVoteMessage(cs.height, cs.round, cs.valKey.Address()))
+	cs.history.querySent(queryVote)
+	cs.broadcaster(cs.signer.Address(),
+		message.NewQueryVoteMessage(cs.height, cs.round, cs.signer.Address()))
 }
 
 func (cs *consensus) broadcastProposal(p *proposal.Proposal) {
 	go cs.mediator.OnPublishProposal(cs, p)
-	cs.broadcaster(cs.valKey.Address(),
+	cs.broadcaster(cs.signer.Address(),
 		message.NewProposalMessage(p))
 }
 
 func (cs *consensus) broadcastVote(v *vote.Vote) {
 	go cs.mediator.OnPublishVote(cs, v)
-	cs.broadcaster(cs.valKey.Address(),
+	cs.broadcaster(cs.signer.Address(),
 		message.NewVoteMessage(v))
 }
 
+// reportEvidence adds the double-sign evidence to the evidence pool and broadcasts it.
+func (cs *consensus) reportEvidence(evd *evidence.Evidence) {
+	cs.logger.Warn("double-sign detected", "evidence", evd)
+
+	if err := cs.evdPool.AddEvidenceAndBroadcast(evd); err != nil {
+		cs.logger.Warn("unable to add evidence to the pool", "evidence", evd, "error", err)
+	}
+}
+
 func (cs *consensus) announceNewBlock(blk *block.Block, cert *certificate.BlockCertificate) {
 	go cs.mediator.OnBlockAnnounce(cs)
-	cs.broadcaster(cs.valKey.Address(),
+	cs.broadcaster(cs.signer.Address(),
 		message.NewBlockAnnounceMessage(blk, cert))
 }
 
@@ -472,6 +575,8 @@ func (cs *consensus) HandleQueryProposal(height uint32, round int16) *proposal.P
 	cs.lk.RLock()
 	defer cs.lk.RUnlock()
 
+	cs.history.queryReceived(queryProposal)
+
 	if !cs.active {
 		return nil
 	}
@@ -503,6 +608,18 @@ func (cs *consensus) HandleQueryVote(height uint32, round int16) *vote.Vote {
 	cs.lk.RLock()
 	defer cs.lk.RUnlock()
 
+	cs.history.queryReceived(queryVote)
+
+	votes := cs.queriedVotes(height, round)
+	if len(votes) == 0 {
+		return nil
+	}
+
+	return votes[util.RandInt32(int32(len(votes)))]
+}
+
+// queriedVotes returns the votes that can be sent in response to a vote query.
+func (cs *consensus) queriedVotes(height uint32, round int16) []*vote.Vote {
 	if !cs.active {
 		return nil
 	}
@@ -527,11 +644,7 @@ func (cs *consensus) HandleQueryVote(height uint32, round int16) *vote.Vote {
 		// Future round
 	}
 
-	if len(votes) == 0 {
-		return nil
-	}
-
-	return votes[util.RandInt32(int32(len(votes)))]
+	return votes
 }
 
 func (cs *consensus) startChangingProposer() {
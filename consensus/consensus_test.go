@@ -5,10 +5,13 @@ import (
 	"testing"
 	"time"
 
+	"github.com/pactus-project/pactus/consensus/wal"
 	"github.com/pactus-project/pactus/crypto"
 	"github.com/pactus-project/pactus/crypto/bls"
 	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/evidencepool"
 	"github.com/pactus-project/pactus/genesis"
+	"github.com/pactus-project/pactus/signer"
 	"github.com/pactus-project/pactus/state"
 	"github.com/pactus-project/pactus/store"
 	"github.com/pactus-project/pactus/sync/bundle/message"
@@ -16,6 +19,7 @@ import (
 	"github.com/pactus-project/pactus/types/account"
 	"github.com/pactus-project/pactus/types/block"
 	"github.com/pactus-project/pactus/types/certificate"
+	"github.com/pactus-project/pactus/types/evidence"
 	"github.com/pactus-project/pactus/types/proposal"
 	"github.com/pactus-project/pactus/types/tx"
 	"github.com/pactus-project/pactus/types/validator"
@@ -44,6 +48,7 @@ type testData struct {
 
 	valKeys      []*bls.ValidatorKey
 	txPool       *txpool.MockTxPool
+	evdPool      *evidencepool.MockEvidencePool
 	genDoc       *genesis.Genesis
 	consX        *consensus // Good peer
 	consY        *consensus // Good peer
@@ -75,6 +80,7 @@ func setupWithSeed(t *testing.T, seed int64) *testData {
 
 	_, valKeys := ts.GenerateTestCommittee(4)
 	txPool := txpool.MockingTxPool()
+	evdPool := evidencepool.MockingEvidencePool()
 
 	vals := make([]*validator.Validator, 4)
 	for i, key := range valKeys {
@@ -93,16 +99,16 @@ func setupWithSeed(t *testing.T, seed int64) *testData {
 	getTime := util.RoundNow(params.BlockIntervalInSecond).
 		Add(time.Duration(params.BlockIntervalInSecond) * time.Second)
 	genDoc := genesis.MakeGenesis(getTime, accs, vals, params)
-	stX, err := state.LoadOrNewState(genDoc, []*bls.ValidatorKey{valKeys[tIndexX]},
+	stX, err := state.LoadOrNewState(genDoc, []signer.Signer{signer.NewLocalSigner(valKeys[tIndexX])},
 		store.MockingStore(ts), txPool, nil)
 	require.NoError(t, err)
-	stY, err := state.LoadOrNewState(genDoc, []*bls.ValidatorKey{valKeys[tIndexY]},
+	stY, err := state.LoadOrNewState(genDoc, []signer.Signer{signer.NewLocalSigner(valKeys[tIndexY])},
 		store.MockingStore(ts), txPool, nil)
 	require.NoError(t, err)
-	stB, err := state.LoadOrNewState(genDoc, []*bls.ValidatorKey{valKeys[tIndexB]},
+	stB, err := state.LoadOrNewState(genDoc, []signer.Signer{signer.NewLocalSigner(valKeys[tIndexB])},
 		store.MockingStore(ts), txPool, nil)
 	require.NoError(t, err)
-	stP, err := state.LoadOrNewState(genDoc, []*bls.ValidatorKey{valKeys[tIndexP]},
+	stP, err := state.LoadOrNewState(genDoc, []signer.Signer{signer.NewLocalSigner(valKeys[tIndexP])},
 		store.MockingStore(ts), txPool, nil)
 	require.NoError(t, err)
 
@@ -111,6 +117,7 @@ func setupWithSeed(t *testing.T, seed int64) *testData {
 		TestSuite:    ts,
 		valKeys:      valKeys,
 		txPool:       txPool,
+		evdPool:      evdPool,
 		genDoc:       genDoc,
 		consMessages: consMessages,
 	}
@@ -121,13 +128,13 @@ func setupWithSeed(t *testing.T, seed int64) *testData {
 			message: msg,
 		})
 	}
-	td.consX = makeConsensus(testConfig(), stX, valKeys[tIndexX],
+	td.consX = makeConsensus(testConfig(), stX, evdPool, signer.NewLocalSigner(valKeys[tIndexX]),
 		valKeys[tIndexX].PublicKey().AccountAddress(), broadcasterFunc, newConcreteMediator())
-	td.consY = makeConsensus(testConfig(), stY, valKeys[tIndexY],
+	td.consY = makeConsensus(testConfig(), stY, evdPool, signer.NewLocalSigner(valKeys[tIndexY]),
 		valKeys[tIndexY].PublicKey().AccountAddress(), broadcasterFunc, newConcreteMediator())
-	td.consB = makeConsensus(testConfig(), stB, valKeys[tIndexB],
+	td.consB = makeConsensus(testConfig(), stB, evdPool, signer.NewLocalSigner(valKeys[tIndexB]),
 		valKeys[tIndexB].PublicKey().AccountAddress(), broadcasterFunc, newConcreteMediator())
-	td.consP = makeConsensus(testConfig(), stP, valKeys[tIndexP],
+	td.consP = makeConsensus(testConfig(), stP, evdPool, signer.NewLocalSigner(valKeys[tIndexP]),
 		valKeys[tIndexP].PublicKey().AccountAddress(), broadcasterFunc, newConcreteMediator())
 
 	// -------------------------------
@@ -152,7 +159,7 @@ func (td *testData) shouldPublishBlockAnnounce(t *testing.T, cons *consensus, h
 	t.Helper()
 
 	for _, consMsg := range td.consMessages {
-		if consMsg.sender == cons.valKey.Address() &&
+		if consMsg.sender == cons.signer.Address() &&
 			consMsg.message.Type() == message.TypeBlockAnnounce {
 			m := consMsg.message.(*message.BlockAnnounceMessage)
 			assert.Equal(t, h, m.Block.Hash())
@@ -169,7 +176,7 @@ func (td *testData) shouldPublishProposal(t *testing.T, cons *consensus,
 	t.Helper()
 
 	for _, consMsg := range td.consMessages {
-		if consMsg.sender == cons.valKey.Address() &&
+		if consMsg.sender == cons.signer.Address() &&
 			consMsg.message.Type() == message.TypeProposal {
 			m := consMsg.message.(*message.ProposalMessage)
 			require.Equal(t, height, m.Proposal.Height())
@@ -187,7 +194,7 @@ func (td *testData) shouldNotPublish(t *testing.T, cons *consensus, msgType mess
 	t.Helper()
 
 	for _, consMsg := range td.consMessages {
-		if consMsg.sender == cons.valKey.Address() &&
+		if consMsg.sender == cons.signer.Address() &&
 			consMsg.message.Type() == msgType {
 			require.Error(t, fmt.Errorf("should not public %s", msgType))
 		}
@@ -198,14 +205,14 @@ func (td *testData) shouldPublishQueryProposal(t *testing.T, cons *consensus, he
 	t.Helper()
 
 	for _, consMsg := range td.consMessages {
-		if consMsg.sender != cons.valKey.Address() ||
+		if consMsg.sender != cons.signer.Address() ||
 			consMsg.message.Type() != message.TypeQueryProposal {
 			continue
 		}
 
 		m := consMsg.message.(*message.QueryProposalMessage)
 		assert.Equal(t, m.Height, height)
-		assert.Equal(t, m.Querier, cons.valKey.Address())
+		assert.Equal(t, m.Querier, cons.signer.Address())
 
 		return
 	}
@@ -216,7 +223,7 @@ func (td *testData) shouldPublishQueryVote(t *testing.T, cons *consensus, height
 	t.Helper()
 
 	for _, consMsg := range td.consMessages {
-		if consMsg.sender != cons.valKey.Address() ||
+		if consMsg.sender != cons.signer.Address() ||
 			consMsg.message.Type() != message.TypeQueryVote {
 			continue
 		}
@@ -224,7 +231,7 @@ func (td *testData) shouldPublishQueryVote(t *testing.T, cons *consensus, height
 		m := consMsg.message.(*message.QueryVoteMessage)
 		assert.Equal(t, m.Height, height)
 		assert.Equal(t, m.Round, round)
-		assert.Equal(t, m.Querier, cons.valKey.Address())
+		assert.Equal(t, m.Querier, cons.signer.Address())
 
 		return
 	}
@@ -236,7 +243,7 @@ func (td *testData) shouldPublishVote(t *testing.T, cons *consensus, voteType vo
 
 	for i := len(td.consMessages) - 1; i >= 0; i-- {
 		consMsg := td.consMessages[i]
-		if consMsg.sender == cons.valKey.Address() &&
+		if consMsg.sender == cons.signer.Address() &&
 			consMsg.message.Type() == message.TypeVote {
 			m := consMsg.message.(*message.VoteMessage)
 			if m.Vote.Type() == voteType &&
@@ -347,10 +354,10 @@ func (td *testData) commitBlockForAllStates(t *testing.T) (*block.Block, *certif
 
 	cert := certificate.NewBlockCertificate(height+1, 0)
 	sb := cert.SignBytes(prop.Block().Hash())
-	sig1 := td.consX.valKey.Sign(sb)
-	sig2 := td.consY.valKey.Sign(sb)
-	sig3 := td.consB.valKey.Sign(sb)
-	sig4 := td.consP.valKey.Sign(sb)
+	sig1 := td.valKeys[tIndexX].Sign(sb)
+	sig2 := td.valKeys[tIndexY].Sign(sb)
+	sig3 := td.valKeys[tIndexB].Sign(sb)
+	sig4 := td.valKeys[tIndexP].Sign(sb)
 
 	sig := bls.SignatureAggregate(sig1, sig2, sig3, sig4)
 	cert.SetSignature([]int32{tIndexX, tIndexY, tIndexB, tIndexP}, []int32{}, sig)
@@ -374,25 +381,25 @@ func (td *testData) makeProposal(t *testing.T, height uint32, round int16) *prop
 	var p *proposal.Proposal
 	switch (height % 4) + uint32(round%4) {
 	case 1:
-		blk, err := td.consX.bcState.ProposeBlock(td.consX.valKey, td.consX.rewardAddr)
+		blk, err := td.consX.bcState.ProposeBlock(td.consX.signer, td.consX.rewardAddr)
 		require.NoError(t, err)
 		p = proposal.NewProposal(height, round, blk)
-		td.HelperSignProposal(td.consX.valKey, p)
+		td.HelperSignProposal(td.valKeys[tIndexX], p)
 	case 2:
-		blk, err := td.consY.bcState.ProposeBlock(td.consY.valKey, td.consY.rewardAddr)
+		blk, err := td.consY.bcState.ProposeBlock(td.consY.signer, td.consY.rewardAddr)
 		require.NoError(t, err)
 		p = proposal.NewProposal(height, round, blk)
-		td.HelperSignProposal(td.consY.valKey, p)
+		td.HelperSignProposal(td.valKeys[tIndexY], p)
 	case 3:
-		blk, err := td.consB.bcState.ProposeBlock(td.consB.valKey, td.consB.rewardAddr)
+		blk, err := td.consB.bcState.ProposeBlock(td.consB.signer, td.consB.rewardAddr)
 		require.NoError(t, err)
 		p = proposal.NewProposal(height, round, blk)
-		td.HelperSignProposal(td.consB.valKey, p)
+		td.HelperSignProposal(td.valKeys[tIndexB], p)
 	case 0, 4:
-		blk, err := td.consP.bcState.ProposeBlock(td.consP.valKey, td.consP.rewardAddr)
+		blk, err := td.consP.bcState.ProposeBlock(td.consP.signer, td.consP.rewardAddr)
 		require.NoError(t, err)
 		p = proposal.NewProposal(height, round, blk)
-		td.HelperSignProposal(td.consP.valKey, p)
+		td.HelperSignProposal(td.valKeys[tIndexP], p)
 	}
 
 	return p
@@ -401,18 +408,50 @@ func (td *testData) makeProposal(t *testing.T, height uint32, round int16) *prop
 func TestStart(t *testing.T) {
 	td := setup(t)
 
-	td.consX.Start()
+	require.NoError(t, td.consX.Start())
 	td.checkHeightRound(t, td.consX, 1, 0)
 }
 
+func TestWALPreventsDoubleSigning(t *testing.T) {
+	td := setup(t)
+
+	// Before restarting, consP has voted "No" for changing the proposer.
+	walPath := util.TempFilePath()
+	prevWAL := wal.NewWAL(walPath)
+	prevVote := vote.NewCPPreVote(hash.UndefHash, 1, 0, 0,
+		vote.CPValueNo, &vote.JustInitNo{}, td.consP.signer.Address())
+	require.NoError(t, prevWAL.Write(prevVote))
+
+	td.consP.wal = wal.NewWAL(walPath)
+	require.NoError(t, td.consP.Start())
+	td.changeProposerTimeout(td.consP)
+
+	td.shouldNotPublish(t, td.consP, message.TypeVote)
+	assert.Empty(t, td.consP.AllVotes())
+}
+
+func TestSignerRefusesToSign(t *testing.T) {
+	td := setup(t)
+
+	// The signer has already signed a vote for a higher round.
+	v := vote.NewPrepareVote(td.RandHash(), 1, 5, td.consP.signer.Address())
+	require.NoError(t, td.consP.signer.SignVote(v))
+
+	td.enterNewHeight(td.consP)
+	td.changeProposerTimeout(td.consP)
+
+	td.shouldNotPublish(t, td.consP, message.TypeVote)
+}
+
 func TestNotInCommittee(t *testing.T) {
 	td := setup(t)
 
 	valKey := td.RandValKey()
 	str := store.MockingStore(td.TestSuite)
 
-	st, _ := state.LoadOrNewState(td.genDoc, []*bls.ValidatorKey{valKey}, str, td.txPool, nil)
-	consInst := NewConsensus(testConfig(), st, valKey, valKey.Address(), make(chan message.Message, 100),
+	st, _ := state.LoadOrNewState(td.genDoc, []signer.Signer{signer.NewLocalSigner(valKey)}, str, td.txPool, nil)
+	consInst := NewConsensus(testConfig(), st, td.evdPool, signer.NewLocalSigner(valKey), valKey.Address(),
+		make(chan message.Message, 100),
 		newConcreteMediator())
 	cons := consInst.(*consensus)
 
@@ -457,6 +496,7 @@ func TestConsensusNormalCase(t *testing.T) {
 
 	td.enterNewHeight(td.consX)
 	td.checkHeightRound(t, td.consX, 2, 0)
+	lastVoteTime := td.consX.LastVoteTime()
 
 	p := td.makeProposal(t, 2, 0)
 	td.consX.SetProposal(p)
@@ -464,6 +504,7 @@ func TestConsensusNormalCase(t *testing.T) {
 	td.addPrepareVote(td.consX, p.Block().Hash(), 2, 0, tIndexY)
 	td.addPrepareVote(td.consX, p.Block().Hash(), 2, 0, tIndexP)
 	td.shouldPublishVote(t, td.consX, vote.VoteTypePrepare, p.Block().Hash())
+	assert.True(t, td.consX.LastVoteTime().After(lastVoteTime))
 
 	td.addPrecommitVote(td.consX, p.Block().Hash(), 2, 0, tIndexY)
 	td.addPrecommitVote(td.consX, p.Block().Hash(), 2, 0, tIndexP)
@@ -711,7 +752,7 @@ func TestDuplicateProposal(t *testing.T) {
 	p1 := td.makeProposal(t, h, r)
 	trx := tx.NewTransferTx(h, td.consX.rewardAddr, td.RandAccAddress(), 1000,
 		1000, tx.WithMemo("proposal changer"))
-	td.HelperSignTransaction(td.consX.valKey.PrivateKey(), trx)
+	td.HelperSignTransaction(td.valKeys[tIndexX].PrivateKey(), trx)
 
 	assert.NoError(t, td.txPool.AppendTx(trx))
 	p2 := td.makeProposal(t, h, r)
@@ -723,12 +764,33 @@ func TestDuplicateProposal(t *testing.T) {
 	assert.Equal(t, p1.Hash(), td.consX.Proposal().Hash())
 }
 
+func TestDuplicateVote(t *testing.T) {
+	td := setup(t)
+
+	td.commitBlockForAllStates(t)
+	td.enterNewHeight(td.consX)
+
+	h := uint32(2)
+	r := int16(0)
+	v1 := td.addPrepareVote(td.consX, td.RandHash(), h, r, tIndexB)
+	v2 := td.addPrepareVote(td.consX, td.RandHash(), h, r, tIndexB)
+
+	require.Equal(t, 1, td.evdPool.Size())
+	evd := td.evdPool.AllEvidence()[0]
+	assert.Equal(t, td.valKeys[tIndexB].Address(), evd.Offender())
+	assert.Equal(t, evidence.NewDuplicateVoteEvidence(v1, v2).Hash(), evd.Hash())
+
+	// Adding the same vote again should not create new evidence
+	td.addVote(td.consX, v2, tIndexB)
+	assert.Equal(t, 1, td.evdPool.Size())
+}
+
 func TestNonActiveValidator(t *testing.T) {
 	td := setup(t)
 
 	valKey := td.RandValKey()
-	consInst := NewConsensus(testConfig(), state.MockingState(td.TestSuite),
-		valKey, valKey.Address(), make(chan message.Message, 100), newConcreteMediator())
+	consInst := NewConsensus(testConfig(), state.MockingState(td.TestSuite), td.evdPool,
+		signer.NewLocalSigner(valKey), valKey.Address(), make(chan message.Message, 100), newConcreteMediator())
 	nonActiveCons := consInst.(*consensus)
 
 	t.Run("non-active instances should be in new-height state", func(t *testing.T) {
@@ -900,7 +962,7 @@ func TestByzantine(t *testing.T) {
 
 	td.consB.AddVote(voteX)
 	td.consB.AddVote(voteY)
-	td.shouldPublishVote(t, td.consB, vote.VoteTypePrepare, p1.Block().Hash())
+	byzPrepare := td.shouldPublishVote(t, td.consB, vote.VoteTypePrepare, p1.Block().Hash())
 	td.shouldPublishVote(t, td.consB, vote.VoteTypePrecommit, p1.Block().Hash())
 
 	td.changeProposerTimeout(td.consB)
@@ -913,13 +975,13 @@ func TestByzantine(t *testing.T) {
 	// Byzantine node create the second proposal and send it to the partitioned node P
 	byzTrx := tx.NewTransferTx(h,
 		td.consB.rewardAddr, td.RandAccAddress(), 1000, 1000)
-	td.HelperSignTransaction(td.consB.valKey.PrivateKey(), byzTrx)
+	td.HelperSignTransaction(td.valKeys[tIndexB].PrivateKey(), byzTrx)
 	assert.NoError(t, td.txPool.AppendTx(byzTrx))
 	p2 := td.makeProposal(t, h, r)
 
 	require.NotEqual(t, p1.Block().Hash(), p2.Block().Hash())
-	require.Equal(t, td.consB.valKey.Address(), p1.Block().Header().ProposerAddress())
-	require.Equal(t, td.consB.valKey.Address(), p2.Block().Header().ProposerAddress())
+	require.Equal(t, td.consB.signer.Address(), p1.Block().Header().ProposerAddress())
+	require.Equal(t, td.consB.signer.Address(), p2.Block().Header().ProposerAddress())
 
 	td.enterNewHeight(td.consP)
 
@@ -944,15 +1006,17 @@ func TestByzantine(t *testing.T) {
 
 	// Let's make Byzantine node happy by removing his votes from the log
 	for j := len(td.consMessages) - 1; j >= 0; j-- {
-		if td.consMessages[j].sender == td.consB.valKey.Address() {
+		if td.consMessages[j].sender == td.consB.signer.Address() {
 			td.consMessages = slices.Delete(td.consMessages, j, j+1)
 		}
 	}
 
 	// =================================
 	// Now, Partition heals
+	// P has prepared the second proposal, and its signer refuses to sign a conflicting prepare vote.
+	// If the proposer is not changed, the prepare vote of B for the first proposal makes the quorum.
 	fmt.Println("== Partition heals")
-	cert, err := checkConsensus(td, h, []*vote.Vote{byzVote1, byzVote2})
+	cert, err := checkConsensus(td, h, []*vote.Vote{byzPrepare, byzVote1, byzVote2})
 
 	require.NoError(t, err)
 	require.Equal(t, h, cert.Height())
@@ -1006,7 +1070,7 @@ func checkConsensus(td *testData, height uint32, byzVotes []*vote.Vote) (
 					p := cons.HandleQueryProposal(m.Height, m.Round)
 					if p != nil {
 						td.consMessages = append(td.consMessages, consMessage{
-							sender:  cons.valKey.Address(),
+							sender:  cons.signer.Address(),
 							message: message.NewProposalMessage(p),
 						})
 					}
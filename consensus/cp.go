@@ -311,6 +311,13 @@ func (cp *changeProposer) checkJust(v *vote.Vote) error {
 }
 
 func (cp *changeProposer) cpStrongTermination() {
+	if cp.cpDecided != -1 {
+		// The change-proposer phase decides once per round.
+		// Our own DECIDED vote is already in the log when the decision is made,
+		// and it shouldn't make us decide again and re-enter the next state.
+		return
+	}
+
 	cpDecided := cp.log.CPDecidedVoteSet(cp.round)
 	if cpDecided.HasAnyVoteFor(cp.cpRound, vote.CPValueNo) {
 		cp.cpDecide(cp.round, vote.CPValueNo)
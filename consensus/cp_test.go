@@ -6,6 +6,7 @@ import (
 
 	"github.com/pactus-project/pactus/crypto"
 	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/sync/bundle/message"
 	"github.com/pactus-project/pactus/types/vote"
 	"github.com/stretchr/testify/assert"
 )
@@ -90,6 +91,60 @@ func TestChangeProposerAgreement0(t *testing.T) {
 	td.checkHeightRound(t, td.consP, h, r)
 }
 
+// countQueryProposals returns the number of the query proposal messages published by the consensus.
+func (td *testData) countQueryProposals(cons *consensus) int {
+	count := 0
+	for _, consMsg := range td.consMessages {
+		if consMsg.sender == cons.signer.Address() &&
+			consMsg.message.Type() == message.TypeQueryProposal {
+			count++
+		}
+	}
+
+	return count
+}
+
+// ConsP decides on its own main votes and receives its own decided vote.
+// The decided vote should not make it decide again and re-enter the prepare state.
+func TestChangeProposerDecideOnce(t *testing.T) {
+	td := setup(t)
+
+	td.commitBlockForAllStates(t) // height 1
+
+	h := uint32(2)
+	r := int16(1)
+	td.enterNewHeight(td.consP)
+	td.enterNextRound(td.consP)
+
+	p := td.makeProposal(t, h, r)
+
+	td.addPrepareVote(td.consP, p.Block().Hash(), h, r, tIndexX)
+	td.addPrepareVote(td.consP, p.Block().Hash(), h, r, tIndexY)
+	td.addPrepareVote(td.consP, p.Block().Hash(), h, r, tIndexB)
+
+	td.changeProposerTimeout(td.consP)
+
+	preVote0 := td.shouldPublishVote(t, td.consP, vote.VoteTypeCPPreVote, p.Block().Hash())
+	td.addCPPreVote(td.consP, p.Block().Hash(), h, r, vote.CPValueNo, preVote0.CPJust(), tIndexX)
+	td.addCPPreVote(td.consP, p.Block().Hash(), h, r, vote.CPValueNo, preVote0.CPJust(), tIndexY)
+
+	queries := td.countQueryProposals(td.consP)
+
+	mainVote0 := td.shouldPublishVote(t, td.consP, vote.VoteTypeCPMainVote, p.Block().Hash())
+	td.addCPMainVote(td.consP, p.Block().Hash(), h, r, vote.CPValueNo, mainVote0.CPJust(), tIndexX)
+	td.addCPMainVote(td.consP, p.Block().Hash(), h, r, vote.CPValueNo, mainVote0.CPJust(), tIndexY)
+
+	decided := td.shouldPublishVote(t, td.consP, vote.VoteTypeCPDecided, p.Block().Hash())
+	td.addCPDecidedVote(td.consP, p.Block().Hash(), h, r, vote.CPValueNo, decided.CPJust(), tIndexX)
+
+	// Deciding "no" queries the missing proposal and enters the prepare state.
+	// The prepare state has a quorum and enters the precommit state, which queries it again.
+	// Deciding twice would repeat both queries.
+	assert.Equal(t, queries+2, td.countQueryProposals(td.consP), "should decide only once")
+	assert.Equal(t, 0, td.consP.cpDecided)
+	td.checkHeightRound(t, td.consP, h, r)
+}
+
 // ConsP receives all PRE-VOTE:0 votes before receiving a proposal or prepare votes.
 // It should vote PRE-VOTES:1 and MAIN-VOTE:0.
 func TestCrashOnTestnet(t *testing.T) {
@@ -102,13 +157,13 @@ func TestCrashOnTestnet(t *testing.T) {
 	td.consP.MoveToNewHeight()
 
 	blockHash := td.RandHash()
-	v1 := vote.NewPrepareVote(blockHash, h, r, td.consX.valKey.Address())
-	v2 := vote.NewPrepareVote(blockHash, h, r, td.consY.valKey.Address())
-	v3 := vote.NewPrepareVote(blockHash, h, r, td.consB.valKey.Address())
+	v1 := vote.NewPrepareVote(blockHash, h, r, td.consX.signer.Address())
+	v2 := vote.NewPrepareVote(blockHash, h, r, td.consY.signer.Address())
+	v3 := vote.NewPrepareVote(blockHash, h, r, td.consB.signer.Address())
 
-	td.HelperSignVote(td.consX.valKey, v1)
-	td.HelperSignVote(td.consY.valKey, v2)
-	td.HelperSignVote(td.consB.valKey, v3)
+	td.HelperSignVote(td.valKeys[tIndexX], v1)
+	td.HelperSignVote(td.valKeys[tIndexY], v2)
+	td.HelperSignVote(td.valKeys[tIndexB], v3)
 
 	votes := map[crypto.Address]*vote.Vote{}
 	votes[v1.Signer()] = v1
@@ -139,7 +194,7 @@ func TestInvalidJustInitOne(t *testing.T) {
 	just := &vote.JustInitYes{}
 
 	t.Run("invalid value: no", func(t *testing.T) {
-		v := vote.NewCPPreVote(hash.UndefHash, h, r, 0, vote.CPValueNo, just, td.consB.valKey.Address())
+		v := vote.NewCPPreVote(hash.UndefHash, h, r, 0, vote.CPValueNo, just, td.consB.signer.Address())
 
 		err := td.consX.changeProposer.checkJust(v)
 		assert.ErrorIs(t, err, invalidJustificationError{
@@ -149,7 +204,7 @@ func TestInvalidJustInitOne(t *testing.T) {
 	})
 
 	t.Run("invalid block hash", func(t *testing.T) {
-		v := vote.NewCPPreVote(hash.UndefHash, h, r, 1, vote.CPValueYes, just, td.consB.valKey.Address())
+		v := vote.NewCPPreVote(hash.UndefHash, h, r, 1, vote.CPValueYes, just, td.consB.signer.Address())
 
 		err := td.consX.changeProposer.checkJust(v)
 		assert.ErrorIs(t, err, invalidJustificationError{
@@ -160,7 +215,7 @@ func TestInvalidJustInitOne(t *testing.T) {
 
 	t.Run("with main-vote justification", func(t *testing.T) {
 		invJust := &vote.JustMainVoteNoConflict{}
-		v := vote.NewCPPreVote(td.RandHash(), h, r, 0, vote.CPValueYes, invJust, td.consB.valKey.Address())
+		v := vote.NewCPPreVote(td.RandHash(), h, r, 0, vote.CPValueYes, invJust, td.consB.signer.Address())
 
 		err := td.consX.changeProposer.checkJust(v)
 		assert.ErrorIs(t, err, invalidJustificationError{
@@ -181,7 +236,7 @@ func TestInvalidJustInitZero(t *testing.T) {
 	}
 
 	t.Run("invalid value: yes", func(t *testing.T) {
-		v := vote.NewCPPreVote(td.RandHash(), h, r, 0, vote.CPValueYes, just, td.consB.valKey.Address())
+		v := vote.NewCPPreVote(td.RandHash(), h, r, 0, vote.CPValueYes, just, td.consB.signer.Address())
 
 		err := td.consX.changeProposer.checkJust(v)
 		assert.ErrorIs(t, err, invalidJustificationError{
@@ -191,7 +246,7 @@ func TestInvalidJustInitZero(t *testing.T) {
 	})
 
 	t.Run("cp-round should be zero", func(t *testing.T) {
-		v := vote.NewCPPreVote(td.RandHash(), h, r, 1, vote.CPValueNo, just, td.consB.valKey.Address())
+		v := vote.NewCPPreVote(td.RandHash(), h, r, 1, vote.CPValueNo, just, td.consB.signer.Address())
 
 		err := td.consX.changeProposer.checkJust(v)
 		assert.ErrorIs(t, err, invalidJustificationError{
@@ -201,7 +256,7 @@ func TestInvalidJustInitZero(t *testing.T) {
 	})
 
 	t.Run("invalid certificate", func(t *testing.T) {
-		v := vote.NewCPPreVote(td.RandHash(), h, r, 0, vote.CPValueNo, just, td.consB.valKey.Address())
+		v := vote.NewCPPreVote(td.RandHash(), h, r, 0, vote.CPValueNo, just, td.consB.signer.Address())
 
 		err := td.consX.changeProposer.checkJust(v)
 		assert.ErrorIs(t, err, invalidJustificationError{
@@ -222,7 +277,7 @@ func TestInvalidJustPreVoteHard(t *testing.T) {
 	}
 
 	t.Run("invalid value: abstain", func(t *testing.T) {
-		v := vote.NewCPPreVote(td.RandHash(), h, r, 1, vote.CPValueAbstain, just, td.consB.valKey.Address())
+		v := vote.NewCPPreVote(td.RandHash(), h, r, 1, vote.CPValueAbstain, just, td.consB.signer.Address())
 
 		err := td.consX.changeProposer.checkJust(v)
 		assert.ErrorIs(t, err, invalidJustificationError{
@@ -232,7 +287,7 @@ func TestInvalidJustPreVoteHard(t *testing.T) {
 	})
 
 	t.Run("cp-round should not be zero", func(t *testing.T) {
-		v := vote.NewCPPreVote(td.RandHash(), h, r, 0, vote.CPValueNo, just, td.consB.valKey.Address())
+		v := vote.NewCPPreVote(td.RandHash(), h, r, 0, vote.CPValueNo, just, td.consB.signer.Address())
 
 		err := td.consX.changeProposer.checkJust(v)
 		assert.ErrorIs(t, err, invalidJustificationError{
@@ -242,7 +297,7 @@ func TestInvalidJustPreVoteHard(t *testing.T) {
 	})
 
 	t.Run("invalid certificate", func(t *testing.T) {
-		v := vote.NewCPPreVote(td.RandHash(), h, r, 1, vote.CPValueNo, just, td.consB.valKey.Address())
+		v := vote.NewCPPreVote(td.RandHash(), h, r, 1, vote.CPValueNo, just, td.consB.signer.Address())
 
 		err := td.consX.changeProposer.checkJust(v)
 		assert.ErrorIs(t, err, invalidJustificationError{
@@ -263,7 +318,7 @@ func TestInvalidJustPreVoteSoft(t *testing.T) {
 	}
 
 	t.Run("invalid value: abstain", func(t *testing.T) {
-		v := vote.NewCPPreVote(td.RandHash(), h, r, 1, vote.CPValueAbstain, just, td.consB.valKey.Address())
+		v := vote.NewCPPreVote(td.RandHash(), h, r, 1, vote.CPValueAbstain, just, td.consB.signer.Address())
 
 		err := td.consX.changeProposer.checkJust(v)
 		assert.ErrorIs(t, err, invalidJustificationError{
@@ -273,7 +328,7 @@ func TestInvalidJustPreVoteSoft(t *testing.T) {
 	})
 
 	t.Run("cp-round should not be zero", func(t *testing.T) {
-		v := vote.NewCPPreVote(td.RandHash(), h, r, 0, vote.CPValueNo, just, td.consB.valKey.Address())
+		v := vote.NewCPPreVote(td.RandHash(), h, r, 0, vote.CPValueNo, just, td.consB.signer.Address())
 
 		err := td.consX.changeProposer.checkJust(v)
 		assert.ErrorIs(t, err, invalidJustificationError{
@@ -283,7 +338,7 @@ func TestInvalidJustPreVoteSoft(t *testing.T) {
 	})
 
 	t.Run("invalid certificate", func(t *testing.T) {
-		v := vote.NewCPPreVote(td.RandHash(), h, r, 1, vote.CPValueNo, just, td.consB.valKey.Address())
+		v := vote.NewCPPreVote(td.RandHash(), h, r, 1, vote.CPValueNo, just, td.consB.signer.Address())
 
 		err := td.consX.changeProposer.checkJust(v)
 		assert.ErrorIs(t, err, invalidJustificationError{
@@ -304,7 +359,7 @@ func TestInvalidJustMainVoteNoConflict(t *testing.T) {
 	}
 
 	t.Run("invalid value: abstain", func(t *testing.T) {
-		v := vote.NewCPMainVote(td.RandHash(), h, r, 1, vote.CPValueAbstain, just, td.consB.valKey.Address())
+		v := vote.NewCPMainVote(td.RandHash(), h, r, 1, vote.CPValueAbstain, just, td.consB.signer.Address())
 
 		err := td.consX.changeProposer.checkJust(v)
 		assert.ErrorIs(t, err, invalidJustificationError{
@@ -314,7 +369,7 @@ func TestInvalidJustMainVoteNoConflict(t *testing.T) {
 	})
 
 	t.Run("invalid certificate", func(t *testing.T) {
-		v := vote.NewCPMainVote(td.RandHash(), h, r, 1, vote.CPValueNo, just, td.consB.valKey.Address())
+		v := vote.NewCPMainVote(td.RandHash(), h, r, 1, vote.CPValueNo, just, td.consB.signer.Address())
 
 		err := td.consX.changeProposer.checkJust(v)
 		assert.ErrorIs(t, err, invalidJustificationError{
@@ -338,7 +393,7 @@ func TestInvalidJustMainVoteConflict(t *testing.T) {
 			},
 			JustYes: &vote.JustInitYes{},
 		}
-		v := vote.NewCPMainVote(td.RandHash(), h, r, 0, vote.CPValueNo, just, td.consB.valKey.Address())
+		v := vote.NewCPMainVote(td.RandHash(), h, r, 0, vote.CPValueNo, just, td.consB.signer.Address())
 
 		err := td.consX.changeProposer.checkJust(v)
 		assert.ErrorIs(t, err, invalidJustificationError{
@@ -354,7 +409,7 @@ func TestInvalidJustMainVoteConflict(t *testing.T) {
 			},
 			JustYes: &vote.JustInitYes{},
 		}
-		v := vote.NewCPMainVote(td.RandHash(), h, r, 0, vote.CPValueYes, just, td.consB.valKey.Address())
+		v := vote.NewCPMainVote(td.RandHash(), h, r, 0, vote.CPValueYes, just, td.consB.signer.Address())
 
 		err := td.consX.changeProposer.checkJust(v)
 		assert.ErrorIs(t, err, invalidJustificationError{
@@ -370,7 +425,7 @@ func TestInvalidJustMainVoteConflict(t *testing.T) {
 			},
 			JustYes: &vote.JustInitYes{},
 		}
-		v := vote.NewCPMainVote(td.RandHash(), h, r, 0, vote.CPValueAbstain, just, td.consB.valKey.Address())
+		v := vote.NewCPMainVote(td.RandHash(), h, r, 0, vote.CPValueAbstain, just, td.consB.signer.Address())
 
 		err := td.consX.changeProposer.checkJust(v)
 		assert.ErrorIs(t, err, invalidJustificationError{
@@ -388,7 +443,7 @@ func TestInvalidJustMainVoteConflict(t *testing.T) {
 				QCert: td.GenerateTestPrepareCertificate(h),
 			},
 		}
-		v := vote.NewCPMainVote(td.RandHash(), h, r, 1, vote.CPValueAbstain, just, td.consB.valKey.Address())
+		v := vote.NewCPMainVote(td.RandHash(), h, r, 1, vote.CPValueAbstain, just, td.consB.signer.Address())
 
 		err := td.consX.changeProposer.checkJust(v)
 		assert.ErrorIs(t, err, invalidJustificationError{
@@ -405,7 +460,7 @@ func TestInvalidJustMainVoteConflict(t *testing.T) {
 			JustNo:  just0,
 			JustYes: &vote.JustInitYes{},
 		}
-		v := vote.NewCPMainVote(td.RandHash(), h, r, 0, vote.CPValueAbstain, just, td.consB.valKey.Address())
+		v := vote.NewCPMainVote(td.RandHash(), h, r, 0, vote.CPValueAbstain, just, td.consB.signer.Address())
 
 		err := td.consX.changeProposer.checkJust(v)
 		assert.ErrorIs(t, err, invalidJustificationError{
@@ -424,7 +479,7 @@ func TestInvalidJustMainVoteConflict(t *testing.T) {
 				QCert: td.GenerateTestPrepareCertificate(h),
 			},
 		}
-		v := vote.NewCPMainVote(td.RandHash(), h, r, 1, vote.CPValueAbstain, just, td.consB.valKey.Address())
+		v := vote.NewCPMainVote(td.RandHash(), h, r, 1, vote.CPValueAbstain, just, td.consB.signer.Address())
 
 		err := td.consX.changeProposer.checkJust(v)
 		assert.ErrorIs(t, err, invalidJustificationError{
@@ -445,7 +500,7 @@ func TestInvalidJustDecided(t *testing.T) {
 	}
 
 	t.Run("invalid value: abstain", func(t *testing.T) {
-		v := vote.NewCPDecidedVote(td.RandHash(), h, r, 0, vote.CPValueAbstain, just, td.consB.valKey.Address())
+		v := vote.NewCPDecidedVote(td.RandHash(), h, r, 0, vote.CPValueAbstain, just, td.consB.signer.Address())
 
 		err := td.consX.changeProposer.checkJust(v)
 		assert.ErrorIs(t, err, invalidJustificationError{
@@ -455,7 +510,7 @@ func TestInvalidJustDecided(t *testing.T) {
 	})
 
 	t.Run("invalid certificate", func(t *testing.T) {
-		v := vote.NewCPDecidedVote(td.RandHash(), h, r, 0, vote.CPValueYes, just, td.consB.valKey.Address())
+		v := vote.NewCPDecidedVote(td.RandHash(), h, r, 0, vote.CPValueYes, just, td.consB.signer.Address())
 
 		err := td.consX.changeProposer.checkJust(v)
 		assert.ErrorIs(t, err, invalidJustificationError{
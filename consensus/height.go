@@ -1,8 +1,6 @@
 package consensus
 
 import (
-	"time"
-
 	"github.com/pactus-project/pactus/types/proposal"
 	"github.com/pactus-project/pactus/types/vote"
 )
@@ -23,10 +21,11 @@ func (s *newHeightState) decide() {
 	s.validators = validators
 	s.height = sateHeight + 1
 	s.round = 0
-	s.active = s.bcState.IsInCommittee(s.valKey.Address())
+	s.active = s.bcState.IsInCommittee(s.signer.Address())
 	s.logger.Info("entering new height", "height", s.height, "active", s.active)
+	s.history.startHeight(s.height, s.clock.Now())
 
-	sleep := time.Until(s.bcState.LastBlockTime().Add(s.bcState.Params().BlockInterval()))
+	sleep := s.bcState.LastBlockTime().Add(s.bcState.Params().BlockInterval()).Sub(s.clock.Now())
 	s.scheduleTimeout(sleep, s.height, s.round, tickerTargetNewHeight)
 }
 
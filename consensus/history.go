@@ -0,0 +1,239 @@
+package consensus
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pactus-project/pactus/types/vote"
+)
+
+// historyLimit is the number of heights that the consensus history keeps.
+const historyLimit = 100
+
+const (
+	phaseChangeProposer = "change-proposer"
+
+	queryVote     = "query-vote"
+	queryProposal = "query-proposal"
+)
+
+// HeightStats is the breakdown of the consensus process of a height.
+type HeightStats struct {
+	Height    uint32
+	Rounds    int16
+	Committed bool
+	StartTime time.Time
+	Duration  time.Duration
+	// PhaseDurations is the time spent in each phase, keyed by the state name.
+	// The change-proposer states are counted together as the change-proposer phase.
+	PhaseDurations map[string]time.Duration
+	// Timeouts is the number of expired timers, keyed by the ticker target.
+	Timeouts map[string]int
+	// ReceivedVotes is the number of votes received from other validators, keyed by the vote type.
+	ReceivedVotes          map[string]int
+	SentQueryVotes         int
+	SentQueryProposals     int
+	ReceivedQueryVotes     int
+	ReceivedQueryProposals int
+}
+
+func newHeightStats(height uint32, now time.Time) *HeightStats {
+	return &HeightStats{
+		Height:         height,
+		StartTime:      now,
+		PhaseDurations: make(map[string]time.Duration),
+		Timeouts:       make(map[string]int),
+		ReceivedVotes:  make(map[string]int),
+	}
+}
+
+func (hs *HeightStats) clone() *HeightStats {
+	cloned := *hs
+	cloned.PhaseDurations = make(map[string]time.Duration, len(hs.PhaseDurations))
+	for phase, d := range hs.PhaseDurations {
+		cloned.PhaseDurations[phase] = d
+	}
+	cloned.Timeouts = make(map[string]int, len(hs.Timeouts))
+	for target, n := range hs.Timeouts {
+		cloned.Timeouts[target] = n
+	}
+	cloned.ReceivedVotes = make(map[string]int, len(hs.ReceivedVotes))
+	for typ, n := range hs.ReceivedVotes {
+		cloned.ReceivedVotes[typ] = n
+	}
+
+	return &cloned
+}
+
+// history keeps the consensus statistics of the recent heights and updates the metrics.
+// It has its own lock, since queries are handled while the consensus is read-locked.
+type history struct {
+	lk sync.Mutex
+
+	validator string
+	current   *HeightStats
+	phase     string
+	heights   []*HeightStats
+
+	// The phase can continue in the next height, so the time spent in the phase
+	// is added to the statistics of the height separately.
+	phaseEnteredAt time.Time
+	phaseCountedAt time.Time
+}
+
+func newHistory(validator string) *history {
+	return &history{
+		validator: validator,
+		heights:   make([]*HeightStats, 0),
+	}
+}
+
+func phaseName(stateName string) string {
+	if strings.HasPrefix(stateName, "cp:") {
+		return phaseChangeProposer
+	}
+
+	return stateName
+}
+
+// enterState records the time spent in the previous phase.
+func (h *history) enterState(stateName string, round int16, now time.Time) {
+	h.lk.Lock()
+	defer h.lk.Unlock()
+
+	h.closePhase(now)
+	h.phase = phaseName(stateName)
+	h.phaseEnteredAt = now
+	h.phaseCountedAt = now
+
+	if h.current == nil {
+		return
+	}
+	if stateName != "new-height" && round+1 > h.current.Rounds {
+		h.current.Rounds = round + 1
+	}
+	if stateName == "commit" {
+		h.current.Committed = true
+	}
+}
+
+func (h *history) closePhase(now time.Time) {
+	if h.phase == "" {
+		return
+	}
+
+	h.countPhase(now)
+	phaseDurationMetric.WithLabelValues(h.validator, h.phase).Observe(now.Sub(h.phaseEnteredAt).Seconds())
+}
+
+// countPhase adds the time spent in the current phase to the statistics of the current height.
+func (h *history) countPhase(now time.Time) {
+	if h.current != nil && h.phase != "" {
+		h.current.PhaseDurations[h.phase] += now.Sub(h.phaseCountedAt)
+	}
+	h.phaseCountedAt = now
+}
+
+// startHeight finishes the statistics of the current height and starts a new one.
+func (h *history) startHeight(height uint32, now time.Time) {
+	h.lk.Lock()
+	defer h.lk.Unlock()
+
+	h.countPhase(now)
+
+	if h.current != nil {
+		h.current.Duration = now.Sub(h.current.StartTime)
+		if h.current.Rounds > 0 {
+			roundsPerHeightMetric.WithLabelValues(h.validator).Observe(float64(h.current.Rounds))
+		}
+
+		h.heights = append(h.heights, h.current)
+		if len(h.heights) > historyLimit {
+			h.heights = h.heights[1:]
+		}
+	}
+
+	h.current = newHeightStats(height, now)
+}
+
+func (h *history) timeout(target tickerTarget) {
+	h.lk.Lock()
+	defer h.lk.Unlock()
+
+	if h.current != nil {
+		h.current.Timeouts[target.String()]++
+	}
+	timeoutsMetric.WithLabelValues(h.validator, target.String()).Inc()
+}
+
+func (h *history) voteReceived(typ vote.Type) {
+	h.lk.Lock()
+	defer h.lk.Unlock()
+
+	if h.current != nil {
+		h.current.ReceivedVotes[typ.String()]++
+	}
+	receivedVotesMetric.WithLabelValues(h.validator, typ.String()).Inc()
+}
+
+func (h *history) querySent(query string) {
+	h.lk.Lock()
+	defer h.lk.Unlock()
+
+	if h.current != nil {
+		switch query {
+		case queryVote:
+			h.current.SentQueryVotes++
+		case queryProposal:
+			h.current.SentQueryProposals++
+		}
+	}
+	queriesMetric.WithLabelValues(h.validator, query, "sent").Inc()
+}
+
+func (h *history) queryReceived(query string) {
+	h.lk.Lock()
+	defer h.lk.Unlock()
+
+	if h.current != nil {
+		switch query {
+		case queryVote:
+			h.current.ReceivedQueryVotes++
+		case queryProposal:
+			h.current.ReceivedQueryProposals++
+		}
+	}
+	queriesMetric.WithLabelValues(h.validator, query, "received").Inc()
+}
+
+// recent returns the statistics of the last count heights, including the current height.
+// The statistics of the current height are calculated up to now.
+func (h *history) recent(count int, now time.Time) []*HeightStats {
+	h.lk.Lock()
+	defer h.lk.Unlock()
+
+	stats := make([]*HeightStats, 0)
+	if count <= 0 {
+		return stats
+	}
+
+	if h.current != nil {
+		count--
+	}
+	start := max(len(h.heights)-count, 0)
+	for _, hs := range h.heights[start:] {
+		stats = append(stats, hs.clone())
+	}
+
+	if h.current != nil {
+		current := h.current.clone()
+		current.Duration = now.Sub(current.StartTime)
+		if h.phase != "" {
+			current.PhaseDurations[h.phase] += now.Sub(h.phaseCountedAt)
+		}
+		stats = append(stats, current)
+	}
+
+	return stats
+}
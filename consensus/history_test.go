@@ -0,0 +1,94 @@
+package consensus
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pactus-project/pactus/types/vote"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistory(t *testing.T) {
+	hist := newHistory("validator")
+	now := time.Unix(1000, 0)
+	after := func(d time.Duration) time.Time {
+		now = now.Add(d)
+
+		return now
+	}
+
+	hist.enterState("new-height", 0, now)
+	hist.startHeight(1, now)
+	hist.enterState("propose", 0, after(10*time.Second))
+	hist.enterState("prepare", 0, now)
+	hist.querySent(queryProposal)
+	hist.timeout(tickerTargetQueryProposal)
+	hist.timeout(tickerTargetChangeProposer)
+	hist.enterState("cp:pre-vote", 0, after(2*time.Second))
+	hist.voteReceived(vote.VoteTypeCPPreVote)
+	hist.enterState("cp:main-vote", 0, after(time.Second))
+	hist.voteReceived(vote.VoteTypeCPMainVote)
+	hist.enterState("cp:decide", 0, after(time.Second))
+	hist.enterState("propose", 1, now)
+	hist.enterState("prepare", 1, now)
+	hist.voteReceived(vote.VoteTypePrepare)
+	hist.queryReceived(queryVote)
+	hist.enterState("precommit", 1, after(time.Second))
+	hist.enterState("commit", 1, after(time.Second))
+	hist.enterState("new-height", 1, now)
+	hist.startHeight(2, now)
+	hist.enterState("propose", 0, after(5*time.Second))
+
+	stats := hist.recent(10, after(time.Second))
+	require.Len(t, stats, 2)
+
+	h1 := stats[0]
+	assert.Equal(t, uint32(1), h1.Height)
+	assert.Equal(t, int16(2), h1.Rounds)
+	assert.True(t, h1.Committed)
+	assert.Equal(t, time.Unix(1000, 0), h1.StartTime)
+	assert.Equal(t, 16*time.Second, h1.Duration)
+	assert.Equal(t, 10*time.Second, h1.PhaseDurations["new-height"])
+	assert.Equal(t, 3*time.Second, h1.PhaseDurations["prepare"])
+	assert.Equal(t, 2*time.Second, h1.PhaseDurations[phaseChangeProposer])
+	assert.Equal(t, time.Second, h1.PhaseDurations["precommit"])
+	assert.Equal(t, 1, h1.Timeouts["query-proposal"])
+	assert.Equal(t, 1, h1.Timeouts["change-proposer"])
+	assert.Equal(t, 1, h1.ReceivedVotes["PRE-VOTE"])
+	assert.Equal(t, 1, h1.ReceivedVotes["MAIN-VOTE"])
+	assert.Equal(t, 1, h1.ReceivedVotes["PREPARE"])
+	assert.Equal(t, 1, h1.SentQueryProposals)
+	assert.Equal(t, 1, h1.ReceivedQueryVotes)
+
+	// The current height is calculated up to now.
+	h2 := stats[1]
+	assert.Equal(t, uint32(2), h2.Height)
+	assert.Equal(t, int16(1), h2.Rounds)
+	assert.False(t, h2.Committed)
+	assert.Equal(t, 6*time.Second, h2.Duration)
+	assert.Equal(t, 5*time.Second, h2.PhaseDurations["new-height"])
+	assert.Equal(t, time.Second, h2.PhaseDurations["propose"])
+
+	// The returned statistics are copies.
+	h2.PhaseDurations["propose"] = 0
+	assert.Equal(t, 2*time.Second, hist.recent(1, after(time.Second))[0].PhaseDurations["propose"])
+
+	assert.Empty(t, hist.recent(0, now))
+	assert.Len(t, hist.recent(1, now), 1)
+}
+
+func TestHistoryLimit(t *testing.T) {
+	hist := newHistory("validator")
+	now := time.Now()
+
+	for height := uint32(1); height <= historyLimit+10; height++ {
+		hist.enterState("new-height", 0, now)
+		hist.startHeight(height, now)
+	}
+
+	stats := hist.recent(historyLimit+10, now)
+	require.Len(t, stats, historyLimit+1)
+	assert.Equal(t, uint32(10), stats[0].Height)
+	assert.Equal(t, uint32(historyLimit+10), stats[historyLimit].Height)
+}
@@ -1,6 +1,8 @@
 package consensus
 
 import (
+	"time"
+
 	"github.com/pactus-project/pactus/crypto/bls"
 	"github.com/pactus-project/pactus/crypto/hash"
 	"github.com/pactus-project/pactus/types/proposal"
@@ -17,12 +19,15 @@ type Reader interface {
 	HeightRound() (uint32, int16)
 	IsActive() bool
 	IsProposer() bool
+	LastVoteTime() time.Time
+	History(count int) []*HeightStats
 }
 
 type Consensus interface {
 	Reader
 
-	Start()
+	Start() error
+	Stop()
 	MoveToNewHeight()
 	AddVote(vte *vote.Vote)
 	SetProposal(prop *proposal.Proposal)
@@ -1,8 +1,10 @@
 package consensus
 
 import (
+	"github.com/pactus-project/pactus/consensus/record"
 	"github.com/pactus-project/pactus/crypto"
-	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/evidencepool"
+	"github.com/pactus-project/pactus/signer"
 	"github.com/pactus-project/pactus/state"
 	"github.com/pactus-project/pactus/sync/bundle/message"
 	"github.com/pactus-project/pactus/types/proposal"
@@ -12,6 +14,7 @@ import (
 )
 
 type manager struct {
+	config    *Config
 	instances []Consensus
 
 	// Caching future votes and proposals due to potential server time misalignments.
@@ -20,47 +23,79 @@ type manager struct {
 	upcomingVotes     []*vote.Vote         // Map to cache votes for future block heights
 	upcomingProposals []*proposal.Proposal // Map to cache proposals for future block heights
 	state             state.Facade
+	recorder          record.Recorder
+	recordFile        *record.FileWriter
 }
 
 // NewManager creates a new manager instance that manages a set of consensus instances,
-// each associated with a validator key and a reward address.
+// each associated with a validator signer and a reward address.
 // It is not thread-safe.
 func NewManager(
 	conf *Config,
 	st state.Facade,
-	valKeys []*bls.ValidatorKey,
+	evdPool evidencepool.EvidencePool,
+	signers []signer.Signer,
 	rewardAddrs []crypto.Address,
 	broadcastCh chan message.Message,
 ) Manager {
 	mgr := &manager{
-		instances:         make([]Consensus, len(valKeys)),
+		config:            conf,
+		instances:         make([]Consensus, len(signers)),
 		upcomingVotes:     make([]*vote.Vote, 0),
 		upcomingProposals: make([]*proposal.Proposal, 0),
 		state:             st,
 	}
 	mediatorConcrete := newConcreteMediator()
 
-	for i, key := range valKeys {
-		cons := NewConsensus(conf, st, key, rewardAddrs[i], broadcastCh, mediatorConcrete)
+	if conf.EnableMetrics {
+		registerMetrics()
+	}
 
-		mgr.instances[i] = cons
+	broadcaster := func(_ crypto.Address, msg message.Message) {
+		broadcastCh <- msg
+	}
+	for i, sgnr := range signers {
+		mgr.instances[i] = makeConsensus(conf, st, evdPool, sgnr, rewardAddrs[i], broadcaster, mediatorConcrete)
 	}
 
 	return mgr
 }
 
-// Start starts the manager.
+// Start opens the record file if recording is enabled, and starts the consensus instances.
 func (mgr *manager) Start() error {
+	if path := mgr.config.RecordFilePath(); path != "" && mgr.recordFile == nil {
+		recordFile, err := record.OpenFile(path)
+		if err != nil {
+			return err
+		}
+		mgr.recordFile = recordFile
+		mgr.recorder = recordFile
+		mgr.setRecorder(recordFile)
+		mgr.recordInstances()
+	}
+
 	logger.Debug("starting consensus instances")
 	for _, cons := range mgr.instances {
-		cons.Start()
+		if err := cons.Start(); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
-// Stop stops the manager.
-func (*manager) Stop() {
+// Stop stops the consensus instances and closes the record file.
+func (mgr *manager) Stop() {
+	for _, cons := range mgr.instances {
+		cons.Stop()
+	}
+
+	if mgr.recordFile != nil {
+		mgr.setRecorder(nil)
+		_ = mgr.recordFile.Close()
+		mgr.recordFile = nil
+		mgr.recorder = nil
+	}
 }
 
 // Instances return all consensus instances that are read-only and
@@ -83,6 +118,7 @@ func (mgr *manager) Proposal() *proposal.Proposal {
 
 // HandleQueryProposal returns the proposal for a specific round from a random consensus instance.
 func (mgr *manager) HandleQueryProposal(height uint32, round int16) *proposal.Proposal {
+	mgr.recordQuery(record.KindQueryProposal, height, round)
 	cons := mgr.getBestInstance()
 
 	return cons.HandleQueryProposal(height, round)
@@ -90,6 +126,7 @@ func (mgr *manager) HandleQueryProposal(height uint32, round int16) *proposal.Pr
 
 // HandleQueryVote returns a random vote from a random consensus instance.
 func (mgr *manager) HandleQueryVote(height uint32, round int16) *vote.Vote {
+	mgr.recordQuery(record.KindQueryVote, height, round)
 	cons := mgr.getBestInstance()
 
 	return cons.HandleQueryVote(height, round)
@@ -115,6 +152,7 @@ func (mgr *manager) HasActiveInstance() bool {
 
 // MoveToNewHeight moves all consensus instances to a new height.
 func (mgr *manager) MoveToNewHeight() {
+	mgr.recordSyncedBlocks()
 	for _, cons := range mgr.instances {
 		cons.MoveToNewHeight()
 	}
@@ -164,6 +202,7 @@ func (mgr *manager) MoveToNewHeight() {
 
 // AddVote adds a vote to all consensus instances.
 func (mgr *manager) AddVote(v *vote.Vote) {
+	mgr.recordVote(v)
 	inst := mgr.getBestInstance()
 	curHeight, _ := inst.HeightRound()
 	switch {
@@ -182,6 +221,7 @@ func (mgr *manager) AddVote(v *vote.Vote) {
 
 // SetProposal sets the proposal for all consensus instances.
 func (mgr *manager) SetProposal(p *proposal.Proposal) {
+	mgr.recordProposal(p)
 	inst := mgr.getBestInstance()
 	curHeight, _ := inst.HeightRound()
 	switch {
@@ -5,6 +5,8 @@ import (
 
 	"github.com/pactus-project/pactus/crypto"
 	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/evidencepool"
+	"github.com/pactus-project/pactus/signer"
 	"github.com/pactus-project/pactus/state"
 	"github.com/pactus-project/pactus/sync/bundle/message"
 	"github.com/pactus-project/pactus/types/proposal"
@@ -29,7 +31,7 @@ func TestManager(t *testing.T) {
 	blk, cert := ts.GenerateTestBlock(randomHeight)
 	st.TestStore.SaveBlock(blk, cert)
 
-	mgrInst := NewManager(testConfig(), st, valKeys, rewardAddrs, broadcastCh)
+	mgrInst := NewManager(testConfig(), st, evidencepool.MockingEvidencePool(), localSigners(valKeys), rewardAddrs, broadcastCh)
 	mgr := mgrInst.(*manager)
 
 	consA := mgr.instances[0].(*consensus) // active
@@ -65,7 +67,7 @@ func TestManager(t *testing.T) {
 
 	t.Run("Testing set proposal", func(t *testing.T) {
 		consHeight, _ := mgr.HeightRound()
-		b, _ := st.ProposeBlock(valKeys[0], valKeys[0].Address())
+		b, _ := st.ProposeBlock(signer.NewLocalSigner(valKeys[0]), valKeys[0].Address())
 		p := proposal.NewProposal(consHeight, 0, b)
 		ts.HelperSignProposal(valKeys[0], p)
 
@@ -86,7 +88,7 @@ func TestManager(t *testing.T) {
 
 	t.Run("Check discarding old proposals", func(t *testing.T) {
 		consHeight, _ := mgr.HeightRound()
-		b, _ := st.ProposeBlock(valKeys[0], valKeys[0].Address())
+		b, _ := st.ProposeBlock(signer.NewLocalSigner(valKeys[0]), valKeys[0].Address())
 		p := proposal.NewProposal(consHeight-1, 1, b)
 		ts.HelperSignProposal(valKeys[0], p)
 
@@ -125,13 +127,13 @@ func TestManager(t *testing.T) {
 
 	t.Run("Processing upcoming proposal", func(t *testing.T) {
 		consHeight, _ := mgr.HeightRound()
-		b1, _ := st.ProposeBlock(valKeys[0], valKeys[0].Address())
+		b1, _ := st.ProposeBlock(signer.NewLocalSigner(valKeys[0]), valKeys[0].Address())
 		p1 := proposal.NewProposal(consHeight+1, 0, b1)
 
-		b2, _ := st.ProposeBlock(valKeys[0], valKeys[0].Address())
+		b2, _ := st.ProposeBlock(signer.NewLocalSigner(valKeys[0]), valKeys[0].Address())
 		p2 := proposal.NewProposal(consHeight+2, 0, b2)
 
-		b3, _ := st.ProposeBlock(valKeys[0], valKeys[0].Address())
+		b3, _ := st.ProposeBlock(signer.NewLocalSigner(valKeys[0]), valKeys[0].Address())
 		p3 := proposal.NewProposal(consHeight+3, 0, b3)
 
 		ts.HelperSignProposal(valKeys[0], p1)
@@ -176,7 +178,7 @@ func TestMediator(t *testing.T) {
 	blk, cert := ts.GenerateTestBlock(stateHeight)
 	st.TestStore.SaveBlock(blk, cert)
 
-	mgrInst := NewManager(testConfig(), st, valKeys, rewardAddrs, broadcastCh)
+	mgrInst := NewManager(testConfig(), st, evidencepool.MockingEvidencePool(), localSigners(valKeys), rewardAddrs, broadcastCh)
 	mgr := mgrInst.(*manager)
 
 	mgr.MoveToNewHeight()
@@ -193,3 +195,12 @@ func TestMediator(t *testing.T) {
 		}
 	}
 }
+
+func localSigners(valKeys []*bls.ValidatorKey) []signer.Signer {
+	signers := make([]signer.Signer, len(valKeys))
+	for i, key := range valKeys {
+		signers[i] = signer.NewLocalSigner(key)
+	}
+
+	return signers
+}
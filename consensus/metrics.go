@@ -0,0 +1,74 @@
+package consensus
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	metricsNamespace = "pactus"
+	metricsSubsystem = "consensus"
+)
+
+var (
+	roundsPerHeightMetric = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "rounds_per_height",
+		Help:      "Number of rounds it took to move to the next height.",
+		Buckets:   []float64{1, 2, 3, 4, 5, 8, 13},
+	}, []string{"validator"})
+
+	phaseDurationMetric = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "phase_duration_seconds",
+		Help:      "Time spent in each phase of the consensus.",
+		Buckets:   prometheus.ExponentialBuckets(0.05, 2, 12),
+	}, []string{"validator", "phase"})
+
+	timeoutsMetric = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "timeouts_total",
+		Help:      "Number of expired timers per target.",
+	}, []string{"validator", "target"})
+
+	receivedVotesMetric = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "received_votes_total",
+		Help:      "Number of votes received from other validators per vote type.",
+	}, []string{"validator", "type"})
+
+	queriesMetric = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "queries_total",
+		Help:      "Number of sent and received queries for the missing votes and proposals.",
+	}, []string{"validator", "query", "direction"})
+
+	changeProposerTimeoutMetric = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "change_proposer_timeout_seconds",
+		Help:      "The change-proposer timeout of the current round, when the adaptive timeout is enabled.",
+	}, []string{"validator"})
+
+	registerMetricsOnce sync.Once
+)
+
+// registerMetrics registers the consensus metrics in the default Prometheus registerer.
+func registerMetrics() {
+	registerMetricsOnce.Do(func() {
+		prometheus.MustRegister(
+			roundsPerHeightMetric,
+			phaseDurationMetric,
+			timeoutsMetric,
+			receivedVotesMetric,
+			queriesMetric,
+			changeProposerTimeoutMetric,
+		)
+	})
+}
@@ -1,6 +1,8 @@
 package consensus
 
 import (
+	"time"
+
 	"github.com/pactus-project/pactus/crypto/bls"
 	"github.com/pactus-project/pactus/crypto/hash"
 	"github.com/pactus-project/pactus/state"
@@ -22,6 +24,8 @@ type MockConsensus struct {
 	Proposer    bool
 	Height      uint32
 	Round       int16
+	LastVote    time.Time
+	Stats       []*HeightStats
 }
 
 func MockingManager(ts *testsuite.TestSuite, st *state.MockState,
@@ -58,7 +62,11 @@ func (m *MockConsensus) MoveToNewHeight() {
 	m.Height = m.State.LastBlockHeight() + 1
 }
 
-func (*MockConsensus) Start() {}
+func (*MockConsensus) Start() error {
+	return nil
+}
+
+func (*MockConsensus) Stop() {}
 
 func (m *MockConsensus) AddVote(v *vote.Vote) {
 	m.Votes = append(m.Votes, v)
@@ -115,6 +123,18 @@ func (m *MockConsensus) IsProposer() bool {
 	return m.Proposer
 }
 
+func (m *MockConsensus) LastVoteTime() time.Time {
+	return m.LastVote
+}
+
+func (m *MockConsensus) History(count int) []*HeightStats {
+	if count >= len(m.Stats) {
+		return m.Stats
+	}
+
+	return m.Stats[len(m.Stats)-count:]
+}
+
 func (m *MockConsensus) SetActive(active bool) {
 	m.Active = active
 }
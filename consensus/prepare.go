@@ -13,7 +13,7 @@ type prepareState struct {
 func (s *prepareState) enter() {
 	s.hasVoted = false
 
-	changeProperTimeout := s.config.CalculateChangeProposerTimeout(s.round)
+	changeProperTimeout := s.changeProposerTimeout()
 	queryProposalTimeout := changeProperTimeout / 2
 	s.scheduleTimeout(queryProposalTimeout, s.height, s.round, tickerTargetQueryProposal)
 	s.scheduleTimeout(changeProperTimeout, s.height, s.round, tickerTargetChangeProposer)
@@ -14,8 +14,10 @@ func (s *proposeState) enter() {
 }
 
 func (s *proposeState) decide() {
+	s.adaptiveTimeout.startRound(s.height, s.round, s.clock.Now())
+
 	proposer := s.proposer(s.round)
-	if proposer.Address() == s.valKey.Address() {
+	if proposer.Address() == s.signer.Address() {
 		s.logger.Info("our turn to propose", "proposer", proposer.Address())
 		s.createProposal(s.height, s.round)
 	} else {
@@ -41,7 +43,7 @@ func (s *proposeState) decide() {
 }
 
 func (s *proposeState) createProposal(height uint32, round int16) {
-	block, err := s.bcState.ProposeBlock(s.valKey, s.rewardAddr)
+	block, err := s.bcState.ProposeBlock(s.signer, s.rewardAddr)
 	if err != nil {
 		s.logger.Error("unable to propose a block!", "error", err)
 
@@ -54,10 +56,15 @@ func (s *proposeState) createProposal(height uint32, round int16) {
 	}
 
 	prop := proposal.NewProposal(height, round, block)
-	sig := s.valKey.Sign(prop.SignBytes())
-	prop.SetSignature(sig)
+	if err := s.signer.SignProposal(prop); err != nil {
+		s.logger.Error("unable to sign the proposal", "error", err)
+
+		return
+	}
 
 	s.log.SetRoundProposal(round, prop)
+	s.traceProposal(prop)
+	s.recordOwnProposal(prop)
 
 	s.broadcastProposal(prop)
 
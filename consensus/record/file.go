@@ -0,0 +1,120 @@
+package record
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/pactus-project/pactus/util/encoding"
+)
+
+// maxEntrySize is the maximum size of an encoded entry, which is large enough for a block.
+const maxEntrySize = 4 << 20
+
+// FileWriter writes the entries to a file. Each entry is encoded in CBOR format
+// and prefixed by its length.
+// Writing errors are ignored, since recording should not affect the consensus.
+type FileWriter struct {
+	lk   sync.Mutex
+	file *os.File
+	buf  *bufio.Writer
+}
+
+// OpenFile opens the record file for appending the entries.
+func OpenFile(path string) (*FileWriter, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return nil, err
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FileWriter{
+		file: file,
+		buf:  bufio.NewWriter(file),
+	}, nil
+}
+
+// Record writes the entry to the file and flushes it.
+func (w *FileWriter) Record(e *Entry) {
+	data, err := cbor.Marshal(e)
+	if err != nil {
+		return
+	}
+
+	w.lk.Lock()
+	defer w.lk.Unlock()
+
+	if err := encoding.WriteVarBytes(w.buf, data); err != nil {
+		return
+	}
+	_ = w.buf.Flush()
+}
+
+// Close closes the record file.
+func (w *FileWriter) Close() error {
+	w.lk.Lock()
+	defer w.lk.Unlock()
+
+	if err := w.buf.Flush(); err != nil {
+		_ = w.file.Close()
+
+		return err
+	}
+
+	return w.file.Close()
+}
+
+// Read reads the entries from the reader.
+// A truncated entry at the end is ignored, since the node might be stopped while writing it.
+func Read(r io.Reader) ([]*Entry, error) {
+	entries := make([]*Entry, 0)
+	br := bufio.NewReader(r)
+	for {
+		size, err := encoding.ReadVarInt(br)
+		if err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				return entries, nil
+			}
+
+			return nil, err
+		}
+		if size > maxEntrySize {
+			return nil, errors.New("entry is too large")
+		}
+
+		data := make([]byte, size)
+		if _, err := io.ReadFull(br, data); err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				return entries, nil
+			}
+
+			return nil, err
+		}
+
+		e := new(Entry)
+		if err := cbor.Unmarshal(data, e); err != nil {
+			return nil, err
+		}
+		entries = append(entries, e)
+	}
+}
+
+// ReadFile reads the entries from the record file.
+func ReadFile(path string) ([]*Entry, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	return Read(file)
+}
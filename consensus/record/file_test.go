@@ -0,0 +1,82 @@
+package record
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func makeTestEntries(ts *testsuite.TestSuite) []*Entry {
+	valAddr := ts.RandValAddress()
+	blockHash := ts.RandHash()
+
+	return []*Entry{
+		{Kind: KindInstance, Time: 1, Validator: &valAddr, PublicKey: ts.RandBytes(96)},
+		{Kind: KindProposal, Time: 2, Height: 10, Round: 1, Data: ts.RandBytes(128)},
+		{Kind: KindVote, Time: 3, Height: 10, Round: 1, Data: ts.RandBytes(64)},
+		{Kind: KindTimeout, Time: 4, Height: 10, Round: 1, Validator: &valAddr, Target: 2},
+		{Kind: KindCommit, Time: 5, Height: 10, Round: 1, Validator: &valAddr, BlockHash: &blockHash},
+	}
+}
+
+func TestMemoryRecorder(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	entries := makeTestEntries(ts)
+	rec := NewMemoryRecorder()
+	for _, e := range entries {
+		rec.Record(e)
+	}
+
+	assert.Equal(t, entries, rec.Entries())
+}
+
+func TestFileWriter(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	entries := makeTestEntries(ts)
+	path := filepath.Join(util.TempDirPath(), "consensus", "consensus.rec")
+
+	writer, err := OpenFile(path)
+	require.NoError(t, err)
+	for _, e := range entries {
+		writer.Record(e)
+	}
+	require.NoError(t, writer.Close())
+
+	read, err := ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, entries, read)
+
+	t.Run("Appending to an existing file", func(t *testing.T) {
+		writer, err := OpenFile(path)
+		require.NoError(t, err)
+		writer.Record(entries[1])
+		require.NoError(t, writer.Close())
+
+		read, err := ReadFile(path)
+		require.NoError(t, err)
+		assert.Len(t, read, len(entries)+1)
+		assert.Equal(t, entries[1], read[len(entries)])
+	})
+
+	t.Run("Truncated entry at the end", func(t *testing.T) {
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(path, data[:len(data)-3], 0o600))
+
+		read, err := ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, entries, read)
+	})
+
+	t.Run("File not exists", func(t *testing.T) {
+		_, err := ReadFile(filepath.Join(util.TempDirPath(), "not-exists.rec"))
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,127 @@
+package record
+
+import (
+	"sync"
+	"time"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/hash"
+)
+
+// Kind defines the kind of a recorded entry.
+type Kind uint8
+
+const (
+	// KindInstance is recorded when a consensus instance starts.
+	// It keeps the keys of the validator to replay its consensus.
+	KindInstance = Kind(1)
+	// KindProposal is recorded when a proposal is received from the network.
+	KindProposal = Kind(2)
+	// KindVote is recorded when a vote is received from the network.
+	KindVote = Kind(3)
+	// KindQueryProposal is recorded when a query for the proposal is received from the network.
+	KindQueryProposal = Kind(4)
+	// KindQueryVote is recorded when a query for the votes is received from the network.
+	KindQueryVote = Kind(5)
+	// KindTimeout is recorded when a timer of a consensus instance expires.
+	KindTimeout = Kind(6)
+	// KindOwnProposal is recorded when a consensus instance signs a proposal.
+	KindOwnProposal = Kind(7)
+	// KindOwnVote is recorded when a consensus instance signs a vote.
+	KindOwnVote = Kind(8)
+	// KindCommit is recorded when a consensus instance commits a block.
+	KindCommit = Kind(9)
+	// KindNewHeight is recorded when the consensus instances move to a new height.
+	// If the last block is not committed by the consensus, it keeps the block and its certificate.
+	KindNewHeight = Kind(10)
+)
+
+func (k Kind) String() string {
+	switch k {
+	case KindInstance:
+		return "instance"
+	case KindProposal:
+		return "proposal"
+	case KindVote:
+		return "vote"
+	case KindQueryProposal:
+		return "query-proposal"
+	case KindQueryVote:
+		return "query-vote"
+	case KindTimeout:
+		return "timeout"
+	case KindOwnProposal:
+		return "own-proposal"
+	case KindOwnVote:
+		return "own-vote"
+	case KindCommit:
+		return "commit"
+	case KindNewHeight:
+		return "new-height"
+	default:
+		return "unknown"
+	}
+}
+
+// Entry is a recorded input or outcome of the consensus.
+// Only the fields related to the kind of the entry are set,
+// and the unset fields are omitted in the encoded entry.
+type Entry struct {
+	Kind Kind `cbor:"1,keyasint"`
+	// Time is the local time of the entry in Unix nanoseconds.
+	Time      int64           `cbor:"2,keyasint"`
+	Height    uint32          `cbor:"3,keyasint,omitempty"`
+	Round     int16           `cbor:"4,keyasint,omitempty"`
+	Validator *crypto.Address `cbor:"5,keyasint,omitempty"`
+	// Target is the target of the expired timer.
+	Target uint8 `cbor:"6,keyasint,omitempty"`
+	// Data keeps the encoded proposal, vote or block.
+	Data []byte `cbor:"7,keyasint,omitempty"`
+	// Cert keeps the encoded certificate of the block.
+	Cert      []byte     `cbor:"8,keyasint,omitempty"`
+	BlockHash *hash.Hash `cbor:"9,keyasint,omitempty"`
+	PublicKey []byte     `cbor:"10,keyasint,omitempty"`
+	// RewardAddr is the reward address of the validator.
+	RewardAddr *crypto.Address `cbor:"11,keyasint,omitempty"`
+}
+
+// Timestamp returns the local time of the entry.
+func (e *Entry) Timestamp() time.Time {
+	return time.Unix(0, e.Time)
+}
+
+// Recorder records the consensus entries.
+type Recorder interface {
+	Record(e *Entry)
+}
+
+// MemoryRecorder keeps the entries in memory.
+type MemoryRecorder struct {
+	lk      sync.Mutex
+	entries []*Entry
+}
+
+// NewMemoryRecorder creates a new in-memory recorder.
+func NewMemoryRecorder() *MemoryRecorder {
+	return &MemoryRecorder{
+		entries: make([]*Entry, 0),
+	}
+}
+
+func (r *MemoryRecorder) Record(e *Entry) {
+	r.lk.Lock()
+	defer r.lk.Unlock()
+
+	r.entries = append(r.entries, e)
+}
+
+// Entries returns a copy of the recorded entries.
+func (r *MemoryRecorder) Entries() []*Entry {
+	r.lk.Lock()
+	defer r.lk.Unlock()
+
+	entries := make([]*Entry, len(r.entries))
+	copy(entries, r.entries)
+
+	return entries
+}
@@ -0,0 +1,219 @@
+package consensus
+
+import (
+	"time"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/pactus-project/pactus/consensus/record"
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/types/proposal"
+	"github.com/pactus-project/pactus/types/vote"
+)
+
+// setRecorder sets the recorder of the consensus inputs and outcomes.
+func (cs *consensus) setRecorder(r record.Recorder) {
+	cs.lk.Lock()
+	defer cs.lk.Unlock()
+
+	cs.recorder = r
+}
+
+func (cs *consensus) makeRecordEntry(kind record.Kind) *record.Entry {
+	addr := cs.signer.Address()
+
+	return &record.Entry{
+		Kind:      kind,
+		Time:      cs.clock.Now().UnixNano(),
+		Height:    cs.height,
+		Round:     cs.round,
+		Validator: &addr,
+	}
+}
+
+// recordTimeout records the expired timer of the current round.
+func (cs *consensus) recordTimeout(t *ticker) {
+	if cs.recorder == nil {
+		return
+	}
+
+	e := cs.makeRecordEntry(record.KindTimeout)
+	e.Target = uint8(t.Target)
+	cs.recorder.Record(e)
+}
+
+// recordOwnProposal records the proposal signed by this instance.
+func (cs *consensus) recordOwnProposal(p *proposal.Proposal) {
+	if cs.recorder == nil {
+		return
+	}
+
+	data, err := cbor.Marshal(p)
+	if err != nil {
+		return
+	}
+
+	e := cs.makeRecordEntry(record.KindOwnProposal)
+	e.Round = p.Round()
+	e.Data = data
+	cs.recorder.Record(e)
+}
+
+// recordOwnVote records the vote signed by this instance.
+func (cs *consensus) recordOwnVote(v *vote.Vote) {
+	if cs.recorder == nil {
+		return
+	}
+
+	data, err := cbor.Marshal(v)
+	if err != nil {
+		return
+	}
+
+	e := cs.makeRecordEntry(record.KindOwnVote)
+	e.Data = data
+	cs.recorder.Record(e)
+}
+
+// recordCommit records the block committed by this instance.
+func (cs *consensus) recordCommit(blockHash hash.Hash) {
+	if cs.recorder == nil {
+		return
+	}
+
+	e := cs.makeRecordEntry(record.KindCommit)
+	e.BlockHash = &blockHash
+	cs.recorder.Record(e)
+}
+
+// record records an entry received by the manager.
+func (mgr *manager) record(e *record.Entry) {
+	if mgr.recorder == nil {
+		return
+	}
+
+	e.Time = time.Now().UnixNano()
+	mgr.recorder.Record(e)
+}
+
+func (mgr *manager) recordProposal(p *proposal.Proposal) {
+	if mgr.recorder == nil {
+		return
+	}
+
+	data, err := cbor.Marshal(p)
+	if err != nil {
+		return
+	}
+
+	mgr.record(&record.Entry{
+		Kind:   record.KindProposal,
+		Height: p.Height(),
+		Round:  p.Round(),
+		Data:   data,
+	})
+}
+
+func (mgr *manager) recordVote(v *vote.Vote) {
+	if mgr.recorder == nil {
+		return
+	}
+
+	data, err := cbor.Marshal(v)
+	if err != nil {
+		return
+	}
+
+	mgr.record(&record.Entry{
+		Kind:   record.KindVote,
+		Height: v.Height(),
+		Round:  v.Round(),
+		Data:   data,
+	})
+}
+
+func (mgr *manager) recordQuery(kind record.Kind, height uint32, round int16) {
+	mgr.record(&record.Entry{
+		Kind:   kind,
+		Height: height,
+		Round:  round,
+	})
+}
+
+// setRecorder sets the recorder of the manager and its consensus instances.
+func (mgr *manager) setRecorder(r record.Recorder) {
+	mgr.recorder = r
+	for _, inst := range mgr.instances {
+		if cons, ok := inst.(*consensus); ok {
+			cons.setRecorder(r)
+		}
+	}
+}
+
+// recordInstances records the keys of the consensus instances, so they can be replayed.
+func (mgr *manager) recordInstances() {
+	for _, inst := range mgr.instances {
+		cons, ok := inst.(*consensus)
+		if !ok {
+			continue
+		}
+
+		addr := cons.signer.Address()
+		rewardAddr := cons.rewardAddr
+		mgr.record(&record.Entry{
+			Kind:       record.KindInstance,
+			Height:     mgr.state.LastBlockHeight(),
+			Validator:  &addr,
+			PublicKey:  cons.signer.PublicKey().Bytes(),
+			RewardAddr: &rewardAddr,
+		})
+	}
+}
+
+// recordSyncedBlocks records the blocks that are committed by the synchronizer,
+// not by the consensus instances, before moving to the new height.
+func (mgr *manager) recordSyncedBlocks() {
+	if mgr.recorder == nil {
+		return
+	}
+
+	consHeight, _ := mgr.getBestInstance().HeightRound()
+	lastHeight := mgr.state.LastBlockHeight()
+	for height := consHeight; height <= lastHeight; height++ {
+		cb := mgr.state.CommittedBlock(height)
+		if cb == nil {
+			continue
+		}
+		blk, err := cb.ToBlock()
+		if err != nil {
+			continue
+		}
+
+		cert := mgr.state.LastCertificate()
+		if height < lastHeight {
+			nextBlock := mgr.state.CommittedBlock(height + 1)
+			if nextBlock == nil {
+				continue
+			}
+			nextBlk, err := nextBlock.ToBlock()
+			if err != nil {
+				continue
+			}
+			cert = nextBlk.PrevCertificate()
+		}
+
+		certData, err := cbor.Marshal(cert)
+		if err != nil {
+			continue
+		}
+
+		blockHash := blk.Hash()
+		mgr.record(&record.Entry{
+			Kind:      record.KindNewHeight,
+			Height:    height,
+			Round:     cert.Round(),
+			Data:      cb.Data,
+			Cert:      certData,
+			BlockHash: &blockHash,
+		})
+	}
+}
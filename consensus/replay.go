@@ -0,0 +1,548 @@
+package consensus
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/pactus-project/pactus/consensus/record"
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/evidencepool"
+	"github.com/pactus-project/pactus/signer"
+	"github.com/pactus-project/pactus/sortition"
+	"github.com/pactus-project/pactus/state"
+	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/types/certificate"
+	"github.com/pactus-project/pactus/types/proposal"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/vote"
+)
+
+// Divergence is a difference between the replayed consensus and the recorded one.
+type Divergence struct {
+	Height    uint32
+	Round     int16
+	Validator crypto.Address
+	Reason    string
+}
+
+func (d Divergence) String() string {
+	if d.Validator == (crypto.Address{}) {
+		return fmt.Sprintf("%d/%d: %s", d.Height, d.Round, d.Reason)
+	}
+
+	return fmt.Sprintf("%d/%d %s: %s", d.Height, d.Round, d.Validator.ShortString(), d.Reason)
+}
+
+// ReplayReport is the result of replaying the recorded consensus.
+type ReplayReport struct {
+	// StartHeight is the first height that is replayed.
+	StartHeight uint32
+	// LastHeight is the last block height of the state after the replay.
+	LastHeight uint32
+	// Replayed is the number of replayed entries.
+	Replayed int
+	// Skipped is the number of entries that are recorded before the start height.
+	Skipped int
+	// Commits is the number of recorded commits that are matched by the replay.
+	Commits     int
+	Divergences []Divergence
+}
+
+// replayClock is the clock of the replayed consensus. Its time is the time of the current entry.
+// The timers never expire by themselves, the recorded timeouts are replayed instead.
+type replayClock struct {
+	now time.Time
+}
+
+func (c *replayClock) Now() time.Time {
+	return c.now
+}
+
+func (*replayClock) AfterFunc(_ time.Duration, _ func()) {}
+
+// replayMediator queues the messages between the consensus instances,
+// so they are delivered in order by the replayer.
+type replayMediator struct {
+	instances []Consensus
+	actions   chan func()
+}
+
+func (m *replayMediator) OnPublishProposal(from Consensus, prop *proposal.Proposal) {
+	m.actions <- func() {
+		for _, cons := range m.instances {
+			if cons != from {
+				cons.SetProposal(prop)
+			}
+		}
+	}
+}
+
+func (m *replayMediator) OnPublishVote(from Consensus, vte *vote.Vote) {
+	m.actions <- func() {
+		for _, cons := range m.instances {
+			if cons != from {
+				cons.AddVote(vte)
+			}
+		}
+	}
+}
+
+func (m *replayMediator) OnBlockAnnounce(from Consensus) {
+	m.actions <- func() {
+		for _, cons := range m.instances {
+			if cons != from {
+				cons.MoveToNewHeight()
+			}
+		}
+	}
+}
+
+func (m *replayMediator) Register(cons Consensus) {
+	m.instances = append(m.instances, cons)
+}
+
+type signatureKey struct {
+	signer   crypto.Address
+	signHash hash.Hash
+}
+
+// replaySigner signs the messages with the recorded signatures, since the private keys
+// are not needed to replay the consensus. Signing a message that is not recorded is a divergence.
+type replaySigner struct {
+	rp        *replayer
+	address   crypto.Address
+	publicKey *bls.PublicKey
+}
+
+func (s *replaySigner) Address() crypto.Address {
+	return s.address
+}
+
+func (s *replaySigner) PublicKey() *bls.PublicKey {
+	return s.publicKey
+}
+
+func (s *replaySigner) SignVote(v *vote.Vote) error {
+	key := signatureKey{signer: s.address, signHash: hash.CalcHash(v.SignBytes())}
+	sig, ok := s.rp.signatures[key]
+	if !ok {
+		s.rp.diverge(v.Height(), v.Round(), s.address, fmt.Sprintf("signed a vote that is not recorded: %s", v))
+
+		return errors.New("vote is not recorded")
+	}
+	s.rp.signed[key] = true
+	v.SetSignature(sig)
+
+	return nil
+}
+
+func (s *replaySigner) SignProposal(p *proposal.Proposal) error {
+	key := signatureKey{signer: s.address, signHash: hash.CalcHash(p.SignBytes())}
+	sig, ok := s.rp.signatures[key]
+	if !ok {
+		s.rp.diverge(p.Height(), p.Round(), s.address,
+			fmt.Sprintf("signed a proposal that is not recorded: %s", p))
+
+		return errors.New("proposal is not recorded")
+	}
+	s.rp.signed[key] = true
+	p.SetSignature(sig)
+
+	return nil
+}
+
+func (*replaySigner) SignSeed(_ sortition.VerifiableSeed) (sortition.VerifiableSeed, error) {
+	return sortition.VerifiableSeed{}, errors.New("signing the seed is not supported in replay")
+}
+
+func (*replaySigner) EvaluateSortition(_ sortition.VerifiableSeed, _, _ int64) (bool, sortition.Proof, error) {
+	return false, sortition.Proof{}, errors.New("evaluating the sortition is not supported in replay")
+}
+
+func (*replaySigner) SignSortitionTx(_ *tx.Tx) error {
+	return errors.New("signing the transaction is not supported in replay")
+}
+
+func (*replaySigner) SignHello(_ *message.HelloMessage) (*bls.Signature, error) {
+	return nil, errors.New("signing the hello message is not supported in replay")
+}
+
+// replayState proposes the recorded blocks, since the proposed blocks depend on
+// the transaction pool and the time of the recorded node.
+type replayState struct {
+	state.Facade
+
+	rp *replayer
+}
+
+func (st *replayState) ProposeBlock(valSigner signer.Signer, _ crypto.Address) (*block.Block, error) {
+	height := st.LastBlockHeight() + 1
+	blocks := st.rp.ownBlocks[valSigner.Address()][height]
+	if len(blocks) == 0 {
+		st.rp.diverge(height, 0, valSigner.Address(), "proposed a block that is not recorded")
+
+		return nil, errors.New("no recorded block to propose")
+	}
+	st.rp.ownBlocks[valSigner.Address()][height] = blocks[1:]
+
+	return blocks[0], nil
+}
+
+type replayer struct {
+	state      *replayState
+	clock      *replayClock
+	mediator   *replayMediator
+	mgr        *manager
+	instances  map[crypto.Address]*consensus
+	signatures map[signatureKey]*bls.Signature
+	signed     map[signatureKey]bool
+	ownBlocks  map[crypto.Address]map[uint32][]*block.Block
+	pending    int
+	report     *ReplayReport
+}
+
+// Replay feeds the recorded entries into the consensus instances built on the given state,
+// and reports the divergences from the recorded outcome.
+// The state should be at the height before the first replayed height, and it is updated
+// by committing the replayed blocks.
+func Replay(conf *Config, st state.Facade, evdPool evidencepool.EvidencePool,
+	entries []*record.Entry,
+) (*ReplayReport, error) {
+	rp := &replayer{
+		clock:      &replayClock{},
+		mediator:   &replayMediator{actions: make(chan func(), 1024)},
+		instances:  make(map[crypto.Address]*consensus),
+		signatures: make(map[signatureKey]*bls.Signature),
+		signed:     make(map[signatureKey]bool),
+		ownBlocks:  make(map[crypto.Address]map[uint32][]*block.Block),
+		report: &ReplayReport{
+			StartHeight: st.LastBlockHeight() + 1,
+			Divergences: make([]Divergence, 0),
+		},
+	}
+	rp.state = &replayState{Facade: st, rp: rp}
+
+	if err := rp.loadOwnMessages(entries); err != nil {
+		return nil, err
+	}
+	if err := rp.makeInstances(conf, evdPool, entries); err != nil {
+		return nil, err
+	}
+
+	started := false
+	for _, e := range entries {
+		if e.Kind == record.KindInstance {
+			continue
+		}
+
+		if !started {
+			if e.Height < rp.report.StartHeight {
+				rp.report.Skipped++
+
+				continue
+			}
+			if e.Height > rp.report.StartHeight {
+				return nil, fmt.Errorf("the record starts at height %d, but the state is at height %d",
+					e.Height, st.LastBlockHeight())
+			}
+
+			rp.clock.now = e.Timestamp()
+			if err := rp.mgr.Start(); err != nil {
+				return nil, err
+			}
+			started = true
+		}
+
+		if err := rp.replay(e); err != nil {
+			return nil, err
+		}
+		rp.report.Replayed++
+	}
+
+	rp.report.LastHeight = st.LastBlockHeight()
+
+	return rp.report, nil
+}
+
+func (rp *replayer) diverge(height uint32, round int16, validator crypto.Address, reason string) {
+	rp.report.Divergences = append(rp.report.Divergences, Divergence{
+		Height:    height,
+		Round:     round,
+		Validator: validator,
+		Reason:    reason,
+	})
+}
+
+// loadOwnMessages loads the signatures of the messages signed by the recorded instances,
+// and the blocks they proposed.
+func (rp *replayer) loadOwnMessages(entries []*record.Entry) error {
+	for _, e := range entries {
+		switch e.Kind {
+		case record.KindOwnVote:
+			v := new(vote.Vote)
+			if err := cbor.Unmarshal(e.Data, v); err != nil {
+				return err
+			}
+			rp.signatures[signatureKey{signer: v.Signer(), signHash: hash.CalcHash(v.SignBytes())}] = v.Signature()
+
+		case record.KindOwnProposal:
+			if e.Validator == nil {
+				return errors.New("the proposer of a recorded proposal is unknown")
+			}
+			p := new(proposal.Proposal)
+			if err := cbor.Unmarshal(e.Data, p); err != nil {
+				return err
+			}
+			key := signatureKey{signer: *e.Validator, signHash: hash.CalcHash(p.SignBytes())}
+			rp.signatures[key] = p.Signature()
+
+			blocks, ok := rp.ownBlocks[*e.Validator]
+			if !ok {
+				blocks = make(map[uint32][]*block.Block)
+				rp.ownBlocks[*e.Validator] = blocks
+			}
+			blocks[p.Height()] = append(blocks[p.Height()], p.Block())
+
+		default:
+			continue
+		}
+	}
+
+	return nil
+}
+
+// makeInstances creates the consensus instances of the recorded validators.
+func (rp *replayer) makeInstances(conf *Config, evdPool evidencepool.EvidencePool,
+	entries []*record.Entry,
+) error {
+	// The replayed consensus doesn't write any file.
+	replayConf := *conf
+	replayConf.WALPath = ""
+	replayConf.Trace = false
+	replayConf.Record = false
+
+	rp.mgr = &manager{
+		config:            &replayConf,
+		instances:         make([]Consensus, 0),
+		upcomingVotes:     make([]*vote.Vote, 0),
+		upcomingProposals: make([]*proposal.Proposal, 0),
+		state:             rp.state,
+	}
+
+	broadcaster := func(_ crypto.Address, msg message.Message) {
+		// These messages are also published to the other instances through the mediator.
+		switch msg.Type() {
+		case message.TypeProposal, message.TypeVote, message.TypeBlockAnnounce:
+			rp.pending++
+		default:
+		}
+	}
+
+	for _, e := range entries {
+		if e.Kind != record.KindInstance {
+			continue
+		}
+		if e.Validator == nil || e.RewardAddr == nil {
+			return errors.New("the recorded instance is not valid")
+		}
+		if _, ok := rp.instances[*e.Validator]; ok {
+			continue
+		}
+
+		pub, err := bls.PublicKeyFromBytes(e.PublicKey)
+		if err != nil {
+			return err
+		}
+
+		sgnr := &replaySigner{rp: rp, address: *e.Validator, publicKey: pub}
+		cons := makeConsensus(&replayConf, rp.state, evdPool, sgnr, *e.RewardAddr, broadcaster, rp.mediator)
+		cons.clock = rp.clock
+
+		rp.instances[*e.Validator] = cons
+		rp.mgr.instances = append(rp.mgr.instances, cons)
+	}
+
+	if len(rp.instances) == 0 {
+		return errors.New("no consensus instance is recorded")
+	}
+
+	return nil
+}
+
+// replay feeds an entry into the consensus instances.
+func (rp *replayer) replay(e *record.Entry) error {
+	rp.clock.now = e.Timestamp()
+
+	switch e.Kind {
+	case record.KindProposal:
+		p := new(proposal.Proposal)
+		if err := cbor.Unmarshal(e.Data, p); err != nil {
+			return err
+		}
+		rp.mgr.SetProposal(p)
+
+	case record.KindVote:
+		v := new(vote.Vote)
+		if err := cbor.Unmarshal(e.Data, v); err != nil {
+			return err
+		}
+		rp.mgr.AddVote(v)
+
+	case record.KindQueryProposal:
+		rp.mgr.HandleQueryProposal(e.Height, e.Round)
+
+	case record.KindQueryVote:
+		rp.mgr.HandleQueryVote(e.Height, e.Round)
+
+	case record.KindTimeout:
+		rp.replayTimeout(e)
+
+	case record.KindNewHeight:
+		if err := rp.replayNewHeight(e); err != nil {
+			return err
+		}
+
+	case record.KindCommit:
+		rp.checkCommit(e)
+
+	case record.KindOwnVote, record.KindOwnProposal:
+		rp.checkSigned(e)
+
+	default:
+		return fmt.Errorf("unknown record entry: %d", e.Kind)
+	}
+
+	rp.deliverMessages()
+
+	return nil
+}
+
+// deliverMessages delivers the messages published by the instances, until there is no pending message.
+func (rp *replayer) deliverMessages() {
+	for rp.pending > 0 {
+		action := <-rp.mediator.actions
+		rp.pending--
+		action()
+	}
+}
+
+func (rp *replayer) replayTimeout(e *record.Entry) {
+	if e.Validator == nil {
+		return
+	}
+	cons, ok := rp.instances[*e.Validator]
+	if !ok {
+		return
+	}
+
+	target := tickerTarget(e.Target)
+	height, round := cons.HeightRound()
+	if height != e.Height || round != e.Round {
+		rp.diverge(e.Height, e.Round, *e.Validator,
+			fmt.Sprintf("the %s timer expired, but the replayed consensus is at %d/%d",
+				target, height, round))
+
+		return
+	}
+
+	cons.handleTimeout(&ticker{Height: e.Height, Round: e.Round, Target: target})
+}
+
+// replayNewHeight commits the block that is committed by the synchronizer in the record,
+// and moves the instances to the new height.
+func (rp *replayer) replayNewHeight(e *record.Entry) error {
+	lastHeight := rp.state.LastBlockHeight()
+	switch {
+	case e.Height == lastHeight+1:
+		blk, err := block.FromBytes(e.Data)
+		if err != nil {
+			return err
+		}
+		cert := new(certificate.BlockCertificate)
+		if err := cbor.Unmarshal(e.Cert, cert); err != nil {
+			return err
+		}
+
+		if err := rp.state.CommitBlock(blk, cert); err != nil {
+			rp.diverge(e.Height, e.Round, crypto.Address{},
+				fmt.Sprintf("unable to commit the synced block: %s", err))
+		}
+
+	case e.Height <= lastHeight:
+		rp.checkBlockHash(e)
+
+	default:
+		rp.diverge(e.Height, e.Round, crypto.Address{},
+			fmt.Sprintf("the block at height %d is not committed in the replay", lastHeight+1))
+	}
+
+	rp.mgr.MoveToNewHeight()
+
+	return nil
+}
+
+// checkCommit checks that the replayed consensus committed the same block as the recorded one.
+func (rp *replayer) checkCommit(e *record.Entry) {
+	validator := crypto.Address{}
+	if e.Validator != nil {
+		validator = *e.Validator
+	}
+
+	if rp.state.LastBlockHeight() < e.Height {
+		rp.diverge(e.Height, e.Round, validator, "the recorded block is not committed in the replay")
+
+		return
+	}
+
+	if rp.checkBlockHash(e) {
+		rp.report.Commits++
+	}
+}
+
+func (rp *replayer) checkBlockHash(e *record.Entry) bool {
+	if e.BlockHash == nil {
+		return false
+	}
+
+	blockHash := rp.state.BlockHash(e.Height)
+	if blockHash != *e.BlockHash {
+		rp.diverge(e.Height, e.Round, crypto.Address{},
+			fmt.Sprintf("block %s is committed in the replay, but block %s is recorded",
+				blockHash.ShortString(), e.BlockHash.ShortString()))
+
+		return false
+	}
+
+	return true
+}
+
+// checkSigned checks that the replayed consensus signed the recorded message.
+func (rp *replayer) checkSigned(e *record.Entry) {
+	var key signatureKey
+	var desc string
+	if e.Kind == record.KindOwnVote {
+		v := new(vote.Vote)
+		if err := cbor.Unmarshal(e.Data, v); err != nil {
+			return
+		}
+		key = signatureKey{signer: v.Signer(), signHash: hash.CalcHash(v.SignBytes())}
+		desc = v.String()
+	} else {
+		p := new(proposal.Proposal)
+		if err := cbor.Unmarshal(e.Data, p); err != nil || e.Validator == nil {
+			return
+		}
+		key = signatureKey{signer: *e.Validator, signHash: hash.CalcHash(p.SignBytes())}
+		desc = p.String()
+	}
+
+	if !rp.signed[key] {
+		rp.diverge(e.Height, e.Round, key.signer,
+			fmt.Sprintf("the recorded message is not signed in the replay: %s", desc))
+	}
+}
@@ -0,0 +1,85 @@
+package consensus
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/consensus/record"
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/state"
+	"github.com/pactus-project/pactus/store"
+	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/types/vote"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplay(t *testing.T) {
+	td := setup(t)
+
+	newState := func(valKeys []*bls.ValidatorKey) state.Facade {
+		st, err := state.LoadOrNewState(td.genDoc, localSigners(valKeys), store.MockingStore(td.TestSuite), td.txPool, nil)
+		require.NoError(t, err)
+
+		return st
+	}
+
+	// Recording a normal run, where validator X proposes and commits the block at height 1.
+	rec := record.NewMemoryRecorder()
+	st := newState([]*bls.ValidatorKey{td.valKeys[tIndexX]})
+	broadcastCh := make(chan message.Message, 100)
+	mgr := NewManager(testConfig(), st, td.evdPool, localSigners(td.valKeys[tIndexX:tIndexX+1]),
+		[]crypto.Address{td.RandAccAddress()}, broadcastCh).(*manager)
+	mgr.setRecorder(rec)
+	mgr.recordInstances()
+	mgr.MoveToNewHeight()
+
+	cons := mgr.instances[0].(*consensus)
+	cons.handleTimeout(&ticker{0, 1, 0, tickerTargetNewHeight})
+	prop := cons.Proposal()
+	require.NotNil(t, prop)
+
+	for _, valID := range []int{tIndexY, tIndexP} {
+		v := vote.NewPrepareVote(prop.Block().Hash(), 1, 0, td.valKeys[valID].Address())
+		td.HelperSignVote(td.valKeys[valID], v)
+		mgr.AddVote(v)
+	}
+	for _, valID := range []int{tIndexY, tIndexP} {
+		v := vote.NewPrecommitVote(prop.Block().Hash(), 1, 0, td.valKeys[valID].Address())
+		td.HelperSignVote(td.valKeys[valID], v)
+		mgr.AddVote(v)
+	}
+	require.Equal(t, uint32(1), st.LastBlockHeight())
+
+	entries := rec.Entries()
+
+	t.Run("Replaying the record", func(t *testing.T) {
+		report, err := Replay(testConfig(), newState(nil), td.evdPool, entries)
+		require.NoError(t, err)
+
+		assert.Empty(t, report.Divergences)
+		assert.Equal(t, uint32(1), report.StartHeight)
+		assert.Equal(t, uint32(1), report.LastHeight)
+		assert.Equal(t, 1, report.Commits)
+		assert.Zero(t, report.Skipped)
+	})
+
+	t.Run("Missing a precommit vote", func(t *testing.T) {
+		lastVote := 0
+		for i, e := range entries {
+			if e.Kind == record.KindVote {
+				lastVote = i
+			}
+		}
+		modified := append([]*record.Entry{}, entries[:lastVote]...)
+		modified = append(modified, entries[lastVote+1:]...)
+
+		report, err := Replay(testConfig(), newState(nil), td.evdPool, modified)
+		require.NoError(t, err)
+
+		assert.Zero(t, report.Commits)
+		assert.Zero(t, report.LastHeight)
+		require.NotEmpty(t, report.Divergences)
+		assert.Equal(t, uint32(1), report.Divergences[0].Height)
+	})
+}
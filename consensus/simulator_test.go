@@ -0,0 +1,690 @@
+package consensus
+
+import (
+	"bytes"
+	"cmp"
+	"container/heap"
+	"fmt"
+	"math/rand"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/pactus-project/pactus/consensus/trace"
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/evidencepool"
+	"github.com/pactus-project/pactus/genesis"
+	"github.com/pactus-project/pactus/signer"
+	"github.com/pactus-project/pactus/state"
+	"github.com/pactus-project/pactus/store"
+	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/txpool"
+	"github.com/pactus-project/pactus/types/account"
+	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/types/certificate"
+	"github.com/pactus-project/pactus/types/validator"
+	"github.com/pactus-project/pactus/types/vote"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// simGenesisTime is a fixed genesis time in the future.
+// It keeps the block times independent of the system time,
+// so the simulation results are reproducible.
+var simGenesisTime = time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// simEvent is a scheduled event in the simulation.
+// Events with the same time are executed in the order they are scheduled.
+type simEvent struct {
+	at   time.Time
+	seq  uint64
+	node *simNode // The node that owns the event, nil for the script events.
+	fn   func()
+}
+
+type simQueue []*simEvent
+
+func (q simQueue) Len() int { return len(q) }
+
+func (q simQueue) Less(i, j int) bool {
+	if q[i].at.Equal(q[j].at) {
+		return q[i].seq < q[j].seq
+	}
+
+	return q[i].at.Before(q[j].at)
+}
+
+func (q simQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *simQueue) Push(x any) { *q = append(*q, x.(*simEvent)) }
+
+func (q *simQueue) Pop() any {
+	old := *q
+	n := len(old)
+	e := old[n-1]
+	*q = old[:n-1]
+
+	return e
+}
+
+// simClock is the virtual clock of a node.
+type simClock struct {
+	sim  *simulator
+	node *simNode
+}
+
+func (c *simClock) Now() time.Time {
+	return c.sim.now.Add(c.node.skew)
+}
+
+func (c *simClock) AfterFunc(d time.Duration, f func()) {
+	c.sim.schedule(d, c.node, f)
+}
+
+type simNode struct {
+	index   int
+	cons    *consensus
+	state   state.Facade
+	skew    time.Duration
+	offline bool
+	height  uint32 // The last height checked by the invariants
+}
+
+// simMessage is a message in flight from one node to another.
+type simMessage struct {
+	from  int
+	to    int
+	msg   message.Message
+	delay time.Duration
+}
+
+// simFilter can drop a message by returning false, or change its delay.
+type simFilter func(m *simMessage) bool
+
+type simCommit struct {
+	blk  *block.Block
+	cert *certificate.BlockCertificate
+}
+
+// simulator runs several consensus instances on real states.
+// The instances are driven by a virtual clock and exchange messages through
+// a scriptable in-memory router that can delay, drop, reorder and partition the traffic.
+// All events are executed sequentially in the order of their virtual time,
+// therefore a simulation is reproducible from its seed.
+type simulator struct {
+	t         *testing.T
+	seed      int64
+	rng       *rand.Rand
+	nodes     []*simNode
+	queue     simQueue
+	seq       uint64
+	now       time.Time
+	minDelay  time.Duration
+	maxDelay  time.Duration
+	dropRate  float64
+	filters   []simFilter
+	groups    map[int]int // Partition group of the nodes, nil if there is no partition
+	commits   map[uint32]simCommit
+	recorder  *trace.Recorder
+	delivered int
+	dropped   int
+}
+
+func newSimulator(t *testing.T, seed int64, numNodes int) *simulator {
+	t.Helper()
+
+	fmt.Printf("=== simulation %s, seed: %d\n", t.Name(), seed)
+
+	ts := testsuite.NewTestSuiteForSeed(seed)
+	_, valKeys := ts.GenerateTestCommittee(numNodes)
+
+	vals := make([]*validator.Validator, numNodes)
+	for i, key := range valKeys {
+		vals[i] = validator.NewValidator(key.PublicKey(), int32(i))
+	}
+
+	acc := account.NewAccount(0)
+	acc.AddToBalance(21 * 1e14)
+	accs := map[crypto.Address]*account.Account{crypto.TreasuryAddress: acc}
+	params := genesis.DefaultGenesisParams()
+	params.CommitteeSize = numNodes
+	genDoc := genesis.MakeGenesis(simGenesisTime, accs, vals, params)
+
+	sim := &simulator{
+		t:        t,
+		seed:     seed,
+		rng:      ts.Rand,
+		now:      simGenesisTime,
+		minDelay: 10 * time.Millisecond,
+		maxDelay: 200 * time.Millisecond,
+		commits:  make(map[uint32]simCommit),
+		recorder: trace.NewRecorder(),
+	}
+
+	conf := DefaultConfig()
+	for i, key := range valKeys {
+		st, err := state.LoadOrNewState(genDoc, []signer.Signer{signer.NewLocalSigner(key)},
+			store.MockingStore(ts), txpool.MockingTxPool(), nil)
+		require.NoError(t, err)
+
+		node := &simNode{
+			index: i,
+			state: st,
+		}
+		index := i
+		broadcaster := func(_ crypto.Address, msg message.Message) {
+			sim.broadcast(index, msg)
+		}
+		node.cons = makeConsensus(conf, st, evidencepool.MockingEvidencePool(),
+			signer.NewLocalSigner(key), key.PublicKey().AccountAddress(),
+			broadcaster, newConcreteMediator())
+		node.cons.clock = &simClock{sim: sim, node: node}
+		node.cons.tracer = sim.recorder
+
+		sim.nodes = append(sim.nodes, node)
+	}
+
+	// The trace of all nodes should conform to the consensus specification.
+	t.Cleanup(func() {
+		if err := trace.Check(sim.recorder.Events()); err != nil {
+			t.Errorf("seed %d: %v", seed, err)
+		}
+	})
+
+	return sim
+}
+
+// schedule adds a new event to the queue that is executed after the given duration.
+func (sim *simulator) schedule(d time.Duration, node *simNode, fn func()) {
+	if d < 0 {
+		d = 0
+	}
+	sim.seq++
+	heap.Push(&sim.queue, &simEvent{
+		at:   sim.now.Add(d),
+		seq:  sim.seq,
+		node: node,
+		fn:   fn,
+	})
+}
+
+// at runs the script function at the given time after the genesis time.
+func (sim *simulator) at(d time.Duration, fn func()) {
+	sim.seq++
+	heap.Push(&sim.queue, &simEvent{
+		at:  simGenesisTime.Add(d),
+		seq: sim.seq,
+		fn:  fn,
+	})
+}
+
+// setDelay sets the range of the message delays.
+// Random delays reorder the messages.
+func (sim *simulator) setDelay(minDelay, maxDelay time.Duration) {
+	sim.minDelay = minDelay
+	sim.maxDelay = maxDelay
+}
+
+// setDropRate sets the probability of dropping a message.
+func (sim *simulator) setDropRate(rate float64) {
+	sim.dropRate = rate
+}
+
+// addFilter adds a filter that is applied to all messages in flight.
+func (sim *simulator) addFilter(f simFilter) {
+	sim.filters = append(sim.filters, f)
+}
+
+// partition splits the network into the given groups.
+// The nodes that are not in any group are isolated.
+func (sim *simulator) partition(groups ...[]int) {
+	sim.groups = make(map[int]int)
+	for g, group := range groups {
+		for _, i := range group {
+			sim.groups[i] = g
+		}
+	}
+}
+
+// heal removes the network partition.
+func (sim *simulator) heal() {
+	sim.groups = nil
+}
+
+// setSkew sets the offset of the node's clock from the simulation time.
+func (sim *simulator) setSkew(i int, skew time.Duration) {
+	sim.nodes[i].skew = skew
+}
+
+// crash stops the node. All its timers and messages are dropped.
+func (sim *simulator) crash(i int) {
+	sim.nodes[i].offline = true
+}
+
+// restart brings the node back, syncs the missing blocks and restarts the consensus.
+func (sim *simulator) restart(i int) {
+	node := sim.nodes[i]
+	node.offline = false
+	sim.syncBlocks(node)
+
+	require.NoError(sim.t, node.cons.Start())
+}
+
+func (sim *simulator) connected(from, to int) bool {
+	if sim.nodes[from].offline || sim.nodes[to].offline {
+		return false
+	}
+	if sim.groups == nil {
+		return true
+	}
+	gFrom, okFrom := sim.groups[from]
+	gTo, okTo := sim.groups[to]
+
+	return okFrom && okTo && gFrom == gTo
+}
+
+func (sim *simulator) randDelay() time.Duration {
+	if sim.maxDelay <= sim.minDelay {
+		return sim.minDelay
+	}
+
+	return sim.minDelay + time.Duration(sim.rng.Int63n(int64(sim.maxDelay-sim.minDelay)))
+}
+
+func (sim *simulator) broadcast(from int, msg message.Message) {
+	if announce, ok := msg.(*message.BlockAnnounceMessage); ok {
+		sim.recordCommit(from, announce.Block, announce.Certificate)
+	}
+
+	for to := range sim.nodes {
+		if to != from {
+			sim.send(from, to, msg)
+		}
+	}
+}
+
+func (sim *simulator) send(from, to int, msg message.Message) {
+	m := &simMessage{
+		from:  from,
+		to:    to,
+		msg:   msg,
+		delay: sim.randDelay(),
+	}
+
+	// Random values are drawn for every message, so that the scripts
+	// don't change the sequence of the random numbers.
+	drop := sim.rng.Float64() < sim.dropRate
+	for _, f := range sim.filters {
+		if !f(m) {
+			drop = true
+		}
+	}
+	if drop || !sim.connected(from, to) {
+		sim.dropped++
+
+		return
+	}
+
+	sim.schedule(m.delay, sim.nodes[to], func() {
+		// The network can change while the message is in flight.
+		if !sim.connected(from, to) {
+			sim.dropped++
+
+			return
+		}
+		sim.delivered++
+		sim.deliver(from, sim.nodes[to], msg)
+	})
+}
+
+// deliver handles the message in the same way as the sync module does.
+func (sim *simulator) deliver(from int, node *simNode, msg message.Message) {
+	// Like the sync module, the node downloads the missing blocks
+	// when it finds a peer with a higher height.
+	if sim.nodes[from].state.LastBlockHeight() > node.state.LastBlockHeight() {
+		sim.syncBlocks(node)
+		node.cons.MoveToNewHeight()
+	}
+
+	switch msg := msg.(type) {
+	case *message.ProposalMessage:
+		node.cons.SetProposal(msg.Proposal)
+
+	case *message.VoteMessage:
+		node.cons.AddVote(msg.Vote)
+
+	case *message.QueryProposalMessage:
+		prop := node.cons.HandleQueryProposal(msg.Height, msg.Round)
+		if prop != nil {
+			sim.broadcast(node.index, message.NewProposalMessage(prop))
+		}
+
+	case *message.QueryVoteMessage:
+		v := sim.queryVote(node, msg.Height, msg.Round)
+		if v != nil {
+			sim.broadcast(node.index, message.NewVoteMessage(v))
+		}
+
+	case *message.BlockAnnounceMessage:
+		sim.syncBlocks(node)
+		node.cons.MoveToNewHeight()
+
+	default:
+		sim.t.Fatalf("unexpected message from node %d: %s", from, msg.Type())
+	}
+}
+
+// queryVote picks a vote in response to a vote query using the simulation's random source.
+func (sim *simulator) queryVote(node *simNode, height uint32, round int16) *vote.Vote {
+	node.cons.lk.RLock()
+	votes := node.cons.queriedVotes(height, round)
+	node.cons.lk.RUnlock()
+
+	if len(votes) == 0 {
+		return nil
+	}
+
+	// Votes are kept in maps, sort them to make the choice deterministic.
+	// The vote hash is not used, because the justifications of the change-proposer votes
+	// are also chosen from maps.
+	slices.SortFunc(votes, func(a, b *vote.Vote) int {
+		ha, hb := a.BlockHash(), b.BlockHash()
+		sa, sb := a.Signer(), b.Signer()
+		c := cmp.Or(
+			cmp.Compare(a.Type(), b.Type()),
+			cmp.Compare(a.Round(), b.Round()),
+			bytes.Compare(sa.Bytes(), sb.Bytes()),
+			bytes.Compare(ha.Bytes(), hb.Bytes()),
+		)
+		if c == 0 && a.IsCPVote() {
+			c = cmp.Or(
+				cmp.Compare(a.CPRound(), b.CPRound()),
+				cmp.Compare(a.CPValue(), b.CPValue()),
+			)
+		}
+
+		return c
+	})
+
+	return votes[sim.rng.Intn(len(votes))]
+}
+
+// syncBlocks commits the blocks that the node has missed.
+func (sim *simulator) syncBlocks(node *simNode) {
+	for {
+		c, ok := sim.commits[node.state.LastBlockHeight()+1]
+		if !ok {
+			return
+		}
+		require.NoError(sim.t, node.state.CommitBlock(c.blk, c.cert))
+	}
+}
+
+func (sim *simulator) recordCommit(from int, blk *block.Block, cert *certificate.BlockCertificate) {
+	committed, ok := sim.commits[cert.Height()]
+	if !ok {
+		sim.commits[cert.Height()] = simCommit{blk: blk, cert: cert}
+
+		return
+	}
+
+	if committed.blk.Hash() != blk.Hash() {
+		sim.t.Fatalf("seed %d: node %d announced block %s at height %d, but block %s is already committed",
+			sim.seed, from, blk.Hash(), cert.Height(), committed.blk.Hash())
+	}
+}
+
+// checkInvariants ensures that all nodes agree on the committed blocks and
+// no node commits a different block at a committed height.
+func (sim *simulator) checkInvariants() {
+	for _, node := range sim.nodes {
+		lastHeight := node.state.LastBlockHeight()
+		if lastHeight < node.height {
+			sim.t.Fatalf("seed %d: height of node %d moved back from %d to %d",
+				sim.seed, node.index, node.height, lastHeight)
+		}
+
+		for h := node.height + 1; h <= lastHeight; h++ {
+			blockHash := node.state.CommittedBlock(h).BlockHash
+			c, ok := sim.commits[h]
+			if !ok {
+				sim.t.Fatalf("seed %d: node %d committed block %s at height %d without announcing it",
+					sim.seed, node.index, blockHash, h)
+			}
+
+			if c.blk.Hash() != blockHash {
+				sim.t.Fatalf("seed %d: agreement violated at height %d, node %d committed %s, expected %s",
+					sim.seed, h, node.index, blockHash, c.blk.Hash())
+			}
+		}
+		node.height = lastHeight
+	}
+}
+
+// start starts the consensus instances.
+func (sim *simulator) start() {
+	for _, node := range sim.nodes {
+		require.NoError(sim.t, node.cons.Start())
+	}
+}
+
+// step executes the next event. It returns false if there is no event.
+func (sim *simulator) step() bool {
+	if sim.queue.Len() == 0 {
+		return false
+	}
+
+	e := heap.Pop(&sim.queue).(*simEvent)
+	sim.now = e.at
+	if e.node == nil || !e.node.offline {
+		e.fn()
+	}
+	sim.checkInvariants()
+
+	return true
+}
+
+// minHeight returns the lowest committed height of the online nodes.
+func (sim *simulator) minHeight() uint32 {
+	minHeight := uint32(0)
+	first := true
+	for _, node := range sim.nodes {
+		if node.offline {
+			continue
+		}
+		h := node.state.LastBlockHeight()
+		if first || h < minHeight {
+			minHeight = h
+			first = false
+		}
+	}
+
+	return minHeight
+}
+
+// runFor executes the events for the given duration of virtual time.
+func (sim *simulator) runFor(d time.Duration) {
+	deadline := sim.now.Add(d)
+	for sim.queue.Len() > 0 && !sim.queue[0].at.After(deadline) {
+		sim.step()
+	}
+	sim.now = deadline
+}
+
+// runUntilHeight executes the events until all online nodes reach the given height.
+// It returns false if the nodes can't reach the height within the given duration of virtual time.
+func (sim *simulator) runUntilHeight(height uint32, d time.Duration) bool {
+	deadline := sim.now.Add(d)
+	for sim.minHeight() < height {
+		if sim.queue.Len() == 0 || sim.queue[0].at.After(deadline) {
+			return false
+		}
+		sim.step()
+	}
+
+	return true
+}
+
+// requireHeight fails the test if the nodes can't reach the given height.
+func (sim *simulator) requireHeight(height uint32, d time.Duration) {
+	sim.t.Helper()
+
+	if !sim.runUntilHeight(height, d) {
+		heights := make([]string, 0, len(sim.nodes))
+		for _, node := range sim.nodes {
+			heights = append(heights, node.cons.String())
+		}
+		sim.t.Fatalf("seed %d: liveness violated, height %d is not reached at %s, nodes: %v",
+			sim.seed, height, sim.now.Sub(simGenesisTime), heights)
+	}
+}
+
+// blockHashes returns the hashes of the committed blocks.
+func (sim *simulator) blockHashes() []hash.Hash {
+	hashes := make([]hash.Hash, 0, len(sim.commits))
+	for h := uint32(1); ; h++ {
+		c, ok := sim.commits[h]
+		if !ok {
+			return hashes
+		}
+		hashes = append(hashes, c.blk.Hash())
+	}
+}
+
+func TestSimulationNormal(t *testing.T) {
+	sim := newSimulator(t, testsuite.GenerateSeed(), 4)
+	sim.start()
+
+	sim.requireHeight(10, 10*time.Minute)
+
+	stats := sim.nodes[0].cons.History(5)
+	require.Len(t, stats, 5)
+	for i, hs := range stats {
+		assert.Equal(t, stats[0].Height+uint32(i), hs.Height)
+	}
+	for _, hs := range stats[:4] {
+		assert.Positive(t, hs.Rounds)
+		assert.Positive(t, hs.Duration)
+		assert.Positive(t, hs.ReceivedVotes["PREPARE"])
+	}
+}
+
+func TestSimulationDeterministic(t *testing.T) {
+	seed := testsuite.GenerateSeed()
+	run := func() *simulator {
+		sim := newSimulator(t, seed, 5)
+		sim.setDelay(0, 3*time.Second)
+		sim.setDropRate(0.1)
+		sim.start()
+		sim.requireHeight(5, time.Hour)
+
+		return sim
+	}
+
+	sim1 := run()
+	sim2 := run()
+
+	require.Equal(t, sim1.blockHashes(), sim2.blockHashes())
+	require.Equal(t, sim1.now, sim2.now)
+	require.Equal(t, sim1.delivered, sim2.delivered)
+	require.Equal(t, sim1.dropped, sim2.dropped)
+}
+
+func TestSimulationDelayAndDrop(t *testing.T) {
+	sim := newSimulator(t, testsuite.GenerateSeed(), 7)
+	sim.setDelay(0, 2*time.Second)
+	sim.setDropRate(0.05)
+	sim.start()
+
+	sim.requireHeight(5, time.Hour)
+}
+
+func TestSimulationProposerCrash(t *testing.T) {
+	sim := newSimulator(t, testsuite.GenerateSeed(), 4)
+	sim.start()
+
+	// The first validator is the proposer of the first block.
+	sim.crash(0)
+	sim.requireHeight(3, 10*time.Minute)
+
+	sim.restart(0)
+	sim.requireHeight(6, 10*time.Minute)
+}
+
+func TestSimulationPartition(t *testing.T) {
+	sim := newSimulator(t, testsuite.GenerateSeed(), 4)
+	sim.partition([]int{0, 1}, []int{2, 3})
+	sim.at(5*time.Minute, sim.heal)
+	sim.start()
+
+	// No side has the quorum.
+	require.False(t, sim.runUntilHeight(1, 4*time.Minute))
+
+	sim.requireHeight(3, 20*time.Minute)
+}
+
+func TestSimulationIsolatedNode(t *testing.T) {
+	sim := newSimulator(t, testsuite.GenerateSeed(), 4)
+	sim.partition([]int{0, 1, 2})
+	sim.start()
+
+	sim.runFor(5 * time.Minute)
+	require.Zero(t, sim.nodes[3].state.LastBlockHeight())
+	require.Positive(t, sim.nodes[0].state.LastBlockHeight())
+
+	sim.heal()
+	sim.requireHeight(sim.nodes[0].state.LastBlockHeight()+2, 10*time.Minute)
+}
+
+func TestSimulationClockSkew(t *testing.T) {
+	sim := newSimulator(t, testsuite.GenerateSeed(), 4)
+	sim.setSkew(1, -30*time.Second)
+	sim.setSkew(2, 20*time.Second)
+	sim.start()
+
+	sim.requireHeight(5, 10*time.Minute)
+}
+
+func TestSimulationDelayedProposals(t *testing.T) {
+	sim := newSimulator(t, testsuite.GenerateSeed(), 4)
+	sim.addFilter(func(m *simMessage) bool {
+		if m.msg.Type() == message.TypeProposal {
+			m.delay += 20 * time.Second
+		}
+
+		return true
+	})
+	sim.start()
+
+	sim.requireHeight(3, 30*time.Minute)
+}
+
+func TestSimulationAdaptiveTimeout(t *testing.T) {
+	sim := newSimulator(t, testsuite.GenerateSeed(), 4)
+	// The config is shared by all nodes.
+	sim.nodes[0].cons.config.AdaptiveTimeout = true
+	sim.addFilter(func(m *simMessage) bool {
+		if m.msg.Type() == message.TypeProposal {
+			m.delay += 7 * time.Second
+		}
+
+		return true
+	})
+	sim.start()
+
+	sim.requireHeight(15, time.Hour)
+
+	for _, node := range sim.nodes {
+		assert.Greater(t, node.cons.adaptiveTimeout.timeout(node.cons.config), 7*time.Second)
+	}
+
+	// Once the timeout is widened, the late proposals don't expire the change-proposer timer.
+	for _, node := range sim.nodes {
+		stats := node.cons.History(4)
+		for _, hs := range stats[:3] {
+			assert.Zero(t, hs.Timeouts["change-proposer"])
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package consensus
+
+import (
+	"slices"
+	"time"
+)
+
+const (
+	// adaptiveTimeoutWindow is the number of recent heights that their proposal arrival times are kept.
+	adaptiveTimeoutWindow = 21
+	// adaptiveTimeoutMinSamples is the number of samples needed before adjusting the timeout.
+	adaptiveTimeoutMinSamples = 5
+	// adaptiveTimeoutFactor is the ratio of the timeout to the slow proposal arrival times,
+	// leaving enough margin for the proposals that arrive later than usual.
+	adaptiveTimeoutFactor = 2
+)
+
+// adaptiveTimeout keeps the proposal arrival times of the recent heights.
+// The arrival time is measured from the start of the round to receiving its proposal.
+type adaptiveTimeout struct {
+	delays []time.Duration
+
+	roundHeight    uint32
+	roundNumber    int16
+	roundStartedAt time.Time
+	sampled        bool
+}
+
+func newAdaptiveTimeout() *adaptiveTimeout {
+	return &adaptiveTimeout{
+		delays: make([]time.Duration, 0, adaptiveTimeoutWindow),
+	}
+}
+
+// startRound records the start time of a round.
+func (a *adaptiveTimeout) startRound(height uint32, round int16, now time.Time) {
+	if a.roundHeight != height {
+		a.sampled = false
+	}
+	a.roundHeight = height
+	a.roundNumber = round
+	a.roundStartedAt = now
+}
+
+// proposalReceived records the arrival time of the proposal, once per height.
+// Proposals received before the round starts are not recorded.
+func (a *adaptiveTimeout) proposalReceived(height uint32, round int16, now time.Time) {
+	if a.sampled || a.roundHeight != height || a.roundNumber != round {
+		return
+	}
+	a.sampled = true
+
+	a.delays = append(a.delays, now.Sub(a.roundStartedAt))
+	if len(a.delays) > adaptiveTimeoutWindow {
+		a.delays = a.delays[1:]
+	}
+}
+
+// timeout returns the change-proposer timeout of the first round.
+// It is twice the 90th percentile of the recent arrival times, within the bounds of the config.
+// Until there are enough samples, the configured timeout is used.
+func (a *adaptiveTimeout) timeout(conf *Config) time.Duration {
+	if len(a.delays) < adaptiveTimeoutMinSamples {
+		return conf.ChangeProposerTimeout
+	}
+
+	sorted := slices.Clone(a.delays)
+	slices.Sort(sorted)
+	slow := sorted[(len(sorted)*9)/10]
+
+	return min(max(slow*adaptiveTimeoutFactor, conf.MinChangeProposerTimeout), conf.MaxChangeProposerTimeout)
+}
+
+// changeProposerTimeout returns the change-proposer timeout of the current round.
+func (cs *consensus) changeProposerTimeout() time.Duration {
+	if !cs.config.AdaptiveTimeout {
+		return cs.config.CalculateChangeProposerTimeout(cs.round)
+	}
+
+	timeout := cs.adaptiveTimeout.timeout(cs.config) +
+		cs.config.ChangeProposerDelta*time.Duration(cs.round)
+	changeProposerTimeoutMetric.WithLabelValues(cs.signer.Address().String()).Set(timeout.Seconds())
+
+	return timeout
+}
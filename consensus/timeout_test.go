@@ -0,0 +1,100 @@
+package consensus
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdaptiveTimeoutMinSamples(t *testing.T) {
+	conf := DefaultConfig()
+	at := newAdaptiveTimeout()
+	now := time.Now()
+
+	for h := uint32(1); h < adaptiveTimeoutMinSamples; h++ {
+		at.startRound(h, 0, now)
+		at.proposalReceived(h, 0, now.Add(10*time.Second))
+	}
+	assert.Equal(t, conf.ChangeProposerTimeout, at.timeout(conf))
+
+	at.startRound(adaptiveTimeoutMinSamples, 0, now)
+	at.proposalReceived(adaptiveTimeoutMinSamples, 0, now.Add(10*time.Second))
+	assert.Equal(t, 20*time.Second, at.timeout(conf))
+}
+
+func TestAdaptiveTimeoutPercentile(t *testing.T) {
+	conf := DefaultConfig()
+	at := newAdaptiveTimeout()
+	now := time.Now()
+
+	// Nine fast proposals and one slow proposal.
+	for h := uint32(1); h <= 10; h++ {
+		delay := 2 * time.Second
+		if h == 5 {
+			delay = 9 * time.Second
+		}
+		at.startRound(h, 0, now)
+		at.proposalReceived(h, 0, now.Add(delay))
+	}
+	assert.Equal(t, 18*time.Second, at.timeout(conf))
+
+	// The slow proposal leaves the window.
+	for h := uint32(11); h <= 30; h++ {
+		at.startRound(h, 0, now)
+		at.proposalReceived(h, 0, now.Add(2*time.Second))
+	}
+	assert.Len(t, at.delays, adaptiveTimeoutWindow)
+	assert.Equal(t, 4*time.Second, at.timeout(conf))
+}
+
+func TestAdaptiveTimeoutBounds(t *testing.T) {
+	conf := DefaultConfig()
+	now := time.Now()
+
+	fast := newAdaptiveTimeout()
+	slow := newAdaptiveTimeout()
+	for h := uint32(1); h <= 10; h++ {
+		fast.startRound(h, 0, now)
+		fast.proposalReceived(h, 0, now.Add(100*time.Millisecond))
+
+		slow.startRound(h, 0, now)
+		slow.proposalReceived(h, 0, now.Add(time.Minute))
+	}
+
+	assert.Equal(t, conf.MinChangeProposerTimeout, fast.timeout(conf))
+	assert.Equal(t, conf.MaxChangeProposerTimeout, slow.timeout(conf))
+}
+
+func TestAdaptiveTimeoutSampling(t *testing.T) {
+	at := newAdaptiveTimeout()
+	now := time.Now()
+
+	// Proposals of other heights or rounds are not sampled.
+	at.startRound(1, 0, now)
+	at.proposalReceived(2, 0, now.Add(time.Second))
+	at.proposalReceived(1, 1, now.Add(time.Second))
+	assert.Empty(t, at.delays)
+
+	// Only the first proposal of a height is sampled.
+	at.proposalReceived(1, 0, now.Add(time.Second))
+	at.startRound(1, 1, now.Add(5*time.Second))
+	at.proposalReceived(1, 1, now.Add(6*time.Second))
+	assert.Equal(t, []time.Duration{time.Second}, at.delays)
+
+	at.startRound(2, 0, now)
+	at.proposalReceived(2, 0, now.Add(3*time.Second))
+	assert.Equal(t, []time.Duration{time.Second, 3 * time.Second}, at.delays)
+}
+
+func TestAdaptiveChangeProposerTimeout(t *testing.T) {
+	td := setup(t)
+
+	td.enterNewHeight(td.consP)
+	assert.Equal(t, td.consP.config.CalculateChangeProposerTimeout(0), td.consP.changeProposerTimeout())
+
+	td.consP.config.AdaptiveTimeout = true
+	td.consP.round = 2
+	assert.Equal(t, td.consP.config.ChangeProposerTimeout+2*td.consP.config.ChangeProposerDelta,
+		td.consP.changeProposerTimeout())
+}
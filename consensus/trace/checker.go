@@ -0,0 +1,510 @@
+package trace
+
+import (
+	"fmt"
+
+	"github.com/pactus-project/pactus/types/vote"
+)
+
+type roundKey struct {
+	height uint32
+	round  int16
+}
+
+type voteKey struct {
+	typ     vote.Type
+	height  uint32
+	round   int16
+	cpRound int16
+}
+
+func makeVoteKey(v *Vote) voteKey {
+	return voteKey{
+		typ:     v.Type,
+		height:  v.Height,
+		round:   v.Round,
+		cpRound: v.CPRound,
+	}
+}
+
+// replica keeps the state of a replica and the messages it has observed.
+type replica struct {
+	last       *Event // The last event
+	lastState  *Event // The last state event
+	committee  map[string]int64
+	totalPower int64
+	proposer   string
+	proposals  map[roundKey]string
+	votes      map[voteKey]map[string]*Vote
+	own        map[voteKey]*Vote
+
+	// The expected value of the initial pre-vote, decided by the state that timed out.
+	initPreVote vote.CPValue
+}
+
+func newReplica() *replica {
+	return &replica{
+		proposals: make(map[roundKey]string),
+		votes:     make(map[voteKey]map[string]*Vote),
+		own:       make(map[voteKey]*Vote),
+	}
+}
+
+// Checker validates the trace events against the state machine of the consensus specification.
+//
+// Each replica is checked separately, using the messages it has observed,
+// and the committed blocks of all replicas are checked for agreement.
+// The state names and transitions follow the specification, with these refinements:
+//   - The timeout of the spec starts the change-proposer phase from the propose, prepare or precommit state.
+//     A replica may take it only once per round, like the `timeout` flag of the spec.
+//   - A decision of the change-proposer phase can also be made by a `DECIDED` vote,
+//     which the spec doesn't model.
+//   - A replica can move to a new height at any time, after syncing the committed blocks.
+//
+// The quorum guards are skipped until the replica enters a new height and the committee is known.
+type Checker struct {
+	replicas  map[string]*replica
+	committed map[uint32]string
+	index     int
+}
+
+// NewChecker creates a new checker.
+func NewChecker() *Checker {
+	return &Checker{
+		replicas:  make(map[string]*replica),
+		committed: make(map[uint32]string),
+	}
+}
+
+// Check validates the given trace events.
+func Check(events []*Event) error {
+	checker := NewChecker()
+	for _, e := range events {
+		if err := checker.Add(e); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Add checks the next event of a replica.
+// Events of each replica should be added in order, but the events of
+// different replicas can be interleaved.
+func (c *Checker) Add(e *Event) error {
+	c.index++
+
+	r, ok := c.replicas[e.Replica]
+	if !ok {
+		r = newReplica()
+		c.replicas[e.Replica] = r
+	}
+
+	var reason string
+	switch e.Kind {
+	case KindState:
+		reason = c.checkState(r, e)
+	case KindProposal:
+		reason = r.checkProposal(e)
+	case KindVote:
+		reason = r.checkVote(e)
+	default:
+		reason = fmt.Sprintf("unknown event kind: %s", e.Kind)
+	}
+
+	if reason == "" && e.Kind != KindState && r.last != nil {
+		reason = r.checkSameState(e)
+	}
+
+	if reason != "" {
+		return DriftError{
+			Index:   c.index,
+			Replica: e.Replica,
+			Reason:  reason,
+		}
+	}
+
+	r.last = e
+	if e.Kind == KindState {
+		r.lastState = e
+	}
+
+	return nil
+}
+
+// checkSameState ensures that the state only changes by the state events.
+func (r *replica) checkSameState(e *Event) string {
+	if e.State != r.last.State {
+		return fmt.Sprintf("state changed from %s to %s without a transition", r.last.State, e.State)
+	}
+
+	// The height is updated right after entering the new-height state.
+	if e.State != StateNewHeight &&
+		(e.Height != r.last.Height || e.Round != r.last.Round) {
+		return fmt.Sprintf("height or round changed from %d/%d to %d/%d without a transition",
+			r.last.Height, r.last.Round, e.Height, e.Round)
+	}
+
+	return ""
+}
+
+func (c *Checker) checkState(r *replica, e *Event) string {
+	prev := r.lastState
+	if prev == nil {
+		// The trace may start at any state.
+		if e.State == StateNewHeight {
+			r.enterNewHeight(e)
+		}
+
+		return ""
+	}
+
+	sameRound := e.Height == prev.Height && e.Round == prev.Round
+	switch e.State {
+	case StateNewHeight:
+		r.enterNewHeight(e)
+
+		return ""
+
+	case StatePropose:
+		if prev.State == StateNewHeight {
+			if e.Height <= prev.Height {
+				return fmt.Sprintf("invalid new height %d after height %d", e.Height, prev.Height)
+			}
+			if e.Round != 0 && !r.decided(prev, e.Height, e.Round-1, vote.CPValueYes) {
+				return fmt.Sprintf("moved to round %d/%d without deciding to change the proposer", e.Height, e.Round)
+			}
+		} else if e.Height != prev.Height || e.Round <= prev.Round ||
+			!r.decided(prev, e.Height, e.Round-1, vote.CPValueYes) {
+			return fmt.Sprintf("moved to round %d/%d without deciding to change the proposer", e.Height, e.Round)
+		}
+		r.proposer = e.Proposer
+
+	case StatePrepare:
+		if prev.State == StatePropose && sameRound {
+			return ""
+		}
+		if e.Height != prev.Height || e.Round < prev.Round ||
+			(e.Round == prev.Round && !isCPState(prev.State)) ||
+			!r.decided(prev, e.Height, e.Round, vote.CPValueNo) {
+			return fmt.Sprintf("moved from %s to prepare without deciding to keep the proposer", prev.State)
+		}
+
+	case StatePrecommit:
+		if prev.State != StatePrepare || !sameRound {
+			return fmt.Sprintf("invalid transition from %s to precommit", prev.State)
+		}
+		if !r.hasQuorum(r.votePower(voteKey{vote.VoteTypePrepare, e.Height, e.Round, 0}, nil)) {
+			return "moved to precommit without a quorum of prepare votes"
+		}
+
+	case StateCommit:
+		return c.checkCommit(r, prev, e)
+
+	case StateCPPreVote:
+		return r.checkCPPreVoteState(prev, e)
+
+	case StateCPMainVote:
+		if prev.State != StateCPPreVote || !sameRound || e.CPRound != prev.CPRound {
+			return fmt.Sprintf("invalid transition from %s to cp:main-vote", prev.State)
+		}
+
+	case StateCPDecide:
+		if prev.State != StateCPMainVote || !sameRound || e.CPRound != prev.CPRound {
+			return fmt.Sprintf("invalid transition from %s to cp:decide", prev.State)
+		}
+		key := voteKey{vote.VoteTypeCPPreVote, e.Height, e.Round, e.CPRound}
+		if !r.hasQuorum(r.votePower(key, nil)) {
+			return "moved to cp:decide without a quorum of pre-votes"
+		}
+
+	default:
+		return fmt.Sprintf("unknown state: %s", e.State)
+	}
+
+	return ""
+}
+
+func (c *Checker) checkCommit(r *replica, prev, e *Event) string {
+	if prev.State != StatePrecommit || e.Height != prev.Height || e.Round != prev.Round {
+		return fmt.Sprintf("invalid transition from %s to commit", prev.State)
+	}
+	if !r.hasQuorum(r.votePower(voteKey{vote.VoteTypePrepare, e.Height, e.Round, 0}, nil)) {
+		return "committed without a quorum of prepare votes"
+	}
+	if !r.hasQuorum(r.votePower(voteKey{vote.VoteTypePrecommit, e.Height, e.Round, 0}, nil)) {
+		return "committed without a quorum of precommit votes"
+	}
+	block, ok := r.proposals[roundKey{e.Height, e.Round}]
+	if !ok {
+		return "committed without a proposal"
+	}
+	if block != e.Block {
+		return fmt.Sprintf("committed block %s is not the proposed block %s", e.Block, block)
+	}
+
+	committed, ok := c.committed[e.Height]
+	if !ok {
+		c.committed[e.Height] = e.Block
+	} else if committed != e.Block {
+		return fmt.Sprintf("agreement violated at height %d: committed %s, but %s is committed by others",
+			e.Height, e.Block, committed)
+	}
+
+	return ""
+}
+
+func (r *replica) checkCPPreVoteState(prev, e *Event) string {
+	sameRound := e.Height == prev.Height && e.Round == prev.Round
+	switch prev.State {
+	case StatePropose, StatePrepare, StatePrecommit:
+		// Timeout
+		if !sameRound || e.CPRound != 0 || e.CPDecided != -1 {
+			return fmt.Sprintf("invalid timeout from %s", prev.State)
+		}
+		if prev.State == StatePrecommit {
+			r.initPreVote = vote.CPValueNo
+		} else {
+			r.initPreVote = vote.CPValueYes
+		}
+
+	case StateCPDecide:
+		if !sameRound || e.CPRound != prev.CPRound+1 {
+			return fmt.Sprintf("invalid change-proposer round %d after %d", e.CPRound, prev.CPRound)
+		}
+		key := voteKey{vote.VoteTypeCPMainVote, e.Height, e.Round, prev.CPRound}
+		if !r.hasQuorum(r.votePower(key, nil)) ||
+			r.hasQuorum(r.votePower(key, cpValue(vote.CPValueYes))) ||
+			r.hasQuorum(r.votePower(key, cpValue(vote.CPValueNo))) {
+			return "moved to the next change-proposer round without conflicting main-votes"
+		}
+
+	default:
+		return fmt.Sprintf("invalid transition from %s to cp:pre-vote", prev.State)
+	}
+
+	return ""
+}
+
+func (r *replica) checkProposal(e *Event) string {
+	p := e.Proposal
+	if p == nil {
+		return "proposal event without proposal"
+	}
+
+	key := roundKey{p.Height, p.Round}
+	block, ok := r.proposals[key]
+	if ok && block != p.Block {
+		return fmt.Sprintf("more than one proposal for round %d/%d", p.Height, p.Round)
+	}
+	r.proposals[key] = p.Block
+
+	if p.Proposer == e.Replica {
+		if e.State != StatePropose || p.Height != e.Height || p.Round != e.Round {
+			return fmt.Sprintf("proposal created in %s state", e.State)
+		}
+		if r.proposer != "" && r.proposer != e.Replica {
+			return "proposal created by a replica that is not the proposer"
+		}
+	}
+
+	return ""
+}
+
+func (r *replica) checkVote(e *Event) string {
+	v := e.Vote
+	if v == nil {
+		return "vote event without vote"
+	}
+
+	key := makeVoteKey(v)
+	if v.Signer == e.Replica {
+		if reason := r.checkOwnVote(e, v); reason != "" {
+			return reason
+		}
+
+		own, ok := r.own[key]
+		if ok && (own.Block != v.Block || own.CPValue != v.CPValue) {
+			return fmt.Sprintf("conflicting %s votes signed at %d/%d", v.Type, v.Height, v.Round)
+		}
+		r.own[key] = v
+	}
+
+	votes, ok := r.votes[key]
+	if !ok {
+		votes = make(map[string]*Vote)
+		r.votes[key] = votes
+	}
+	votes[v.Signer] = v
+
+	return ""
+}
+
+//nolint:exhaustive // other types are checked by BasicCheck
+func (r *replica) checkOwnVote(e *Event, v *Vote) string {
+	expectedState := map[vote.Type]string{
+		vote.VoteTypePrepare:    StatePrepare,
+		vote.VoteTypePrecommit:  StatePrecommit,
+		vote.VoteTypeCPPreVote:  StateCPPreVote,
+		vote.VoteTypeCPMainVote: StateCPMainVote,
+		vote.VoteTypeCPDecided:  StateCPDecide,
+	}[v.Type]
+	if e.State != expectedState {
+		return fmt.Sprintf("%s vote signed in %s state", v.Type, e.State)
+	}
+
+	switch v.Type {
+	case vote.VoteTypePrepare:
+		if r.proposals[roundKey{v.Height, v.Round}] != v.Block {
+			return "prepare vote signed without the proposal"
+		}
+
+	case vote.VoteTypePrecommit:
+		if r.proposals[roundKey{v.Height, v.Round}] != v.Block {
+			return "precommit vote signed without the proposal"
+		}
+		if !r.hasQuorum(r.votePower(voteKey{vote.VoteTypePrepare, v.Height, v.Round, 0}, nil)) {
+			return "precommit vote signed without a quorum of prepare votes"
+		}
+
+	case vote.VoteTypeCPPreVote:
+		return r.checkOwnPreVote(v)
+
+	case vote.VoteTypeCPMainVote:
+		key := voteKey{vote.VoteTypeCPPreVote, v.Height, v.Round, v.CPRound}
+		switch v.CPValue {
+		case vote.CPValueYes, vote.CPValueNo:
+			if !r.hasQuorum(r.votePower(key, cpValue(v.CPValue))) {
+				return fmt.Sprintf("main-vote for %s without a quorum of pre-votes", v.CPValue)
+			}
+		case vote.CPValueAbstain:
+			if r.votePower(key, cpValue(vote.CPValueYes)) == 0 ||
+				r.votePower(key, cpValue(vote.CPValueNo)) == 0 {
+				return "abstain main-vote without conflicting pre-votes"
+			}
+		}
+
+	case vote.VoteTypeCPDecided:
+		key := voteKey{vote.VoteTypeCPMainVote, v.Height, v.Round, v.CPRound}
+		if !r.hasQuorum(r.votePower(key, cpValue(v.CPValue))) {
+			return fmt.Sprintf("decided %s without a quorum of main-votes", v.CPValue)
+		}
+	}
+
+	return ""
+}
+
+func (r *replica) checkOwnPreVote(v *Vote) string {
+	if v.CPRound == 0 {
+		if v.CPValue != r.initPreVote {
+			return fmt.Sprintf("initial pre-vote for %s, expected %s", v.CPValue, r.initPreVote)
+		}
+
+		return ""
+	}
+
+	key := voteKey{vote.VoteTypeCPMainVote, v.Height, v.Round, v.CPRound - 1}
+	switch v.CPValue {
+	case vote.CPValueYes:
+		if r.votePower(key, cpValue(vote.CPValueYes)) > 0 {
+			return ""
+		}
+	case vote.CPValueNo:
+		if r.votePower(key, cpValue(vote.CPValueNo)) > 0 ||
+			r.hasQuorum(r.votePower(key, cpValue(vote.CPValueAbstain))) {
+			return ""
+		}
+	case vote.CPValueAbstain:
+	}
+
+	return fmt.Sprintf("pre-vote for %s is not justified by the previous main-votes", v.CPValue)
+}
+
+func (r *replica) enterNewHeight(e *Event) {
+	if len(e.Committee) > 0 {
+		r.committee = make(map[string]int64, len(e.Committee))
+		r.totalPower = 0
+		for _, m := range e.Committee {
+			r.committee[m.Address] = m.Power
+			r.totalPower += m.Power
+		}
+	}
+
+	// Messages of the previous heights are not needed anymore.
+	for key := range r.proposals {
+		if key.height <= e.Height {
+			delete(r.proposals, key)
+		}
+	}
+	for key := range r.votes {
+		if key.height <= e.Height {
+			delete(r.votes, key)
+		}
+	}
+	for key := range r.own {
+		if key.height <= e.Height {
+			delete(r.own, key)
+		}
+	}
+	r.proposer = ""
+}
+
+// decided checks if the change-proposer phase of the given round is decided for the value,
+// either by a quorum of main-votes in the last change-proposer round or by a decided vote.
+func (r *replica) decided(prev *Event, height uint32, round int16, value vote.CPValue) bool {
+	if prev.Round == round {
+		key := voteKey{vote.VoteTypeCPMainVote, height, round, prev.CPRound}
+		if r.hasQuorum(r.votePower(key, cpValue(value))) {
+			return true
+		}
+	}
+
+	for key, votes := range r.votes {
+		if key.typ != vote.VoteTypeCPDecided || key.height != height || key.round != round {
+			continue
+		}
+		for _, v := range votes {
+			if v.CPValue == value {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (r *replica) votePower(key voteKey, filter func(v *Vote) bool) int64 {
+	power := int64(0)
+	for signer, v := range r.votes[key] {
+		if filter != nil && !filter(v) {
+			continue
+		}
+		if r.committee == nil {
+			power++
+		} else {
+			power += r.committee[signer]
+		}
+	}
+
+	return power
+}
+
+// hasQuorum checks if the power is more than 2/3 of the total power.
+// It returns true if the committee is not known.
+func (r *replica) hasQuorum(power int64) bool {
+	if r.committee == nil {
+		return true
+	}
+
+	return power > (r.totalPower * 2 / 3)
+}
+
+func cpValue(value vote.CPValue) func(v *Vote) bool {
+	return func(v *Vote) bool {
+		return v.CPValue == value
+	}
+}
+
+func isCPState(name string) bool {
+	return name == StateCPPreVote || name == StateCPMainVote || name == StateCPDecide
+}
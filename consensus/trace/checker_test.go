@@ -0,0 +1,279 @@
+package trace
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/pactus-project/pactus/types/vote"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testTrace struct {
+	replicas  []string
+	committee []Member
+	events    []*Event
+	states    map[string]*Event
+}
+
+func newTestTrace(numReplicas int) *testTrace {
+	tr := &testTrace{
+		states: make(map[string]*Event),
+	}
+	for i := 0; i < numReplicas; i++ {
+		addr := fmt.Sprintf("replica-%d", i)
+		tr.replicas = append(tr.replicas, addr)
+		tr.committee = append(tr.committee, Member{Address: addr, Power: 1})
+	}
+
+	return tr
+}
+
+func (tr *testTrace) state(replica, state string, height uint32, round, cpRound int16, cpDecided int) *Event {
+	e := &Event{
+		Replica:   replica,
+		Kind:      KindState,
+		State:     state,
+		Height:    height,
+		Round:     round,
+		CPRound:   cpRound,
+		CPDecided: cpDecided,
+	}
+	if state == StateNewHeight {
+		e.Committee = tr.committee
+	}
+	tr.states[replica] = e
+	tr.events = append(tr.events, e)
+
+	return e
+}
+
+func (tr *testTrace) event(replica string, kind Kind) *Event {
+	st := tr.states[replica]
+	e := &Event{
+		Replica:   replica,
+		Kind:      kind,
+		State:     st.State,
+		Height:    st.Height,
+		Round:     st.Round,
+		CPRound:   st.CPRound,
+		CPDecided: st.CPDecided,
+	}
+	tr.events = append(tr.events, e)
+
+	return e
+}
+
+func (tr *testTrace) proposal(replica string, height uint32, round int16, block, proposer string) {
+	e := tr.event(replica, KindProposal)
+	e.Proposal = &Proposal{Height: height, Round: round, Block: block, Proposer: proposer}
+}
+
+func (tr *testTrace) vote(replica string, v Vote) {
+	e := tr.event(replica, KindVote)
+	e.Vote = &v
+}
+
+// votes adds the votes of all replicas to the log of the replica, starting with its own vote.
+func (tr *testTrace) votes(replica string, v Vote) {
+	v.Signer = replica
+	tr.vote(replica, v)
+	for _, signer := range tr.replicas {
+		if signer != replica {
+			v.Signer = signer
+			tr.vote(replica, v)
+		}
+	}
+}
+
+// commitHeight adds the events of committing a block in round zero by all replicas.
+func (tr *testTrace) commitHeight(height uint32, block string) {
+	proposer := tr.replicas[0]
+	for _, r := range tr.replicas {
+		tr.state(r, StateNewHeight, height-1, 0, 0, -1)
+		tr.state(r, StatePropose, height, 0, 0, -1).Proposer = proposer
+		tr.proposal(r, height, 0, block, proposer)
+		tr.state(r, StatePrepare, height, 0, 0, -1)
+		tr.votes(r, Vote{Type: vote.VoteTypePrepare, Height: height, Block: block})
+		tr.state(r, StatePrecommit, height, 0, 0, -1)
+		tr.votes(r, Vote{Type: vote.VoteTypePrecommit, Height: height, Block: block})
+		tr.state(r, StateCommit, height, 0, 0, -1).Block = block
+	}
+}
+
+// changeProposer adds the events of changing the proposer of round zero by all replicas.
+func (tr *testTrace) changeProposer(height uint32) {
+	for _, r := range tr.replicas {
+		tr.state(r, StateNewHeight, height-1, 0, 0, -1)
+		tr.state(r, StatePropose, height, 0, 0, -1).Proposer = tr.replicas[0]
+		tr.state(r, StatePrepare, height, 0, 0, -1)
+		tr.state(r, StateCPPreVote, height, 0, 0, -1)
+		tr.votes(r, Vote{
+			Type: vote.VoteTypeCPPreVote, Height: height,
+			CPValue: vote.CPValueYes,
+		})
+		tr.state(r, StateCPMainVote, height, 0, 0, -1)
+		tr.votes(r, Vote{
+			Type: vote.VoteTypeCPMainVote, Height: height,
+			CPValue: vote.CPValueYes,
+		})
+		tr.state(r, StateCPDecide, height, 0, 0, -1)
+		tr.vote(r, Vote{
+			Type: vote.VoteTypeCPDecided, Height: height,
+			CPValue: vote.CPValueYes, Signer: r,
+		})
+		tr.state(r, StatePropose, height, 1, 0, 1).Proposer = tr.replicas[1]
+	}
+}
+
+func requireDrift(t *testing.T, events []*Event, reason string) {
+	t.Helper()
+
+	err := Check(events)
+	var driftErr DriftError
+	require.ErrorAs(t, err, &driftErr)
+	assert.Contains(t, driftErr.Reason, reason)
+}
+
+func TestCheckValidTrace(t *testing.T) {
+	tr := newTestTrace(4)
+	tr.commitHeight(1, "block-1")
+	tr.commitHeight(2, "block-2")
+
+	assert.NoError(t, Check(tr.events))
+}
+
+func TestCheckChangeProposer(t *testing.T) {
+	tr := newTestTrace(4)
+	tr.changeProposer(1)
+
+	assert.NoError(t, Check(tr.events))
+}
+
+func TestCheckPrecommitWithoutQuorum(t *testing.T) {
+	tr := newTestTrace(4)
+	r := tr.replicas[0]
+	tr.state(r, StateNewHeight, 0, 0, 0, -1)
+	tr.state(r, StatePropose, 1, 0, 0, -1).Proposer = r
+	tr.proposal(r, 1, 0, "block-1", r)
+	tr.state(r, StatePrepare, 1, 0, 0, -1)
+	tr.vote(r, Vote{Type: vote.VoteTypePrepare, Height: 1, Block: "block-1", Signer: r})
+	tr.vote(r, Vote{Type: vote.VoteTypePrepare, Height: 1, Block: "block-1", Signer: tr.replicas[1]})
+	tr.state(r, StatePrecommit, 1, 0, 0, -1)
+
+	requireDrift(t, tr.events, "without a quorum of prepare votes")
+}
+
+func TestCheckAgreement(t *testing.T) {
+	tr := newTestTrace(4)
+	tr.commitHeight(1, "block-1")
+	tr.events[len(tr.events)-1].Block = "block-2"
+
+	requireDrift(t, tr.events, "committed block block-2 is not the proposed block")
+}
+
+func TestCheckAgreementBetweenReplicas(t *testing.T) {
+	tr1 := newTestTrace(4)
+	tr1.commitHeight(1, "block-1")
+	tr2 := newTestTrace(4)
+	tr2.commitHeight(1, "block-2")
+	for _, e := range tr2.events {
+		e.Replica += "-other"
+	}
+
+	requireDrift(t, append(tr1.events, tr2.events...), "agreement violated at height 1")
+}
+
+func TestCheckDoubleProposal(t *testing.T) {
+	tr := newTestTrace(4)
+	r := tr.replicas[1]
+	tr.state(r, StateNewHeight, 0, 0, 0, -1)
+	tr.state(r, StatePropose, 1, 0, 0, -1).Proposer = tr.replicas[0]
+	tr.proposal(r, 1, 0, "block-1", tr.replicas[0])
+	tr.proposal(r, 1, 0, "block-2", tr.replicas[0])
+
+	requireDrift(t, tr.events, "more than one proposal for round 1/0")
+}
+
+func TestCheckProposalByNonProposer(t *testing.T) {
+	tr := newTestTrace(4)
+	r := tr.replicas[1]
+	tr.state(r, StateNewHeight, 0, 0, 0, -1)
+	tr.state(r, StatePropose, 1, 0, 0, -1).Proposer = tr.replicas[0]
+	tr.proposal(r, 1, 0, "block-1", r)
+
+	requireDrift(t, tr.events, "not the proposer")
+}
+
+func TestCheckVoteWithoutProposal(t *testing.T) {
+	tr := newTestTrace(4)
+	r := tr.replicas[0]
+	tr.state(r, StateNewHeight, 0, 0, 0, -1)
+	tr.state(r, StatePropose, 1, 0, 0, -1).Proposer = r
+	tr.proposal(r, 1, 0, "block-1", r)
+	tr.state(r, StatePrepare, 1, 0, 0, -1)
+	tr.vote(r, Vote{Type: vote.VoteTypePrepare, Height: 1, Block: "block-2", Signer: r})
+
+	requireDrift(t, tr.events, "prepare vote signed without the proposal")
+}
+
+func TestCheckEquivocation(t *testing.T) {
+	tr := newTestTrace(4)
+	r := tr.replicas[0]
+	tr.state(r, StateNewHeight, 0, 0, 0, -1)
+	tr.state(r, StatePropose, 1, 0, 0, -1).Proposer = r
+	tr.state(r, StatePrepare, 1, 0, 0, -1)
+	tr.state(r, StateCPPreVote, 1, 0, 0, -1)
+	tr.votes(r, Vote{Type: vote.VoteTypeCPPreVote, Height: 1, CPValue: vote.CPValueYes})
+	tr.vote(r, Vote{Type: vote.VoteTypeCPPreVote, Height: 1, CPValue: vote.CPValueNo, Signer: "replica-3"})
+	tr.state(r, StateCPMainVote, 1, 0, 0, -1)
+	tr.vote(r, Vote{Type: vote.VoteTypeCPMainVote, Height: 1, CPValue: vote.CPValueYes, Signer: r})
+	tr.vote(r, Vote{Type: vote.VoteTypeCPMainVote, Height: 1, CPValue: vote.CPValueAbstain, Signer: r})
+
+	requireDrift(t, tr.events, "conflicting MAIN-VOTE votes")
+}
+
+func TestCheckNewRoundWithoutDecision(t *testing.T) {
+	tr := newTestTrace(4)
+	r := tr.replicas[0]
+	tr.state(r, StateNewHeight, 0, 0, 0, -1)
+	tr.state(r, StatePropose, 1, 0, 0, -1).Proposer = r
+	tr.state(r, StatePrepare, 1, 0, 0, -1)
+	tr.state(r, StatePropose, 1, 1, 0, -1)
+
+	requireDrift(t, tr.events, "without deciding to change the proposer")
+}
+
+func TestCheckInvalidInitialPreVote(t *testing.T) {
+	tr := newTestTrace(4)
+	r := tr.replicas[0]
+	tr.state(r, StateNewHeight, 0, 0, 0, -1)
+	tr.state(r, StatePropose, 1, 0, 0, -1).Proposer = r
+	tr.state(r, StatePrepare, 1, 0, 0, -1)
+	tr.state(r, StateCPPreVote, 1, 0, 0, -1)
+	tr.vote(r, Vote{Type: vote.VoteTypeCPPreVote, Height: 1, CPValue: vote.CPValueNo, Signer: r})
+
+	requireDrift(t, tr.events, "initial pre-vote for")
+}
+
+func TestCheckStateChangeWithoutTransition(t *testing.T) {
+	tr := newTestTrace(4)
+	r := tr.replicas[0]
+	tr.state(r, StateNewHeight, 0, 0, 0, -1)
+	tr.state(r, StatePropose, 1, 0, 0, -1).Proposer = tr.replicas[1]
+	e := tr.event(r, KindProposal)
+	e.State = StatePrepare
+	e.Proposal = &Proposal{Height: 1, Block: "block-1", Proposer: tr.replicas[1]}
+
+	requireDrift(t, tr.events, "without a transition")
+}
+
+func TestCheckUnknownCommittee(t *testing.T) {
+	// A trace can start at any state, the quorum guards are skipped until the committee is known.
+	tr := newTestTrace(4)
+	r := tr.replicas[0]
+	tr.state(r, StatePrepare, 1, 0, 0, -1)
+	tr.state(r, StatePrecommit, 1, 0, 0, -1)
+
+	assert.NoError(t, Check(tr.events))
+}
@@ -0,0 +1,23 @@
+package trace
+
+import "fmt"
+
+// DriftError is returned when a trace event is not allowed by the consensus specification.
+type DriftError struct {
+	Index   int
+	Replica string
+	Reason  string
+}
+
+func (e DriftError) Error() string {
+	return fmt.Sprintf("event %d of replica %s: %s", e.Index, e.Replica, e.Reason)
+}
+
+// ExportError is returned when a trace can't be exported as a TLA+ behavior.
+type ExportError struct {
+	Reason string
+}
+
+func (e ExportError) Error() string {
+	return e.Reason
+}
@@ -0,0 +1,284 @@
+package trace
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pactus-project/pactus/types/vote"
+)
+
+// TLA is a trace exported as a TLA+ behavior of the consensus specification.
+//
+// The module extends the `Pactus` module and replays the observed states of the replicas,
+// one state per trace event, so TLC can check the invariants of the specification on them.
+// The height of the trace is renumbered to one, so the model configuration uses `MaxHeight = 1`.
+type TLA struct {
+	Name   string
+	Module string
+	Config string
+}
+
+type tlaState struct {
+	name      string
+	height    int
+	round     int16
+	timeout   bool
+	cpRound   int16
+	cpDecided int
+}
+
+func (s tlaState) String() string {
+	return fmt.Sprintf("[name |-> %q, height |-> %d, round |-> %d, timeout |-> %s, cp_round |-> %d, cp_decided |-> %d]",
+		s.name, s.height, s.round, tlaBool(s.timeout), s.cpRound, s.cpDecided)
+}
+
+type tlaMessage struct {
+	typ     string
+	height  int
+	round   int16
+	index   int
+	cpRound int16
+	cpVal   vote.CPValue
+}
+
+func (m tlaMessage) String() string {
+	return fmt.Sprintf("[type |-> %q, height |-> %d, round |-> %d, index |-> %d, cp_round |-> %d, cp_val |-> %d]",
+		m.typ, m.height, m.round, m.index, m.cpRound, m.cpVal)
+}
+
+// ExportTLA exports the events of the given height as a TLA+ behavior.
+// The committee of the height should have 3f+1 members with the same power,
+// as the specification assumes.
+func ExportTLA(events []*Event, height uint32, name string) (*TLA, error) {
+	if height == 0 {
+		return nil, ExportError{Reason: "height should be greater than zero"}
+	}
+
+	committee := findCommittee(events, height)
+	if committee == nil {
+		return nil, ExportError{Reason: fmt.Sprintf("committee of height %d is not traced", height)}
+	}
+	if len(committee) < 4 || (len(committee)-1)%3 != 0 {
+		return nil, ExportError{
+			Reason: fmt.Sprintf("committee size should be 3f+1 with f >= 1, got %d", len(committee)),
+		}
+	}
+	indices := make(map[string]int, len(committee))
+	for i, m := range committee {
+		if m.Power != committee[0].Power {
+			return nil, ExportError{Reason: "committee members should have the same power"}
+		}
+		indices[m.Address] = i
+	}
+
+	states := make([]tlaState, len(committee))
+	for i := range states {
+		states[i] = tlaState{name: StateNewHeight, cpDecided: -1}
+	}
+	msgs := make([]tlaMessage, 0)
+	seen := make(map[tlaMessage]bool)
+	addMessage := func(m tlaMessage) {
+		if !seen[m] {
+			seen[m] = true
+			msgs = append(msgs, m)
+		}
+	}
+
+	behavior := []string{tlaBehaviorState(states, msgs)}
+	maxRound := int16(1)
+	maxCPRound := int16(1)
+	for _, e := range events {
+		index, ok := indices[e.Replica]
+		if !ok {
+			continue
+		}
+
+		switch e.Kind {
+		case KindState:
+			st, ok := makeTLAState(e, height, states[index])
+			if !ok {
+				continue
+			}
+			states[index] = st
+			maxRound = max(maxRound, st.round)
+			maxCPRound = max(maxCPRound, st.cpRound)
+
+			if e.State == StateCommit {
+				addMessage(tlaMessage{typ: "BLOCK-ANNOUNCE", height: 1, round: e.Round, index: index})
+			}
+
+		case KindProposal:
+			if e.Proposal == nil || e.Proposal.Height != height {
+				continue
+			}
+			proposer, ok := indices[e.Proposal.Proposer]
+			if !ok {
+				continue
+			}
+			addMessage(tlaMessage{typ: "PROPOSAL", height: 1, round: e.Proposal.Round, index: proposer})
+
+		case KindVote:
+			if e.Vote == nil || e.Vote.Height != height {
+				continue
+			}
+			m, ok := makeTLAMessage(e.Vote, indices)
+			if !ok {
+				continue
+			}
+			addMessage(m)
+		}
+
+		behavior = append(behavior, tlaBehaviorState(states, msgs))
+	}
+
+	numFaulty := (len(committee) - 1) / 3
+	module := fmt.Sprintf(`---- MODULE %s ----
+\* The trace of height %d, exported from the consensus trace events.
+EXTENDS Pactus
+
+VARIABLE i
+
+Trace == <<
+%s
+>>
+
+TraceInit ==
+    /\ i = 1
+    /\ log = Trace[1].log
+    /\ states = Trace[1].states
+
+TraceNext ==
+    /\ i < Len(Trace)
+    /\ i' = i + 1
+    /\ log' = Trace[i'].log
+    /\ states' = Trace[i'].states
+
+TraceSpec == TraceInit /\ [][TraceNext]_<<i, log, states>>
+====
+`, name, height, strings.Join(behavior, ",\n"))
+
+	config := fmt.Sprintf(`SPECIFICATION TraceSpec
+CONSTANTS
+  NumFaulty = %d
+  FaultyNodes = {}
+  MaxHeight = 1
+  MaxRound = %d
+  MaxCPRound = %d
+
+INVARIANT TypeOK
+`, numFaulty, maxRound, maxCPRound)
+
+	return &TLA{
+		Name:   name,
+		Module: module,
+		Config: config,
+	}, nil
+}
+
+// WriteFiles writes the module and its model configuration into the given directory.
+func (t *TLA) WriteFiles(dir string) error {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(dir, t.Name+".tla"), []byte(t.Module), 0o600); err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(dir, t.Name+".cfg"), []byte(t.Config), 0o600)
+}
+
+// findCommittee returns the committee of the given height,
+// recorded when a replica enters the new-height state.
+func findCommittee(events []*Event, height uint32) []Member {
+	for _, e := range events {
+		if e.Kind == KindState && e.State == StateNewHeight &&
+			e.Height == height-1 && len(e.Committee) > 0 {
+			return e.Committee
+		}
+	}
+
+	return nil
+}
+
+// makeTLAState maps the state of a replica to the state of the specification.
+// It returns false if the event doesn't belong to the exported height.
+func makeTLAState(e *Event, height uint32, prev tlaState) (tlaState, bool) {
+	st := tlaState{
+		name:      e.State,
+		height:    1,
+		round:     e.Round,
+		timeout:   prev.timeout,
+		cpRound:   e.CPRound,
+		cpDecided: e.CPDecided,
+	}
+
+	switch {
+	case e.State == StateNewHeight && e.Height == height-1:
+		// The height is increased after entering the new-height state.
+		st.height = 0
+		st.timeout = false
+
+	case e.Height != height:
+		return tlaState{}, false
+
+	case e.State == StatePropose:
+		st.timeout = false
+
+	case isCPState(e.State):
+		st.timeout = true
+	}
+
+	return st, true
+}
+
+func makeTLAMessage(v *Vote, indices map[string]int) (tlaMessage, bool) {
+	index, ok := indices[v.Signer]
+	if !ok {
+		return tlaMessage{}, false
+	}
+
+	m := tlaMessage{height: 1, round: v.Round, index: index}
+	switch v.Type {
+	case vote.VoteTypePrepare:
+		m.typ = "PREPARE"
+	case vote.VoteTypePrecommit:
+		m.typ = "PRECOMMIT"
+	case vote.VoteTypeCPPreVote:
+		m.typ = "CP:PRE-VOTE"
+		m.cpRound = v.CPRound
+		m.cpVal = v.CPValue
+	case vote.VoteTypeCPMainVote:
+		m.typ = "CP:MAIN-VOTE"
+		m.cpRound = v.CPRound
+		m.cpVal = v.CPValue
+	default:
+		// Decided votes are not modeled in the specification.
+		return tlaMessage{}, false
+	}
+
+	return m, true
+}
+
+func tlaBehaviorState(states []tlaState, msgs []tlaMessage) string {
+	stateStrs := make([]string, len(states))
+	for i, st := range states {
+		stateStrs[i] = st.String()
+	}
+	msgStrs := make([]string, len(msgs))
+	for i, m := range msgs {
+		msgStrs[i] = m.String()
+	}
+
+	return fmt.Sprintf("[states |-> [j \\in 0..%d |-> <<%s>>[j + 1]],\n log |-> {%s}]",
+		len(states)-1, strings.Join(stateStrs, ", "), strings.Join(msgStrs, ", "))
+}
+
+func tlaBool(b bool) string {
+	if b {
+		return "TRUE"
+	}
+
+	return "FALSE"
+}
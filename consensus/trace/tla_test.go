@@ -0,0 +1,90 @@
+package trace
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pactus-project/pactus/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportTLA(t *testing.T) {
+	tr := newTestTrace(4)
+	tr.commitHeight(1, "block-1")
+	tr.changeProposer(2)
+
+	tla, err := ExportTLA(tr.events, 1, "PactusTrace_1")
+	require.NoError(t, err)
+
+	assert.Contains(t, tla.Module, "---- MODULE PactusTrace_1 ----")
+	assert.Contains(t, tla.Module, "EXTENDS Pactus")
+	assert.Contains(t, tla.Module,
+		`[type |-> "PROPOSAL", height |-> 1, round |-> 0, index |-> 0, cp_round |-> 0, cp_val |-> 0]`)
+	assert.Contains(t, tla.Module,
+		`[type |-> "PRECOMMIT", height |-> 1, round |-> 0, index |-> 3, cp_round |-> 0, cp_val |-> 0]`)
+	assert.Contains(t, tla.Module,
+		`[type |-> "BLOCK-ANNOUNCE", height |-> 1, round |-> 0, index |-> 2, cp_round |-> 0, cp_val |-> 0]`)
+	assert.Contains(t, tla.Module,
+		`[name |-> "commit", height |-> 1, round |-> 0, timeout |-> FALSE, cp_round |-> 0, cp_decided |-> -1]`)
+	// Events of the other heights are not exported
+	assert.NotContains(t, tla.Module, "CP:PRE-VOTE")
+
+	assert.Contains(t, tla.Config, "NumFaulty = 1")
+	assert.Contains(t, tla.Config, "MaxHeight = 1")
+	assert.Contains(t, tla.Config, "INVARIANT TypeOK")
+
+	t.Run("Change proposer", func(t *testing.T) {
+		tla, err := ExportTLA(tr.events, 2, "PactusTrace_2")
+		require.NoError(t, err)
+
+		assert.Contains(t, tla.Module,
+			`[type |-> "CP:MAIN-VOTE", height |-> 1, round |-> 0, index |-> 1, cp_round |-> 0, cp_val |-> 1]`)
+		assert.Contains(t, tla.Module,
+			`[name |-> "cp:decide", height |-> 1, round |-> 0, timeout |-> TRUE, cp_round |-> 0, cp_decided |-> -1]`)
+		assert.Contains(t, tla.Module,
+			`[name |-> "propose", height |-> 1, round |-> 1, timeout |-> FALSE, cp_round |-> 0, cp_decided |-> 1]`)
+		assert.NotContains(t, tla.Module, "DECIDED")
+	})
+
+	t.Run("Write files", func(t *testing.T) {
+		dir := util.TempDirPath()
+		require.NoError(t, tla.WriteFiles(dir))
+
+		module, err := os.ReadFile(filepath.Join(dir, "PactusTrace_1.tla"))
+		require.NoError(t, err)
+		assert.Equal(t, tla.Module, string(module))
+
+		config, err := os.ReadFile(filepath.Join(dir, "PactusTrace_1.cfg"))
+		require.NoError(t, err)
+		assert.Equal(t, tla.Config, string(config))
+	})
+}
+
+func TestExportTLAInvalidCommittee(t *testing.T) {
+	t.Run("Not traced", func(t *testing.T) {
+		tr := newTestTrace(4)
+		tr.commitHeight(1, "block-1")
+
+		_, err := ExportTLA(tr.events, 2, "PactusTrace")
+		assert.ErrorIs(t, err, ExportError{Reason: "committee of height 2 is not traced"})
+	})
+
+	t.Run("Invalid size", func(t *testing.T) {
+		tr := newTestTrace(5)
+		tr.commitHeight(1, "block-1")
+
+		_, err := ExportTLA(tr.events, 1, "PactusTrace")
+		assert.ErrorIs(t, err, ExportError{Reason: "committee size should be 3f+1 with f >= 1, got 5"})
+	})
+
+	t.Run("Different power", func(t *testing.T) {
+		tr := newTestTrace(4)
+		tr.committee[1].Power = 2
+		tr.commitHeight(1, "block-1")
+
+		_, err := ExportTLA(tr.events, 1, "PactusTrace")
+		assert.ErrorIs(t, err, ExportError{Reason: "committee members should have the same power"})
+	})
+}
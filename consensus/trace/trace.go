@@ -0,0 +1,82 @@
+package trace
+
+import (
+	"github.com/pactus-project/pactus/types/vote"
+)
+
+// Kind defines the kind of a trace event.
+type Kind string
+
+const (
+	// KindState is recorded when a replica enters a new state.
+	KindState = Kind("state")
+	// KindProposal is recorded when a proposal is set for a round.
+	KindProposal = Kind("proposal")
+	// KindVote is recorded when a vote is added to the log of a replica.
+	KindVote = Kind("vote")
+)
+
+// State names, as they are defined in the consensus specification.
+const (
+	StateNewHeight  = "new-height"
+	StatePropose    = "propose"
+	StatePrepare    = "prepare"
+	StatePrecommit  = "precommit"
+	StateCommit     = "commit"
+	StateCPPreVote  = "cp:pre-vote"
+	StateCPMainVote = "cp:main-vote"
+	StateCPDecide   = "cp:decide"
+)
+
+// Member is a member of the committee.
+type Member struct {
+	Address string `json:"address"`
+	Power   int64  `json:"power"`
+}
+
+// Proposal is a proposal that is set for a round.
+type Proposal struct {
+	Height   uint32 `json:"height"`
+	Round    int16  `json:"round"`
+	Block    string `json:"block"`
+	Proposer string `json:"proposer"`
+}
+
+// Vote is a vote that is added to the log of a replica.
+type Vote struct {
+	Type    vote.Type    `json:"type"`
+	Height  uint32       `json:"height"`
+	Round   int16        `json:"round"`
+	CPRound int16        `json:"cp_round"`
+	CPValue vote.CPValue `json:"cp_value"`
+	Block   string       `json:"block"`
+	Signer  string       `json:"signer"`
+}
+
+// Event is a structured record of a consensus event in a replica.
+// The state fields keep the state of the replica right after the event.
+type Event struct {
+	Replica   string `json:"replica"`
+	Kind      Kind   `json:"kind"`
+	State     string `json:"state"`
+	Height    uint32 `json:"height"`
+	Round     int16  `json:"round"`
+	CPRound   int16  `json:"cp_round"`
+	CPDecided int    `json:"cp_decided"`
+
+	// Proposer is the proposer of the round, set when the replica enters the propose state.
+	Proposer string `json:"proposer,omitempty"`
+	// Committee is the committee of the next height, set when the replica enters the new-height state.
+	Committee []Member `json:"committee,omitempty"`
+	// Block is the committed block hash, set when the replica enters the commit state.
+	Block string `json:"block,omitempty"`
+
+	Proposal *Proposal `json:"proposal,omitempty"`
+	Vote     *Vote     `json:"vote,omitempty"`
+}
+
+// Tracer records the consensus events.
+// It is called while the consensus lock is held, so it should not block.
+type Tracer interface {
+	Trace(e *Event)
+}
@@ -0,0 +1,118 @@
+package trace
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// Recorder keeps the trace events in memory.
+type Recorder struct {
+	lk     sync.Mutex
+	events []*Event
+}
+
+// NewRecorder creates a new in-memory recorder.
+func NewRecorder() *Recorder {
+	return &Recorder{
+		events: make([]*Event, 0),
+	}
+}
+
+func (r *Recorder) Trace(e *Event) {
+	r.lk.Lock()
+	defer r.lk.Unlock()
+
+	r.events = append(r.events, e)
+}
+
+// Events returns a copy of the recorded events.
+func (r *Recorder) Events() []*Event {
+	r.lk.Lock()
+	defer r.lk.Unlock()
+
+	events := make([]*Event, len(r.events))
+	copy(events, r.events)
+
+	return events
+}
+
+// FileWriter writes the trace events to a file, one JSON object per line.
+// Writing errors are ignored, since tracing should not affect the consensus.
+type FileWriter struct {
+	lk   sync.Mutex
+	file *os.File
+	enc  *json.Encoder
+}
+
+// OpenFile opens the trace file for appending the events.
+func OpenFile(path string) (*FileWriter, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return nil, err
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FileWriter{
+		file: file,
+		enc:  json.NewEncoder(file),
+	}, nil
+}
+
+func (w *FileWriter) Trace(e *Event) {
+	w.lk.Lock()
+	defer w.lk.Unlock()
+
+	_ = w.enc.Encode(e)
+}
+
+// Close closes the trace file.
+func (w *FileWriter) Close() error {
+	w.lk.Lock()
+	defer w.lk.Unlock()
+
+	return w.file.Close()
+}
+
+// Read decodes the trace events, one JSON object per line.
+func Read(r io.Reader) ([]*Event, error) {
+	events := make([]*Event, 0)
+	dec := json.NewDecoder(bufio.NewReader(r))
+	for {
+		e := new(Event)
+		err := dec.Decode(e)
+		if err == io.EOF {
+			return events, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, e)
+	}
+}
+
+// ReadFile reads the trace events from the given files.
+// Traces of several replicas can be merged by passing all of them.
+func ReadFile(paths ...string) ([]*Event, error) {
+	events := make([]*Event, 0)
+	for _, path := range paths {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		fileEvents, err := Read(file)
+		_ = file.Close()
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, fileEvents...)
+	}
+
+	return events, nil
+}
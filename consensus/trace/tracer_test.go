@@ -0,0 +1,68 @@
+package trace
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/pactus-project/pactus/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecorder(t *testing.T) {
+	tr := newTestTrace(4)
+	tr.commitHeight(1, "block-1")
+
+	rec := NewRecorder()
+	for _, e := range tr.events {
+		rec.Trace(e)
+	}
+
+	assert.Equal(t, tr.events, rec.Events())
+}
+
+func TestFileWriter(t *testing.T) {
+	tr := newTestTrace(4)
+	tr.commitHeight(1, "block-1")
+
+	dir := util.TempDirPath()
+	paths := make([]string, 0)
+	for _, replica := range tr.replicas {
+		path := filepath.Join(dir, "traces", replica+".trace")
+		paths = append(paths, path)
+
+		writer, err := OpenFile(path)
+		require.NoError(t, err)
+		for _, e := range tr.events {
+			if e.Replica == replica {
+				writer.Trace(e)
+			}
+		}
+		require.NoError(t, writer.Close())
+	}
+
+	events, err := ReadFile(paths...)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, tr.events, events)
+	assert.NoError(t, Check(events))
+
+	t.Run("Appending to an existing file", func(t *testing.T) {
+		writer, err := OpenFile(paths[0])
+		require.NoError(t, err)
+		writer.Trace(tr.events[0])
+		require.NoError(t, writer.Close())
+
+		events, err := ReadFile(paths[0])
+		require.NoError(t, err)
+		assert.Len(t, events, len(tr.events)/len(tr.replicas)+1)
+	})
+}
+
+func TestReadInvalidTrace(t *testing.T) {
+	_, err := Read(strings.NewReader("{\"replica\":\"replica-0\"}\nnot-json\n"))
+	assert.Error(t, err)
+
+	_, err = ReadFile(filepath.Join(util.TempDirPath(), "not-exists"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,89 @@
+package consensus
+
+import (
+	"github.com/pactus-project/pactus/consensus/trace"
+	"github.com/pactus-project/pactus/types/proposal"
+	"github.com/pactus-project/pactus/types/vote"
+)
+
+func (cs *consensus) makeTraceEvent(kind trace.Kind) *trace.Event {
+	return &trace.Event{
+		Replica:   cs.signer.Address().String(),
+		Kind:      kind,
+		State:     cs.currentState.name(),
+		Height:    cs.height,
+		Round:     cs.round,
+		CPRound:   cs.cpRound,
+		CPDecided: cs.cpDecided,
+	}
+}
+
+// traceState records entering the current state.
+func (cs *consensus) traceState() {
+	if cs.tracer == nil {
+		return
+	}
+
+	e := cs.makeTraceEvent(trace.KindState)
+	switch cs.currentState {
+	case cs.newHeightState:
+		validators := cs.bcState.CommitteeValidators()
+		e.Committee = make([]trace.Member, 0, len(validators))
+		for _, val := range validators {
+			e.Committee = append(e.Committee, trace.Member{
+				Address: val.Address().String(),
+				Power:   val.Power(),
+			})
+		}
+
+	case cs.proposeState:
+		e.Proposer = cs.proposer(cs.round).Address().String()
+
+	case cs.commitState:
+		roundProposal := cs.log.RoundProposal(cs.round)
+		if roundProposal != nil {
+			e.Block = roundProposal.Block().Hash().String()
+		}
+	}
+
+	cs.tracer.Trace(e)
+}
+
+// traceProposal records setting the proposal of a round.
+func (cs *consensus) traceProposal(p *proposal.Proposal) {
+	if cs.tracer == nil {
+		return
+	}
+
+	e := cs.makeTraceEvent(trace.KindProposal)
+	e.Proposal = &trace.Proposal{
+		Height:   p.Height(),
+		Round:    p.Round(),
+		Block:    p.Block().Hash().String(),
+		Proposer: cs.proposer(p.Round()).Address().String(),
+	}
+
+	cs.tracer.Trace(e)
+}
+
+// traceVote records adding a vote to the log.
+func (cs *consensus) traceVote(v *vote.Vote) {
+	if cs.tracer == nil {
+		return
+	}
+
+	e := cs.makeTraceEvent(trace.KindVote)
+	e.Vote = &trace.Vote{
+		Type:   v.Type(),
+		Height: v.Height(),
+		Round:  v.Round(),
+		Block:  v.BlockHash().String(),
+		Signer: v.Signer().String(),
+	}
+	if v.IsCPVote() {
+		e.Vote.CPRound = v.CPRound()
+		e.Vote.CPValue = v.CPValue()
+	}
+
+	cs.tracer.Trace(e)
+}
@@ -0,0 +1,21 @@
+package voteset
+
+import (
+	"github.com/pactus-project/pactus/types/evidence"
+	"github.com/pactus-project/pactus/util/errors"
+)
+
+// DuplicateVoteError is returned when a validator casts a second, conflicting vote
+// in the same round. It carries the evidence of the misbehavior.
+type DuplicateVoteError struct {
+	Evidence *evidence.Evidence
+}
+
+func (e DuplicateVoteError) Error() string {
+	return "duplicate vote: " + e.Evidence.String()
+}
+
+// Code returns the error code, so the error is recognized as ErrDuplicateVote.
+func (DuplicateVoteError) Code() int {
+	return errors.ErrDuplicateVote
+}
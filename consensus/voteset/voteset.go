@@ -1,7 +1,10 @@
 package voteset
 
 import (
+	"bytes"
+
 	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/types/evidence"
 	"github.com/pactus-project/pactus/types/validator"
 	"github.com/pactus-project/pactus/types/vote"
 	"github.com/pactus-project/pactus/util/errors"
@@ -53,3 +56,17 @@ func (vs *voteSet) isTwoThirdOfTotalPower(power int64) bool {
 func (vs *voteSet) isOneThirdOfTotalPower(power int64) bool {
 	return power > (vs.totalPower * 1 / 3)
 }
+
+// duplicateVoteError creates an error for a second vote from the same signer.
+// If both votes are signed for different data, the error carries the evidence
+// of the misbehavior.
+func duplicateVoteError(existingVote, newVote *vote.Vote) error {
+	if bytes.Equal(existingVote.SignBytes(), newVote.SignBytes()) {
+		// The votes only differ in the unsigned parts, like the justification.
+		return errors.Error(errors.ErrDuplicateVote)
+	}
+
+	return DuplicateVoteError{
+		Evidence: evidence.NewDuplicateVoteEvidence(existingVote, newVote),
+	}
+}
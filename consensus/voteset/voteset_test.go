@@ -12,6 +12,7 @@ import (
 	"github.com/pactus-project/pactus/util/errors"
 	"github.com/pactus-project/pactus/util/testsuite"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func setupCommittee(ts *testsuite.TestSuite, stakes ...amount.Amount) (
@@ -147,6 +148,12 @@ func TestDuplicateBlockVote(t *testing.T) {
 	assert.Equal(t, errors.ErrDuplicateVote, errors.Code(err))
 	assert.True(t, added)
 
+	dupErr, ok := err.(DuplicateVoteError)
+	require.True(t, ok)
+	assert.NoError(t, dupErr.Evidence.BasicCheck())
+	assert.NoError(t, dupErr.Evidence.Verify(valKeys[0].PublicKey()))
+	assert.Equal(t, addr, dupErr.Evidence.Offender())
+
 	added, err = vs.AddVote(duplicatedVote2)
 	assert.Equal(t, errors.ErrDuplicateVote, errors.Code(err))
 	assert.True(t, added)
@@ -195,6 +202,33 @@ func TestDuplicateBinaryVote(t *testing.T) {
 	assert.False(t, vs.HasOneThirdOfTotalPower(0))
 }
 
+func TestDuplicateBinaryVoteWithDifferentJust(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	valsMap, valKeys, totalPower := setupCommittee(ts, 1, 1, 1, 1)
+
+	h1 := ts.RandHash()
+	addr := valKeys[0].Address()
+	vs := NewCPPreVoteVoteSet(0, totalPower, valsMap)
+
+	just1 := &vote.JustInitYes{}
+	just2 := &vote.JustInitNo{QCert: ts.GenerateTestPrepareCertificate(1)}
+	vote1 := vote.NewCPPreVote(h1, 1, 0, 0, vote.CPValueYes, just1, addr)
+	vote2 := vote.NewCPPreVote(h1, 1, 0, 0, vote.CPValueYes, just2, addr)
+
+	ts.HelperSignVote(valKeys[0], vote1)
+	ts.HelperSignVote(valKeys[0], vote2)
+
+	_, err := vs.AddVote(vote1)
+	assert.NoError(t, err)
+
+	// The justification is not signed, so it is not a double-sign.
+	_, err = vs.AddVote(vote2)
+	assert.Equal(t, errors.ErrDuplicateVote, errors.Code(err))
+	_, ok := err.(DuplicateVoteError)
+	assert.False(t, ok)
+}
+
 func TestQuorum(t *testing.T) {
 	ts := testsuite.NewTestSuite(t)
 
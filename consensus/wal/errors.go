@@ -0,0 +1,10 @@
+package wal
+
+// ConflictError is returned when a vote conflicts with a previously signed vote.
+type ConflictError struct {
+	Reason string
+}
+
+func (e ConflictError) Error() string {
+	return e.Reason
+}
@@ -0,0 +1,172 @@
+package wal
+
+import (
+	"fmt"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/types/vote"
+	"github.com/pactus-project/pactus/util"
+)
+
+// Record keeps the information of a vote that is signed by the validator.
+type Record struct {
+	Height    uint32    `cbor:"1,keyasint"`
+	Round     int16     `cbor:"2,keyasint"`
+	Type      vote.Type `cbor:"3,keyasint"`
+	CPRound   int16     `cbor:"4,keyasint"`
+	BlockHash hash.Hash `cbor:"5,keyasint"`
+	SignHash  hash.Hash `cbor:"6,keyasint"` // Hash of the sign bytes
+}
+
+func makeRecord(v *vote.Vote) Record {
+	rec := Record{
+		Height:    v.Height(),
+		Round:     v.Round(),
+		Type:      v.Type(),
+		BlockHash: v.BlockHash(),
+		SignHash:  hash.CalcHash(v.SignBytes()),
+	}
+	if v.IsCPVote() {
+		rec.CPRound = v.CPRound()
+	}
+
+	return rec
+}
+
+// WAL is a write-ahead log that keeps the votes signed by a validator at its last signed height.
+// Votes should be written to the WAL before signing them,
+// so that a restarted validator never signs a vote that conflicts with
+// the votes it signed before the restart.
+// Conflicting votes within the same run are left to the consensus protocol,
+// since it may re-vote in the same round after the change-proposer phase.
+// If the path is empty, the WAL is kept only in memory.
+// It is not thread-safe.
+type WAL struct {
+	path    string
+	records []Record
+	loaded  int // Number of records loaded from the previous run
+}
+
+// NewWAL creates a new WAL that persists its records at the given path.
+func NewWAL(path string) *WAL {
+	return &WAL{
+		path:    path,
+		records: make([]Record, 0),
+	}
+}
+
+// Load reads the records from the WAL file, if it exists.
+func (w *WAL) Load() error {
+	if w.path == "" || !util.PathExists(w.path) {
+		return nil
+	}
+
+	data, err := util.ReadFile(w.path)
+	if err != nil {
+		return err
+	}
+
+	records := make([]Record, 0)
+	if err := cbor.Unmarshal(data, &records); err != nil {
+		return fmt.Errorf("unable to decode WAL file %s: %w", w.path, err)
+	}
+	w.records = records
+	w.loaded = len(records)
+
+	return nil
+}
+
+// LastHeight returns the last height that the validator has signed a vote for.
+func (w *WAL) LastHeight() uint32 {
+	if len(w.records) == 0 {
+		return 0
+	}
+
+	return w.records[0].Height
+}
+
+// Records returns the records of the last signed height.
+func (w *WAL) Records() []Record {
+	return w.records
+}
+
+// Check ensures that signing the given vote doesn't conflict with
+// the votes signed in the previous run.
+func (w *WAL) Check(v *vote.Vote) error {
+	lastHeight := w.LastHeight()
+	if v.Height() < lastHeight {
+		return ConflictError{
+			Reason: fmt.Sprintf("vote height %d is lower than the last signed height %d",
+				v.Height(), lastHeight),
+		}
+	}
+
+	rec := makeRecord(v)
+	conflicted := false
+	for _, r := range w.records[:w.loaded] {
+		if r.Height == rec.Height &&
+			r.Round == rec.Round &&
+			r.Type == rec.Type &&
+			r.CPRound == rec.CPRound {
+			if r.SignHash == rec.SignHash {
+				return nil
+			}
+			conflicted = true
+		}
+	}
+
+	if conflicted {
+		return ConflictError{
+			Reason: fmt.Sprintf("a different %s vote is already signed at %d/%d",
+				rec.Type, rec.Height, rec.Round),
+		}
+	}
+
+	return nil
+}
+
+// Write checks the vote and records it in the WAL.
+// If the WAL has a path, the records are flushed to the disk before returning.
+func (w *WAL) Write(v *vote.Vote) error {
+	if err := w.Check(v); err != nil {
+		return err
+	}
+
+	rec := makeRecord(v)
+	records := make([]Record, 0, len(w.records)+1)
+	if rec.Height == w.LastHeight() {
+		for _, r := range w.records {
+			if r == rec {
+				// Already recorded.
+				return nil
+			}
+		}
+		records = append(records, w.records...)
+	}
+	records = append(records, rec)
+
+	if err := w.flush(records); err != nil {
+		return err
+	}
+	if rec.Height != w.LastHeight() {
+		w.loaded = 0
+	}
+	w.records = records
+
+	return nil
+}
+
+// flush atomically replaces the WAL file with the given records.
+func (w *WAL) flush(records []Record) error {
+	if w.path == "" {
+		return nil
+	}
+
+	data, err := cbor.Marshal(records)
+	if err != nil {
+		return err
+	}
+
+	return util.WriteFileAtomic(w.path, data)
+}
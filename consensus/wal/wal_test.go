@@ -0,0 +1,124 @@
+package wal
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/pactus-project/pactus/types/vote"
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWrite(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	w := NewWAL("")
+	require.NoError(t, w.Load())
+
+	valAddr := ts.RandValAddress()
+	blockHash := ts.RandHash()
+	v1 := vote.NewPrepareVote(blockHash, 10, 1, valAddr)
+	v2 := vote.NewPrecommitVote(blockHash, 10, 1, valAddr)
+	v3 := vote.NewCPPreVote(blockHash, 10, 1, 0, vote.CPValueYes, &vote.JustInitYes{}, valAddr)
+
+	for _, v := range []*vote.Vote{v1, v2, v3} {
+		assert.NoError(t, w.Write(v))
+	}
+	assert.Equal(t, uint32(10), w.LastHeight())
+	assert.Len(t, w.Records(), 3)
+
+	t.Run("Same vote", func(t *testing.T) {
+		assert.NoError(t, w.Write(vote.NewPrepareVote(blockHash, 10, 1, valAddr)))
+		assert.Len(t, w.Records(), 3)
+	})
+
+	t.Run("Re-voting in the same run", func(t *testing.T) {
+		assert.NoError(t, w.Write(vote.NewPrepareVote(ts.RandHash(), 10, 1, valAddr)))
+		assert.Len(t, w.Records(), 4)
+	})
+
+	t.Run("Lower height", func(t *testing.T) {
+		v := vote.NewPrepareVote(ts.RandHash(), 9, 1, valAddr)
+		assert.ErrorAs(t, w.Write(v), &ConflictError{})
+	})
+
+	t.Run("Next height", func(t *testing.T) {
+		v := vote.NewPrepareVote(ts.RandHash(), 11, 0, valAddr)
+		assert.NoError(t, w.Write(v))
+		assert.Equal(t, uint32(11), w.LastHeight())
+		assert.Len(t, w.Records(), 1)
+	})
+}
+
+func TestCheckAfterRestart(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	path := filepath.Join(util.TempDirPath(), "wal", "validator.wal")
+	valAddr := ts.RandValAddress()
+	blockHash := ts.RandHash()
+	v1 := vote.NewPrepareVote(blockHash, 10, 1, valAddr)
+	v2 := vote.NewPrecommitVote(blockHash, 10, 1, valAddr)
+	v3 := vote.NewCPPreVote(blockHash, 10, 1, 0, vote.CPValueYes, &vote.JustInitYes{}, valAddr)
+
+	w1 := NewWAL(path)
+	require.NoError(t, w1.Load())
+	for _, v := range []*vote.Vote{v1, v2, v3} {
+		require.NoError(t, w1.Write(v))
+	}
+	assert.True(t, util.PathExists(path))
+	assert.False(t, util.PathExists(path+".tmp"))
+
+	// Reopen the WAL, like restarting the node.
+	w2 := NewWAL(path)
+	require.NoError(t, w2.Load())
+	assert.Equal(t, w1.Records(), w2.Records())
+
+	t.Run("Same vote", func(t *testing.T) {
+		assert.NoError(t, w2.Check(v1))
+		assert.NoError(t, w2.Check(v2))
+		assert.NoError(t, w2.Check(v3))
+	})
+
+	t.Run("Conflicting prepare vote", func(t *testing.T) {
+		v := vote.NewPrepareVote(ts.RandHash(), 10, 1, valAddr)
+		assert.ErrorAs(t, w2.Check(v), &ConflictError{})
+		assert.ErrorAs(t, w2.Write(v), &ConflictError{})
+	})
+
+	t.Run("Conflicting change-proposer vote", func(t *testing.T) {
+		v := vote.NewCPPreVote(blockHash, 10, 1, 0, vote.CPValueNo, &vote.JustInitNo{}, valAddr)
+		assert.ErrorAs(t, w2.Check(v), &ConflictError{})
+	})
+
+	t.Run("Other change-proposer round", func(t *testing.T) {
+		v := vote.NewCPPreVote(blockHash, 10, 1, 1, vote.CPValueNo, &vote.JustInitNo{}, valAddr)
+		assert.NoError(t, w2.Check(v))
+	})
+
+	t.Run("Other round", func(t *testing.T) {
+		v := vote.NewPrepareVote(ts.RandHash(), 10, 2, valAddr)
+		assert.NoError(t, w2.Check(v))
+	})
+
+	t.Run("Lower height", func(t *testing.T) {
+		v := vote.NewPrepareVote(ts.RandHash(), 9, 1, valAddr)
+		assert.ErrorAs(t, w2.Check(v), &ConflictError{})
+	})
+
+	t.Run("Next height", func(t *testing.T) {
+		require.NoError(t, w2.Write(vote.NewPrepareVote(ts.RandHash(), 11, 0, valAddr)))
+
+		// The previous run's votes are no longer relevant.
+		assert.NoError(t, w2.Write(vote.NewPrepareVote(ts.RandHash(), 11, 0, valAddr)))
+	})
+}
+
+func TestLoadInvalidFile(t *testing.T) {
+	path := util.TempFilePath()
+	require.NoError(t, util.WriteFile(path, []byte("invalid")))
+
+	w := NewWAL(path)
+	assert.Error(t, w.Load())
+}
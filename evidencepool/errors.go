@@ -0,0 +1,12 @@
+package evidencepool
+
+import "fmt"
+
+// AddError is returned when the evidence can't be added to the pool.
+type AddError struct {
+	Err error
+}
+
+func (e AddError) Error() string {
+	return fmt.Sprintf("unable to add evidence to pool: %s", e.Err)
+}
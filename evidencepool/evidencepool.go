@@ -0,0 +1,207 @@
+package evidencepool
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/state"
+	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/types/evidence"
+	"github.com/pactus-project/pactus/util/linkedmap"
+	"github.com/pactus-project/pactus/util/logger"
+	"github.com/pactus-project/pactus/www/nanomsg/event"
+)
+
+const (
+	// maxSize is the maximum number of evidence kept in the pool.
+	// When the pool is full, the oldest evidence is removed.
+	maxSize = 1000
+
+	// maxAge is the maximum age, in blocks, of the accepted evidence.
+	maxAge = 8640 // ~ one day
+)
+
+type evidencePool struct {
+	lk sync.RWMutex
+
+	state       state.Facade
+	list        *linkedmap.LinkedMap[hash.Hash, *evidence.Evidence]
+	broadcastCh chan message.Message
+	eventCh     chan event.Event
+	logger      *logger.SubLogger
+}
+
+// NewEvidencePool creates a new evidence pool.
+// The eventCh is optional and can be nil.
+func NewEvidencePool(st state.Facade, broadcastCh chan message.Message,
+	eventCh chan event.Event,
+) EvidencePool {
+	pool := &evidencePool{
+		state:       st,
+		list:        linkedmap.New[hash.Hash, *evidence.Evidence](maxSize),
+		broadcastCh: broadcastCh,
+		eventCh:     eventCh,
+	}
+
+	pool.logger = logger.NewSubLogger("_evidence", pool)
+
+	return pool
+}
+
+// AddEvidence validates the evidence and adds it into the pool without broadcasting it.
+func (p *evidencePool) AddEvidence(evd *evidence.Evidence) error {
+	added, err := p.addEvidence(evd)
+	if err != nil {
+		return err
+	}
+
+	if added {
+		p.publishEvent(evd)
+	}
+
+	return nil
+}
+
+// AddEvidenceAndBroadcast validates the evidence, adds it into the pool and
+// broadcasts it. The evidence is broadcast only if it is new to the pool.
+func (p *evidencePool) AddEvidenceAndBroadcast(evd *evidence.Evidence) error {
+	added, err := p.addEvidence(evd)
+	if err != nil {
+		return err
+	}
+
+	if added {
+		p.publishEvent(evd)
+
+		go func(e *evidence.Evidence) {
+			p.broadcastCh <- message.NewEvidenceMessage(e)
+		}(evd)
+	}
+
+	return nil
+}
+
+func (p *evidencePool) addEvidence(evd *evidence.Evidence) (bool, error) {
+	p.lk.Lock()
+	defer p.lk.Unlock()
+
+	id := evd.Hash()
+	if p.list.Has(id) {
+		p.logger.Trace("evidence is already in pool", "id", id)
+
+		return false, nil
+	}
+
+	if err := p.checkEvidence(evd); err != nil {
+		p.logger.Debug("invalid evidence", "evidence", evd, "error", err)
+
+		return false, AddError{
+			Err: err,
+		}
+	}
+
+	p.list.PushBack(id, evd)
+	p.logger.Warn("double-sign evidence added into pool", "evidence", evd)
+
+	return true, nil
+}
+
+func (p *evidencePool) checkEvidence(evd *evidence.Evidence) error {
+	if err := evd.BasicCheck(); err != nil {
+		return err
+	}
+
+	lastHeight := p.state.LastBlockHeight()
+	if evd.Height() > lastHeight+1 {
+		return fmt.Errorf("evidence height is in the future: %d", evd.Height())
+	}
+	if lastHeight > maxAge && evd.Height() < lastHeight-maxAge {
+		return fmt.Errorf("evidence is too old: %d", evd.Height())
+	}
+
+	pub, err := p.offenderPublicKey(evd)
+	if err != nil {
+		return err
+	}
+
+	return evd.Verify(pub)
+}
+
+// offenderPublicKey returns the public key of the offender.
+// If the offender has rotated its key, it is not registered at its old address anymore,
+// but its old public key is kept by the store for the transactions it signed before.
+func (p *evidencePool) offenderPublicKey(evd *evidence.Evidence) (*bls.PublicKey, error) {
+	val := p.state.ValidatorByAddress(evd.Offender())
+	if val != nil {
+		return val.PublicKey(), nil
+	}
+
+	pub, err := p.state.PublicKey(evd.Offender())
+	if err != nil {
+		return nil, fmt.Errorf("unknown validator: %s", evd.Offender())
+	}
+
+	blsPub, ok := pub.(*bls.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("unknown validator: %s", evd.Offender())
+	}
+
+	return blsPub, nil
+}
+
+func (p *evidencePool) publishEvent(evd *evidence.Evidence) {
+	if p.eventCh == nil {
+		return
+	}
+
+	go func(e *evidence.Evidence) {
+		p.eventCh <- event.CreateEvidenceEvent(e.Offender(), e.Height())
+	}(evd)
+}
+
+// Evidence returns the evidence with the given ID.
+// If the evidence doesn't exist inside the pool, it returns nil.
+func (p *evidencePool) Evidence(id hash.Hash) *evidence.Evidence {
+	p.lk.RLock()
+	defer p.lk.RUnlock()
+
+	n := p.list.GetNode(id)
+	if n == nil {
+		return nil
+	}
+
+	return n.Data.Value
+}
+
+func (p *evidencePool) HasEvidence(id hash.Hash) bool {
+	p.lk.RLock()
+	defer p.lk.RUnlock()
+
+	return p.list.Has(id)
+}
+
+// AllEvidence returns all the evidence inside the pool, ordered from oldest to newest.
+func (p *evidencePool) AllEvidence() []*evidence.Evidence {
+	p.lk.RLock()
+	defer p.lk.RUnlock()
+
+	evds := make([]*evidence.Evidence, 0, p.list.Size())
+	for n := p.list.HeadNode(); n != nil; n = n.Next {
+		evds = append(evds, n.Data.Value)
+	}
+
+	return evds
+}
+
+func (p *evidencePool) Size() int {
+	p.lk.RLock()
+	defer p.lk.RUnlock()
+
+	return p.list.Size()
+}
+
+func (p *evidencePool) String() string {
+	return fmt.Sprintf("{⚠️ %v}", p.list.Size())
+}
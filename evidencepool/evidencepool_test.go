@@ -0,0 +1,173 @@
+package evidencepool
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/state"
+	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/types/evidence"
+	"github.com/pactus-project/pactus/types/validator"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/pactus-project/pactus/www/nanomsg/event"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testData struct {
+	*testsuite.TestSuite
+
+	pool    *evidencePool
+	state   *state.MockState
+	msgCh   chan message.Message
+	eventCh chan event.Event
+}
+
+func setup(t *testing.T) *testData {
+	t.Helper()
+
+	ts := testsuite.NewTestSuite(t)
+
+	msgCh := make(chan message.Message, 10)
+	eventCh := make(chan event.Event, 10)
+	st := state.MockingState(ts)
+	st.CommitTestBlocks(10)
+	p := NewEvidencePool(st, msgCh, eventCh)
+	pool := p.(*evidencePool)
+	assert.NotNil(t, pool)
+
+	return &testData{
+		TestSuite: ts,
+		pool:      pool,
+		state:     st,
+		msgCh:     msgCh,
+		eventCh:   eventCh,
+	}
+}
+
+func (td *testData) makeEvidence(height uint32) (*evidence.Evidence, *bls.ValidatorKey) {
+	evd, valKey := td.GenerateTestEvidence(height, td.RandRound())
+	val := validator.NewValidator(valKey.PublicKey(), td.RandInt32(1000))
+	td.state.TestStore.UpdateValidator(val)
+
+	return evd, valKey
+}
+
+func (td *testData) shouldPublishEvidence(t *testing.T, evd *evidence.Evidence) {
+	t.Helper()
+
+	select {
+	case <-time.After(1 * time.Second):
+		require.FailNow(t, "timeout")
+
+	case msg := <-td.msgCh:
+		m := msg.(*message.EvidenceMessage)
+		assert.Equal(t, evd.Hash(), m.Evidence.Hash())
+	}
+}
+
+func (td *testData) shouldNotPublishEvidence(t *testing.T) {
+	t.Helper()
+
+	select {
+	case <-time.After(100 * time.Millisecond):
+		return
+
+	case msg := <-td.msgCh:
+		require.FailNow(t, "unexpected message", msg)
+	}
+}
+
+func (td *testData) shouldEmitEvent(t *testing.T, evd *evidence.Evidence) {
+	t.Helper()
+
+	select {
+	case <-time.After(1 * time.Second):
+		require.FailNow(t, "timeout")
+
+	case e := <-td.eventCh:
+		assert.Equal(t, event.CreateEvidenceEvent(evd.Offender(), evd.Height()), e)
+	}
+}
+
+func TestAddEvidence(t *testing.T) {
+	td := setup(t)
+
+	evd, _ := td.makeEvidence(td.state.LastBlockHeight())
+
+	assert.NoError(t, td.pool.AddEvidence(evd))
+	td.shouldEmitEvent(t, evd)
+	td.shouldNotPublishEvidence(t)
+
+	assert.True(t, td.pool.HasEvidence(evd.Hash()))
+	assert.Equal(t, evd, td.pool.Evidence(evd.Hash()))
+	assert.Equal(t, 1, td.pool.Size())
+	assert.Nil(t, td.pool.Evidence(td.RandHash()))
+}
+
+func TestAddEvidenceAndBroadcast(t *testing.T) {
+	td := setup(t)
+
+	evd, _ := td.makeEvidence(td.state.LastBlockHeight() + 1)
+
+	assert.NoError(t, td.pool.AddEvidenceAndBroadcast(evd))
+	td.shouldPublishEvidence(t, evd)
+	td.shouldEmitEvent(t, evd)
+
+	// Adding again should not broadcast it
+	assert.NoError(t, td.pool.AddEvidenceAndBroadcast(evd))
+	td.shouldNotPublishEvidence(t)
+	assert.Equal(t, []*evidence.Evidence{evd}, td.pool.AllEvidence())
+}
+
+func TestRotatedOffender(t *testing.T) {
+	td := setup(t)
+
+	// The offender has rotated its key, so it is not registered at its old address,
+	// but its old public key is kept by the store.
+	evd, valKey := td.GenerateTestEvidence(td.state.LastBlockHeight(), 0)
+	td.state.TestStore.SavePublicKey(valKey.Address(), valKey.PublicKey())
+	assert.Nil(t, td.state.ValidatorByAddress(valKey.Address()))
+
+	assert.NoError(t, td.pool.AddEvidence(evd))
+	assert.True(t, td.pool.HasEvidence(evd.Hash()))
+}
+
+func TestInvalidEvidence(t *testing.T) {
+	td := setup(t)
+
+	t.Run("Unknown validator", func(t *testing.T) {
+		evd, _ := td.GenerateTestEvidence(td.state.LastBlockHeight(), 0)
+
+		err := td.pool.AddEvidence(evd)
+		assert.ErrorContains(t, err, "unknown validator")
+	})
+
+	t.Run("Future height", func(t *testing.T) {
+		evd, _ := td.makeEvidence(td.state.LastBlockHeight() + 2)
+
+		err := td.pool.AddEvidence(evd)
+		assert.ErrorContains(t, err, "evidence height is in the future")
+	})
+
+	t.Run("Too old", func(t *testing.T) {
+		td.state.TestStore.LastHeight = maxAge + 100
+		evd, _ := td.makeEvidence(50)
+
+		err := td.pool.AddEvidence(evd)
+		assert.ErrorContains(t, err, "evidence is too old")
+	})
+
+	t.Run("Invalid signature", func(t *testing.T) {
+		evd, _ := td.GenerateTestEvidence(td.state.LastBlockHeight(), 0)
+		val := validator.NewValidator(td.RandValKey().PublicKey(), td.RandInt32(1000))
+		// Register the offender address with a different public key
+		td.state.TestStore.Validators[evd.Offender()] = val
+
+		err := td.pool.AddEvidence(evd)
+		assert.Error(t, err)
+	})
+
+	assert.Zero(t, td.pool.Size())
+}
@@ -0,0 +1,20 @@
+package evidencepool
+
+import (
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/types/evidence"
+)
+
+type Reader interface {
+	Evidence(id hash.Hash) *evidence.Evidence
+	HasEvidence(id hash.Hash) bool
+	AllEvidence() []*evidence.Evidence
+	Size() int
+}
+
+type EvidencePool interface {
+	Reader
+
+	AddEvidence(evd *evidence.Evidence) error
+	AddEvidenceAndBroadcast(evd *evidence.Evidence) error
+}
@@ -0,0 +1,53 @@
+package evidencepool
+
+import (
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/types/evidence"
+)
+
+var _ EvidencePool = &MockEvidencePool{}
+
+// MockEvidencePool is a testing mock.
+type MockEvidencePool struct {
+	Evidences []*evidence.Evidence
+}
+
+func MockingEvidencePool() *MockEvidencePool {
+	return &MockEvidencePool{
+		Evidences: make([]*evidence.Evidence, 0),
+	}
+}
+
+func (m *MockEvidencePool) Evidence(id hash.Hash) *evidence.Evidence {
+	for _, evd := range m.Evidences {
+		if evd.Hash() == id {
+			return evd
+		}
+	}
+
+	return nil
+}
+
+func (m *MockEvidencePool) HasEvidence(id hash.Hash) bool {
+	return m.Evidence(id) != nil
+}
+
+func (m *MockEvidencePool) AllEvidence() []*evidence.Evidence {
+	return m.Evidences
+}
+
+func (m *MockEvidencePool) Size() int {
+	return len(m.Evidences)
+}
+
+func (m *MockEvidencePool) AddEvidence(evd *evidence.Evidence) error {
+	if !m.HasEvidence(evd.Hash()) {
+		m.Evidences = append(m.Evidences, evd)
+	}
+
+	return nil
+}
+
+func (m *MockEvidencePool) AddEvidenceAndBroadcast(evd *evidence.Evidence) error {
+	return m.AddEvidence(evd)
+}
@@ -0,0 +1,46 @@
+package executor
+
+import (
+	"github.com/pactus-project/pactus/sandbox"
+	"github.com/pactus-project/pactus/types/account"
+	"github.com/pactus-project/pactus/types/amount"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/tx/payload"
+)
+
+type AnchorExecutor struct {
+	sb     sandbox.Sandbox
+	pld    *payload.AnchorPayload
+	fee    amount.Amount
+	sender *account.Account
+}
+
+func newAnchorExecutor(trx *tx.Tx, sb sandbox.Sandbox) (*AnchorExecutor, error) {
+	pld := trx.Payload().(*payload.AnchorPayload)
+
+	sender := sb.Account(pld.Sender)
+	if sender == nil {
+		return nil, AccountNotFoundError{Address: pld.Sender}
+	}
+
+	return &AnchorExecutor{
+		sb:     sb,
+		pld:    pld,
+		fee:    trx.Fee(),
+		sender: sender,
+	}, nil
+}
+
+func (e *AnchorExecutor) Check(_ bool) error {
+	if e.sender.Balance() < e.fee {
+		return ErrInsufficientFunds
+	}
+
+	return nil
+}
+
+func (e *AnchorExecutor) Execute() {
+	e.sender.SubtractFromBalance(e.fee)
+
+	e.sb.UpdateAccount(e.pld.Sender, e.sender)
+}
@@ -0,0 +1,47 @@
+package executor
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExecuteAnchorTx(t *testing.T) {
+	td := setup(t)
+
+	senderAddr, senderAcc := td.sandbox.TestStore.RandomTestAcc()
+	senderBalance := senderAcc.Balance()
+	hashes := []hash.Hash{td.RandHash(), td.RandHash()}
+	fee := td.RandFee()
+	lockTime := td.sandbox.CurrentHeight()
+
+	t.Run("Should fail, unknown address", func(t *testing.T) {
+		randomAddr := td.RandAccAddress()
+		trx := tx.NewAnchorTx(lockTime, randomAddr, "ns", hashes, fee)
+
+		td.check(t, trx, true, AccountNotFoundError{Address: randomAddr})
+		td.check(t, trx, false, AccountNotFoundError{Address: randomAddr})
+	})
+
+	t.Run("Should fail, insufficient balance", func(t *testing.T) {
+		trx := tx.NewAnchorTx(lockTime, senderAddr, "ns", hashes, senderBalance+1)
+
+		td.check(t, trx, true, ErrInsufficientFunds)
+		td.check(t, trx, false, ErrInsufficientFunds)
+	})
+
+	t.Run("Ok", func(t *testing.T) {
+		trx := tx.NewAnchorTx(lockTime, senderAddr, "ns", hashes, fee)
+
+		td.check(t, trx, true, nil)
+		td.check(t, trx, false, nil)
+		td.execute(t, trx)
+	})
+
+	updatedSenderAcc := td.sandbox.Account(senderAddr)
+	assert.Equal(t, senderBalance-fee, updatedSenderAcc.Balance())
+
+	td.checkTotalCoin(t, fee)
+}
@@ -24,6 +24,12 @@ var ErrValidatorBonded = errors.New("validator is bonded")
 // ErrValidatorUnbonded indicates that the validator has unbonded.
 var ErrValidatorUnbonded = errors.New("validator has unbonded")
 
+// ErrValidatorAlreadyExists indicates that a validator is already registered with the given public key.
+var ErrValidatorAlreadyExists = errors.New("validator already exists")
+
+// ErrValidatorKeyRotated indicates that the validator's key has already been rotated in this block.
+var ErrValidatorKeyRotated = errors.New("validator key is already rotated in this block")
+
 // ErrBondingPeriod is returned when a validator is in the bonding period.
 var ErrBondingPeriod = errors.New("validator in bonding period")
 
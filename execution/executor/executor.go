@@ -25,6 +25,12 @@ func MakeExecutor(trx *tx.Tx, sb sandbox.Sandbox) (Executor, error) {
 		exe, err = newWithdrawExecutor(trx, sb)
 	case payload.TypeSortition:
 		exe, err = newSortitionExecutor(trx, sb)
+	case payload.TypeMetadata:
+		exe, err = newMetadataExecutor(trx, sb)
+	case payload.TypeKeyRotation:
+		exe, err = newKeyRotationExecutor(trx, sb)
+	case payload.TypeAnchor:
+		exe, err = newAnchorExecutor(trx, sb)
 	default:
 		return nil, InvalidPayloadTypeError{
 			PayloadType: t,
@@ -0,0 +1,87 @@
+package executor
+
+import (
+	"github.com/pactus-project/pactus/sandbox"
+	"github.com/pactus-project/pactus/types/amount"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/tx/payload"
+	"github.com/pactus-project/pactus/types/validator"
+)
+
+type KeyRotationExecutor struct {
+	sb        sandbox.Sandbox
+	pld       *payload.KeyRotationPayload
+	fee       amount.Amount
+	validator *validator.Validator
+}
+
+func newKeyRotationExecutor(trx *tx.Tx, sb sandbox.Sandbox) (*KeyRotationExecutor, error) {
+	pld := trx.Payload().(*payload.KeyRotationPayload)
+
+	val := sb.Validator(pld.Validator)
+	if val == nil {
+		return nil, ValidatorNotFoundError{Address: pld.Validator}
+	}
+
+	return &KeyRotationExecutor{
+		sb:        sb,
+		pld:       pld,
+		fee:       trx.Fee(),
+		validator: val,
+	}, nil
+}
+
+func (e *KeyRotationExecutor) Check(strict bool) error {
+	// The fee is paid from the validator's stake.
+	if e.validator.Stake() < e.fee {
+		return ErrInsufficientFunds
+	}
+
+	if e.validator.UnbondingHeight() > 0 {
+		return ErrValidatorUnbonded
+	}
+
+	// A validator can rotate its key only once per block. This prevents chained
+	// rotations (A to B, then B to C) and rotating back to an old address.
+	if e.sb.IsRotatedValidator(e.pld.Validator) {
+		return ErrValidatorKeyRotated
+	}
+
+	newAddr := e.pld.NewPublicKey.ValidatorAddress()
+	if e.sb.Validator(newAddr) != nil || e.sb.IsRotatedValidator(newAddr) {
+		return ErrValidatorAlreadyExists
+	}
+
+	if strict {
+		// The committee is identified by the validators' addresses.
+		// In strict mode, key rotation transactions will be rejected if a validator is
+		// in the committee or is going to join the committee in the next height.
+		// In non-strict mode, they are added to the transaction pool and
+		// processed once the validator leaves the committee.
+		if e.sb.Committee().Contains(e.pld.Validator) {
+			return ErrValidatorInCommittee
+		}
+
+		if e.sb.IsJoinedCommittee(e.pld.Validator) {
+			return ErrValidatorInCommittee
+		}
+	}
+
+	return nil
+}
+
+func (e *KeyRotationExecutor) Execute() {
+	powerBefore := e.validator.Power()
+	e.validator.SubtractFromStake(e.fee)
+	e.sb.UpdatePowerDelta(e.validator.Power() - powerBefore)
+
+	meta := e.sb.ValidatorMetadata(e.pld.Validator)
+
+	e.validator.UpdatePublicKey(e.pld.NewPublicKey)
+	e.sb.RotateValidatorKey(e.pld.Validator, e.validator)
+
+	// The metadata follows the validator to its new address.
+	if meta != nil {
+		e.sb.UpdateValidatorMetadata(e.validator.Address(), meta)
+	}
+}
@@ -0,0 +1,145 @@
+package executor
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/tx/payload"
+	"github.com/pactus-project/pactus/types/validator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecuteKeyRotationTx(t *testing.T) {
+	td := setup(t)
+
+	bonderAddr, bonderAcc := td.sandbox.TestStore.RandomTestAcc()
+	stake := td.RandAmountRange(
+		td.sandbox.TestParams.MinimumStake,
+		bonderAcc.Balance())
+	bonderAcc.SubtractFromBalance(stake)
+	td.sandbox.UpdateAccount(bonderAddr, bonderAcc)
+
+	valPub, valPrv := td.RandBLSKeyPair()
+	val := td.sandbox.MakeNewValidator(valPub)
+	val.AddToStake(stake)
+	td.sandbox.UpdateValidator(val)
+	meta := &validator.Metadata{Moniker: "moniker"}
+	td.sandbox.UpdateValidatorMetadata(val.Address(), meta)
+
+	valAddr := val.Address()
+	newPub, newPrv := td.RandBLSKeyPair()
+	proofOf := func(addr crypto.Address) *bls.Signature {
+		return newPrv.SignNative(payload.KeyRotationProofBytes(addr, newPub))
+	}
+	fee := td.RandFee()
+	lockTime := td.sandbox.CurrentHeight()
+
+	t.Run("Should fail, unknown address", func(t *testing.T) {
+		randomAddr := td.RandValAddress()
+		trx := tx.NewKeyRotationTx(lockTime, randomAddr, newPub, proofOf(randomAddr), fee)
+
+		td.check(t, trx, true, ValidatorNotFoundError{Address: randomAddr})
+		td.check(t, trx, false, ValidatorNotFoundError{Address: randomAddr})
+	})
+
+	t.Run("Should fail, insufficient stake", func(t *testing.T) {
+		trx := tx.NewKeyRotationTx(lockTime, valAddr, newPub, proofOf(valAddr), stake+1)
+
+		td.check(t, trx, true, ErrInsufficientFunds)
+		td.check(t, trx, false, ErrInsufficientFunds)
+	})
+
+	t.Run("Should fail, new key belongs to another validator", func(t *testing.T) {
+		otherVal := td.sandbox.TestStore.RandomTestVal()
+		trx := tx.NewKeyRotationTx(lockTime, valAddr, otherVal.PublicKey(), proofOf(valAddr), fee)
+
+		td.check(t, trx, true, ErrValidatorAlreadyExists)
+		td.check(t, trx, false, ErrValidatorAlreadyExists)
+	})
+
+	t.Run("Should fail, inside committee", func(t *testing.T) {
+		val0 := td.sandbox.Committee().Proposer(0)
+		trx := tx.NewKeyRotationTx(lockTime, val0.Address(), newPub, proofOf(val0.Address()), 0)
+
+		td.check(t, trx, true, ErrValidatorInCommittee)
+		td.check(t, trx, false, nil)
+	})
+
+	t.Run("Should fail, joining committee", func(t *testing.T) {
+		randPub, _ := td.RandBLSKeyPair()
+		randVal := td.sandbox.MakeNewValidator(randPub)
+		td.sandbox.UpdateValidator(randVal)
+		td.sandbox.JoinedToCommittee(randVal.Address())
+		trx := tx.NewKeyRotationTx(lockTime, randVal.Address(), newPub, proofOf(randVal.Address()), 0)
+
+		td.check(t, trx, true, ErrValidatorInCommittee)
+		td.check(t, trx, false, nil)
+	})
+
+	t.Run("Should fail, unbonded validator", func(t *testing.T) {
+		randPub, _ := td.RandBLSKeyPair()
+		randVal := td.sandbox.MakeNewValidator(randPub)
+		randVal.UpdateUnbondingHeight(td.RandHeight())
+		td.sandbox.UpdateValidator(randVal)
+		trx := tx.NewKeyRotationTx(lockTime, randVal.Address(), newPub, proofOf(randVal.Address()), 0)
+
+		td.check(t, trx, true, ErrValidatorUnbonded)
+		td.check(t, trx, false, ErrValidatorUnbonded)
+	})
+
+	t.Run("Should fail, new key doesn't prove its possession", func(t *testing.T) {
+		_, otherPrv := td.RandBLSKeyPair()
+		trx := tx.NewKeyRotationTx(lockTime, valAddr, newPub,
+			otherPrv.SignNative(payload.KeyRotationProofBytes(valAddr, newPub)), fee)
+		trx.SetSignature(valPrv.SignNative(trx.SignBytes()))
+		trx.SetPublicKey(valPub)
+
+		assert.ErrorIs(t, trx.BasicCheck(), tx.BasicCheckError{
+			Reason: "invalid payload: invalid proof of possession",
+		})
+	})
+
+	t.Run("Should pass, Everything is Ok!", func(t *testing.T) {
+		trx := tx.NewKeyRotationTx(lockTime, valAddr, newPub, proofOf(valAddr), fee)
+
+		td.check(t, trx, true, nil)
+		td.check(t, trx, false, nil)
+		td.execute(t, trx)
+	})
+
+	t.Run("Should fail, rotating the rotated validator again", func(t *testing.T) {
+		rotatedAddr := newPub.ValidatorAddress()
+		nextPub, nextPrv := td.RandBLSKeyPair()
+		trx := tx.NewKeyRotationTx(lockTime, rotatedAddr, nextPub,
+			nextPrv.SignNative(payload.KeyRotationProofBytes(rotatedAddr, nextPub)), fee)
+
+		td.check(t, trx, true, ErrValidatorKeyRotated)
+		td.check(t, trx, false, ErrValidatorKeyRotated)
+	})
+
+	t.Run("Should fail, rotating to the old key", func(t *testing.T) {
+		randPub, _ := td.RandBLSKeyPair()
+		randVal := td.sandbox.MakeNewValidator(randPub)
+		td.sandbox.UpdateValidator(randVal)
+		trx := tx.NewKeyRotationTx(lockTime, randVal.Address(), valPub,
+			valPrv.SignNative(payload.KeyRotationProofBytes(randVal.Address(), valPub)), 0)
+
+		td.check(t, trx, true, ErrValidatorAlreadyExists)
+		td.check(t, trx, false, ErrValidatorAlreadyExists)
+	})
+
+	assert.Nil(t, td.sandbox.Validator(valAddr))
+
+	rotatedVal := td.sandbox.Validator(newPub.ValidatorAddress())
+	require.NotNil(t, rotatedVal)
+	assert.Equal(t, val.Number(), rotatedVal.Number())
+	assert.Equal(t, newPub, rotatedVal.PublicKey())
+	assert.Equal(t, stake-fee, rotatedVal.Stake())
+	assert.Equal(t, -int64(fee), td.sandbox.PowerDelta())
+	assert.Equal(t, meta, td.sandbox.ValidatorMetadata(rotatedVal.Address()))
+
+	td.checkTotalCoin(t, fee)
+}
@@ -0,0 +1,72 @@
+package executor
+
+import (
+	"github.com/pactus-project/pactus/sandbox"
+	"github.com/pactus-project/pactus/types/amount"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/tx/payload"
+	"github.com/pactus-project/pactus/types/validator"
+)
+
+type MetadataExecutor struct {
+	sb        sandbox.Sandbox
+	pld       *payload.MetadataPayload
+	fee       amount.Amount
+	validator *validator.Validator
+}
+
+func newMetadataExecutor(trx *tx.Tx, sb sandbox.Sandbox) (*MetadataExecutor, error) {
+	pld := trx.Payload().(*payload.MetadataPayload)
+
+	val := sb.Validator(pld.Validator)
+	if val == nil {
+		return nil, ValidatorNotFoundError{Address: pld.Validator}
+	}
+
+	return &MetadataExecutor{
+		sb:        sb,
+		pld:       pld,
+		fee:       trx.Fee(),
+		validator: val,
+	}, nil
+}
+
+func (e *MetadataExecutor) Check(strict bool) error {
+	// The fee is paid from the validator's stake.
+	if e.validator.Stake() < e.fee {
+		return ErrInsufficientFunds
+	}
+
+	if strict {
+		// The stake of validators inside the committee should not change.
+		// In strict mode, metadata transactions will be rejected if a validator is
+		// in the committee or is going to join the committee in the next height.
+		// In non-strict mode, they are added to the transaction pool and
+		// processed once eligible.
+		if e.sb.Committee().Contains(e.pld.Validator) {
+			return ErrValidatorInCommittee
+		}
+
+		if e.sb.IsJoinedCommittee(e.pld.Validator) {
+			return ErrValidatorInCommittee
+		}
+	}
+
+	return nil
+}
+
+func (e *MetadataExecutor) Execute() {
+	powerBefore := e.validator.Power()
+	e.validator.SubtractFromStake(e.fee)
+	e.sb.UpdatePowerDelta(e.validator.Power() - powerBefore)
+
+	meta := &validator.Metadata{
+		Moniker:       e.pld.Moniker,
+		Website:       e.pld.Website,
+		Contact:       e.pld.Contact,
+		RewardAddress: e.pld.RewardAddress,
+	}
+
+	e.sb.UpdateValidator(e.validator)
+	e.sb.UpdateValidatorMetadata(e.pld.Validator, meta)
+}
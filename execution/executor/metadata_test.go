@@ -0,0 +1,85 @@
+package executor
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecuteMetadataTx(t *testing.T) {
+	td := setup(t)
+
+	bonderAddr, bonderAcc := td.sandbox.TestStore.RandomTestAcc()
+	stake := td.RandAmountRange(
+		td.sandbox.TestParams.MinimumStake,
+		bonderAcc.Balance())
+	bonderAcc.SubtractFromBalance(stake)
+	td.sandbox.UpdateAccount(bonderAddr, bonderAcc)
+
+	valPub, _ := td.RandBLSKeyPair()
+	val := td.sandbox.MakeNewValidator(valPub)
+	val.AddToStake(stake)
+	td.sandbox.UpdateValidator(val)
+
+	valAddr := val.Address()
+	rewardAddr := td.RandAccAddress()
+	fee := td.RandFee()
+	lockTime := td.sandbox.CurrentHeight()
+
+	t.Run("Should fail, unknown address", func(t *testing.T) {
+		randomAddr := td.RandValAddress()
+		trx := tx.NewMetadataTx(lockTime, randomAddr, "moniker", "", "", rewardAddr, fee)
+
+		td.check(t, trx, true, ValidatorNotFoundError{Address: randomAddr})
+		td.check(t, trx, false, ValidatorNotFoundError{Address: randomAddr})
+	})
+
+	t.Run("Should fail, insufficient stake", func(t *testing.T) {
+		trx := tx.NewMetadataTx(lockTime, valAddr, "moniker", "", "", rewardAddr, stake+1)
+
+		td.check(t, trx, true, ErrInsufficientFunds)
+		td.check(t, trx, false, ErrInsufficientFunds)
+	})
+
+	t.Run("Should fail, inside committee", func(t *testing.T) {
+		val0 := td.sandbox.Committee().Proposer(0)
+		trx := tx.NewMetadataTx(lockTime, val0.Address(), "moniker", "", "", rewardAddr, 0)
+
+		td.check(t, trx, true, ErrValidatorInCommittee)
+		td.check(t, trx, false, nil)
+	})
+
+	t.Run("Should fail, joining committee", func(t *testing.T) {
+		randPub, _ := td.RandBLSKeyPair()
+		randVal := td.sandbox.MakeNewValidator(randPub)
+		td.sandbox.UpdateValidator(randVal)
+		td.sandbox.JoinedToCommittee(randVal.Address())
+		trx := tx.NewMetadataTx(lockTime, randVal.Address(), "moniker", "", "", rewardAddr, 0)
+
+		td.check(t, trx, true, ErrValidatorInCommittee)
+		td.check(t, trx, false, nil)
+	})
+
+	t.Run("Should pass, Everything is Ok!", func(t *testing.T) {
+		trx := tx.NewMetadataTx(lockTime, valAddr, "moniker", "https://pactus.org", "info@pactus.org", rewardAddr, fee)
+
+		td.check(t, trx, true, nil)
+		td.check(t, trx, false, nil)
+		td.execute(t, trx)
+	})
+
+	updatedVal := td.sandbox.Validator(valAddr)
+	meta := td.sandbox.ValidatorMetadata(valAddr)
+	require.NotNil(t, meta)
+
+	assert.Equal(t, stake-fee, updatedVal.Stake())
+	assert.Equal(t, -int64(fee), td.sandbox.PowerDelta())
+	assert.Equal(t, "moniker", meta.Moniker)
+	assert.Equal(t, "https://pactus.org", meta.Website)
+	assert.Equal(t, "info@pactus.org", meta.Contact)
+	assert.Equal(t, rewardAddr, meta.RewardAddress)
+
+	td.checkTotalCoin(t, fee)
+}
@@ -2,6 +2,9 @@ package network
 
 import (
 	"fmt"
+	"net"
+	"slices"
+	"time"
 
 	lp2pcore "github.com/libp2p/go-libp2p/core"
 	lp2ppeer "github.com/libp2p/go-libp2p/core/peer"
@@ -24,32 +27,56 @@ type Config struct {
 	EnableMetrics        bool     `toml:"enable_metrics"`
 	ForcePrivateNetwork  bool     `toml:"force_private_network"`
 
+	// Sentry node configs
+	UnconditionalPeerStrings []string `toml:"unconditional_peers"`
+	PrivatePeerIDStrings     []string `toml:"private_peer_ids"`
+	NoAdvertise              bool     `toml:"no_advertise"`
+
+	// Proxy configs
+	ProxyAddr string `toml:"proxy_addr"`
+	ProxyOnly bool   `toml:"proxy_only"`
+
 	// Private configs
-	NetworkName                 string   `toml:"-"`
-	DefaultPort                 int      `toml:"-"`
-	DefaultBootstrapAddrStrings []string `toml:"-"`
-	IsBootstrapper              bool     `toml:"-"`
-	PeerStorePath               string   `toml:"-"`
+	NetworkName                 string        `toml:"-"`
+	DefaultPort                 int           `toml:"-"`
+	DefaultBootstrapAddrStrings []string      `toml:"-"`
+	IsBootstrapper              bool          `toml:"-"`
+	PeerStorePath               string        `toml:"-"`
+	ReputationStorePath         string        `toml:"-"`
+	BanScore                    int           `toml:"-"`
+	BanDuration                 time.Duration `toml:"-"`
+	PenaltyHalfLife             time.Duration `toml:"-"`
+	BannedNets                  []string      `toml:"-"`
 }
 
 func DefaultConfig() *Config {
 	return &Config{
-		NetworkKey:           "network_key",
-		PublicAddrString:     "",
-		ListenAddrStrings:    []string{},
-		BootstrapAddrStrings: []string{},
-		MaxConns:             64,
-		EnableUDP:            false,
-		EnableNATService:     false,
-		EnableUPnP:           false,
-		EnableRelay:          true,
-		EnableRelayService:   false,
-		EnableMdns:           false,
-		EnableMetrics:        false,
-		ForcePrivateNetwork:  false,
-		DefaultPort:          0,
-		IsBootstrapper:       false,
-		PeerStorePath:        "peers.json",
+		NetworkKey:               "network_key",
+		PublicAddrString:         "",
+		ListenAddrStrings:        []string{},
+		BootstrapAddrStrings:     []string{},
+		MaxConns:                 64,
+		EnableUDP:                false,
+		EnableNATService:         false,
+		EnableUPnP:               false,
+		EnableRelay:              true,
+		EnableRelayService:       false,
+		EnableMdns:               false,
+		EnableMetrics:            false,
+		ForcePrivateNetwork:      false,
+		UnconditionalPeerStrings: []string{},
+		PrivatePeerIDStrings:     []string{},
+		NoAdvertise:              false,
+		ProxyAddr:                "",
+		ProxyOnly:                false,
+		DefaultPort:              0,
+		IsBootstrapper:           false,
+		PeerStorePath:            "peers.json",
+		ReputationStorePath:      "reputation.json",
+		BanScore:                 100,
+		BanDuration:              24 * time.Hour,
+		PenaltyHalfLife:          time.Hour,
+		BannedNets:               []string{},
 	}
 }
 
@@ -98,6 +125,34 @@ func (conf *Config) BasicCheck() error {
 			Reason: "maximum connection should be greater than 16",
 		}
 	}
+	if err := validateAddrInfo(conf.UnconditionalPeerStrings...); err != nil {
+		return err
+	}
+	for _, str := range conf.PrivatePeerIDStrings {
+		if _, err := lp2ppeer.Decode(str); err != nil {
+			return ConfigError{
+				Reason: fmt.Sprintf("private peer ID is not valid: %s", err.Error()),
+			}
+		}
+	}
+	if conf.NoAdvertise && len(conf.UnconditionalPeerStrings) == 0 {
+		return ConfigError{
+			Reason: "at least one unconditional peer is required when no-advertise is enabled",
+		}
+	}
+
+	if conf.ProxyAddr != "" {
+		if _, _, err := net.SplitHostPort(conf.ProxyAddr); err != nil {
+			return ConfigError{
+				Reason: fmt.Sprintf("proxy address is not valid: %s", err.Error()),
+			}
+		}
+	}
+	if conf.ProxyOnly && conf.ProxyAddr == "" {
+		return ConfigError{
+			Reason: "proxy address is required when proxy-only mode is enabled",
+		}
+	}
 
 	return validateAddrInfo(conf.BootstrapAddrStrings...)
 }
@@ -134,6 +189,41 @@ func (conf *Config) BootstrapAddrInfos() []lp2ppeer.AddrInfo {
 	return addrInfos
 }
 
+// UnconditionalAddrInfos returns the peers that the node always keeps connected to,
+// regardless of the connection limits.
+func (conf *Config) UnconditionalAddrInfos() []lp2ppeer.AddrInfo {
+	addrInfos, _ := MakeAddrInfos(conf.UnconditionalPeerStrings)
+
+	return addrInfos
+}
+
+// PrivatePeerIDs returns the peers that their addresses are never shared with other peers.
+func (conf *Config) PrivatePeerIDs() []lp2ppeer.ID {
+	pids := make([]lp2ppeer.ID, 0, len(conf.PrivatePeerIDStrings))
+	for _, str := range conf.PrivatePeerIDStrings {
+		pid, err := lp2ppeer.Decode(str)
+		if err == nil {
+			pids = append(pids, pid)
+		}
+	}
+
+	return pids
+}
+
+// TrustedPeerIDs returns the unconditional and private peers.
+// The trusted peers are not subject to the connection limits and
+// their messages are relayed directly.
+func (conf *Config) TrustedPeerIDs() []lp2ppeer.ID {
+	pids := conf.PrivatePeerIDs()
+	for _, ai := range conf.UnconditionalAddrInfos() {
+		if !slices.Contains(pids, ai.ID) {
+			pids = append(pids, ai.ID)
+		}
+	}
+
+	return pids
+}
+
 func (conf *Config) CheckIsBootstrapper(pid lp2pcore.PeerID) {
 	addrInfos := conf.BootstrapAddrInfos()
 	for _, ai := range addrInfos {
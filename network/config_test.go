@@ -86,6 +86,66 @@ func TestConfigBasicCheck(t *testing.T) {
 				c.MaxConns = 8
 			},
 		},
+		{
+			name: "Invalid UnconditionalPeerStrings",
+			expectedErr: ConfigError{
+				Reason: "address is not valid: invalid p2p multiaddr",
+			},
+			updateFn: func(c *Config) {
+				c.UnconditionalPeerStrings = []string{"/ip4/127.0.0.1/"}
+			},
+		},
+		{
+			name: "Invalid PrivatePeerIDStrings",
+			expectedErr: ConfigError{
+				Reason: "private peer ID is not valid: failed to parse peer ID: invalid cid: selected encoding not supported",
+			},
+			updateFn: func(c *Config) {
+				c.PrivatePeerIDStrings = []string{"invalid"}
+			},
+		},
+		{
+			name: "No-advertise without unconditional peers",
+			expectedErr: ConfigError{
+				Reason: "at least one unconditional peer is required when no-advertise is enabled",
+			},
+			updateFn: func(c *Config) {
+				c.NoAdvertise = true
+			},
+		},
+		{
+			name: "Valid sentry config",
+			updateFn: func(c *Config) {
+				c.UnconditionalPeerStrings = []string{"/ip4/127.0.0.1/p2p/12D3KooWQBpPV6NtZy1dvN2oF7dJdLoooRZfEmwtHiDUf42ArDjT"}
+				c.PrivatePeerIDStrings = []string{"12D3KooWBqutgDboACf1i1c9uN9BQg9xdREoeXYb2rvFHQU1QcAp"}
+				c.NoAdvertise = true
+			},
+		},
+		{
+			name: "Invalid ProxyAddr",
+			expectedErr: ConfigError{
+				Reason: "proxy address is not valid: address 127.0.0.1: missing port in address",
+			},
+			updateFn: func(c *Config) {
+				c.ProxyAddr = "127.0.0.1"
+			},
+		},
+		{
+			name: "Proxy-only mode without ProxyAddr",
+			expectedErr: ConfigError{
+				Reason: "proxy address is required when proxy-only mode is enabled",
+			},
+			updateFn: func(c *Config) {
+				c.ProxyOnly = true
+			},
+		},
+		{
+			name: "Valid Proxy-only mode",
+			updateFn: func(c *Config) {
+				c.ProxyAddr = "127.0.0.1:9050"
+				c.ProxyOnly = true
+			},
+		},
 		{
 			name: "Valid Public Address",
 			updateFn: func(c *Config) {
@@ -174,3 +234,23 @@ func TestPublicAddr(t *testing.T) {
 	conf2.PublicAddrString = "/ip4/127.0.0.1/p2p/12D3KooWQBpPV6NtZy1dvN2oF7dJdLoooRZfEmwtHiDUf42ArDjT"
 	assert.NotNil(t, conf2.PublicAddr())
 }
+
+func TestTrustedPeerIDs(t *testing.T) {
+	conf := DefaultConfig()
+	conf.UnconditionalPeerStrings = []string{
+		"/ip4/127.0.0.1/p2p/12D3KooWQBpPV6NtZy1dvN2oF7dJdLoooRZfEmwtHiDUf42ArDjT",
+		"/ip4/127.0.0.2/p2p/12D3KooWBqutgDboACf1i1c9uN9BQg9xdREoeXYb2rvFHQU1QcAp",
+	}
+	conf.PrivatePeerIDStrings = []string{
+		"12D3KooWBqutgDboACf1i1c9uN9BQg9xdREoeXYb2rvFHQU1QcAp",
+		"12D3KooWQQKidG8Nn6fLgxjryHFhRCfG9fUWU88yGSZNd59Kbqka",
+	}
+
+	pid1, _ := lp2ppeer.Decode("12D3KooWQBpPV6NtZy1dvN2oF7dJdLoooRZfEmwtHiDUf42ArDjT")
+	pid2, _ := lp2ppeer.Decode("12D3KooWBqutgDboACf1i1c9uN9BQg9xdREoeXYb2rvFHQU1QcAp")
+	pid3, _ := lp2ppeer.Decode("12D3KooWQQKidG8Nn6fLgxjryHFhRCfG9fUWU88yGSZNd59Kbqka")
+
+	assert.Len(t, conf.UnconditionalAddrInfos(), 2)
+	assert.Equal(t, []lp2ppeer.ID{pid2, pid3}, conf.PrivatePeerIDs())
+	assert.ElementsMatch(t, []lp2ppeer.ID{pid1, pid2, pid3}, conf.TrustedPeerIDs())
+}
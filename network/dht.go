@@ -2,10 +2,12 @@ package network
 
 import (
 	"context"
+	"slices"
 
 	lp2pdht "github.com/libp2p/go-libp2p-kad-dht"
 	lp2pcore "github.com/libp2p/go-libp2p/core"
 	lp2phost "github.com/libp2p/go-libp2p/core/host"
+	lp2ppeer "github.com/libp2p/go-libp2p/core/peer"
 	"github.com/pactus-project/pactus/util/logger"
 )
 
@@ -20,13 +22,28 @@ func newDHTService(ctx context.Context, host lp2phost.Host, protocolID lp2pcore.
 	conf *Config, log *logger.SubLogger,
 ) *dhtService {
 	mode := lp2pdht.ModeAuto
-	if conf.IsBootstrapper {
+	bootstrapPeers := conf.BootstrapAddrInfos()
+	switch {
+	case conf.IsBootstrapper:
 		mode = lp2pdht.ModeServer
+
+	case conf.NoAdvertise:
+		// The node is not added to the routing table of other peers.
+		mode = lp2pdht.ModeClient
+		bootstrapPeers = conf.UnconditionalAddrInfos()
+	}
+
+	// The private peers are not added to the routing table, so they are never shared with other peers.
+	privatePeers := conf.PrivatePeerIDs()
+	routingTableFilter := func(_ any, pid lp2ppeer.ID) bool {
+		return !slices.Contains(privatePeers, pid)
 	}
+
 	opts := []lp2pdht.Option{
 		lp2pdht.Mode(mode),
 		lp2pdht.ProtocolPrefix(protocolID),
-		lp2pdht.BootstrapPeers(conf.BootstrapAddrInfos()...),
+		lp2pdht.BootstrapPeers(bootstrapPeers...),
+		lp2pdht.RoutingTableFilter(routingTableFilter),
 	}
 
 	kademlia, err := lp2pdht.New(ctx, host, opts...)
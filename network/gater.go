@@ -8,22 +8,29 @@ import (
 	lp2pnetwork "github.com/libp2p/go-libp2p/core/network"
 	lp2ppeer "github.com/libp2p/go-libp2p/core/peer"
 	"github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr/net"
 	"github.com/pactus-project/pactus/util/logger"
 )
 
 var _ lp2pconnmgr.ConnectionGater = &ConnectionGater{}
 
+// ConnectionGater limits the number of connections and filters the private addresses and the banned peers.
+// The trusted peers, i.e. the unconditional and private peers, are not gated.
+// In no-advertise mode, only the connections to the unconditional peers are allowed.
 type ConnectionGater struct {
 	lk sync.RWMutex
 
 	filters     *multiaddr.Filters
 	peerMgr     *peerMgr
+	reputation  *reputationStore
 	acceptLimit int
 	dialLimit   int
+	trusted     map[lp2ppeer.ID]bool
+	onlyTrusted bool
 	logger      *logger.SubLogger
 }
 
-func NewConnectionGater(conf *Config, log *logger.SubLogger) (*ConnectionGater, error) {
+func NewConnectionGater(conf *Config, reputation *reputationStore, log *logger.SubLogger) (*ConnectionGater, error) {
 	filters := multiaddr.NewFilters()
 	if !conf.ForcePrivateNetwork {
 		privateSubnets := PrivateSubnets()
@@ -32,12 +39,21 @@ func NewConnectionGater(conf *Config, log *logger.SubLogger) (*ConnectionGater,
 
 	acceptLimit := conf.MaxConns
 	dialLimit := conf.MaxConns / 4
-	log.Info("connection gater created", "listen", acceptLimit, "dial", dialLimit)
+
+	trusted := make(map[lp2ppeer.ID]bool)
+	for _, pid := range conf.TrustedPeerIDs() {
+		trusted[pid] = true
+	}
+	log.Info("connection gater created", "listen", acceptLimit, "dial", dialLimit,
+		"trusted", len(trusted), "onlyTrusted", conf.NoAdvertise)
 
 	return &ConnectionGater{
 		filters:     filters,
+		reputation:  reputation,
 		acceptLimit: acceptLimit,
 		dialLimit:   dialLimit,
+		trusted:     trusted,
+		onlyTrusted: conf.NoAdvertise,
 		logger:      log,
 	}, nil
 }
@@ -69,6 +85,22 @@ func (g *ConnectionGater) InterceptPeerDial(pid lp2ppeer.ID) bool {
 	g.lk.RLock()
 	defer g.lk.RUnlock()
 
+	if g.trusted[pid] {
+		return true
+	}
+
+	if g.onlyTrusted {
+		g.logger.Debug("InterceptPeerDial rejected: not a trusted peer", "pid", pid)
+
+		return false
+	}
+
+	if g.reputation.IsBanned(pid) {
+		g.logger.Debug("InterceptPeerDial rejected: banned peer", "pid", pid)
+
+		return false
+	}
+
 	if g.onDialLimit() {
 		g.logger.Debug("InterceptPeerDial rejected: many connections",
 			"pid", pid, "outbound", g.peerMgr.NumOutbound())
@@ -83,6 +115,22 @@ func (g *ConnectionGater) InterceptAddrDial(pid lp2ppeer.ID, ma multiaddr.Multia
 	g.lk.RLock()
 	defer g.lk.RUnlock()
 
+	if g.trusted[pid] {
+		return true
+	}
+
+	if g.onlyTrusted {
+		g.logger.Debug("InterceptAddrDial rejected: not a trusted peer", "pid", pid)
+
+		return false
+	}
+
+	if g.reputation.IsBanned(pid) {
+		g.logger.Debug("InterceptAddrDial rejected: banned peer", "pid", pid)
+
+		return false
+	}
+
 	if g.onDialLimit() {
 		g.logger.Debug("InterceptAddrDial rejected: many connections",
 			"pid", pid, "ma", ma.String(), "outbound", g.peerMgr.NumOutbound())
@@ -90,6 +138,12 @@ func (g *ConnectionGater) InterceptAddrDial(pid lp2ppeer.ID, ma multiaddr.Multia
 		return false
 	}
 
+	if g.isAddrBanned(ma) {
+		g.logger.Debug("InterceptAddrDial rejected: banned address", "pid", pid, "ma", ma.String())
+
+		return false
+	}
+
 	deny := g.filters.AddrBlocked(ma)
 	if deny {
 		g.logger.Debug("InterceptAddrDial rejected", "pid", pid, "ma", ma.String())
@@ -104,6 +158,45 @@ func (g *ConnectionGater) InterceptAccept(cma lp2pnetwork.ConnMultiaddrs) bool {
 	g.lk.RLock()
 	defer g.lk.RUnlock()
 
+	// The peer ID is not known yet.
+	// If there are trusted peers, the connection is checked once it is secured.
+	if len(g.trusted) > 0 {
+		return true
+	}
+
+	return g.acceptAllowed(cma)
+}
+
+func (g *ConnectionGater) InterceptSecured(dir lp2pnetwork.Direction, pid lp2ppeer.ID,
+	cma lp2pnetwork.ConnMultiaddrs,
+) bool {
+	g.lk.RLock()
+	defer g.lk.RUnlock()
+
+	if g.trusted[pid] {
+		return true
+	}
+
+	if g.onlyTrusted {
+		g.logger.Debug("InterceptSecured rejected: not a trusted peer", "pid", pid)
+
+		return false
+	}
+
+	if g.reputation.IsBanned(pid) {
+		g.logger.Debug("InterceptSecured rejected: banned peer", "pid", pid)
+
+		return false
+	}
+
+	if dir == lp2pnetwork.DirInbound && len(g.trusted) > 0 {
+		return g.acceptAllowed(cma)
+	}
+
+	return true
+}
+
+func (g *ConnectionGater) acceptAllowed(cma lp2pnetwork.ConnMultiaddrs) bool {
 	if g.onAcceptLimit() {
 		g.logger.Debug("InterceptAccept rejected: many connections",
 			"inbound", g.peerMgr.NumInbound())
@@ -111,6 +204,12 @@ func (g *ConnectionGater) InterceptAccept(cma lp2pnetwork.ConnMultiaddrs) bool {
 		return false
 	}
 
+	if g.isAddrBanned(cma.RemoteMultiaddr()) {
+		g.logger.Debug("InterceptAccept rejected: banned address", "ma", cma.RemoteMultiaddr().String())
+
+		return false
+	}
+
 	deny := g.filters.AddrBlocked(cma.RemoteMultiaddr())
 	if deny {
 		g.logger.Debug("InterceptAccept rejected")
@@ -121,8 +220,14 @@ func (g *ConnectionGater) InterceptAccept(cma lp2pnetwork.ConnMultiaddrs) bool {
 	return true
 }
 
-func (*ConnectionGater) InterceptSecured(_ lp2pnetwork.Direction, _ lp2ppeer.ID, _ lp2pnetwork.ConnMultiaddrs) bool {
-	return true
+// isAddrBanned checks if the IP address of the multiaddr is inside a banned network.
+func (g *ConnectionGater) isAddrBanned(ma multiaddr.Multiaddr) bool {
+	ip, err := manet.ToIP(ma)
+	if err != nil {
+		return false
+	}
+
+	return g.reputation.IsIPBanned(ip.String())
 }
 
 func (*ConnectionGater) InterceptUpgraded(_ lp2pnetwork.Conn) (bool, lp2pcontrol.DisconnectReason) {
@@ -2,11 +2,13 @@ package network
 
 import (
 	"testing"
+	"time"
 
 	lp2pnetwork "github.com/libp2p/go-libp2p/core/network"
 	"github.com/multiformats/go-multiaddr"
 	"github.com/pactus-project/pactus/util/testsuite"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type mockConnMultiaddrs struct {
@@ -99,3 +101,144 @@ func TestMaxConnection(t *testing.T) {
 	assert.False(t, net.connGater.InterceptAccept(cmaPrivate))
 	assert.False(t, net.connGater.InterceptAccept(cmaPublic))
 }
+
+func TestTrustedPeers(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+	trustedPID := ts.RandPeerID()
+	conf := testConfig()
+	conf.MaxConns = 4
+	conf.ForcePrivateNetwork = false
+	conf.PrivatePeerIDStrings = []string{trustedPID.String()}
+	net := makeTestNetwork(t, conf, nil)
+
+	maPrivate := multiaddr.StringCast("/ip4/127.0.0.1/tcp/1234")
+	maPublic := multiaddr.StringCast("/ip4/8.8.8.8/tcp/1234")
+	cmaPrivate := &mockConnMultiaddrs{remote: maPrivate}
+	cmaPublic := &mockConnMultiaddrs{remote: maPublic}
+	pid := ts.RandPeerID()
+
+	for i := 0; i < 6; i++ {
+		net.peerMgr.SetPeerConnected(ts.RandPeerID(), maPublic, lp2pnetwork.DirOutbound)
+		net.peerMgr.SetPeerConnected(ts.RandPeerID(), maPublic, lp2pnetwork.DirInbound)
+	}
+
+	// The trusted peers are not limited.
+	assert.True(t, net.connGater.InterceptPeerDial(trustedPID))
+	assert.True(t, net.connGater.InterceptAddrDial(trustedPID, maPrivate))
+	assert.True(t, net.connGater.InterceptSecured(lp2pnetwork.DirInbound, trustedPID, cmaPrivate))
+
+	// Inbound connections are checked once the peer ID is known.
+	assert.True(t, net.connGater.InterceptAccept(cmaPrivate))
+	assert.True(t, net.connGater.InterceptAccept(cmaPublic))
+	assert.False(t, net.connGater.InterceptSecured(lp2pnetwork.DirInbound, pid, cmaPublic))
+	assert.False(t, net.connGater.InterceptPeerDial(pid))
+	assert.False(t, net.connGater.InterceptAddrDial(pid, maPublic))
+}
+
+func TestNoAdvertise(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+	sentryPID := ts.RandPeerID()
+	conf := testConfig()
+	conf.NoAdvertise = true
+	conf.UnconditionalPeerStrings = []string{"/ip4/127.0.0.1/tcp/1234/p2p/" + sentryPID.String()}
+	net := makeTestNetwork(t, conf, nil)
+
+	maPublic := multiaddr.StringCast("/ip4/8.8.8.8/tcp/1234")
+	cmaPublic := &mockConnMultiaddrs{remote: maPublic}
+	pid := ts.RandPeerID()
+
+	assert.True(t, net.connGater.InterceptPeerDial(sentryPID))
+	assert.True(t, net.connGater.InterceptSecured(lp2pnetwork.DirInbound, sentryPID, cmaPublic))
+	assert.True(t, net.connGater.InterceptSecured(lp2pnetwork.DirOutbound, sentryPID, cmaPublic))
+
+	assert.False(t, net.connGater.InterceptPeerDial(pid))
+	assert.False(t, net.connGater.InterceptAddrDial(pid, maPublic))
+	assert.False(t, net.connGater.InterceptSecured(lp2pnetwork.DirInbound, pid, cmaPublic))
+	assert.False(t, net.connGater.InterceptSecured(lp2pnetwork.DirOutbound, pid, cmaPublic))
+
+	// The addresses of the node are not advertised.
+	assert.Empty(t, net.HostAddrs())
+}
+
+func TestDenyBannedPeers(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+	conf := testConfig()
+	net := makeTestNetwork(t, conf, nil)
+
+	maPublic := multiaddr.StringCast("/ip4/8.8.8.8/tcp/1234")
+	cmaPublic := &mockConnMultiaddrs{remote: maPublic}
+	pid := ts.RandPeerID()
+
+	net.PenalizePeer(pid, conf.BanScore, "invalid bundle")
+	assert.True(t, net.IsPeerBanned(pid))
+
+	assert.False(t, net.connGater.InterceptPeerDial(pid))
+	assert.False(t, net.connGater.InterceptAddrDial(pid, maPublic))
+	assert.False(t, net.connGater.InterceptSecured(lp2pnetwork.DirInbound, pid, cmaPublic))
+	assert.True(t, net.connGater.InterceptSecured(lp2pnetwork.DirInbound, ts.RandPeerID(), cmaPublic))
+}
+
+func TestDenyBannedNets(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+	conf := testConfig()
+	net := makeTestNetwork(t, conf, nil)
+
+	maBanned := multiaddr.StringCast("/ip4/8.8.8.8/tcp/1234")
+	maAllowed := multiaddr.StringCast("/ip4/8.8.4.4/tcp/1234")
+	cmaBanned := &mockConnMultiaddrs{remote: maBanned}
+	cmaAllowed := &mockConnMultiaddrs{remote: maAllowed}
+	pid := ts.RandPeerID()
+
+	assert.Error(t, net.BanNet("invalid-net", time.Hour, "spamming"))
+	assert.NoError(t, net.BanNet("8.8.8.0/24", time.Hour, "spamming"))
+
+	assert.False(t, net.connGater.InterceptAddrDial(pid, maBanned))
+	assert.False(t, net.connGater.InterceptAccept(cmaBanned))
+	assert.True(t, net.connGater.InterceptAddrDial(pid, maAllowed))
+	assert.True(t, net.connGater.InterceptAccept(cmaAllowed))
+
+	bans := net.Bans()
+	assert.Len(t, bans, 1)
+	assert.Equal(t, "8.8.8.0/24", bans[0].Target)
+	assert.Equal(t, "spamming", bans[0].Reason)
+
+	assert.True(t, net.UnbanNet("8.8.8.0/24"))
+	assert.True(t, net.connGater.InterceptAccept(cmaBanned))
+	assert.Empty(t, net.Bans())
+}
+
+func TestDenyConfiguredBannedNets(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+	conf := testConfig()
+	conf.BannedNets = []string{"8.8.8.0/24"}
+	net := makeTestNetwork(t, conf, nil)
+
+	maBanned := multiaddr.StringCast("/ip4/8.8.8.8/tcp/1234")
+	assert.False(t, net.connGater.InterceptAddrDial(ts.RandPeerID(), maBanned))
+	assert.True(t, net.IsIPBanned("8.8.8.8"))
+
+	// The permanent bans have no expiry time.
+	bans := net.Bans()
+	require.Len(t, bans, 1)
+	assert.True(t, bans[0].BannedUntil.IsZero())
+}
+
+func TestBanPeerByAdmin(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+	conf := testConfig()
+	net := makeTestNetwork(t, conf, nil)
+	pid := ts.RandPeerID()
+
+	net.BanPeer(pid, 0, "spamming")
+	assert.True(t, net.IsPeerBanned(pid))
+	assert.False(t, net.connGater.InterceptPeerDial(pid))
+
+	bans := net.Bans()
+	assert.Len(t, bans, 1)
+	assert.Equal(t, pid.String(), bans[0].Target)
+	assert.WithinDuration(t, time.Now().Add(conf.BanDuration), bans[0].BannedUntil, time.Minute)
+
+	assert.True(t, net.UnbanPeer(pid))
+	assert.False(t, net.IsPeerBanned(pid))
+	assert.True(t, net.connGater.InterceptPeerDial(pid))
+}
@@ -3,12 +3,14 @@ package network
 import (
 	"context"
 	"fmt"
+	"slices"
 	"sync"
 	"time"
 
 	lp2pps "github.com/libp2p/go-libp2p-pubsub"
 	lp2pcore "github.com/libp2p/go-libp2p/core"
 	lp2phost "github.com/libp2p/go-libp2p/core/host"
+	lp2ppeer "github.com/libp2p/go-libp2p/core/peer"
 	"github.com/pactus-project/pactus/util/logger"
 )
 
@@ -38,11 +40,23 @@ func newGossipService(ctx context.Context, host lp2phost.Host, eventCh chan Even
 		lp2pps.WithPeerOutboundQueueSize(600),
 	}
 
-	if conf.IsBootstrapper {
-		// enable Peer eXchange on bootstrappers
+	if conf.IsBootstrapper && len(conf.PrivatePeerIDStrings) == 0 {
+		// enable Peer eXchange on bootstrappers, unless the private peers might be exchanged.
 		opts = append(opts, lp2pps.WithPeerExchange(true))
 	}
 
+	// The messages are always relayed to the trusted peers, like the sentry nodes of a validator,
+	// or the validator behind a sentry node.
+	directPeers := conf.UnconditionalAddrInfos()
+	for _, pid := range conf.PrivatePeerIDs() {
+		if !slices.ContainsFunc(directPeers, func(ai lp2ppeer.AddrInfo) bool { return ai.ID == pid }) {
+			directPeers = append(directPeers, lp2ppeer.AddrInfo{ID: pid})
+		}
+	}
+	if len(directPeers) > 0 {
+		opts = append(opts, lp2pps.WithDirectPeers(directPeers))
+	}
+
 	gsParams := lp2pps.DefaultGossipSubParams()
 	if conf.IsBootstrapper {
 		gsParams.Dhi = 12
@@ -209,7 +223,7 @@ func (g *gossipService) joinTopic(topicID TopicID, sp ShouldPropagate) (*lp2pps.
 				return
 			}
 
-			g.onReceiveMessage(m)
+			g.onReceiveMessage(m, topicID)
 		}
 	}()
 
@@ -234,7 +248,7 @@ func (g *gossipService) createValidator(topicID TopicID, sp ShouldPropagate,
 			g.logger.Debug("message ignored", "from", peerId, "topic", topicID)
 
 			// Consume the message first
-			g.onReceiveMessage(m)
+			g.onReceiveMessage(m, topicID)
 
 			return lp2pps.ValidationIgnore
 		}
@@ -260,7 +274,7 @@ func (g *gossipService) Stop() {
 	g.wg.Wait()
 }
 
-func (g *gossipService) onReceiveMessage(m *lp2pps.Message) {
+func (g *gossipService) onReceiveMessage(m *lp2pps.Message, topicID TopicID) {
 	// only forward messages delivered by others
 	if m.ReceivedFrom == g.host.ID() {
 		return
@@ -268,8 +282,9 @@ func (g *gossipService) onReceiveMessage(m *lp2pps.Message) {
 
 	g.logger.Debug("receiving new gossip message", "from", m.ReceivedFrom)
 	event := &GossipMessage{
-		From: m.ReceivedFrom,
-		Data: m.Data,
+		From:    m.ReceivedFrom,
+		Data:    m.Data,
+		TopicID: topicID,
 	}
 
 	g.eventCh <- event
@@ -2,6 +2,7 @@ package network
 
 import (
 	"io"
+	"time"
 
 	lp2pcore "github.com/libp2p/go-libp2p/core"
 )
@@ -121,6 +122,14 @@ func (*ProtocolsEvents) Type() EventType {
 	return EventTypeProtocols
 }
 
+// BanInfo describes a banned peer or network.
+type BanInfo struct {
+	// Target is the peer ID or the network in CIDR notation.
+	Target      string
+	BannedUntil time.Time
+	Reason      string
+}
+
 // ShouldPropagate determines whether a message should be disregarded:
 // it will be neither delivered to the application nor forwarded to the network.
 type ShouldPropagate func(*GossipMessage) bool
@@ -134,6 +143,15 @@ type Network interface {
 	SendTo([]byte, lp2pcore.PeerID)
 	JoinTopic(TopicID, ShouldPropagate) error
 	CloseConnection(lp2pcore.PeerID)
+	PenalizePeer(pid lp2pcore.PeerID, score int, reason string)
+	IsPeerBanned(lp2pcore.PeerID) bool
+	ConnectPeer(addr string) error
+	BanPeer(pid lp2pcore.PeerID, duration time.Duration, reason string)
+	UnbanPeer(lp2pcore.PeerID) bool
+	BanNet(target string, duration time.Duration, reason string) error
+	UnbanNet(target string) bool
+	IsIPBanned(ip string) bool
+	Bans() []BanInfo
 	SelfID() lp2pcore.PeerID
 	NumConnectedPeers() int
 	NumInbound() int
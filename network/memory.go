@@ -0,0 +1,713 @@
+package network
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"slices"
+	"sync"
+	"time"
+
+	lp2pcore "github.com/libp2p/go-libp2p/core"
+	lp2pnetwork "github.com/libp2p/go-libp2p/core/network"
+	lp2ppeer "github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr/net"
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/linkedmap"
+	"github.com/pactus-project/pactus/util/logger"
+)
+
+var _ Network = &memoryNetwork{}
+
+// Switchboard connects the in-memory networks that run in the same process.
+// It lets many nodes run in one test binary without opening any socket.
+// The latency and the loss rate of the links can be changed at any time.
+type Switchboard struct {
+	lk sync.RWMutex
+
+	networks map[lp2ppeer.ID]*memoryNetwork
+	lastIP   int
+
+	// The link parameters have their own lock, because they are read while sending messages.
+	paramsLk sync.RWMutex
+	latency  time.Duration
+	lossRate float64
+}
+
+// NewSwitchboard creates a switchboard without latency and loss.
+func NewSwitchboard() *Switchboard {
+	return &Switchboard{
+		networks: make(map[lp2ppeer.ID]*memoryNetwork),
+	}
+}
+
+// SetLatency sets the time that takes for a message to be delivered.
+func (sb *Switchboard) SetLatency(latency time.Duration) {
+	sb.paramsLk.Lock()
+	defer sb.paramsLk.Unlock()
+
+	sb.latency = latency
+}
+
+// SetLossRate sets the probability of losing a message, between zero and one.
+func (sb *Switchboard) SetLossRate(rate float64) {
+	sb.paramsLk.Lock()
+	defer sb.paramsLk.Unlock()
+
+	sb.lossRate = rate
+}
+
+// schedule returns the delivery time of a message that is sent now,
+// or false if the message is lost.
+func (sb *Switchboard) schedule() (time.Time, bool) {
+	sb.paramsLk.RLock()
+	defer sb.paramsLk.RUnlock()
+
+	if sb.lossRate > 0 && float64(util.RandUint64(1_000_000)) < sb.lossRate*1_000_000 {
+		return time.Time{}, false
+	}
+
+	return time.Now().Add(sb.latency), true
+}
+
+func (sb *Switchboard) register(n *memoryNetwork) error {
+	sb.lk.Lock()
+	defer sb.lk.Unlock()
+
+	if _, ok := sb.networks[n.id]; ok {
+		return fmt.Errorf("peer %s is already registered", n.id)
+	}
+
+	// Each network gets a unique address, so the networks can be banned by their IP.
+	sb.lastIP++
+	n.addr = fmt.Sprintf("/ip4/10.%d.%d.%d/tcp/21888",
+		(sb.lastIP>>16)&0xff, (sb.lastIP>>8)&0xff, sb.lastIP&0xff)
+	sb.networks[n.id] = n
+
+	return nil
+}
+
+func (sb *Switchboard) unregister(n *memoryNetwork) {
+	sb.lk.Lock()
+	defer sb.lk.Unlock()
+
+	for _, l := range n.connectedLinks() {
+		sb.disconnectLocked(n, l.remote)
+	}
+	delete(sb.networks, n.id)
+}
+
+// connectAll connects the network to all the registered networks,
+// as if they were discovered in a local network.
+func (sb *Switchboard) connectAll(n *memoryNetwork) {
+	sb.lk.Lock()
+	defer sb.lk.Unlock()
+
+	for _, other := range sb.networks {
+		if other == n {
+			continue
+		}
+
+		if err := sb.connectLocked(n, other); err != nil {
+			n.logger.Debug("unable to connect", "pid", other.id, "error", err)
+		}
+	}
+}
+
+func (sb *Switchboard) connect(n *memoryNetwork, pid lp2ppeer.ID) error {
+	sb.lk.Lock()
+	defer sb.lk.Unlock()
+
+	other, ok := sb.networks[pid]
+	if !ok {
+		return fmt.Errorf("peer %s is not reachable", pid)
+	}
+
+	return sb.connectLocked(n, other)
+}
+
+func (sb *Switchboard) connectLocked(from, to *memoryNetwork) error {
+	if from == to {
+		return fmt.Errorf("unable to connect to self")
+	}
+
+	if from.isConnected(to.id) {
+		return nil
+	}
+
+	if from.refuses(to) || to.refuses(from) {
+		return fmt.Errorf("connection to %s is refused", to.id)
+	}
+
+	if from.isFull() || to.isFull() {
+		return fmt.Errorf("connection to %s is refused, too many connections", to.id)
+	}
+
+	from.addLink(to, lp2pnetwork.DirOutbound)
+	to.addLink(from, lp2pnetwork.DirInbound)
+
+	return nil
+}
+
+func (sb *Switchboard) disconnect(n *memoryNetwork, pid lp2ppeer.ID) {
+	sb.lk.Lock()
+	defer sb.lk.Unlock()
+
+	other, ok := sb.networks[pid]
+	if !ok {
+		return
+	}
+
+	sb.disconnectLocked(n, other)
+}
+
+func (*Switchboard) disconnectLocked(a, b *memoryNetwork) {
+	a.removeLink(b.id)
+	b.removeLink(a.id)
+}
+
+// pipe delivers the items in order, not before their delivery time.
+// Pushing to a pipe never blocks.
+type pipe[T any] struct {
+	lk sync.Mutex
+
+	pending   []pipeItem[T]
+	notifyCh  chan struct{}
+	closeCh   chan struct{}
+	closeOnce sync.Once
+}
+
+type pipeItem[T any] struct {
+	at   time.Time
+	item T
+}
+
+func newPipe[T any](deliver func(item T, closeCh <-chan struct{})) *pipe[T] {
+	p := &pipe[T]{
+		pending:  make([]pipeItem[T], 0),
+		notifyCh: make(chan struct{}, 1),
+		closeCh:  make(chan struct{}),
+	}
+
+	go p.run(deliver)
+
+	return p
+}
+
+func (p *pipe[T]) push(item T, at time.Time) {
+	p.lk.Lock()
+	p.pending = append(p.pending, pipeItem[T]{at: at, item: item})
+	p.lk.Unlock()
+
+	select {
+	case p.notifyCh <- struct{}{}:
+	default:
+	}
+}
+
+func (p *pipe[T]) pop() (pipeItem[T], bool) {
+	p.lk.Lock()
+	defer p.lk.Unlock()
+
+	if len(p.pending) == 0 {
+		return pipeItem[T]{}, false
+	}
+	item := p.pending[0]
+	p.pending = p.pending[1:]
+
+	return item, true
+}
+
+func (p *pipe[T]) run(deliver func(item T, closeCh <-chan struct{})) {
+	for {
+		select {
+		case <-p.closeCh:
+			return
+		case <-p.notifyCh:
+		}
+
+		for {
+			pi, ok := p.pop()
+			if !ok {
+				break
+			}
+
+			if wait := time.Until(pi.at); wait > 0 {
+				timer := time.NewTimer(wait)
+				select {
+				case <-p.closeCh:
+					timer.Stop()
+
+					return
+				case <-timer.C:
+				}
+			}
+
+			deliver(pi.item, p.closeCh)
+		}
+	}
+}
+
+// close stops the pipe. The pending items are dropped.
+func (p *pipe[T]) close() {
+	p.closeOnce.Do(func() {
+		close(p.closeCh)
+	})
+}
+
+// packet is a gossip or stream message that is sent over a link.
+type packet struct {
+	from    lp2ppeer.ID
+	data    []byte
+	topicID TopicID
+	gossip  bool
+}
+
+// link is a connection to a remote network.
+type link struct {
+	remote    *memoryNetwork
+	direction lp2pnetwork.Direction
+	pipe      *pipe[packet]
+}
+
+// remoteIP returns the IP address of the remote network.
+func (l *link) remoteIP() string {
+	return l.remote.ip()
+}
+
+// memoryNetwork is a Network that exchanges the messages in memory, through a switchboard.
+// Once started, it connects to all the other networks on the switchboard.
+// The gossip messages are flooded to the connected peers that joined the topic,
+// and each peer forwards them if they should be propagated.
+type memoryNetwork struct {
+	lk sync.RWMutex
+
+	config     *Config
+	board      *Switchboard
+	id         lp2ppeer.ID
+	addr       string
+	eventCh    chan Event
+	events     *pipe[Event]
+	topics     map[TopicID]ShouldPropagate
+	links      map[lp2ppeer.ID]*link
+	seen       *linkedmap.LinkedMap[hash.Hash, bool]
+	reputation *reputationStore
+	running    bool
+	logger     *logger.SubLogger
+}
+
+// NewMemoryNetwork creates an in-memory network that is connected to the other networks
+// through the switchboard.
+func NewMemoryNetwork(conf *Config, board *Switchboard) (Network, error) {
+	return newMemoryNetwork(conf, board)
+}
+
+func newMemoryNetwork(conf *Config, board *Switchboard) (*memoryNetwork, error) {
+	networkKey, err := loadOrCreateKey(conf.NetworkKey)
+	if err != nil {
+		return nil, LibP2PError{Err: err}
+	}
+
+	pid, err := lp2ppeer.IDFromPrivateKey(networkKey)
+	if err != nil {
+		return nil, LibP2PError{Err: err}
+	}
+
+	n := &memoryNetwork{
+		config:  conf,
+		board:   board,
+		id:      pid,
+		eventCh: make(chan Event, 500),
+		topics:  make(map[TopicID]ShouldPropagate),
+		links:   make(map[lp2ppeer.ID]*link),
+		seen:    linkedmap.New[hash.Hash, bool](1024),
+	}
+	n.logger = logger.NewSubLogger("_network", nil)
+	n.reputation, err = newReputationStore(conf, n.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return n, nil
+}
+
+func (n *memoryNetwork) String() string {
+	return fmt.Sprintf("{%d}", n.NumConnectedPeers())
+}
+
+func (n *memoryNetwork) Start() error {
+	n.lk.Lock()
+	n.events = newPipe(func(e Event, closeCh <-chan struct{}) {
+		select {
+		case n.eventCh <- e:
+		case <-closeCh:
+		}
+	})
+	n.running = true
+	n.lk.Unlock()
+
+	if err := n.board.register(n); err != nil {
+		n.lk.Lock()
+		n.running = false
+		n.events.close()
+		n.lk.Unlock()
+
+		return err
+	}
+
+	n.board.connectAll(n)
+
+	n.logger.Info("memory network started", "addr", n.addr, "id", n.id)
+
+	return nil
+}
+
+func (n *memoryNetwork) Stop() {
+	n.board.unregister(n)
+
+	n.lk.Lock()
+	n.running = false
+	if n.events != nil {
+		n.events.close()
+	}
+	n.lk.Unlock()
+
+	if err := n.reputation.Save(); err != nil {
+		n.logger.Error("can't save reputation store", "err", err)
+	}
+}
+
+func (*memoryNetwork) Protect(_ lp2pcore.PeerID, _ string) {}
+
+func (n *memoryNetwork) EventChannel() <-chan Event {
+	return n.eventCh
+}
+
+// emit queues the event for the event channel.
+// The caller should hold the lock.
+func (n *memoryNetwork) emit(e Event) {
+	if n.running {
+		n.events.push(e, time.Now())
+	}
+}
+
+// ip returns the IP address of the network that is assigned by the switchboard.
+func (n *memoryNetwork) ip() string {
+	ma, err := multiaddr.NewMultiaddr(n.addr)
+	if err != nil {
+		return ""
+	}
+
+	ip, err := manet.ToIP(ma)
+	if err != nil {
+		return ""
+	}
+
+	return ip.String()
+}
+
+func (n *memoryNetwork) isConnected(pid lp2ppeer.ID) bool {
+	n.lk.RLock()
+	defer n.lk.RUnlock()
+
+	_, ok := n.links[pid]
+
+	return ok
+}
+
+func (n *memoryNetwork) isFull() bool {
+	n.lk.RLock()
+	defer n.lk.RUnlock()
+
+	return n.config.MaxConns > 0 && len(n.links) >= n.config.MaxConns
+}
+
+// refuses checks if the network doesn't accept the connection of the other network.
+func (n *memoryNetwork) refuses(other *memoryNetwork) bool {
+	if n.reputation.IsBanned(other.id) {
+		return true
+	}
+
+	return n.reputation.IsIPBanned(other.ip())
+}
+
+func (n *memoryNetwork) connectedLinks() []*link {
+	n.lk.RLock()
+	defer n.lk.RUnlock()
+
+	links := make([]*link, 0, len(n.links))
+	for _, l := range n.links {
+		links = append(links, l)
+	}
+
+	return links
+}
+
+func (n *memoryNetwork) addLink(remote *memoryNetwork, direction lp2pnetwork.Direction) {
+	n.lk.Lock()
+	defer n.lk.Unlock()
+
+	n.links[remote.id] = &link{
+		remote:    remote,
+		direction: direction,
+		pipe: newPipe(func(p packet, _ <-chan struct{}) {
+			remote.receive(p)
+		}),
+	}
+
+	n.logger.Info("connected to peer", "pid", remote.id, "direction", direction, "addr", remote.addr)
+	n.emit(&ConnectEvent{
+		PeerID:        remote.id,
+		RemoteAddress: remote.addr,
+		Direction:     direction.String(),
+	})
+	n.emit(&ProtocolsEvents{
+		PeerID:    remote.id,
+		Protocols: remote.Protocols(),
+	})
+}
+
+func (n *memoryNetwork) removeLink(pid lp2ppeer.ID) {
+	n.lk.Lock()
+	defer n.lk.Unlock()
+
+	l, ok := n.links[pid]
+	if !ok {
+		return
+	}
+
+	l.pipe.close()
+	delete(n.links, pid)
+
+	n.logger.Info("disconnected from peer", "pid", pid)
+	n.emit(&DisconnectEvent{PeerID: pid})
+}
+
+// receive handles a packet that is delivered by a link.
+func (n *memoryNetwork) receive(p packet) {
+	if !p.gossip {
+		n.lk.Lock()
+		n.emit(&StreamMessage{
+			From:   p.from,
+			Reader: io.NopCloser(bytes.NewReader(p.data)),
+		})
+		n.lk.Unlock()
+
+		return
+	}
+
+	n.lk.Lock()
+	sp, joined := n.topics[p.topicID]
+	msgID := hash.CalcHash(p.data)
+	if !joined || n.seen.Has(msgID) {
+		n.lk.Unlock()
+
+		return
+	}
+	n.seen.PushBack(msgID, true)
+
+	msg := &GossipMessage{
+		From:    p.from,
+		Data:    p.data,
+		TopicID: p.topicID,
+	}
+	n.emit(msg)
+	n.lk.Unlock()
+
+	if sp == nil || sp(msg) {
+		n.publish(p.data, p.topicID, p.from)
+	}
+}
+
+// publish sends the gossip message to the connected peers, except the peer that sent it.
+func (n *memoryNetwork) publish(data []byte, topicID TopicID, except lp2ppeer.ID) {
+	n.lk.RLock()
+	defer n.lk.RUnlock()
+
+	for pid, l := range n.links {
+		if pid == except {
+			continue
+		}
+
+		at, ok := n.board.schedule()
+		if !ok {
+			continue
+		}
+
+		l.pipe.push(packet{
+			from:    n.id,
+			data:    data,
+			topicID: topicID,
+			gossip:  true,
+		}, at)
+	}
+}
+
+func (n *memoryNetwork) Broadcast(data []byte, topicID TopicID) {
+	n.lk.Lock()
+	n.seen.PushBack(hash.CalcHash(data), true)
+	n.lk.Unlock()
+
+	n.publish(data, topicID, "")
+}
+
+func (n *memoryNetwork) SendTo(data []byte, pid lp2pcore.PeerID) {
+	n.lk.RLock()
+	defer n.lk.RUnlock()
+
+	l, ok := n.links[pid]
+	if !ok {
+		n.logger.Warn("error on sending msg", "pid", pid, "error", "peer is not connected")
+
+		return
+	}
+
+	at, ok := n.board.schedule()
+	if !ok {
+		return
+	}
+
+	l.pipe.push(packet{
+		from: n.id,
+		data: data,
+	}, at)
+}
+
+func (n *memoryNetwork) JoinTopic(topicID TopicID, sp ShouldPropagate) error {
+	n.lk.Lock()
+	defer n.lk.Unlock()
+
+	if _, ok := n.topics[topicID]; ok {
+		return fmt.Errorf("already joined to topic %s", topicID)
+	}
+	n.topics[topicID] = sp
+
+	return nil
+}
+
+func (n *memoryNetwork) CloseConnection(pid lp2ppeer.ID) {
+	n.logger.Debug("closing connection", "pid", pid)
+
+	n.board.disconnect(n, pid)
+}
+
+func (n *memoryNetwork) PenalizePeer(pid lp2ppeer.ID, score int, reason string) {
+	n.logger.Debug("penalizing peer", "pid", pid, "score", score, "reason", reason)
+
+	if n.reputation.Penalize(pid, score, reason) {
+		n.CloseConnection(pid)
+	}
+}
+
+func (n *memoryNetwork) IsPeerBanned(pid lp2ppeer.ID) bool {
+	return n.reputation.IsBanned(pid)
+}
+
+func (n *memoryNetwork) ConnectPeer(addr string) error {
+	addrInfo, err := lp2ppeer.AddrInfoFromString(addr)
+	if err != nil {
+		return err
+	}
+
+	return n.board.connect(n, addrInfo.ID)
+}
+
+func (n *memoryNetwork) BanPeer(pid lp2ppeer.ID, duration time.Duration, reason string) {
+	if duration == 0 {
+		duration = n.config.BanDuration
+	}
+
+	n.reputation.Ban(pid, time.Now().Add(duration), reason)
+	n.CloseConnection(pid)
+}
+
+func (n *memoryNetwork) UnbanPeer(pid lp2ppeer.ID) bool {
+	return n.reputation.Unban(pid)
+}
+
+func (n *memoryNetwork) BanNet(target string, duration time.Duration, reason string) error {
+	if duration == 0 {
+		duration = n.config.BanDuration
+	}
+
+	if err := n.reputation.BanNet(target, time.Now().Add(duration), reason); err != nil {
+		return err
+	}
+
+	for _, l := range n.connectedLinks() {
+		if n.reputation.IsIPBanned(l.remoteIP()) {
+			n.CloseConnection(l.remote.id)
+		}
+	}
+
+	return nil
+}
+
+func (n *memoryNetwork) UnbanNet(target string) bool {
+	return n.reputation.UnbanNet(target)
+}
+
+func (n *memoryNetwork) IsIPBanned(ip string) bool {
+	return n.reputation.IsIPBanned(ip)
+}
+
+func (n *memoryNetwork) Bans() []BanInfo {
+	return makeBanInfos(n.reputation)
+}
+
+func (n *memoryNetwork) SelfID() lp2ppeer.ID {
+	return n.id
+}
+
+func (n *memoryNetwork) NumConnectedPeers() int {
+	n.lk.RLock()
+	defer n.lk.RUnlock()
+
+	return len(n.links)
+}
+
+func (n *memoryNetwork) numLinks(direction lp2pnetwork.Direction) int {
+	n.lk.RLock()
+	defer n.lk.RUnlock()
+
+	count := 0
+	for _, l := range n.links {
+		if l.direction == direction {
+			count++
+		}
+	}
+
+	return count
+}
+
+func (n *memoryNetwork) NumInbound() int {
+	return n.numLinks(lp2pnetwork.DirInbound)
+}
+
+func (n *memoryNetwork) NumOutbound() int {
+	return n.numLinks(lp2pnetwork.DirOutbound)
+}
+
+func (*memoryNetwork) ReachabilityStatus() string {
+	return lp2pnetwork.ReachabilityPublic.String()
+}
+
+func (n *memoryNetwork) HostAddrs() []string {
+	return []string{n.addr}
+}
+
+func (n *memoryNetwork) Name() string {
+	return n.config.NetworkName
+}
+
+func (n *memoryNetwork) Protocols() []string {
+	protocols := []string{
+		fmt.Sprintf("/%s/gossip/v1", n.config.NetworkName),
+		fmt.Sprintf("/%s/stream/v1", n.config.NetworkName),
+	}
+	slices.Sort(protocols)
+
+	return protocols
+}
@@ -0,0 +1,292 @@
+package network
+
+import (
+	"io"
+	"testing"
+	"time"
+
+	lp2ppeer "github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pactus-project/pactus/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func makeMemoryNetwork(t *testing.T, board *Switchboard) *memoryNetwork {
+	t.Helper()
+
+	conf := DefaultConfig()
+	conf.NetworkKey = util.TempFilePath()
+	conf.ReputationStorePath = util.TempFilePath()
+	conf.NetworkName = "test"
+
+	net, err := newMemoryNetwork(conf, board)
+	require.NoError(t, err)
+
+	return net
+}
+
+func startMemoryNetworks(t *testing.T, board *Switchboard, count int) []*memoryNetwork {
+	t.Helper()
+
+	nets := make([]*memoryNetwork, count)
+	for i := 0; i < count; i++ {
+		nets[i] = makeMemoryNetwork(t, board)
+		require.NoError(t, nets[i].Start())
+
+		for _, topicID := range []TopicID{TopicIDBlock, TopicIDConsensus} {
+			require.NoError(t, nets[i].JoinTopic(topicID, alwaysPropagate))
+		}
+	}
+
+	t.Cleanup(func() {
+		for _, net := range nets {
+			net.Stop()
+		}
+	})
+
+	return nets
+}
+
+// shouldReceiveMemoryEvent returns the next event of the given type, skipping the other events.
+func shouldReceiveMemoryEvent[T Event](t *testing.T, net *memoryNetwork) T {
+	t.Helper()
+
+	timer := time.NewTimer(time.Second)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-timer.C:
+			require.FailNow(t, "event not received")
+
+		case e := <-net.EventChannel():
+			if target, ok := e.(T); ok {
+				return target
+			}
+		}
+	}
+}
+
+// drainEvents removes the events that are not read yet.
+func drainEvents(net *memoryNetwork) {
+	for {
+		select {
+		case <-net.EventChannel():
+		case <-time.After(50 * time.Millisecond):
+			return
+		}
+	}
+}
+
+func shouldNotReceiveMemoryEvent[T Event](t *testing.T, net *memoryNetwork, wait time.Duration) {
+	t.Helper()
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-timer.C:
+			return
+
+		case e := <-net.EventChannel():
+			if _, ok := e.(T); ok {
+				require.FailNow(t, "unexpected event", "event: %v", e)
+			}
+		}
+	}
+}
+
+func TestMemoryNetworkConnect(t *testing.T) {
+	board := NewSwitchboard()
+	nets := startMemoryNetworks(t, board, 3)
+
+	for _, net := range nets {
+		assert.Equal(t, 2, net.NumConnectedPeers())
+		assert.Equal(t, net.NumConnectedPeers(), net.NumInbound()+net.NumOutbound())
+	}
+	assert.Equal(t, 2, nets[0].NumInbound())
+	assert.Equal(t, 2, nets[2].NumOutbound())
+
+	ce := shouldReceiveMemoryEvent[*ConnectEvent](t, nets[0])
+	assert.Contains(t, []lp2ppeer.ID{nets[1].SelfID(), nets[2].SelfID()}, ce.PeerID)
+	assert.Equal(t, "Inbound", ce.Direction)
+
+	pe := shouldReceiveMemoryEvent[*ProtocolsEvents](t, nets[0])
+	assert.Contains(t, pe.Protocols, "/test/stream/v1")
+
+	t.Run("Each network has a unique address", func(t *testing.T) {
+		assert.NotEqual(t, nets[0].HostAddrs(), nets[1].HostAddrs())
+	})
+
+	t.Run("Close connection", func(t *testing.T) {
+		nets[0].CloseConnection(nets[1].SelfID())
+
+		de := shouldReceiveMemoryEvent[*DisconnectEvent](t, nets[1])
+		assert.Equal(t, nets[0].SelfID(), de.PeerID)
+		assert.Equal(t, 1, nets[0].NumConnectedPeers())
+		assert.Equal(t, 1, nets[1].NumConnectedPeers())
+	})
+
+	t.Run("Connect peer", func(t *testing.T) {
+		drainEvents(nets[0])
+		drainEvents(nets[1])
+
+		addr := nets[1].HostAddrs()[0] + "/p2p/" + nets[1].SelfID().String()
+		require.NoError(t, nets[0].ConnectPeer(addr))
+
+		ce0 := shouldReceiveMemoryEvent[*ConnectEvent](t, nets[0])
+		assert.Equal(t, nets[1].SelfID(), ce0.PeerID)
+		assert.Equal(t, "Outbound", ce0.Direction)
+
+		ce1 := shouldReceiveMemoryEvent[*ConnectEvent](t, nets[1])
+		assert.Equal(t, nets[0].SelfID(), ce1.PeerID)
+		assert.Equal(t, "Inbound", ce1.Direction)
+	})
+
+	t.Run("Connect to an unknown peer", func(t *testing.T) {
+		unknown := makeMemoryNetwork(t, board)
+		addr := "/ip4/10.0.0.99/tcp/21888/p2p/" + unknown.SelfID().String()
+		assert.Error(t, nets[0].ConnectPeer(addr))
+	})
+
+	t.Run("Stopped network is disconnected", func(t *testing.T) {
+		drainEvents(nets[0])
+		nets[2].Stop()
+
+		de := shouldReceiveMemoryEvent[*DisconnectEvent](t, nets[0])
+		assert.Equal(t, nets[2].SelfID(), de.PeerID)
+		assert.Equal(t, 1, nets[0].NumConnectedPeers())
+	})
+}
+
+func TestMemoryNetworkGossip(t *testing.T) {
+	board := NewSwitchboard()
+	nets := startMemoryNetworks(t, board, 3)
+
+	t.Run("Broadcast to all peers", func(t *testing.T) {
+		nets[0].Broadcast([]byte("hello"), TopicIDBlock)
+
+		for _, net := range nets[1:] {
+			msg := shouldReceiveMemoryEvent[*GossipMessage](t, net)
+			assert.Equal(t, []byte("hello"), msg.Data)
+			assert.Equal(t, TopicIDBlock, msg.TopicID)
+		}
+		shouldNotReceiveMemoryEvent[*GossipMessage](t, nets[0], 100*time.Millisecond)
+	})
+
+	t.Run("Peers that didn't join the topic don't receive the message", func(t *testing.T) {
+		nets[0].Broadcast([]byte("transaction"), TopicIDTransaction)
+
+		shouldNotReceiveMemoryEvent[*GossipMessage](t, nets[1], 100*time.Millisecond)
+	})
+
+	t.Run("Messages are forwarded by the peers", func(t *testing.T) {
+		nets[0].CloseConnection(nets[2].SelfID())
+		shouldReceiveMemoryEvent[*DisconnectEvent](t, nets[2])
+
+		nets[0].Broadcast([]byte("forwarded"), TopicIDConsensus)
+
+		msg := shouldReceiveMemoryEvent[*GossipMessage](t, nets[2])
+		assert.Equal(t, []byte("forwarded"), msg.Data)
+		assert.Equal(t, nets[1].SelfID(), msg.From)
+	})
+}
+
+func TestMemoryNetworkShouldPropagate(t *testing.T) {
+	board := NewSwitchboard()
+	nets := make([]*memoryNetwork, 3)
+	for i := range nets {
+		nets[i] = makeMemoryNetwork(t, board)
+		require.NoError(t, nets[i].Start())
+		defer nets[i].Stop()
+	}
+	require.NoError(t, nets[0].JoinTopic(TopicIDBlock, alwaysPropagate))
+	require.NoError(t, nets[1].JoinTopic(TopicIDBlock, func(_ *GossipMessage) bool { return false }))
+	require.NoError(t, nets[2].JoinTopic(TopicIDBlock, alwaysPropagate))
+
+	nets[0].CloseConnection(nets[2].SelfID())
+	shouldReceiveMemoryEvent[*DisconnectEvent](t, nets[2])
+
+	nets[0].Broadcast([]byte("ignored"), TopicIDBlock)
+
+	// The message is delivered, but not forwarded.
+	shouldReceiveMemoryEvent[*GossipMessage](t, nets[1])
+	shouldNotReceiveMemoryEvent[*GossipMessage](t, nets[2], 100*time.Millisecond)
+}
+
+func TestMemoryNetworkStream(t *testing.T) {
+	board := NewSwitchboard()
+	nets := startMemoryNetworks(t, board, 2)
+
+	for i := 0; i < 10; i++ {
+		nets[0].SendTo([]byte{byte(i)}, nets[1].SelfID())
+	}
+
+	// The stream messages are delivered in order.
+	for i := 0; i < 10; i++ {
+		msg := shouldReceiveMemoryEvent[*StreamMessage](t, nets[1])
+		data, err := io.ReadAll(msg.Reader)
+		require.NoError(t, err)
+		assert.Equal(t, []byte{byte(i)}, data)
+		assert.Equal(t, nets[0].SelfID(), msg.From)
+	}
+}
+
+func TestMemoryNetworkLatency(t *testing.T) {
+	board := NewSwitchboard()
+	nets := startMemoryNetworks(t, board, 2)
+
+	latency := 200 * time.Millisecond
+	board.SetLatency(latency)
+
+	start := time.Now()
+	nets[0].SendTo([]byte("slow"), nets[1].SelfID())
+	shouldReceiveMemoryEvent[*StreamMessage](t, nets[1])
+	assert.GreaterOrEqual(t, time.Since(start), latency)
+}
+
+func TestMemoryNetworkLoss(t *testing.T) {
+	board := NewSwitchboard()
+	nets := startMemoryNetworks(t, board, 2)
+
+	board.SetLossRate(1)
+	nets[0].SendTo([]byte("lost"), nets[1].SelfID())
+	nets[0].Broadcast([]byte("lost"), TopicIDBlock)
+	shouldNotReceiveMemoryEvent[*StreamMessage](t, nets[1], 100*time.Millisecond)
+	shouldNotReceiveMemoryEvent[*GossipMessage](t, nets[1], 100*time.Millisecond)
+
+	board.SetLossRate(0)
+	nets[0].SendTo([]byte("delivered"), nets[1].SelfID())
+	shouldReceiveMemoryEvent[*StreamMessage](t, nets[1])
+}
+
+func TestMemoryNetworkBan(t *testing.T) {
+	board := NewSwitchboard()
+	nets := startMemoryNetworks(t, board, 3)
+
+	t.Run("Ban peer", func(t *testing.T) {
+		nets[0].BanPeer(nets[1].SelfID(), time.Hour, "spamming")
+		assert.True(t, nets[0].IsPeerBanned(nets[1].SelfID()))
+		assert.False(t, nets[0].isConnected(nets[1].SelfID()))
+
+		addr := nets[0].HostAddrs()[0] + "/p2p/" + nets[0].SelfID().String()
+		assert.Error(t, nets[1].ConnectPeer(addr))
+	})
+
+	t.Run("Ban network", func(t *testing.T) {
+		require.NoError(t, nets[0].BanNet(nets[2].ip(), time.Hour, "spamming"))
+		assert.False(t, nets[0].isConnected(nets[2].SelfID()))
+		assert.Len(t, nets[0].Bans(), 2)
+	})
+
+	t.Run("Unban", func(t *testing.T) {
+		assert.True(t, nets[0].UnbanPeer(nets[1].SelfID()))
+		assert.True(t, nets[0].UnbanNet(nets[2].ip()))
+
+		addr := nets[0].HostAddrs()[0] + "/p2p/" + nets[0].SelfID().String()
+		assert.NoError(t, nets[1].ConnectPeer(addr))
+		assert.NoError(t, nets[2].ConnectPeer(addr))
+		assert.Equal(t, 2, nets[0].NumConnectedPeers())
+	})
+}
@@ -3,9 +3,11 @@ package network
 import (
 	"bytes"
 	"io"
+	"time"
 
 	lp2pcore "github.com/libp2p/go-libp2p/core"
 	lp2ppeer "github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pactus-project/pactus/util/ipblocker"
 	"github.com/pactus-project/pactus/util/testsuite"
 )
 
@@ -23,6 +25,10 @@ type MockNetwork struct {
 	EventCh   chan Event
 	ID        lp2ppeer.ID
 	OtherNets []*MockNetwork
+	Penalties map[lp2ppeer.ID]int
+	Banned    map[lp2ppeer.ID]bool
+	Connected []string
+	BannedNet *ipblocker.IPBlocker
 }
 
 func MockingNetwork(ts *testsuite.TestSuite, id lp2ppeer.ID) *MockNetwork {
@@ -31,6 +37,10 @@ func MockingNetwork(ts *testsuite.TestSuite, id lp2ppeer.ID) *MockNetwork {
 		PublishCh: make(chan PublishData, 100),
 		EventCh:   make(chan Event, 100),
 		OtherNets: make([]*MockNetwork, 0),
+		Penalties: make(map[lp2ppeer.ID]int),
+		Banned:    make(map[lp2ppeer.ID]bool),
+		Connected: make([]string, 0),
+		BannedNet: &ipblocker.IPBlocker{},
 		ID:        id,
 	}
 }
@@ -107,6 +117,66 @@ func (mock *MockNetwork) IsClosed(pid lp2ppeer.ID) bool {
 	return true
 }
 
+func (mock *MockNetwork) PenalizePeer(pid lp2ppeer.ID, score int, _ string) {
+	mock.Penalties[pid] += score
+}
+
+func (mock *MockNetwork) IsPeerBanned(pid lp2ppeer.ID) bool {
+	return mock.Banned[pid]
+}
+
+func (mock *MockNetwork) ConnectPeer(addr string) error {
+	if _, err := lp2ppeer.AddrInfoFromString(addr); err != nil {
+		return err
+	}
+	mock.Connected = append(mock.Connected, addr)
+
+	return nil
+}
+
+func (mock *MockNetwork) BanPeer(pid lp2ppeer.ID, _ time.Duration, _ string) {
+	mock.Banned[pid] = true
+	mock.CloseConnection(pid)
+}
+
+func (mock *MockNetwork) UnbanPeer(pid lp2ppeer.ID) bool {
+	if !mock.Banned[pid] {
+		return false
+	}
+	delete(mock.Banned, pid)
+
+	return true
+}
+
+func (mock *MockNetwork) BanNet(target string, duration time.Duration, reason string) error {
+	until := time.Time{}
+	if duration > 0 {
+		until = time.Now().Add(duration)
+	}
+
+	return mock.BannedNet.Ban(target, until, reason)
+}
+
+func (mock *MockNetwork) UnbanNet(target string) bool {
+	return mock.BannedNet.Unban(target)
+}
+
+func (mock *MockNetwork) IsIPBanned(ip string) bool {
+	return mock.BannedNet.IsBanned(ip)
+}
+
+func (mock *MockNetwork) Bans() []BanInfo {
+	bans := make([]BanInfo, 0)
+	for pid := range mock.Banned {
+		bans = append(bans, BanInfo{Target: pid.String()})
+	}
+	for _, ban := range mock.BannedNet.Bans() {
+		bans = append(bans, BanInfo{Target: ban.Net.String(), BannedUntil: ban.BannedUntil, Reason: ban.Reason})
+	}
+
+	return bans
+}
+
 func (mock *MockNetwork) NumConnectedPeers() int {
 	return len(mock.OtherNets)
 }
@@ -19,6 +19,7 @@ import (
 	lp2quic "github.com/libp2p/go-libp2p/p2p/transport/quic"
 	lp2ptcp "github.com/libp2p/go-libp2p/p2p/transport/tcp"
 	"github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr/net"
 	"github.com/pactus-project/pactus/util"
 	"github.com/pactus-project/pactus/util/logger"
 	"github.com/pactus-project/pactus/version"
@@ -37,6 +38,7 @@ type network struct {
 	dht          *dhtService
 	peerMgr      *peerMgr
 	connGater    *ConnectionGater
+	reputation   *reputationStore
 	stream       *streamService
 	gossip       *gossipService
 	notifee      *NotifeeService
@@ -143,10 +145,28 @@ func makeNetwork(conf *Config, log *logger.SubLogger, opts []lp2p.Option) (*netw
 		lp2p.ResourceManager(resMgr),
 		lp2p.ConnectionManager(connMgr),
 		lp2p.Ping(true),
-		lp2p.Transport(lp2ptcp.NewTCPTransport),
 	)
 
-	if conf.EnableUDP {
+	if conf.ProxyAddr != "" {
+		log.Info("dialing through the proxy", "proxy", conf.ProxyAddr)
+		resolver, err := newProxyResolver(conf.ProxyAddr)
+		if err != nil {
+			return nil, LibP2PError{Err: err}
+		}
+		opts = append(opts,
+			lp2p.Transport(newProxyTransport(conf.ProxyAddr)),
+			lp2p.MultiaddrResolver(resolver))
+	} else {
+		opts = append(opts,
+			lp2p.Transport(lp2ptcp.NewTCPTransport))
+	}
+
+	if conf.ProxyOnly {
+		// The proxy-only mode prevents revealing the IP address of the node.
+		log.Info("proxy-only mode enabled, UDP, UPnP, mDNS and relay are disabled")
+	}
+
+	if conf.EnableUDP && !conf.ProxyOnly {
 		log.Info("UDP is enabled")
 		opts = append(opts,
 			lp2p.Transport(lp2quic.NewTransport))
@@ -159,7 +179,7 @@ func makeNetwork(conf *Config, log *logger.SubLogger, opts []lp2p.Option) (*netw
 		)
 	}
 
-	if conf.EnableUPnP {
+	if conf.EnableUPnP && !conf.ProxyOnly {
 		log.Info("UPnP enabled")
 		opts = append(opts,
 			lp2p.NATPortMap(),
@@ -177,7 +197,7 @@ func makeNetwork(conf *Config, log *logger.SubLogger, opts []lp2p.Option) (*netw
 		return self
 	}
 
-	if conf.EnableRelay {
+	if conf.EnableRelay && !conf.NoAdvertise && !conf.ProxyOnly {
 		log.Info("relay enabled")
 
 		autoRelayOpt := []lp2pautorelay.Option{
@@ -209,6 +229,9 @@ func makeNetwork(conf *Config, log *logger.SubLogger, opts []lp2p.Option) (*netw
 
 	addrFactory := lp2p.AddrsFactory(func(mas []multiaddr.Multiaddr) []multiaddr.Multiaddr {
 		addrs := []multiaddr.Multiaddr{}
+		if conf.NoAdvertise || conf.ProxyOnly {
+			return addrs
+		}
 		for _, addr := range mas {
 			if conf.ForcePrivateNetwork || !privateFilters.AddrBlocked(addr) {
 				addrs = append(addrs, addr)
@@ -222,7 +245,11 @@ func makeNetwork(conf *Config, log *logger.SubLogger, opts []lp2p.Option) (*netw
 	})
 	opts = append(opts, addrFactory)
 
-	connGater, err := NewConnectionGater(conf, log)
+	reputation, err := newReputationStore(conf, log)
+	if err != nil {
+		return nil, LibP2PError{Err: err}
+	}
+	connGater, err := NewConnectionGater(conf, reputation, log)
 	if err != nil {
 		return nil, LibP2PError{Err: err}
 	}
@@ -241,6 +268,7 @@ func makeNetwork(conf *Config, log *logger.SubLogger, opts []lp2p.Option) (*netw
 	self.logger = log
 	self.host = host
 	self.connGater = connGater
+	self.reputation = reputation
 	self.eventChannel = make(chan Event, 100)
 
 	log.SetObj(self)
@@ -250,20 +278,26 @@ func makeNetwork(conf *Config, log *logger.SubLogger, opts []lp2p.Option) (*netw
 	kadProtocolID := lp2pcore.ProtocolID(fmt.Sprintf("/%s/gossip/v1", conf.NetworkName)) // TODO: better name?
 	streamProtocolID := lp2pcore.ProtocolID(fmt.Sprintf("/%s/stream/v1", conf.NetworkName))
 
-	if conf.EnableMdns {
+	if conf.EnableMdns && !conf.NoAdvertise && !conf.ProxyOnly {
 		self.mdns = newMdnsService(ctx, self.host, self.logger)
 	}
 
 	self.dht = newDHTService(self.ctx, self.host, kadProtocolID, conf, self.logger)
-	self.peerMgr = newPeerMgr(ctx, host, conf, self.logger)
+	self.peerMgr = newPeerMgr(ctx, host, reputation, conf, self.logger)
 	self.stream = newStreamService(ctx, self.host, streamProtocolID, self.eventChannel, self.logger)
 	self.gossip = newGossipService(ctx, self.host, self.eventChannel, conf, self.logger)
 	self.notifee = newNotifeeService(ctx, self.host, self.eventChannel, self.peerMgr, streamProtocolID, self.logger)
 
+	// The trusted peers are never pruned by the connection manager.
+	for _, pid := range conf.TrustedPeerIDs() {
+		self.host.ConnManager().Protect(pid, "trusted")
+	}
+
 	self.logger.Info("network setup", "id", self.host.ID(),
 		"name", conf.NetworkName,
 		"address", conf.ListenAddrs(),
 		"bootstrapper", conf.IsBootstrapper,
+		"noAdvertise", conf.NoAdvertise,
 		"maxConns", conf.MaxConns)
 
 	return self, nil
@@ -353,6 +387,10 @@ func (n *network) Stop() {
 	n.stream.Stop()
 	n.peerMgr.Stop()
 	n.notifee.Stop()
+
+	if err := n.reputation.Save(); err != nil {
+		n.logger.Error("can't save reputation store", "err", err)
+	}
 	n.dht.Stop()
 
 	if err := n.host.Close(); err != nil {
@@ -404,6 +442,118 @@ func (n *network) CloseConnection(pid lp2ppeer.ID) {
 	n.logger.Debug("connection closed", "pid", pid)
 }
 
+// PenalizePeer adds the penalty score to the peer's reputation.
+// The peer is disconnected if it gets banned.
+func (n *network) PenalizePeer(pid lp2ppeer.ID, score int, reason string) {
+	n.logger.Debug("penalizing peer", "pid", pid, "score", score, "reason", reason)
+
+	if n.reputation.Penalize(pid, score, reason) {
+		n.CloseConnection(pid)
+	}
+}
+
+func (n *network) IsPeerBanned(pid lp2ppeer.ID) bool {
+	return n.reputation.IsBanned(pid)
+}
+
+// ConnectPeer connects to the peer at the given multiaddr, which should include the peer ID.
+func (n *network) ConnectPeer(addr string) error {
+	addrInfo, err := lp2ppeer.AddrInfoFromString(addr)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(n.ctx, 20*time.Second)
+	defer cancel()
+
+	if err := n.host.Connect(ctx, *addrInfo); err != nil {
+		return LibP2PError{Err: err}
+	}
+
+	return nil
+}
+
+// BanPeer bans the peer for the given duration and disconnects it.
+// If the duration is zero, the peer is banned for the default ban duration.
+func (n *network) BanPeer(pid lp2ppeer.ID, duration time.Duration, reason string) {
+	if duration == 0 {
+		duration = n.config.BanDuration
+	}
+
+	n.reputation.Ban(pid, time.Now().Add(duration), reason)
+	n.CloseConnection(pid)
+}
+
+func (n *network) UnbanPeer(pid lp2ppeer.ID) bool {
+	return n.reputation.Unban(pid)
+}
+
+// BanNet bans an IP address or a network in CIDR notation for the given duration
+// and disconnects the connected peers inside it.
+// If the duration is zero, the network is banned for the default ban duration.
+func (n *network) BanNet(target string, duration time.Duration, reason string) error {
+	if duration == 0 {
+		duration = n.config.BanDuration
+	}
+
+	if err := n.reputation.BanNet(target, time.Now().Add(duration), reason); err != nil {
+		return err
+	}
+
+	for _, conn := range n.host.Network().Conns() {
+		ip, err := manet.ToIP(conn.RemoteMultiaddr())
+		if err != nil {
+			continue
+		}
+
+		if n.reputation.IsIPBanned(ip.String()) {
+			n.CloseConnection(conn.RemotePeer())
+		}
+	}
+
+	return nil
+}
+
+func (n *network) UnbanNet(target string) bool {
+	return n.reputation.UnbanNet(target)
+}
+
+func (n *network) IsIPBanned(ip string) bool {
+	return n.reputation.IsIPBanned(ip)
+}
+
+// Bans returns the banned peers and networks.
+func (n *network) Bans() []BanInfo {
+	return makeBanInfos(n.reputation)
+}
+
+// makeBanInfos returns the banned peers and the banned networks in the reputation store.
+func makeBanInfos(rs *reputationStore) []BanInfo {
+	bans := make([]BanInfo, 0)
+	for pid, rep := range rs.BannedPeers() {
+		reason := ""
+		if len(rep.Penalties) > 0 {
+			reason = rep.Penalties[len(rep.Penalties)-1].Reason
+		}
+
+		bans = append(bans, BanInfo{
+			Target:      pid.String(),
+			BannedUntil: rep.BannedUntil,
+			Reason:      reason,
+		})
+	}
+
+	for _, ban := range rs.BannedNets() {
+		bans = append(bans, BanInfo{
+			Target:      ban.Net.String(),
+			BannedUntil: ban.BannedUntil,
+			Reason:      ban.Reason,
+		})
+	}
+
+	return bans
+}
+
 func (n *network) String() string {
 	return fmt.Sprintf("{%d}", n.NumConnectedPeers())
 }
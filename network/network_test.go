@@ -51,6 +51,10 @@ func testConfig() *Config {
 		NetworkName:          "test",
 		DefaultPort:          12345,
 		PeerStorePath:        util.TempFilePath(),
+		ReputationStorePath:  util.TempFilePath(),
+		BanScore:             100,
+		BanDuration:          time.Hour,
+		PenaltyHalfLife:      time.Hour,
 	}
 }
 
@@ -28,25 +28,38 @@ type peerMgr struct {
 
 	ctx           context.Context
 	minConns      int
+	unconditional []lp2ppeer.AddrInfo
+	private       map[lp2ppeer.ID]bool
 	numInbound    int
 	numOutbound   int
 	host          lp2phost.Host
 	peers         map[lp2ppeer.ID]*peerInfo
 	peerStorePath string
+	reputation    *reputationStore
 	logger        *logger.SubLogger
 }
 
 // newPeerMgr creates a new Peer Manager instance.
-func newPeerMgr(ctx context.Context, h lp2phost.Host,
+func newPeerMgr(ctx context.Context, h lp2phost.Host, reputation *reputationStore,
 	conf *Config, log *logger.SubLogger,
 ) *peerMgr {
-	peerStore, err := loadPeerStore(conf.PeerStorePath)
-	if err != nil {
-		log.Debug("failed to load peer store", "err", err)
+	// In no-advertise mode, the node connects only to the unconditional peers.
+	peerStore := make([]lp2ppeer.AddrInfo, 0)
+	if !conf.NoAdvertise {
+		var err error
+		peerStore, err = loadPeerStore(conf.PeerStorePath)
+		if err != nil {
+			log.Debug("failed to load peer store", "err", err)
+		}
+		log.Info("peer store loaded successfully")
+
+		peerStore = append(peerStore, conf.BootstrapAddrInfos()...)
 	}
-	log.Info("peer store loaded successfully")
 
-	peerStore = append(peerStore, conf.BootstrapAddrInfos()...)
+	private := make(map[lp2ppeer.ID]bool)
+	for _, pid := range conf.PrivatePeerIDs() {
+		private[pid] = true
+	}
 
 	peers := make(map[lp2ppeer.ID]*peerInfo)
 	for _, ai := range peerStore {
@@ -60,8 +73,11 @@ func newPeerMgr(ctx context.Context, h lp2phost.Host,
 	pm := &peerMgr{
 		ctx:           ctx,
 		minConns:      conf.MinConns(),
+		unconditional: conf.UnconditionalAddrInfos(),
+		private:       private,
 		peers:         peers,
 		peerStorePath: conf.PeerStorePath,
+		reputation:    reputation,
 		host:          h,
 		logger:        log,
 	}
@@ -85,6 +101,11 @@ func (mgr *peerMgr) Start() {
 				return
 			case <-ticker.C:
 				mgr.CheckConnectivity()
+
+				// The penalties that don't ban the peers are saved periodically.
+				if err := mgr.reputation.Save(); err != nil {
+					mgr.logger.Error("can't save reputation store", "err", err)
+				}
 			}
 		}
 	}()
@@ -171,6 +192,14 @@ func (mgr *peerMgr) CheckConnectivity() {
 
 	net := mgr.host.Network()
 
+	// The unconditional peers are reconnected, regardless of the number of connections.
+	for _, ai := range mgr.unconditional {
+		if net.Connectedness(ai.ID) != lp2pnet.Connected {
+			mgr.logger.Debug("try connecting to an unconditional peer", "peer", ai.ID.String())
+			ConnectAsync(mgr.ctx, mgr.host, ai, mgr.logger)
+		}
+	}
+
 	// Check if some peers are disconnected
 	numConnected := 0
 	for pid := range mgr.peers {
@@ -232,6 +261,10 @@ func (mgr *peerMgr) savePeerStore() error {
 
 	ps := make([]string, 0)
 	for id, info := range mgr.peers {
+		// The addresses of the private peers are not kept.
+		if mgr.private[id] {
+			continue
+		}
 		ps = append(ps, fmt.Sprintf("%s/p2p/%s", info.MultiAddress.String(), id.String()))
 	}
 
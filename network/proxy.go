@@ -0,0 +1,265 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+
+	lp2pnetwork "github.com/libp2p/go-libp2p/core/network"
+	lp2ppeer "github.com/libp2p/go-libp2p/core/peer"
+	lp2ptransport "github.com/libp2p/go-libp2p/core/transport"
+	"github.com/multiformats/go-multiaddr"
+	madns "github.com/multiformats/go-multiaddr-dns"
+	manet "github.com/multiformats/go-multiaddr/net"
+	"golang.org/x/net/proxy"
+)
+
+const proxyDialTimeout = 30 * time.Second
+
+// proxyTransport is a TCP transport that dials the peers through a SOCKS5 proxy, like Tor.
+// It can dial the onion addresses as well.
+// It listens on TCP addresses directly, so the node can still accept inbound connections,
+// like the connections that are forwarded by a Tor hidden service.
+type proxyTransport struct {
+	upgrader lp2ptransport.Upgrader
+	rcmgr    lp2pnetwork.ResourceManager
+	dialer   proxy.ContextDialer
+}
+
+var _ lp2ptransport.Transport = &proxyTransport{}
+
+// newProxyTransport returns a constructor of the proxy transport for the libp2p host.
+func newProxyTransport(proxyAddr string) func(lp2ptransport.Upgrader,
+	lp2pnetwork.ResourceManager) (*proxyTransport, error) {
+	return func(upgrader lp2ptransport.Upgrader, rcmgr lp2pnetwork.ResourceManager) (*proxyTransport, error) {
+		dialer, err := proxy.SOCKS5("tcp", proxyAddr, nil, proxy.Direct)
+		if err != nil {
+			return nil, err
+		}
+
+		contextDialer, ok := dialer.(proxy.ContextDialer)
+		if !ok {
+			return nil, fmt.Errorf("SOCKS5 dialer doesn't support context")
+		}
+
+		return &proxyTransport{
+			upgrader: upgrader,
+			rcmgr:    rcmgr,
+			dialer:   contextDialer,
+		}, nil
+	}
+}
+
+// proxyTarget returns the address that the proxy should connect to.
+// The supported addresses are "/ip4/.../tcp/...", "/ip6/.../tcp/...", "/dns/.../tcp/..." and "/onion3/...".
+// The host names are passed to the proxy, so they are resolved remotely.
+func proxyTarget(addr multiaddr.Multiaddr) (string, error) {
+	protocols := addr.Protocols()
+
+	if len(protocols) == 1 && protocols[0].Code == multiaddr.P_ONION3 {
+		value, _ := addr.ValueForProtocol(multiaddr.P_ONION3)
+		host, port, _ := strings.Cut(value, ":")
+
+		return net.JoinHostPort(host+".onion", port), nil
+	}
+
+	if len(protocols) == 2 && protocols[1].Code == multiaddr.P_TCP {
+		code := protocols[0].Code
+		switch code {
+		case multiaddr.P_IP4, multiaddr.P_IP6,
+			multiaddr.P_DNS, multiaddr.P_DNS4, multiaddr.P_DNS6:
+			host, _ := addr.ValueForProtocol(code)
+			port, _ := addr.ValueForProtocol(multiaddr.P_TCP)
+
+			return net.JoinHostPort(host, port), nil
+		}
+	}
+
+	return "", fmt.Errorf("unable to dial %s through the proxy", addr)
+}
+
+func (*proxyTransport) CanDial(addr multiaddr.Multiaddr) bool {
+	_, err := proxyTarget(addr)
+
+	return err == nil
+}
+
+func (t *proxyTransport) Dial(ctx context.Context, raddr multiaddr.Multiaddr,
+	pid lp2ppeer.ID,
+) (lp2ptransport.CapableConn, error) {
+	connScope, err := t.rcmgr.OpenConnection(lp2pnetwork.DirOutbound, true, raddr)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := t.dialWithScope(ctx, raddr, pid, connScope)
+	if err != nil {
+		connScope.Done()
+
+		return nil, err
+	}
+
+	return conn, nil
+}
+
+func (t *proxyTransport) dialWithScope(ctx context.Context, raddr multiaddr.Multiaddr,
+	pid lp2ppeer.ID, connScope lp2pnetwork.ConnManagementScope,
+) (lp2ptransport.CapableConn, error) {
+	if err := connScope.SetPeer(pid); err != nil {
+		return nil, err
+	}
+
+	target, err := proxyTarget(raddr)
+	if err != nil {
+		return nil, err
+	}
+
+	dialCtx, cancel := context.WithTimeout(ctx, proxyDialTimeout)
+	defer cancel()
+
+	conn, err := t.dialer.DialContext(dialCtx, "tcp", target)
+	if err != nil {
+		return nil, err
+	}
+
+	// The local address is the address of the connection to the proxy.
+	laddr, err := manet.FromNetAddr(conn.LocalAddr())
+	if err != nil {
+		_ = conn.Close()
+
+		return nil, err
+	}
+
+	maConn := &proxyConn{
+		Conn:  conn,
+		laddr: laddr,
+		raddr: raddr,
+	}
+
+	return t.upgrader.Upgrade(ctx, t, maConn, lp2pnetwork.DirOutbound, pid, connScope)
+}
+
+func (t *proxyTransport) Listen(laddr multiaddr.Multiaddr) (lp2ptransport.Listener, error) {
+	list, err := manet.Listen(laddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.upgrader.UpgradeListener(t, list), nil
+}
+
+func (*proxyTransport) Protocols() []int {
+	return []int{multiaddr.P_TCP, multiaddr.P_ONION3}
+}
+
+// Proxy returns true, since the connections are established through the proxy and they are not direct.
+func (*proxyTransport) Proxy() bool {
+	return true
+}
+
+func (*proxyTransport) String() string {
+	return "SOCKS5"
+}
+
+// proxyConn is a connection that is established through the proxy.
+// Its remote address is the address of the peer, not the proxy.
+type proxyConn struct {
+	net.Conn
+
+	laddr multiaddr.Multiaddr
+	raddr multiaddr.Multiaddr
+}
+
+func (c *proxyConn) LocalMultiaddr() multiaddr.Multiaddr {
+	return c.laddr
+}
+
+func (c *proxyConn) RemoteMultiaddr() multiaddr.Multiaddr {
+	return c.raddr
+}
+
+// The SOCKS5 RESOLVE command is an extension of Tor that resolves a host name through the proxy.
+const socks5ResolveCommand = 0xF0
+
+// proxyResolver resolves the host names through the SOCKS5 proxy, so they don't leak to the local resolver.
+// The swarm resolves the "/dns" addresses before dialing them, and the proxy should support
+// the RESOLVE command for that, like Tor. The "/dnsaddr" addresses can't be resolved through the proxy.
+type proxyResolver struct {
+	proxyAddr string
+}
+
+var _ madns.BasicResolver = &proxyResolver{}
+
+// newProxyResolver returns a multiaddr resolver that resolves the host names through the proxy.
+func newProxyResolver(proxyAddr string) (*madns.Resolver, error) {
+	return madns.NewResolver(madns.WithDefaultResolver(&proxyResolver{proxyAddr: proxyAddr}))
+}
+
+func (r *proxyResolver) LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error) {
+	if len(host) > 255 {
+		return nil, fmt.Errorf("host name is too long: %s", host)
+	}
+
+	dialer := net.Dialer{}
+	conn, err := dialer.DialContext(ctx, "tcp", r.proxyAddr)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	} else {
+		_ = conn.SetDeadline(time.Now().Add(proxyDialTimeout))
+	}
+
+	// Greeting: version 5 with no authentication.
+	if _, err := conn.Write([]byte{0x05, 0x01, 0x00}); err != nil {
+		return nil, err
+	}
+	reply := make([]byte, 2)
+	if _, err := io.ReadFull(conn, reply); err != nil {
+		return nil, err
+	}
+	if reply[0] != 0x05 || reply[1] != 0x00 {
+		return nil, fmt.Errorf("proxy requires authentication")
+	}
+
+	// Request: version, command, reserved, the domain name and a zero port.
+	request := []byte{0x05, socks5ResolveCommand, 0x00, 0x03, byte(len(host))}
+	request = append(request, host...)
+	request = append(request, 0x00, 0x00)
+	if _, err := conn.Write(request); err != nil {
+		return nil, err
+	}
+
+	header := make([]byte, 4)
+	if _, err := io.ReadFull(conn, header); err != nil {
+		return nil, err
+	}
+	if header[1] != 0x00 {
+		return nil, fmt.Errorf("proxy is unable to resolve %s, code: %d", host, header[1])
+	}
+
+	var ip net.IP
+	switch header[3] {
+	case 0x01: // IPv4
+		ip = make(net.IP, net.IPv4len)
+	case 0x04: // IPv6
+		ip = make(net.IP, net.IPv6len)
+	default:
+		return nil, fmt.Errorf("unexpected address type from proxy: %d", header[3])
+	}
+	if _, err := io.ReadFull(conn, ip); err != nil {
+		return nil, err
+	}
+
+	return []net.IPAddr{{IP: ip}}, nil
+}
+
+func (*proxyResolver) LookupTXT(_ context.Context, name string) ([]string, error) {
+	return nil, fmt.Errorf("unable to resolve TXT records through the proxy: %s", name)
+}
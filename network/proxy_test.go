@@ -0,0 +1,302 @@
+package network
+
+import (
+	"encoding/binary"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// socksStandIn is a minimal SOCKS5 server that supports the CONNECT and the RESOLVE commands
+// without authentication.
+// The onion addresses are forwarded to the local addresses that are registered for them,
+// and the host names are resolved to the IP addresses that are registered for them.
+type socksStandIn struct {
+	lk sync.Mutex
+
+	listener net.Listener
+	onions   map[string]string
+	hosts    map[string]net.IP
+	targets  []string
+	resolved []string
+}
+
+func startSocksStandIn(t *testing.T) *socksStandIn {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	s := &socksStandIn{
+		listener: listener,
+		onions:   make(map[string]string),
+		hosts:    make(map[string]net.IP),
+	}
+	t.Cleanup(func() { _ = listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go s.handle(conn)
+		}
+	}()
+
+	return s
+}
+
+func (s *socksStandIn) Addr() string {
+	return s.listener.Addr().String()
+}
+
+func (s *socksStandIn) addOnion(onion, addr string) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	s.onions[onion] = addr
+}
+
+func (s *socksStandIn) addHost(host string, ip net.IP) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	s.hosts[host] = ip
+}
+
+func (s *socksStandIn) Targets() []string {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	return append([]string{}, s.targets...)
+}
+
+func (s *socksStandIn) Resolved() []string {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	return append([]string{}, s.resolved...)
+}
+
+func (s *socksStandIn) handle(conn net.Conn) {
+	defer conn.Close()
+
+	// Greeting: version, number of methods and the methods.
+	header := make([]byte, 2)
+	if _, err := io.ReadFull(conn, header); err != nil {
+		return
+	}
+	if _, err := io.ReadFull(conn, make([]byte, header[1])); err != nil {
+		return
+	}
+	if _, err := conn.Write([]byte{0x05, 0x00}); err != nil {
+		return
+	}
+
+	// Request: version, command, reserved and the address type.
+	request := make([]byte, 4)
+	if _, err := io.ReadFull(conn, request); err != nil {
+		return
+	}
+
+	var host string
+	switch request[3] {
+	case 0x01: // IPv4
+		ip := make([]byte, net.IPv4len)
+		if _, err := io.ReadFull(conn, ip); err != nil {
+			return
+		}
+		host = net.IP(ip).String()
+
+	case 0x03: // Domain name
+		length := make([]byte, 1)
+		if _, err := io.ReadFull(conn, length); err != nil {
+			return
+		}
+		domain := make([]byte, length[0])
+		if _, err := io.ReadFull(conn, domain); err != nil {
+			return
+		}
+		host = string(domain)
+
+	default:
+		return
+	}
+
+	port := make([]byte, 2)
+	if _, err := io.ReadFull(conn, port); err != nil {
+		return
+	}
+
+	if request[1] == socks5ResolveCommand {
+		s.lk.Lock()
+		s.resolved = append(s.resolved, host)
+		ip := s.hosts[host].To4()
+		s.lk.Unlock()
+
+		if ip == nil {
+			// Host unreachable
+			_, _ = conn.Write([]byte{0x05, 0x04, 0x00, 0x01, 0, 0, 0, 0, 0, 0})
+
+			return
+		}
+		reply := append([]byte{0x05, 0x00, 0x00, 0x01}, ip...)
+		_, _ = conn.Write(append(reply, 0, 0))
+
+		return
+	}
+
+	target := net.JoinHostPort(host, strconv.Itoa(int(binary.BigEndian.Uint16(port))))
+
+	s.lk.Lock()
+	s.targets = append(s.targets, target)
+	addr := target
+	if strings.HasSuffix(host, ".onion") {
+		addr = s.onions[target]
+	}
+	s.lk.Unlock()
+
+	remote, err := net.Dial("tcp", addr)
+	if err != nil {
+		// Host unreachable
+		_, _ = conn.Write([]byte{0x05, 0x04, 0x00, 0x01, 0, 0, 0, 0, 0, 0})
+
+		return
+	}
+	defer remote.Close()
+
+	if _, err := conn.Write([]byte{0x05, 0x00, 0x00, 0x01, 0, 0, 0, 0, 0, 0}); err != nil {
+		return
+	}
+
+	go func() { _, _ = io.Copy(remote, conn) }()
+	_, _ = io.Copy(conn, remote)
+}
+
+func TestProxyTarget(t *testing.T) {
+	testCases := []struct {
+		addr     string
+		expected string
+	}{
+		{"/ip4/1.2.3.4/tcp/21888", "1.2.3.4:21888"},
+		{"/ip6/::1/tcp/21888", "[::1]:21888"},
+		{
+			"/onion3/vww6ybal4bd7szmgncyruucpgfkqahzddi37ktceo3ah7ngmcopnpyyd:21888",
+			"vww6ybal4bd7szmgncyruucpgfkqahzddi37ktceo3ah7ngmcopnpyyd.onion:21888",
+		},
+		{"/dns/pactus.org/tcp/21888", "pactus.org:21888"},
+		{"/dns4/pactus.org/tcp/21888", "pactus.org:21888"},
+		{"/dns6/pactus.org/tcp/21888", "pactus.org:21888"},
+		{"/ip4/1.2.3.4/udp/21888/quic-v1", ""},
+		{"/dnsaddr/pactus.org", ""},
+		{"/ip4/1.2.3.4/tcp/21888/ws", ""},
+	}
+
+	for _, tc := range testCases {
+		addr, err := multiaddr.NewMultiaddr(tc.addr)
+		require.NoError(t, err)
+
+		target, err := proxyTarget(addr)
+		if tc.expected == "" {
+			assert.Error(t, err, "address: %s", tc.addr)
+			assert.False(t, (&proxyTransport{}).CanDial(addr))
+		} else {
+			assert.NoError(t, err, "address: %s", tc.addr)
+			assert.Equal(t, tc.expected, target)
+			assert.True(t, (&proxyTransport{}).CanDial(addr))
+		}
+	}
+}
+
+func TestProxy(t *testing.T) {
+	standIn := startSocksStandIn(t)
+
+	makeListeningNetwork := func(t *testing.T) *network {
+		t.Helper()
+
+		conf := testConfig()
+		conf.EnableUDP = false
+		conf.ListenAddrStrings = []string{"/ip4/127.0.0.1/tcp/0"}
+		n := makeTestNetwork(t, conf, nil)
+		t.Cleanup(n.Stop)
+
+		return n
+	}
+
+	confP := testConfig()
+	confP.EnableUDP = true
+	confP.EnableRelay = true
+	confP.ProxyAddr = standIn.Addr()
+	confP.ProxyOnly = true
+	confP.ListenAddrStrings = []string{
+		"/ip4/127.0.0.1/tcp/0",
+		"/ip4/127.0.0.1/udp/0/quic-v1",
+	}
+	networkP := makeTestNetwork(t, confP, nil)
+	defer networkP.Stop()
+
+	t.Run("Proxy-only mode doesn't reveal the addresses", func(t *testing.T) {
+		assert.Empty(t, networkP.HostAddrs())
+
+		for _, addr := range networkP.host.Network().ListenAddresses() {
+			assert.NotContains(t, addr.String(), "quic", "UDP should be disabled")
+		}
+	})
+
+	t.Run("Dial an IP address through the proxy", func(t *testing.T) {
+		networkA := makeListeningNetwork(t)
+		addrA, err := multiaddr.NewMultiaddr(networkA.HostAddrs()[0])
+		require.NoError(t, err)
+		ipA, _ := addrA.ValueForProtocol(multiaddr.P_IP4)
+		portA, _ := addrA.ValueForProtocol(multiaddr.P_TCP)
+
+		require.NoError(t, networkP.ConnectPeer(addrA.String()+"/p2p/"+networkA.SelfID().String()))
+
+		assert.Contains(t, standIn.Targets(), net.JoinHostPort(ipA, portA))
+		assert.Contains(t, networkP.host.Network().Peers(), networkA.SelfID())
+	})
+
+	t.Run("Dial an onion address through the proxy", func(t *testing.T) {
+		networkB := makeListeningNetwork(t)
+		addrB, err := multiaddr.NewMultiaddr(networkB.HostAddrs()[0])
+		require.NoError(t, err)
+		ipB, _ := addrB.ValueForProtocol(multiaddr.P_IP4)
+		portB, _ := addrB.ValueForProtocol(multiaddr.P_TCP)
+
+		onion := "vww6ybal4bd7szmgncyruucpgfkqahzddi37ktceo3ah7ngmcopnpyyd"
+		standIn.addOnion(onion+".onion:21888", net.JoinHostPort(ipB, portB))
+
+		addr := "/onion3/" + onion + ":21888/p2p/" + networkB.SelfID().String()
+		require.NoError(t, networkP.ConnectPeer(addr))
+
+		assert.Contains(t, standIn.Targets(), onion+".onion:21888")
+		assert.Contains(t, networkP.host.Network().Peers(), networkB.SelfID())
+	})
+
+	t.Run("Dial a DNS address through the proxy", func(t *testing.T) {
+		networkC := makeListeningNetwork(t)
+		addrC, err := multiaddr.NewMultiaddr(networkC.HostAddrs()[0])
+		require.NoError(t, err)
+		ipC, _ := addrC.ValueForProtocol(multiaddr.P_IP4)
+		portC, _ := addrC.ValueForProtocol(multiaddr.P_TCP)
+
+		// The ".test" domain can't be resolved locally.
+		host := "peer-c.pactus.test"
+		standIn.addHost(host, net.ParseIP(ipC))
+
+		addr := "/dns4/" + host + "/tcp/" + portC + "/p2p/" + networkC.SelfID().String()
+		require.NoError(t, networkP.ConnectPeer(addr))
+
+		assert.Contains(t, standIn.Resolved(), host)
+		assert.Contains(t, standIn.Targets(), net.JoinHostPort(ipC, portC))
+		assert.Contains(t, networkP.host.Network().Peers(), networkC.SelfID())
+	})
+}
@@ -0,0 +1,357 @@
+package network
+
+import (
+	"encoding/json"
+	"math"
+	"sync"
+	"time"
+
+	lp2ppeer "github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/ipblocker"
+	"github.com/pactus-project/pactus/util/logger"
+)
+
+// maxPenaltyRecords is the number of the recent penalties that are kept for each peer.
+const maxPenaltyRecords = 16
+
+// Penalty records why a peer was penalized.
+type Penalty struct {
+	Time   time.Time `json:"time"`
+	Score  int       `json:"score"`
+	Reason string    `json:"reason"`
+}
+
+// Reputation keeps the penalty score of a peer.
+// The score decays over time, and the peer is banned once its score reaches the ban score.
+type Reputation struct {
+	Score       float64   `json:"score"`
+	UpdatedAt   time.Time `json:"updated_at"`
+	BannedUntil time.Time `json:"banned_until"`
+	Penalties   []Penalty `json:"penalties"`
+}
+
+// IsBanned checks if the peer is banned at the given time.
+func (r *Reputation) IsBanned(now time.Time) bool {
+	return now.Before(r.BannedUntil)
+}
+
+// storedBan is a network that is banned at runtime, kept in the reputation store file.
+type storedBan struct {
+	Net         string    `json:"net"`
+	BannedUntil time.Time `json:"banned_until"`
+	Reason      string    `json:"reason"`
+}
+
+// storedReputation is the content of the reputation store file.
+type storedReputation struct {
+	Peers map[string]*Reputation `json:"peers"`
+	Nets  []storedBan            `json:"nets"`
+}
+
+// reputationStore keeps the reputation of the peers and the banned networks across restarts,
+// so a misbehaving peer doesn't get a fresh start after restarting the node.
+// The networks in the config are banned permanently, and only the networks that are banned
+// at runtime are saved.
+// The store is saved whenever a peer or a network is banned or unbanned, so the bans survive a crash.
+type reputationStore struct {
+	lk sync.RWMutex
+
+	path        string
+	banScore    int
+	banDuration time.Duration
+	halfLife    time.Duration
+	records     map[lp2ppeer.ID]*Reputation
+	bannedNets  *ipblocker.IPBlocker
+	logger      *logger.SubLogger
+}
+
+func newReputationStore(conf *Config, log *logger.SubLogger) (*reputationStore, error) {
+	bannedNets, err := ipblocker.New(conf.BannedNets)
+	if err != nil {
+		return nil, err
+	}
+
+	rs := &reputationStore{
+		path:        conf.ReputationStorePath,
+		banScore:    conf.BanScore,
+		banDuration: conf.BanDuration,
+		halfLife:    conf.PenaltyHalfLife,
+		records:     make(map[lp2ppeer.ID]*Reputation),
+		bannedNets:  bannedNets,
+		logger:      log,
+	}
+
+	records, bans, err := loadReputationStore(conf.ReputationStorePath)
+	if err != nil {
+		log.Debug("failed to load reputation store", "err", err)
+	} else {
+		rs.records = records
+		now := time.Now()
+		for _, ban := range bans {
+			if !ban.BannedUntil.After(now) {
+				continue
+			}
+			if err := bannedNets.Ban(ban.Net, ban.BannedUntil, ban.Reason); err != nil {
+				log.Warn("invalid banned network in reputation store", "net", ban.Net, "err", err)
+			}
+		}
+		log.Info("reputation store loaded successfully", "peers", len(records), "nets", len(bans))
+	}
+
+	return rs, nil
+}
+
+// decay reduces the score of the peer based on the time elapsed since the last update.
+func (rs *reputationStore) decay(rep *Reputation, now time.Time) {
+	elapsed := now.Sub(rep.UpdatedAt)
+	if elapsed > 0 && rs.halfLife > 0 {
+		rep.Score *= math.Pow(0.5, float64(elapsed)/float64(rs.halfLife))
+	}
+	rep.UpdatedAt = now
+}
+
+// Penalize adds the penalty score to the peer and records the reason.
+// It returns true if the peer gets banned by this penalty.
+func (rs *reputationStore) Penalize(pid lp2ppeer.ID, score int, reason string) bool {
+	rs.lk.Lock()
+	defer rs.lk.Unlock()
+
+	now := time.Now()
+	rep, ok := rs.records[pid]
+	if !ok {
+		rep = &Reputation{UpdatedAt: now}
+		rs.records[pid] = rep
+	}
+
+	rs.decay(rep, now)
+	rep.Score += float64(score)
+	rep.Penalties = append(rep.Penalties, Penalty{
+		Time:   now,
+		Score:  score,
+		Reason: reason,
+	})
+	if len(rep.Penalties) > maxPenaltyRecords {
+		rep.Penalties = rep.Penalties[len(rep.Penalties)-maxPenaltyRecords:]
+	}
+
+	if rep.IsBanned(now) || rep.Score < float64(rs.banScore) {
+		return false
+	}
+
+	rep.BannedUntil = now.Add(rs.banDuration)
+	rs.logger.Info("peer is banned", "pid", pid, "score", rep.Score,
+		"until", rep.BannedUntil, "reason", reason)
+	rs.trySave()
+
+	return true
+}
+
+// Ban bans the peer until the given time, regardless of its score.
+func (rs *reputationStore) Ban(pid lp2ppeer.ID, until time.Time, reason string) {
+	rs.lk.Lock()
+	defer rs.lk.Unlock()
+
+	now := time.Now()
+	rep, ok := rs.records[pid]
+	if !ok {
+		rep = &Reputation{UpdatedAt: now}
+		rs.records[pid] = rep
+	}
+
+	rep.BannedUntil = until
+	rep.Penalties = append(rep.Penalties, Penalty{
+		Time:   now,
+		Reason: reason,
+	})
+	if len(rep.Penalties) > maxPenaltyRecords {
+		rep.Penalties = rep.Penalties[len(rep.Penalties)-maxPenaltyRecords:]
+	}
+
+	rs.logger.Info("peer is banned", "pid", pid, "until", until, "reason", reason)
+	rs.trySave()
+}
+
+// Unban lifts the ban of the peer and clears its score.
+// It returns false if the peer is not banned.
+func (rs *reputationStore) Unban(pid lp2ppeer.ID) bool {
+	rs.lk.Lock()
+	defer rs.lk.Unlock()
+
+	rep, ok := rs.records[pid]
+	if !ok || !rep.IsBanned(time.Now()) {
+		return false
+	}
+
+	rep.Score = 0
+	rep.BannedUntil = time.Time{}
+	rs.logger.Info("peer is unbanned", "pid", pid)
+	rs.trySave()
+
+	return true
+}
+
+// BannedPeers returns a copy of the reputation of the peers that are banned now.
+func (rs *reputationStore) BannedPeers() map[lp2ppeer.ID]*Reputation {
+	rs.lk.RLock()
+	defer rs.lk.RUnlock()
+
+	now := time.Now()
+	banned := make(map[lp2ppeer.ID]*Reputation)
+	for pid, rep := range rs.records {
+		if rep.IsBanned(now) {
+			cloned := *rep
+			cloned.Penalties = append([]Penalty{}, rep.Penalties...)
+			banned[pid] = &cloned
+		}
+	}
+
+	return banned
+}
+
+// IsBanned checks if the peer is banned.
+func (rs *reputationStore) IsBanned(pid lp2ppeer.ID) bool {
+	rs.lk.RLock()
+	defer rs.lk.RUnlock()
+
+	rep, ok := rs.records[pid]
+	if !ok {
+		return false
+	}
+
+	return rep.IsBanned(time.Now())
+}
+
+// Reputation returns a copy of the reputation of the peer, or nil if the peer has no penalty.
+func (rs *reputationStore) Reputation(pid lp2ppeer.ID) *Reputation {
+	rs.lk.Lock()
+	defer rs.lk.Unlock()
+
+	rep, ok := rs.records[pid]
+	if !ok {
+		return nil
+	}
+
+	rs.decay(rep, time.Now())
+	cloned := *rep
+	cloned.Penalties = append([]Penalty{}, rep.Penalties...)
+
+	return &cloned
+}
+
+// BanNet bans an IP address or a network in CIDR notation until the given time.
+func (rs *reputationStore) BanNet(target string, until time.Time, reason string) error {
+	if err := rs.bannedNets.Ban(target, until, reason); err != nil {
+		return err
+	}
+	rs.logger.Info("network is banned", "net", target, "until", until, "reason", reason)
+
+	rs.lk.Lock()
+	defer rs.lk.Unlock()
+
+	rs.trySave()
+
+	return nil
+}
+
+// UnbanNet lifts the ban of the network. It returns false if the network is not banned.
+func (rs *reputationStore) UnbanNet(target string) bool {
+	if !rs.bannedNets.Unban(target) {
+		return false
+	}
+	rs.logger.Info("network is unbanned", "net", target)
+
+	rs.lk.Lock()
+	defer rs.lk.Unlock()
+
+	rs.trySave()
+
+	return true
+}
+
+// BannedNets returns the networks that are banned now.
+func (rs *reputationStore) BannedNets() []ipblocker.Ban {
+	return rs.bannedNets.Bans()
+}
+
+// IsIPBanned checks if the IP address is inside a banned network.
+func (rs *reputationStore) IsIPBanned(ip string) bool {
+	return rs.bannedNets.IsBanned(ip)
+}
+
+// prune removes the peers that are not banned and their score is negligible.
+func (rs *reputationStore) prune(now time.Time) {
+	for pid, rep := range rs.records {
+		rs.decay(rep, now)
+		if !rep.IsBanned(now) && rep.Score < 1 {
+			delete(rs.records, pid)
+		}
+	}
+}
+
+func (rs *reputationStore) Save() error {
+	rs.lk.Lock()
+	defer rs.lk.Unlock()
+
+	return rs.save()
+}
+
+// trySave saves the store and logs the error, if any. The lock should be held by the caller.
+func (rs *reputationStore) trySave() {
+	if err := rs.save(); err != nil {
+		rs.logger.Error("can't save reputation store", "err", err)
+	}
+}
+
+func (rs *reputationStore) save() error {
+	rs.prune(time.Now())
+
+	stored := storedReputation{
+		Peers: make(map[string]*Reputation, len(rs.records)),
+		Nets:  make([]storedBan, 0),
+	}
+	for pid, rep := range rs.records {
+		stored.Peers[pid.String()] = rep
+	}
+	for _, ban := range rs.bannedNets.Bans() {
+		// The permanent bans come from the config.
+		if ban.BannedUntil.IsZero() {
+			continue
+		}
+		stored.Nets = append(stored.Nets, storedBan{
+			Net:         ban.Net.String(),
+			BannedUntil: ban.BannedUntil,
+			Reason:      ban.Reason,
+		})
+	}
+
+	data, err := json.Marshal(stored)
+	if err != nil {
+		return err
+	}
+
+	return util.WriteFile(rs.path, data)
+}
+
+func loadReputationStore(path string) (map[lp2ppeer.ID]*Reputation, []storedBan, error) {
+	data, err := util.ReadFile(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stored := storedReputation{}
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return nil, nil, err
+	}
+
+	records := make(map[lp2ppeer.ID]*Reputation, len(stored.Peers))
+	for str, rep := range stored.Peers {
+		pid, err := lp2ppeer.Decode(str)
+		if err != nil {
+			return nil, nil, err
+		}
+		records[pid] = rep
+	}
+
+	return records, stored.Nets, nil
+}
@@ -0,0 +1,202 @@
+package network
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/logger"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testReputationStore(t *testing.T) *reputationStore {
+	t.Helper()
+
+	conf := DefaultConfig()
+	conf.ReputationStorePath = util.TempFilePath()
+	rs, err := newReputationStore(conf, logger.NewSubLogger("_network", nil))
+	require.NoError(t, err)
+
+	return rs
+}
+
+func TestPenalize(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+	rs := testReputationStore(t)
+	pid := ts.RandPeerID()
+
+	assert.Nil(t, rs.Reputation(pid))
+	assert.False(t, rs.IsBanned(pid))
+
+	assert.False(t, rs.Penalize(pid, 60, "invalid bundle"))
+	assert.False(t, rs.IsBanned(pid))
+
+	assert.True(t, rs.Penalize(pid, 60, "unexpected message"))
+	assert.True(t, rs.IsBanned(pid))
+
+	// Already banned
+	assert.False(t, rs.Penalize(pid, 60, "invalid bundle"))
+
+	rep := rs.Reputation(pid)
+	require.NotNil(t, rep)
+	assert.InDelta(t, 180, rep.Score, 0.1)
+	assert.Len(t, rep.Penalties, 3)
+	assert.Equal(t, "unexpected message", rep.Penalties[1].Reason)
+	assert.WithinDuration(t, time.Now().Add(24*time.Hour), rep.BannedUntil, time.Minute)
+}
+
+func TestPenaltyRecords(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+	rs := testReputationStore(t)
+	pid := ts.RandPeerID()
+
+	for i := 0; i < maxPenaltyRecords+4; i++ {
+		rs.Penalize(pid, 1, "invalid bundle")
+	}
+
+	assert.Len(t, rs.Reputation(pid).Penalties, maxPenaltyRecords)
+}
+
+func TestScoreDecay(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+	rs := testReputationStore(t)
+	pid := ts.RandPeerID()
+
+	rs.Penalize(pid, 80, "invalid bundle")
+
+	// Two half-lives ago
+	rs.records[pid].UpdatedAt = time.Now().Add(-2 * time.Hour)
+	assert.InDelta(t, 20, rs.Reputation(pid).Score, 0.1)
+
+	// The score has decayed, so it doesn't reach the ban score.
+	assert.False(t, rs.Penalize(pid, 60, "invalid bundle"))
+}
+
+func TestBanExpiry(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+	rs := testReputationStore(t)
+	pid := ts.RandPeerID()
+
+	assert.True(t, rs.Penalize(pid, 100, "invalid bundle"))
+	assert.True(t, rs.IsBanned(pid))
+
+	rs.records[pid].BannedUntil = time.Now().Add(-time.Second)
+	assert.False(t, rs.IsBanned(pid))
+}
+
+func TestSaveReputationStore(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+	rs := testReputationStore(t)
+	pid1 := ts.RandPeerID()
+	pid2 := ts.RandPeerID()
+	pid3 := ts.RandPeerID()
+
+	rs.Penalize(pid1, 100, "invalid bundle")
+	rs.Penalize(pid2, 10, "invalid bundle")
+	rs.Penalize(pid3, 10, "invalid bundle")
+	// The score of the third peer is negligible.
+	rs.records[pid3].UpdatedAt = time.Now().Add(-24 * time.Hour)
+
+	require.NoError(t, rs.Save())
+
+	conf := DefaultConfig()
+	conf.ReputationStorePath = rs.path
+	loaded, err := newReputationStore(conf, logger.NewSubLogger("_network", nil))
+	require.NoError(t, err)
+
+	assert.True(t, loaded.IsBanned(pid1))
+	assert.False(t, loaded.IsBanned(pid2))
+	assert.InDelta(t, 10, loaded.Reputation(pid2).Score, 0.1)
+	assert.Equal(t, "invalid bundle", loaded.Reputation(pid2).Penalties[0].Reason)
+	assert.Nil(t, loaded.Reputation(pid3))
+}
+
+func TestBanAndUnban(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+	rs := testReputationStore(t)
+	pid1 := ts.RandPeerID()
+	pid2 := ts.RandPeerID()
+
+	assert.False(t, rs.Unban(pid1))
+
+	rs.Ban(pid1, time.Now().Add(time.Hour), "spamming")
+	rs.Penalize(pid2, 10, "invalid bundle")
+	assert.True(t, rs.IsBanned(pid1))
+
+	banned := rs.BannedPeers()
+	require.Len(t, banned, 1)
+	assert.Equal(t, "spamming", banned[pid1].Penalties[0].Reason)
+
+	assert.True(t, rs.Unban(pid1))
+	assert.False(t, rs.IsBanned(pid1))
+	assert.Empty(t, rs.BannedPeers())
+
+	// A penalized peer is not banned, so it can't be unbanned.
+	assert.False(t, rs.Unban(pid2))
+	assert.InDelta(t, 10, rs.Reputation(pid2).Score, 0.1)
+}
+
+func TestSaveBannedNets(t *testing.T) {
+	rs := testReputationStore(t)
+
+	require.NoError(t, rs.BanNet("10.0.0.0/8", time.Now().Add(time.Hour), "spam"))
+	require.NoError(t, rs.BanNet("10.1.1.1", time.Now().Add(-time.Second), "expired"))
+	assert.True(t, rs.IsIPBanned("10.2.3.4"))
+
+	require.NoError(t, rs.Save())
+
+	conf := DefaultConfig()
+	conf.ReputationStorePath = rs.path
+	conf.BannedNets = []string{"192.168.0.0/16"}
+	loaded, err := newReputationStore(conf, logger.NewSubLogger("_network", nil))
+	require.NoError(t, err)
+
+	assert.True(t, loaded.IsIPBanned("10.2.3.4"))
+	assert.True(t, loaded.IsIPBanned("192.168.1.1"))
+
+	bans := loaded.BannedNets()
+	require.Len(t, bans, 2)
+
+	// The networks in the config are not saved.
+	conf.BannedNets = []string{}
+	require.NoError(t, loaded.Save())
+	reloaded, err := newReputationStore(conf, logger.NewSubLogger("_network", nil))
+	require.NoError(t, err)
+
+	assert.True(t, reloaded.IsIPBanned("10.2.3.4"))
+	assert.False(t, reloaded.IsIPBanned("192.168.1.1"))
+}
+
+func TestSaveOnBan(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+	rs := testReputationStore(t)
+	pid1 := ts.RandPeerID()
+	pid2 := ts.RandPeerID()
+
+	load := func() *reputationStore {
+		conf := DefaultConfig()
+		conf.ReputationStorePath = rs.path
+		loaded, err := newReputationStore(conf, logger.NewSubLogger("_network", nil))
+		require.NoError(t, err)
+
+		return loaded
+	}
+
+	// The store is saved without stopping the network.
+	rs.Penalize(pid1, 100, "invalid bundle")
+	assert.True(t, load().IsBanned(pid1))
+
+	rs.Ban(pid2, time.Now().Add(time.Hour), "spamming")
+	assert.True(t, load().IsBanned(pid2))
+
+	require.NoError(t, rs.BanNet("10.0.0.0/8", time.Now().Add(time.Hour), "spamming"))
+	assert.True(t, load().IsIPBanned("10.1.1.1"))
+
+	assert.True(t, rs.Unban(pid1))
+	assert.False(t, load().IsBanned(pid1))
+
+	assert.True(t, rs.UnbanNet("10.0.0.0/8"))
+	assert.False(t, load().IsIPBanned("10.1.1.1"))
+}
@@ -1,14 +1,18 @@
 package node
 
 import (
+	"fmt"
+	"path/filepath"
 	"time"
 
 	"github.com/pactus-project/pactus/config"
 	"github.com/pactus-project/pactus/consensus"
 	"github.com/pactus-project/pactus/crypto"
 	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/evidencepool"
 	"github.com/pactus-project/pactus/genesis"
 	"github.com/pactus-project/pactus/network"
+	"github.com/pactus-project/pactus/signer"
 	"github.com/pactus-project/pactus/state"
 	"github.com/pactus-project/pactus/store"
 	"github.com/pactus-project/pactus/sync"
@@ -18,6 +22,7 @@ import (
 	"github.com/pactus-project/pactus/util/logger"
 	"github.com/pactus-project/pactus/version"
 	"github.com/pactus-project/pactus/wallet"
+	"github.com/pactus-project/pactus/watchdog"
 	"github.com/pactus-project/pactus/www/grpc"
 	"github.com/pactus-project/pactus/www/http"
 	"github.com/pactus-project/pactus/www/jsonrpc"
@@ -32,7 +37,10 @@ type Node struct {
 	state      state.Facade
 	store      store.Store
 	txPool     txpool.TxPool
+	evdPool    evidencepool.EvidencePool
 	consMgr    consensus.Manager
+	watchdog   watchdog.Watchdog
+	signers    []signer.Signer
 	network    network.Network
 	sync       sync.Synchronizer
 	http       *http.Server
@@ -42,7 +50,24 @@ type Node struct {
 }
 
 func NewNode(genDoc *genesis.Genesis, conf *config.Config,
-	valKeys []*bls.ValidatorKey, rewardAddrs []crypto.Address,
+	signers []signer.Signer, rewardAddrs []crypto.Address,
+) (*Node, error) {
+	return newNode(genDoc, conf, signers, rewardAddrs, network.NewNetwork)
+}
+
+// NewMemoryNode creates a node that is connected to the other nodes through the in-memory switchboard,
+// instead of the libp2p network. It is useful for simulating many nodes in a single process.
+func NewMemoryNode(genDoc *genesis.Genesis, conf *config.Config,
+	signers []signer.Signer, rewardAddrs []crypto.Address, board *network.Switchboard,
+) (*Node, error) {
+	return newNode(genDoc, conf, signers, rewardAddrs, func(netConf *network.Config) (network.Network, error) {
+		return network.NewMemoryNetwork(netConf, board)
+	})
+}
+
+func newNode(genDoc *genesis.Genesis, conf *config.Config,
+	signers []signer.Signer, rewardAddrs []crypto.Address,
+	newNetwork func(*network.Config) (network.Network, error),
 ) (*Node, error) {
 	// Initialize the logger
 	logger.InitGlobalLogger(conf.Logger)
@@ -66,23 +91,32 @@ func NewNode(genDoc *genesis.Genesis, conf *config.Config,
 		return nil, err
 	}
 
-	st, err := state.LoadOrNewState(genDoc, valKeys, str, txPool, eventCh)
+	st, err := state.LoadOrNewState(genDoc, signers, str, txPool, eventCh)
 	if err != nil {
 		return nil, err
 	}
 
-	net, err := network.NewNetwork(conf.Network)
+	evdPool := evidencepool.NewEvidencePool(st, messageCh, eventCh)
+
+	// The network and the firewall share the banned networks.
+	conf.Network.BannedNets = conf.Sync.Firewall.BannedNets
+	net, err := newNetwork(conf.Network)
 	if err != nil {
 		return nil, err
 	}
 
-	consMgr := consensus.NewManager(conf.Consensus, st, valKeys, rewardAddrs, messageCh)
+	conf.Consensus.WALPath = filepath.Join(conf.Store.DataPath(), "consensus")
+	consMgr := consensus.NewManager(conf.Consensus, st, evdPool, signers, rewardAddrs, messageCh)
+	wd := watchdog.NewWatchdog(conf.Watchdog, st, consMgr,
+		conf.Consensus.MinimumAvailabilityScore, eventCh)
 	walletMgr := wallet.NewWalletManager(conf.WalletManager)
 
-	if !str.IsPruned() {
+	// A node that bootstraps from a state snapshot doesn't have the old blocks.
+	stateSyncing := conf.Sync.StateSync && str.LastCertificate() == nil
+	if !str.IsPruned() && !stateSyncing {
 		conf.Sync.Services.Append(service.FullNode)
 	}
-	syn, err := sync.NewSynchronizer(conf.Sync, valKeys, st, consMgr, net, messageCh)
+	syn, err := sync.NewSynchronizer(conf.Sync, signers, st, consMgr, evdPool, net, messageCh)
 	if err != nil {
 		return nil, err
 	}
@@ -91,7 +125,7 @@ func NewNode(genDoc *genesis.Genesis, conf *config.Config,
 	if conf.GRPC.BasicAuth != "" {
 		enableHTTPAuth = true
 	}
-	grpcServer := grpc.NewServer(conf.GRPC, st, syn, net, consMgr, walletMgr)
+	grpcServer := grpc.NewServer(conf.GRPC, st, evdPool, syn, net, consMgr, wd, walletMgr)
 	httpServer := http.NewServer(conf.HTTP, enableHTTPAuth)
 	jsonrpcServer := jsonrpc.NewServer(conf.JSONRPC)
 	nanomsgServer := nanomsg.NewServer(conf.Nanomsg, eventCh)
@@ -102,7 +136,10 @@ func NewNode(genDoc *genesis.Genesis, conf *config.Config,
 		network:    net,
 		state:      st,
 		txPool:     txPool,
+		evdPool:    evdPool,
 		consMgr:    consMgr,
+		watchdog:   wd,
+		signers:    signers,
 		sync:       syn,
 		store:      str,
 		http:       httpServer,
@@ -114,6 +151,48 @@ func NewNode(genDoc *genesis.Genesis, conf *config.Config,
 	return node, nil
 }
 
+// MakeSigners creates the signers for the given validator addresses.
+// If a remote signer is configured, the messages are signed by the remote signer,
+// and the validator keys are not loaded at all.
+// Otherwise, the validator keys are loaded by the given function and used directly.
+func MakeSigners(conf *signer.Config, valAddrs []crypto.Address,
+	loadKeys func() ([]*bls.ValidatorKey, error),
+) ([]signer.Signer, error) {
+	signers := make([]signer.Signer, len(valAddrs))
+	if !conf.IsRemote() {
+		valKeys, err := loadKeys()
+		if err != nil {
+			return nil, err
+		}
+		if len(valKeys) != len(valAddrs) {
+			return nil, fmt.Errorf("expected %d validator keys, got %d", len(valAddrs), len(valKeys))
+		}
+		for i, key := range valKeys {
+			if key.Address() != valAddrs[i] {
+				return nil, fmt.Errorf("validator key doesn't match the address %s", valAddrs[i])
+			}
+			signers[i] = signer.NewLocalSigner(key)
+		}
+
+		return signers, nil
+	}
+
+	client := signer.NewClient(conf)
+	for i, addr := range valAddrs {
+		remoteSigner, err := client.Signer(addr)
+		if err != nil {
+			client.Close()
+
+			return nil, errors.Wrap(err, "could not connect to remote signer")
+		}
+		signers[i] = remoteSigner
+	}
+	logger.Info("messages are signed by the remote signer",
+		"address", conf.RemoteAddress)
+
+	return signers, nil
+}
+
 func (n *Node) Start() error {
 	now := time.Now()
 	genTime := n.genesisDoc.GenesisTime()
@@ -137,6 +216,10 @@ func (n *Node) Start() error {
 		return errors.Wrap(err, "could not start Consensus manager")
 	}
 
+	if err := n.watchdog.Start(); err != nil {
+		return errors.Wrap(err, "could not start Watchdog")
+	}
+
 	err := n.grpc.StartServer()
 	if err != nil {
 		return errors.Wrap(err, "could not start gRPC server")
@@ -168,7 +251,13 @@ func (n *Node) Stop() {
 	// Wait for network to stop
 	time.Sleep(1 * time.Second)
 
+	n.watchdog.Stop()
 	n.consMgr.Stop()
+	for _, valSigner := range n.signers {
+		if remoteSigner, ok := valSigner.(*signer.RemoteSigner); ok {
+			remoteSigner.Close()
+		}
+	}
 	n.sync.Stop()
 	n.state.Close()
 	n.store.Close()
@@ -1,6 +1,8 @@
 package node
 
 import (
+	"path/filepath"
+	"sync"
 	"testing"
 	"time"
 
@@ -9,11 +11,15 @@ import (
 	"github.com/pactus-project/pactus/crypto/bls"
 	"github.com/pactus-project/pactus/crypto/hash"
 	"github.com/pactus-project/pactus/genesis"
+	"github.com/pactus-project/pactus/network"
+	"github.com/pactus-project/pactus/signer"
+	"github.com/pactus-project/pactus/sync/peerset/peer"
 	"github.com/pactus-project/pactus/types/account"
 	"github.com/pactus-project/pactus/types/validator"
 	"github.com/pactus-project/pactus/util"
 	"github.com/pactus-project/pactus/util/logger"
 	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -45,10 +51,11 @@ func TestRunningNode(t *testing.T) {
 	conf.Network.EnableRelay = false
 	conf.Network.NetworkKey = util.TempFilePath()
 	conf.Network.PeerStorePath = util.TempFilePath()
+	conf.Network.ReputationStorePath = util.TempFilePath()
 
-	valKeys := []*bls.ValidatorKey{ts.RandValKey(), ts.RandValKey()}
+	signers := []signer.Signer{signer.NewLocalSigner(ts.RandValKey()), signer.NewLocalSigner(ts.RandValKey())}
 	rewardAddrs := []crypto.Address{ts.RandAccAddress(), ts.RandAccAddress()}
-	nd, err := NewNode(gen, conf, valKeys, rewardAddrs)
+	nd, err := NewNode(gen, conf, signers, rewardAddrs)
 	assert.True(t, conf.Sync.Services.IsFullNode())
 	assert.True(t, conf.Sync.Services.IsPrunedNode())
 
@@ -72,3 +79,127 @@ func TestRunningNode(t *testing.T) {
 
 	nd.Stop()
 }
+
+func TestRunningNodesInMemory(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	// Prevent log from messing the workspace
+	logger.LogFilename = util.TempFilePath()
+	pub, _ := ts.RandBLSKeyPair()
+	acc := account.NewAccount(0)
+	acc.AddToBalance(21 * 1e14)
+	val := validator.NewValidator(pub, 0)
+	gen := genesis.MakeGenesis(time.Now(),
+		map[crypto.Address]*account.Account{crypto.TreasuryAddress: acc},
+		[]*validator.Validator{val}, genesis.DefaultGenesisParams())
+
+	board := network.NewSwitchboard()
+	board.SetLatency(10 * time.Millisecond)
+
+	totalNodes := 8
+	nodes := make([]*Node, totalNodes)
+	for i := 0; i < totalNodes; i++ {
+		conf := config.DefaultConfigMainnet()
+		conf.Store.Path = util.TempDirPath()
+		conf.Network.NetworkKey = util.TempFilePath()
+		conf.Network.PeerStorePath = util.TempFilePath()
+		conf.Network.ReputationStorePath = util.TempFilePath()
+		conf.GRPC.Enable = false
+
+		signers := []signer.Signer{signer.NewLocalSigner(ts.RandValKey())}
+		rewardAddrs := []crypto.Address{ts.RandAccAddress()}
+		nd, err := NewMemoryNode(gen, conf, signers, rewardAddrs, board)
+		require.NoError(t, err)
+
+		nodes[i] = nd
+	}
+
+	// Starting and stopping a node takes a while, so the nodes are started and stopped together.
+	var wg sync.WaitGroup
+	for _, nd := range nodes {
+		wg.Add(1)
+		go func(nd *Node) {
+			defer wg.Done()
+
+			assert.NoError(t, nd.Start())
+		}(nd)
+	}
+	wg.Wait()
+
+	// All the nodes should connect to each other and complete the handshake.
+	for _, nd := range nodes {
+		assert.Eventually(t, func() bool {
+			known := 0
+			nd.Sync().PeerSet().IteratePeers(func(p *peer.Peer) bool {
+				if p.Status.IsKnown() {
+					known++
+				}
+
+				return false
+			})
+
+			return known == totalNodes-1
+		}, 5*time.Second, 50*time.Millisecond)
+	}
+
+	for _, nd := range nodes {
+		wg.Add(1)
+		go func(nd *Node) {
+			defer wg.Done()
+
+			nd.Stop()
+		}(nd)
+	}
+	wg.Wait()
+}
+
+func TestMakeSigners(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	valKeys := []*bls.ValidatorKey{ts.RandValKey(), ts.RandValKey()}
+	valAddrs := []crypto.Address{valKeys[0].Address(), valKeys[1].Address()}
+	loadKeys := func() ([]*bls.ValidatorKey, error) {
+		return valKeys, nil
+	}
+
+	t.Run("Local signers", func(t *testing.T) {
+		signers, err := MakeSigners(signer.DefaultConfig(), valAddrs, loadKeys)
+		require.NoError(t, err)
+		assert.Len(t, signers, 2)
+		assert.IsType(t, &signer.LocalSigner{}, signers[0])
+		assert.Equal(t, valKeys[1].Address(), signers[1].Address())
+	})
+
+	t.Run("Local signers, mismatched keys", func(t *testing.T) {
+		_, err := MakeSigners(signer.DefaultConfig(), []crypto.Address{valAddrs[1], valAddrs[0]}, loadKeys)
+		assert.Error(t, err)
+	})
+
+	t.Run("Remote signers", func(t *testing.T) {
+		authKey := "0123456789abcdef"
+		server := signer.NewServer("unix://"+filepath.Join(util.TempDirPath(), "signer.sock"), authKey,
+			[]*signer.LocalSigner{signer.NewLocalSigner(valKeys[0]), signer.NewLocalSigner(valKeys[1])})
+		require.NoError(t, server.StartServer())
+		defer server.StopServer()
+
+		conf := signer.DefaultConfig()
+		conf.RemoteAddress = server.Address()
+		conf.AuthKey = authKey
+
+		noKeys := func() ([]*bls.ValidatorKey, error) {
+			return nil, errors.New("validator keys should not be loaded")
+		}
+
+		signers, err := MakeSigners(conf, valAddrs, noKeys)
+		require.NoError(t, err)
+		defer signers[0].(*signer.RemoteSigner).Close()
+
+		assert.Len(t, signers, 2)
+		assert.IsType(t, &signer.RemoteSigner{}, signers[0])
+		assert.Equal(t, valKeys[1].Address(), signers[1].Address())
+
+		// The remote signer doesn't have this key.
+		_, err = MakeSigners(conf, []crypto.Address{ts.RandValAddress()}, noKeys)
+		assert.Error(t, err)
+	})
+}
@@ -24,6 +24,10 @@ type Sandbox interface {
 	Validator(crypto.Address) *validator.Validator
 	MakeNewValidator(*bls.PublicKey) *validator.Validator
 	UpdateValidator(*validator.Validator)
+	RotateValidatorKey(oldAddr crypto.Address, val *validator.Validator)
+	IsRotatedValidator(crypto.Address) bool
+	ValidatorMetadata(crypto.Address) *validator.Metadata
+	UpdateValidatorMetadata(crypto.Address, *validator.Metadata)
 	JoinedToCommittee(crypto.Address)
 	IsJoinedCommittee(crypto.Address) bool
 	UpdatePowerDelta(delta int64)
@@ -38,4 +42,6 @@ type Sandbox interface {
 
 	IterateAccounts(consumer func(crypto.Address, *account.Account, bool))
 	IterateValidators(consumer func(*validator.Validator, bool, bool))
+	IterateRotatedValidators(consumer func(crypto.Address, *validator.Validator))
+	IterateValidatorMetadata(consumer func(crypto.Address, *validator.Metadata))
 }
@@ -21,25 +21,27 @@ var _ Sandbox = &MockSandbox{}
 type MockSandbox struct {
 	ts *testsuite.TestSuite
 
-	TestParams           *param.Params
-	TestStore            *store.MockStore
-	TestCommittee        committee.Committee
-	TestAcceptSortition  bool
-	TestJoinedValidators map[crypto.Address]bool
-	TestCommittedTrxs    map[tx.ID]*tx.Tx
-	TestPowerDelta       int64
+	TestParams            *param.Params
+	TestStore             *store.MockStore
+	TestCommittee         committee.Committee
+	TestAcceptSortition   bool
+	TestJoinedValidators  map[crypto.Address]bool
+	TestRotatedValidators map[crypto.Address]bool
+	TestCommittedTrxs     map[tx.ID]*tx.Tx
+	TestPowerDelta        int64
 }
 
 func MockingSandbox(ts *testsuite.TestSuite) *MockSandbox {
 	cmt, _ := ts.GenerateTestCommittee(7)
 
 	sb := &MockSandbox{
-		ts:                   ts,
-		TestParams:           param.FromGenesis(genesis.DefaultGenesisParams()),
-		TestStore:            store.MockingStore(ts),
-		TestCommittee:        cmt,
-		TestJoinedValidators: make(map[crypto.Address]bool),
-		TestCommittedTrxs:    make(map[tx.ID]*tx.Tx),
+		ts:                    ts,
+		TestParams:            param.FromGenesis(genesis.DefaultGenesisParams()),
+		TestStore:             store.MockingStore(ts),
+		TestCommittee:         cmt,
+		TestJoinedValidators:  make(map[crypto.Address]bool),
+		TestRotatedValidators: make(map[crypto.Address]bool),
+		TestCommittedTrxs:     make(map[tx.ID]*tx.Tx),
 	}
 
 	treasuryAmt := amount.Amount(21_000_000 * 1e9)
@@ -108,6 +110,26 @@ func (m *MockSandbox) UpdateValidator(val *validator.Validator) {
 	m.TestStore.UpdateValidator(val)
 }
 
+func (m *MockSandbox) RotateValidatorKey(oldAddr crypto.Address, val *validator.Validator) {
+	m.TestStore.RotateValidatorKey(oldAddr, val)
+	m.TestRotatedValidators[oldAddr] = true
+	m.TestRotatedValidators[val.Address()] = true
+}
+
+func (m *MockSandbox) IsRotatedValidator(addr crypto.Address) bool {
+	return m.TestRotatedValidators[addr]
+}
+
+func (m *MockSandbox) ValidatorMetadata(addr crypto.Address) *validator.Metadata {
+	meta, _ := m.TestStore.ValidatorMetadata(addr)
+
+	return meta
+}
+
+func (m *MockSandbox) UpdateValidatorMetadata(addr crypto.Address, meta *validator.Metadata) {
+	m.TestStore.UpdateValidatorMetadata(addr, meta)
+}
+
 func (m *MockSandbox) CurrentHeight() uint32 {
 	return m.TestStore.LastHeight + 1
 }
@@ -132,6 +154,16 @@ func (m *MockSandbox) IterateValidators(consumer func(*validator.Validator, bool
 	})
 }
 
+func (*MockSandbox) IterateRotatedValidators(func(crypto.Address, *validator.Validator)) {
+	// The rotations are applied directly to the test store.
+}
+
+func (m *MockSandbox) IterateValidatorMetadata(consumer func(crypto.Address, *validator.Metadata)) {
+	for addr, meta := range m.TestStore.Metadata {
+		consumer(addr, meta)
+	}
+}
+
 func (m *MockSandbox) Committee() committee.Reader {
 	return m.TestCommittee
 }
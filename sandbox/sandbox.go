@@ -25,6 +25,8 @@ type sandbox struct {
 	committee       committee.Reader
 	accounts        map[crypto.Address]*sandboxAccount
 	validators      map[crypto.Address]*sandboxValidator
+	metadata        map[crypto.Address]*validator.Metadata
+	rotations       map[crypto.Address]crypto.Address
 	committedTrxs   map[tx.ID]*tx.Tx
 	params          *param.Params
 	height          uint32
@@ -59,6 +61,8 @@ func NewSandbox(height uint32, str store.Reader, params *param.Params,
 
 	sb.accounts = make(map[crypto.Address]*sandboxAccount)
 	sb.validators = make(map[crypto.Address]*sandboxValidator)
+	sb.metadata = make(map[crypto.Address]*validator.Metadata)
+	sb.rotations = make(map[crypto.Address]crypto.Address)
 	sb.committedTrxs = make(map[tx.ID]*tx.Tx)
 	sb.totalAccounts = sb.store.TotalAccounts()
 	sb.totalValidators = sb.store.TotalValidators()
@@ -156,6 +160,11 @@ func (sb *sandbox) Validator(addr crypto.Address) *validator.Validator {
 		return s.validator.Clone()
 	}
 
+	if _, rotated := sb.rotations[addr]; rotated {
+		// The validator has moved to a new address.
+		return nil
+	}
+
 	val, err := sb.store.Validator(addr)
 	if err != nil {
 		return nil
@@ -227,6 +236,92 @@ func (sb *sandbox) UpdateValidator(val *validator.Validator) {
 	s.updated = true
 }
 
+// RotateValidatorKey moves the validator registered at oldAddr to the address
+// of its new public key. The validator keeps its number, stake and bonding heights.
+// This function takes ownership of the validator pointer.
+func (sb *sandbox) RotateValidatorKey(oldAddr crypto.Address, val *validator.Validator) {
+	sb.lk.Lock()
+	defer sb.lk.Unlock()
+
+	s, ok := sb.validators[oldAddr]
+	if !ok {
+		sb.shouldPanicForUnknownAddress()
+	}
+
+	newAddr := val.Address()
+	if _, exists := sb.validators[newAddr]; exists || sb.store.HasValidator(newAddr) {
+		sb.shouldPanicForDuplicatedAddress()
+	}
+
+	// The metadata of the old address should not be written back after rotation.
+	delete(sb.metadata, oldAddr)
+	delete(sb.validators, oldAddr)
+	sb.validators[newAddr] = &sandboxValidator{
+		validator: val,
+		updated:   true,
+		joined:    s.joined,
+	}
+
+	// If the validator was rotated before in this sandbox, the chain of rotations
+	// is collapsed, so the original address is moved to the latest one.
+	for origAddr, rotatedAddr := range sb.rotations {
+		if rotatedAddr == oldAddr {
+			sb.rotations[origAddr] = newAddr
+
+			return
+		}
+	}
+	sb.rotations[oldAddr] = newAddr
+}
+
+// IsRotatedValidator returns true if the address is the old or the new address
+// of a key rotation in this sandbox.
+func (sb *sandbox) IsRotatedValidator(addr crypto.Address) bool {
+	sb.lk.RLock()
+	defer sb.lk.RUnlock()
+
+	for oldAddr, newAddr := range sb.rotations {
+		if addr == oldAddr || addr == newAddr {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ValidatorMetadata returns the metadata of the validator, or nil if the
+// validator has not published any metadata yet.
+func (sb *sandbox) ValidatorMetadata(addr crypto.Address) *validator.Metadata {
+	sb.lk.RLock()
+	defer sb.lk.RUnlock()
+
+	meta, ok := sb.metadata[addr]
+	if ok {
+		return meta.Clone()
+	}
+
+	meta, err := sb.store.ValidatorMetadata(addr)
+	if err != nil {
+		return nil
+	}
+
+	return meta
+}
+
+// This function takes ownership of the metadata pointer.
+// It is important that the caller should not modify the metadata and
+// keep it immutable.
+func (sb *sandbox) UpdateValidatorMetadata(addr crypto.Address, meta *validator.Metadata) {
+	sb.lk.Lock()
+	defer sb.lk.Unlock()
+
+	if _, ok := sb.validators[addr]; !ok {
+		sb.shouldPanicForUnknownAddress()
+	}
+
+	sb.metadata[addr] = meta
+}
+
 func (sb *sandbox) Params() *param.Params {
 	return sb.params
 }
@@ -260,6 +355,28 @@ func (sb *sandbox) IterateValidators(
 	}
 }
 
+func (sb *sandbox) IterateRotatedValidators(
+	consumer func(oldAddr crypto.Address, val *validator.Validator),
+) {
+	sb.lk.RLock()
+	defer sb.lk.RUnlock()
+
+	for oldAddr, newAddr := range sb.rotations {
+		consumer(oldAddr, sb.validators[newAddr].validator)
+	}
+}
+
+func (sb *sandbox) IterateValidatorMetadata(
+	consumer func(crypto.Address, *validator.Metadata),
+) {
+	sb.lk.RLock()
+	defer sb.lk.RUnlock()
+
+	for addr, meta := range sb.metadata {
+		consumer(addr, meta)
+	}
+}
+
 func (sb *sandbox) Committee() committee.Reader {
 	return sb.committee
 }
@@ -222,6 +222,106 @@ func TestValidatorChange(t *testing.T) {
 	})
 }
 
+func TestValidatorMetadataChange(t *testing.T) {
+	td := setup(t)
+
+	val := td.store.RandomTestVal()
+	_ = td.sandbox.Validator(val.Address())
+
+	t.Run("Should returns nil for no metadata", func(t *testing.T) {
+		assert.Nil(t, td.sandbox.ValidatorMetadata(val.Address()))
+
+		td.sandbox.IterateValidatorMetadata(func(_ crypto.Address, _ *validator.Metadata) {
+			panic("should be empty")
+		})
+	})
+
+	t.Run("Update metadata", func(t *testing.T) {
+		meta := &validator.Metadata{
+			Moniker:       td.RandString(16),
+			RewardAddress: td.RandAccAddress(),
+		}
+		td.sandbox.UpdateValidatorMetadata(val.Address(), meta)
+
+		assert.Equal(t, meta, td.sandbox.ValidatorMetadata(val.Address()))
+		assert.Nil(t, td.store.Metadata[val.Address()])
+
+		t.Run("Should be iterated", func(t *testing.T) {
+			td.sandbox.IterateValidatorMetadata(func(addr crypto.Address, m *validator.Metadata) {
+				assert.Equal(t, val.Address(), addr)
+				assert.Equal(t, meta, m)
+			})
+		})
+	})
+
+	t.Run("Try update metadata of an unknown validator, Should panic", func(t *testing.T) {
+		assert.Panics(t, func() {
+			td.sandbox.UpdateValidatorMetadata(td.RandValAddress(), &validator.Metadata{})
+		})
+	})
+}
+
+func TestValidatorKeyRotation(t *testing.T) {
+	td := setup(t)
+
+	val := td.store.RandomTestVal()
+	oldAddr := val.Address()
+	sbVal := td.sandbox.Validator(oldAddr)
+
+	t.Run("Rotate key of an unknown validator, Should panic", func(t *testing.T) {
+		pub, _ := td.RandBLSKeyPair()
+		rotated := validator.NewValidator(pub, 0)
+		assert.Panics(t, func() {
+			td.sandbox.RotateValidatorKey(td.RandValAddress(), rotated)
+		})
+	})
+
+	t.Run("Rotate key to an existing validator, Should panic", func(t *testing.T) {
+		other := td.store.RandomTestVal()
+		for other.Address() == oldAddr {
+			other = td.store.RandomTestVal()
+		}
+		assert.Panics(t, func() {
+			td.sandbox.RotateValidatorKey(oldAddr, other.Clone())
+		})
+	})
+
+	t.Run("Rotate key", func(t *testing.T) {
+		td.sandbox.UpdateValidatorMetadata(oldAddr, &validator.Metadata{Moniker: "moniker"})
+
+		pub, _ := td.RandBLSKeyPair()
+		rotated := sbVal.Clone()
+		rotated.UpdatePublicKey(pub)
+		td.sandbox.RotateValidatorKey(oldAddr, rotated)
+
+		assert.Nil(t, td.sandbox.Validator(oldAddr))
+		assert.Equal(t, rotated, td.sandbox.Validator(pub.ValidatorAddress()))
+		assert.True(t, td.store.HasValidator(oldAddr))
+		assert.True(t, td.sandbox.IsRotatedValidator(oldAddr))
+		assert.True(t, td.sandbox.IsRotatedValidator(pub.ValidatorAddress()))
+		assert.False(t, td.sandbox.IsRotatedValidator(td.RandValAddress()))
+
+		td.sandbox.IterateRotatedValidators(func(addr crypto.Address, v *validator.Validator) {
+			assert.Equal(t, oldAddr, addr)
+			assert.Equal(t, rotated, v)
+		})
+
+		td.sandbox.IterateValidatorMetadata(func(addr crypto.Address, _ *validator.Metadata) {
+			assert.NotEqual(t, oldAddr, addr)
+		})
+
+		updated := 0
+		td.sandbox.IterateValidators(func(v *validator.Validator, isUpdated bool, _ bool) {
+			assert.NotEqual(t, oldAddr, v.Address())
+			if isUpdated {
+				updated++
+				assert.Equal(t, rotated, v)
+			}
+		})
+		assert.Equal(t, 1, updated)
+	})
+}
+
 func TestTotalAccountCounter(t *testing.T) {
 	td := setup(t)
 
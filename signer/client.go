@@ -0,0 +1,320 @@
+package signer
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/sortition"
+	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/types/proposal"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/vote"
+)
+
+// Client connects the node to a remote signer.
+// The connection is established lazily and re-established after a failure.
+// It is safe for concurrent use.
+type Client struct {
+	lk sync.Mutex
+
+	config  *Config
+	session *session
+}
+
+// NewClient creates a new client for the remote signer.
+func NewClient(conf *Config) *Client {
+	return &Client{
+		config: conf,
+	}
+}
+
+// Signer returns a signer for the given validator address.
+// It fetches the public key from the remote signer.
+func (c *Client) Signer(addr crypto.Address) (*RemoteSigner, error) {
+	res, err := c.call(&request{
+		Method:  methodPublicKey,
+		Address: addr,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	pub, err := bls.PublicKeyFromBytes(res.Data)
+	if err != nil {
+		return nil, err
+	}
+	if pub.ValidatorAddress() != addr {
+		return nil, fmt.Errorf("public key doesn't match the validator address %s", addr)
+	}
+
+	return &RemoteSigner{
+		client:    c,
+		address:   addr,
+		publicKey: pub,
+	}, nil
+}
+
+// Close closes the connection to the remote signer.
+func (c *Client) Close() {
+	c.lk.Lock()
+	defer c.lk.Unlock()
+
+	c.closeSession()
+}
+
+func (c *Client) connect() error {
+	network, addr, err := ParseAddress(c.config.RemoteAddress)
+	if err != nil {
+		return err
+	}
+
+	conn, err := net.DialTimeout(network, addr, c.config.Timeout)
+	if err != nil {
+		return err
+	}
+
+	sess, err := clientHandshake(conn, c.config.AuthKey, c.config.Timeout)
+	if err != nil {
+		_ = conn.Close()
+
+		return err
+	}
+	c.session = sess
+
+	return nil
+}
+
+func (c *Client) closeSession() {
+	if c.session != nil {
+		c.session.close()
+		c.session = nil
+	}
+}
+
+// call sends the request to the remote signer and waits for the response.
+// Since signing is idempotent for the signer's high-water mark,
+// the request is retried once on a broken connection.
+func (c *Client) call(req *request) (*response, error) {
+	c.lk.Lock()
+	defer c.lk.Unlock()
+
+	var err error
+	for attempt := 0; attempt < 2; attempt++ {
+		var res *response
+		res, err = c.roundTrip(req)
+		if err == nil {
+			return res, res.err(req.Address)
+		}
+
+		c.closeSession()
+		if errors.As(err, &AuthError{}) {
+			break
+		}
+	}
+
+	return nil, err
+}
+
+func (c *Client) roundTrip(req *request) (*response, error) {
+	if c.session == nil {
+		if err := c.connect(); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := c.session.send(req); err != nil {
+		return nil, err
+	}
+
+	res := &response{}
+	if err := c.session.recv(res, c.config.Timeout); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (res *response) err(addr crypto.Address) error {
+	switch res.Code {
+	case codeOK:
+		return nil
+	case codeDoubleSign:
+		return DoubleSignError{Reason: res.Error}
+	case codeUnknownSigner:
+		return UnknownSignerError{Address: addr}
+	default:
+		return fmt.Errorf("remote signer: %s", res.Error)
+	}
+}
+
+var _ Signer = &RemoteSigner{}
+
+// RemoteSigner signs the consensus messages through a remote signer.
+// The signatures are verified locally before being used.
+type RemoteSigner struct {
+	client    *Client
+	address   crypto.Address
+	publicKey *bls.PublicKey
+}
+
+func (s *RemoteSigner) Address() crypto.Address {
+	return s.address
+}
+
+func (s *RemoteSigner) PublicKey() *bls.PublicKey {
+	return s.publicKey
+}
+
+func (s *RemoteSigner) SignVote(v *vote.Vote) error {
+	res, err := s.client.call(&request{
+		Method:  methodSignVote,
+		Address: s.address,
+		Vote:    v,
+	})
+	if err != nil {
+		return err
+	}
+
+	sig, err := bls.SignatureFromBytes(res.Data)
+	if err != nil {
+		return err
+	}
+	if err := s.publicKey.Verify(v.SignBytes(), sig); err != nil {
+		return err
+	}
+	v.SetSignature(sig)
+
+	return nil
+}
+
+func (s *RemoteSigner) SignProposal(p *proposal.Proposal) error {
+	res, err := s.client.call(&request{
+		Method:    methodSignProposal,
+		Address:   s.address,
+		Height:    p.Height(),
+		Round:     p.Round(),
+		BlockHash: p.Block().Hash(),
+	})
+	if err != nil {
+		return err
+	}
+
+	sig, err := bls.SignatureFromBytes(res.Data)
+	if err != nil {
+		return err
+	}
+	if err := s.publicKey.Verify(p.SignBytes(), sig); err != nil {
+		return err
+	}
+	p.SetSignature(sig)
+
+	return nil
+}
+
+func (s *RemoteSigner) SignSeed(prevSeed sortition.VerifiableSeed) (sortition.VerifiableSeed, error) {
+	res, err := s.client.call(&request{
+		Method:  methodSignSeed,
+		Address: s.address,
+		Seed:    prevSeed[:],
+	})
+	if err != nil {
+		return sortition.UndefVerifiableSeed, err
+	}
+
+	seed, err := sortition.VerifiableSeedFromBytes(res.Data)
+	if err != nil {
+		return sortition.UndefVerifiableSeed, err
+	}
+	if !seed.Verify(s.publicKey, prevSeed) {
+		return sortition.UndefVerifiableSeed, fmt.Errorf("invalid seed from remote signer")
+	}
+
+	return seed, nil
+}
+
+func (s *RemoteSigner) EvaluateSortition(seed sortition.VerifiableSeed,
+	totalPower, power int64,
+) (bool, sortition.Proof, error) {
+	res, err := s.client.call(&request{
+		Method:  methodSortition,
+		Address: s.address,
+		Seed:    seed[:],
+		Total:   totalPower,
+		Power:   power,
+	})
+	if err != nil {
+		return false, sortition.Proof{}, err
+	}
+	if len(res.Data) == 0 {
+		// Not selected
+		return false, sortition.Proof{}, nil
+	}
+
+	proof, err := sortition.ProofFromBytes(res.Data)
+	if err != nil {
+		return false, sortition.Proof{}, err
+	}
+	if !sortition.VerifyProof(seed, proof, s.publicKey, totalPower, power) {
+		return false, sortition.Proof{}, fmt.Errorf("invalid sortition proof from remote signer")
+	}
+
+	return true, proof, nil
+}
+
+func (s *RemoteSigner) SignSortitionTx(trx *tx.Tx) error {
+	data, err := trx.Bytes()
+	if err != nil {
+		return err
+	}
+	res, err := s.client.call(&request{
+		Method:  methodSignTx,
+		Address: s.address,
+		Tx:      data,
+	})
+	if err != nil {
+		return err
+	}
+
+	sig, err := bls.SignatureFromBytes(res.Data)
+	if err != nil {
+		return err
+	}
+	if err := s.publicKey.Verify(trx.SignBytes(), sig); err != nil {
+		return err
+	}
+	trx.SetSignature(sig)
+	trx.SetPublicKey(s.publicKey)
+
+	return nil
+}
+
+func (s *RemoteSigner) SignHello(msg *message.HelloMessage) (*bls.Signature, error) {
+	res, err := s.client.call(&request{
+		Method:  methodSignHello,
+		Address: s.address,
+		Hello:   msg,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := bls.SignatureFromBytes(res.Data)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.publicKey.Verify(msg.SignBytes(), sig); err != nil {
+		return nil, err
+	}
+
+	return sig, nil
+}
+
+// Close closes the connection to the remote signer.
+// The connection is shared by all the signers of the same client.
+func (s *RemoteSigner) Close() {
+	s.client.Close()
+}
@@ -0,0 +1,80 @@
+package signer
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// MinAuthKeyLength is the minimum length of the shared secret between the node and the remote signer.
+const MinAuthKeyLength = 16
+
+type Config struct {
+	RemoteAddress string        `toml:"remote_address"`
+	AuthKey       string        `toml:"auth_key"`
+	Timeout       time.Duration `toml:"-"`
+}
+
+func DefaultConfig() *Config {
+	return &Config{
+		RemoteAddress: "",
+		AuthKey:       "",
+		Timeout:       5 * time.Second,
+	}
+}
+
+// IsRemote returns true if the validator messages should be signed by a remote signer.
+func (conf *Config) IsRemote() bool {
+	return conf.RemoteAddress != ""
+}
+
+// BasicCheck performs basic checks on the configuration.
+func (conf *Config) BasicCheck() error {
+	if !conf.IsRemote() {
+		return nil
+	}
+
+	if _, _, err := ParseAddress(conf.RemoteAddress); err != nil {
+		return err
+	}
+	if len(conf.AuthKey) < MinAuthKeyLength {
+		return ConfigError{
+			Reason: fmt.Sprintf("auth key should be at least %d characters", MinAuthKeyLength),
+		}
+	}
+	if conf.Timeout <= 0 {
+		return ConfigError{
+			Reason: "timeout must be greater than zero",
+		}
+	}
+
+	return nil
+}
+
+// ParseAddress parses the address of the remote signer and returns the network and the address.
+// The address can be a Unix socket like `unix:///path/to/signer.sock`,
+// or a TCP address like `tcp://127.0.0.1:50055` or `127.0.0.1:50055`.
+func ParseAddress(addr string) (string, string, error) {
+	network := "tcp"
+	switch {
+	case strings.HasPrefix(addr, "unix://"):
+		network = "unix"
+		addr = strings.TrimPrefix(addr, "unix://")
+
+	case strings.HasPrefix(addr, "tcp://"):
+		addr = strings.TrimPrefix(addr, "tcp://")
+
+	case strings.Contains(addr, "://"):
+		return "", "", ConfigError{
+			Reason: "unsupported signer address: " + addr,
+		}
+	}
+
+	if addr == "" {
+		return "", "", ConfigError{
+			Reason: "signer address is empty",
+		}
+	}
+
+	return network, addr, nil
+}
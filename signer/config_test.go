@@ -0,0 +1,98 @@
+package signer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigBasicCheck(t *testing.T) {
+	testCases := []struct {
+		name        string
+		expectedErr error
+		updateFn    func(c *Config)
+	}{
+		{
+			name: "Invalid address",
+			expectedErr: ConfigError{
+				Reason: "unsupported signer address: http://localhost",
+			},
+			updateFn: func(c *Config) {
+				c.RemoteAddress = "http://localhost"
+				c.AuthKey = "0123456789abcdef"
+			},
+		},
+		{
+			name: "Short auth key",
+			expectedErr: ConfigError{
+				Reason: "auth key should be at least 16 characters",
+			},
+			updateFn: func(c *Config) {
+				c.RemoteAddress = "unix:///tmp/signer.sock"
+				c.AuthKey = "secret"
+			},
+		},
+		{
+			name: "Invalid timeout",
+			expectedErr: ConfigError{
+				Reason: "timeout must be greater than zero",
+			},
+			updateFn: func(c *Config) {
+				c.RemoteAddress = "tcp://127.0.0.1:50055"
+				c.AuthKey = "0123456789abcdef"
+				c.Timeout = 0
+			},
+		},
+		{
+			name:     "DefaultConfig",
+			updateFn: func(*Config) {},
+		},
+		{
+			name: "Valid remote signer",
+			updateFn: func(c *Config) {
+				c.RemoteAddress = "127.0.0.1:50055"
+				c.AuthKey = "0123456789abcdef"
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			conf := DefaultConfig()
+			tc.updateFn(conf)
+			if tc.expectedErr != nil {
+				err := conf.BasicCheck()
+				assert.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				err := conf.BasicCheck()
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestParseAddress(t *testing.T) {
+	testCases := []struct {
+		addr    string
+		network string
+		address string
+		wantErr bool
+	}{
+		{"unix:///tmp/signer.sock", "unix", "/tmp/signer.sock", false},
+		{"tcp://127.0.0.1:50055", "tcp", "127.0.0.1:50055", false},
+		{"127.0.0.1:50055", "tcp", "127.0.0.1:50055", false},
+		{"unix://", "", "", true},
+		{"udp://127.0.0.1:50055", "", "", true},
+	}
+
+	for _, tc := range testCases {
+		network, address, err := ParseAddress(tc.addr)
+		if tc.wantErr {
+			assert.Error(t, err, tc.addr)
+		} else {
+			assert.NoError(t, err, tc.addr)
+			assert.Equal(t, tc.network, network)
+			assert.Equal(t, tc.address, address)
+		}
+	}
+}
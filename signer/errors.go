@@ -0,0 +1,45 @@
+package signer
+
+import (
+	"fmt"
+
+	"github.com/pactus-project/pactus/crypto"
+)
+
+// DoubleSignError is returned when signing a message might lead to double-signing.
+type DoubleSignError struct {
+	Reason string
+}
+
+func (e DoubleSignError) Error() string {
+	return fmt.Sprintf("refused to sign: %s", e.Reason)
+}
+
+// UnknownSignerError is returned when the remote signer doesn't hold the key
+// for the requested validator address.
+type UnknownSignerError struct {
+	Address crypto.Address
+}
+
+func (e UnknownSignerError) Error() string {
+	return fmt.Sprintf("unknown signer: %s", e.Address.String())
+}
+
+// AuthError is returned when the authentication between the node and
+// the remote signer fails.
+type AuthError struct {
+	Reason string
+}
+
+func (e AuthError) Error() string {
+	return fmt.Sprintf("authentication failed: %s", e.Reason)
+}
+
+// ConfigError is returned when the config is not valid with a descriptive Reason message.
+type ConfigError struct {
+	Reason string
+}
+
+func (e ConfigError) Error() string {
+	return e.Reason
+}
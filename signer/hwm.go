@@ -0,0 +1,162 @@
+package signer
+
+import (
+	"fmt"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/types/vote"
+	"github.com/pactus-project/pactus/util"
+)
+
+// HighWaterMark keeps the highest height and round that a validator has signed a message for.
+// The signer refuses to sign any message below the high-water mark.
+// At the high-water mark, it keeps the hash of the sign bytes of each signed message,
+// and refuses to sign a different message of the same kind, like two different proposals
+// or two different prepare votes for the same height and round.
+// If the path is empty, the high-water mark is kept only in memory.
+// It is not thread-safe.
+type HighWaterMark struct {
+	path  string
+	state hwmState
+}
+
+type hwmState struct {
+	Height uint32      `cbor:"1,keyasint"`
+	Round  int16       `cbor:"2,keyasint"`
+	Signed []hwmSigned `cbor:"3,keyasint"` // Messages signed at this height and round
+}
+
+// hwmKindProposal is the kind of the proposals. The kind of the votes is their vote type.
+const hwmKindProposal = 0
+
+// hwmSigned is a message that is signed at the high-water mark.
+type hwmSigned struct {
+	Kind     uint8     `cbor:"1,keyasint"`
+	CPRound  int16     `cbor:"2,keyasint"` // Change-proposer round, only for the change-proposer votes
+	SignHash hash.Hash `cbor:"3,keyasint"` // Hash of the sign bytes
+}
+
+func (s hwmSigned) sameKind(other hwmSigned) bool {
+	return s.Kind == other.Kind && s.CPRound == other.CPRound
+}
+
+func (s hwmSigned) kindString() string {
+	if s.Kind == hwmKindProposal {
+		return "proposal"
+	}
+
+	return vote.Type(s.Kind).String()
+}
+
+// NewHighWaterMark creates a new high-water mark that is kept in memory.
+func NewHighWaterMark() *HighWaterMark {
+	return &HighWaterMark{}
+}
+
+// LoadHighWaterMark loads the high-water mark from the given path.
+// If the file doesn't exist, it starts from zero.
+func LoadHighWaterMark(path string) (*HighWaterMark, error) {
+	hwm := &HighWaterMark{
+		path: path,
+	}
+	if !util.PathExists(path) {
+		return hwm, nil
+	}
+
+	data, err := util.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := cbor.Unmarshal(data, &hwm.state); err != nil {
+		return nil, fmt.Errorf("unable to decode high-water mark file %s: %w", path, err)
+	}
+
+	return hwm, nil
+}
+
+// Height returns the highest signed height.
+func (m *HighWaterMark) Height() uint32 {
+	return m.state.Height
+}
+
+// Round returns the highest signed round at the highest signed height.
+func (m *HighWaterMark) Round() int16 {
+	return m.state.Round
+}
+
+// advanceVote checks the vote against the high-water mark and moves it forward.
+func (m *HighWaterMark) advanceVote(v *vote.Vote) error {
+	signed := hwmSigned{
+		Kind:     uint8(v.Type()),
+		SignHash: hash.CalcHash(v.SignBytes()),
+	}
+	if v.IsCPVote() {
+		signed.CPRound = v.CPRound()
+	}
+
+	return m.advance(v.Height(), v.Round(), signed)
+}
+
+// advanceProposal checks the proposal sign bytes against the high-water mark and moves it forward.
+func (m *HighWaterMark) advanceProposal(height uint32, round int16, signBytes []byte) error {
+	return m.advance(height, round, hwmSigned{
+		Kind:     hwmKindProposal,
+		SignHash: hash.CalcHash(signBytes),
+	})
+}
+
+// advance checks the message against the high-water mark and moves it forward.
+// Signing the same message again is allowed, but not a different message of the same kind.
+// The new high-water mark is flushed to the disk before returning.
+func (m *HighWaterMark) advance(height uint32, round int16, signed hwmSigned) error {
+	if height < m.state.Height ||
+		(height == m.state.Height && round < m.state.Round) {
+		return DoubleSignError{
+			Reason: fmt.Sprintf("%d/%d is below the high-water mark %d/%d",
+				height, round, m.state.Height, m.state.Round),
+		}
+	}
+
+	var newState hwmState
+	if height != m.state.Height || round != m.state.Round {
+		newState = hwmState{
+			Height: height,
+			Round:  round,
+			Signed: []hwmSigned{signed},
+		}
+	} else {
+		for _, s := range m.state.Signed {
+			if !s.sameKind(signed) {
+				continue
+			}
+			if s.SignHash != signed.SignHash {
+				return DoubleSignError{
+					Reason: fmt.Sprintf("a different %s is already signed at %d/%d",
+						signed.kindString(), height, round),
+				}
+			}
+
+			return nil
+		}
+
+		newState = hwmState{
+			Height: height,
+			Round:  round,
+			Signed: append(append([]hwmSigned{}, m.state.Signed...), signed),
+		}
+	}
+
+	if m.path != "" {
+		data, err := cbor.Marshal(newState)
+		if err != nil {
+			return err
+		}
+		if err := util.WriteFileAtomic(m.path, data); err != nil {
+			return err
+		}
+	}
+	m.state = newState
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package signer
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/types/proposal"
+	"github.com/pactus-project/pactus/types/vote"
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHighWaterMark(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	addr := ts.RandValAddress()
+	blockHash := ts.RandHash()
+	prepare := vote.NewPrepareVote(blockHash, 10, 2, addr)
+
+	hwm := NewHighWaterMark()
+	require.NoError(t, hwm.advanceVote(prepare))
+	assert.Equal(t, uint32(10), hwm.Height())
+	assert.Equal(t, int16(2), hwm.Round())
+
+	t.Run("Same vote", func(t *testing.T) {
+		assert.NoError(t, hwm.advanceVote(vote.NewPrepareVote(blockHash, 10, 2, addr)))
+	})
+
+	t.Run("Conflicting votes", func(t *testing.T) {
+		v := vote.NewPrepareVote(ts.RandHash(), 10, 2, addr)
+		assert.ErrorAs(t, hwm.advanceVote(v), &DoubleSignError{})
+	})
+
+	t.Run("Different vote types", func(t *testing.T) {
+		precommit := vote.NewPrecommitVote(blockHash, 10, 2, addr)
+		assert.NoError(t, hwm.advanceVote(precommit))
+		assert.ErrorAs(t, hwm.advanceVote(vote.NewPrecommitVote(ts.RandHash(), 10, 2, addr)),
+			&DoubleSignError{})
+	})
+
+	t.Run("Change-proposer votes", func(t *testing.T) {
+		just := &vote.JustInitYes{}
+		preVote0 := vote.NewCPPreVote(hash.UndefHash, 10, 2, 0, vote.CPValueYes, just, addr)
+		preVote1 := vote.NewCPPreVote(hash.UndefHash, 10, 2, 1, vote.CPValueYes, just, addr)
+		conflicting := vote.NewCPPreVote(blockHash, 10, 2, 0, vote.CPValueNo, just, addr)
+
+		assert.NoError(t, hwm.advanceVote(preVote0))
+		assert.NoError(t, hwm.advanceVote(preVote1))
+		assert.ErrorAs(t, hwm.advanceVote(conflicting), &DoubleSignError{})
+	})
+
+	t.Run("Conflicting proposals", func(t *testing.T) {
+		signBytes := proposal.SignBytes(blockHash, 10, 2)
+		assert.NoError(t, hwm.advanceProposal(10, 2, signBytes))
+		assert.NoError(t, hwm.advanceProposal(10, 2, signBytes))
+		assert.ErrorAs(t, hwm.advanceProposal(10, 2, proposal.SignBytes(ts.RandHash(), 10, 2)),
+			&DoubleSignError{})
+
+		// Voting after the proposal is allowed.
+		assert.NoError(t, hwm.advanceVote(prepare))
+	})
+
+	t.Run("Lower round", func(t *testing.T) {
+		v := vote.NewPrepareVote(blockHash, 10, 1, addr)
+		assert.ErrorAs(t, hwm.advanceVote(v), &DoubleSignError{})
+	})
+
+	t.Run("Lower height", func(t *testing.T) {
+		v := vote.NewPrepareVote(blockHash, 9, 5, addr)
+		assert.ErrorAs(t, hwm.advanceVote(v), &DoubleSignError{})
+	})
+
+	t.Run("Next round", func(t *testing.T) {
+		assert.NoError(t, hwm.advanceVote(vote.NewPrepareVote(ts.RandHash(), 10, 3, addr)))
+		assert.Equal(t, int16(3), hwm.Round())
+	})
+
+	t.Run("Next height", func(t *testing.T) {
+		assert.NoError(t, hwm.advanceVote(vote.NewPrepareVote(blockHash, 11, 0, addr)))
+		assert.Equal(t, uint32(11), hwm.Height())
+		assert.Equal(t, int16(0), hwm.Round())
+	})
+}
+
+func TestHighWaterMarkPersistence(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	addr := ts.RandValAddress()
+	path := filepath.Join(util.TempDirPath(), "signer", "hwm")
+	hwm1, err := LoadHighWaterMark(path)
+	require.NoError(t, err)
+	assert.Zero(t, hwm1.Height())
+
+	blockHash := ts.RandHash()
+	propSignBytes := proposal.SignBytes(blockHash, 10, 2)
+	require.NoError(t, hwm1.advanceProposal(10, 2, propSignBytes))
+	require.NoError(t, hwm1.advanceVote(vote.NewPrepareVote(blockHash, 10, 2, addr)))
+
+	hwm2, err := LoadHighWaterMark(path)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(10), hwm2.Height())
+	assert.Equal(t, int16(2), hwm2.Round())
+	assert.ErrorAs(t, hwm2.advanceVote(vote.NewPrepareVote(blockHash, 10, 1, addr)), &DoubleSignError{})
+	assert.ErrorAs(t, hwm2.advanceProposal(10, 2, proposal.SignBytes(ts.RandHash(), 10, 2)),
+		&DoubleSignError{})
+	assert.ErrorAs(t, hwm2.advanceVote(vote.NewPrepareVote(ts.RandHash(), 10, 2, addr)), &DoubleSignError{})
+	assert.NoError(t, hwm2.advanceProposal(10, 2, propSignBytes))
+	assert.NoError(t, hwm2.advanceVote(vote.NewPrepareVote(blockHash, 10, 2, addr)))
+
+	t.Run("Invalid file", func(t *testing.T) {
+		invPath := util.TempFilePath()
+		require.NoError(t, util.WriteFile(invPath, []byte("invalid")))
+
+		_, err := LoadHighWaterMark(invPath)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,147 @@
+package signer
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/sortition"
+	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/types/proposal"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/vote"
+)
+
+var _ Signer = &LocalSigner{}
+
+// LocalSigner signs the consensus messages with a validator key kept in the process memory.
+type LocalSigner struct {
+	lk sync.Mutex
+
+	key *bls.ValidatorKey
+	hwm *HighWaterMark
+}
+
+// NewLocalSigner creates a new local signer with an in-memory high-water mark.
+func NewLocalSigner(valKey *bls.ValidatorKey) *LocalSigner {
+	return &LocalSigner{
+		key: valKey,
+		hwm: NewHighWaterMark(),
+	}
+}
+
+// LoadLocalSigner creates a new local signer and loads its high-water mark from the given path.
+func LoadLocalSigner(valKey *bls.ValidatorKey, hwmPath string) (*LocalSigner, error) {
+	hwm, err := LoadHighWaterMark(hwmPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LocalSigner{
+		key: valKey,
+		hwm: hwm,
+	}, nil
+}
+
+func (s *LocalSigner) Address() crypto.Address {
+	return s.key.Address()
+}
+
+func (s *LocalSigner) PublicKey() *bls.PublicKey {
+	return s.key.PublicKey()
+}
+
+// HighWaterMark returns the highest height and round that the signer has signed for.
+func (s *LocalSigner) HighWaterMark() (uint32, int16) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	return s.hwm.Height(), s.hwm.Round()
+}
+
+func (s *LocalSigner) SignVote(v *vote.Vote) error {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	if v.Signer() != s.key.Address() {
+		return vote.InvalidSignerError{
+			Expected: s.key.Address(),
+			Got:      v.Signer(),
+		}
+	}
+
+	if err := s.hwm.advanceVote(v); err != nil {
+		return err
+	}
+
+	v.SetSignature(s.key.Sign(v.SignBytes()))
+
+	return nil
+}
+
+func (s *LocalSigner) SignProposal(p *proposal.Proposal) error {
+	proposer := p.Block().Header().ProposerAddress()
+	if proposer != s.key.Address() {
+		return vote.InvalidSignerError{
+			Expected: s.key.Address(),
+			Got:      proposer,
+		}
+	}
+
+	sig, err := s.signProposal(p.Block().Hash(), p.Height(), p.Round())
+	if err != nil {
+		return err
+	}
+	p.SetSignature(sig)
+
+	return nil
+}
+
+func (s *LocalSigner) signProposal(blockHash hash.Hash, height uint32, round int16) (*bls.Signature, error) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	signBytes := proposal.SignBytes(blockHash, height, round)
+	if err := s.hwm.advanceProposal(height, round, signBytes); err != nil {
+		return nil, err
+	}
+
+	return s.key.Sign(signBytes), nil
+}
+
+func (s *LocalSigner) SignSeed(prevSeed sortition.VerifiableSeed) (sortition.VerifiableSeed, error) {
+	return prevSeed.GenerateNext(s.key.PrivateKey()), nil
+}
+
+func (s *LocalSigner) EvaluateSortition(seed sortition.VerifiableSeed,
+	totalPower, power int64,
+) (bool, sortition.Proof, error) {
+	ok, proof := sortition.EvaluateSortition(seed, s.key.PrivateKey(), totalPower, power)
+
+	return ok, proof, nil
+}
+
+// SignSortitionTx signs the sortition transaction.
+// It refuses to sign any other transaction, or a sortition transaction of another validator.
+func (s *LocalSigner) SignSortitionTx(trx *tx.Tx) error {
+	if !trx.IsSortitionTx() {
+		return fmt.Errorf("not a sortition transaction: %s", trx.Payload().Type())
+	}
+	if trx.Payload().Signer() != s.key.Address() {
+		return vote.InvalidSignerError{
+			Expected: s.key.Address(),
+			Got:      trx.Payload().Signer(),
+		}
+	}
+
+	trx.SetSignature(s.key.Sign(trx.SignBytes()))
+	trx.SetPublicKey(s.key.PublicKey())
+
+	return nil
+}
+
+func (s *LocalSigner) SignHello(msg *message.HelloMessage) (*bls.Signature, error) {
+	return s.key.Sign(msg.SignBytes()), nil
+}
@@ -0,0 +1,79 @@
+package signer
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/types/proposal"
+	"github.com/pactus-project/pactus/types/vote"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalSignVote(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	valKey := ts.RandValKey()
+	s := NewLocalSigner(valKey)
+	assert.Equal(t, valKey.Address(), s.Address())
+	assert.Equal(t, valKey.PublicKey(), s.PublicKey())
+
+	v1 := vote.NewPrepareVote(ts.RandHash(), 10, 2, valKey.Address())
+	require.NoError(t, s.SignVote(v1))
+	assert.NoError(t, v1.Verify(valKey.PublicKey()))
+
+	h, r := s.HighWaterMark()
+	assert.Equal(t, uint32(10), h)
+	assert.Equal(t, int16(2), r)
+
+	t.Run("Conflicting vote", func(t *testing.T) {
+		v := vote.NewPrepareVote(ts.RandHash(), 10, 2, valKey.Address())
+		assert.ErrorAs(t, s.SignVote(v), &DoubleSignError{})
+		assert.Nil(t, v.Signature())
+	})
+
+	t.Run("Below the high-water mark", func(t *testing.T) {
+		v := vote.NewPrecommitVote(ts.RandHash(), 10, 1, valKey.Address())
+		assert.ErrorAs(t, s.SignVote(v), &DoubleSignError{})
+		assert.Nil(t, v.Signature())
+	})
+
+	t.Run("Invalid signer", func(t *testing.T) {
+		v := vote.NewPrecommitVote(ts.RandHash(), 10, 2, ts.RandValAddress())
+		assert.ErrorAs(t, s.SignVote(v), &vote.InvalidSignerError{})
+	})
+}
+
+func TestLocalSignProposal(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	valKey := ts.RandValKey()
+	s := NewLocalSigner(valKey)
+
+	blk1, _ := ts.GenerateTestBlock(10, testsuite.BlockWithProposer(valKey.Address()))
+	blk2, _ := ts.GenerateTestBlock(10, testsuite.BlockWithProposer(valKey.Address()))
+	prop1 := proposal.NewProposal(10, 2, blk1)
+	prop2 := proposal.NewProposal(10, 2, blk2)
+
+	require.NoError(t, s.SignProposal(prop1))
+	assert.NoError(t, prop1.Verify(valKey.PublicKey()))
+	assert.ErrorAs(t, s.SignProposal(prop2), &DoubleSignError{})
+
+	t.Run("Invalid proposer", func(t *testing.T) {
+		blk, _ := ts.GenerateTestBlock(10)
+		prop := proposal.NewProposal(10, 3, blk)
+		assert.ErrorAs(t, s.SignProposal(prop), &vote.InvalidSignerError{})
+	})
+}
+
+func TestLocalSignSeed(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	valKey := ts.RandValKey()
+	s := NewLocalSigner(valKey)
+
+	prevSeed := ts.RandSeed()
+	seed, err := s.SignSeed(prevSeed)
+	require.NoError(t, err)
+	assert.True(t, seed.Verify(valKey.PublicKey(), prevSeed))
+}
@@ -0,0 +1,311 @@
+package signer
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"time"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/types/vote"
+)
+
+// The node and the remote signer talk over a stream connection (Unix socket or TCP).
+// Each message is a frame: a 4-byte big-endian length followed by a CBOR payload.
+//
+// The connection starts with a mutual challenge-response handshake based on the shared auth key:
+//
+//	signer -> node:   {nonce_s}
+//	node   -> signer: {nonce_n, HMAC(key, "client" | nonce_s | nonce_n)}
+//	signer -> node:   {HMAC(key, "server" | nonce_s | nonce_n)}
+//
+// After that, every message is wrapped in an envelope that is authenticated by
+// a session key derived from both nonces, and carries a sequence number to prevent replays.
+
+const (
+	maxFrameSize = 1 << 20
+	nonceSize    = 32
+
+	labelClient  = "pactus-signer-client"
+	labelServer  = "pactus-signer-server"
+	labelSession = "pactus-signer-session"
+)
+
+type method uint8
+
+const (
+	methodPublicKey    = method(1)
+	methodSignVote     = method(2)
+	methodSignProposal = method(3)
+	methodSignSeed     = method(4)
+	methodSortition    = method(5)
+	methodSignTx       = method(6)
+	methodSignHello    = method(7)
+)
+
+type errorCode uint8
+
+const (
+	codeOK            = errorCode(0)
+	codeDoubleSign    = errorCode(1)
+	codeUnknownSigner = errorCode(2)
+	codeFailed        = errorCode(3)
+)
+
+type request struct {
+	Method    method                `cbor:"1,keyasint"`
+	Address   crypto.Address        `cbor:"2,keyasint"`
+	Vote      *vote.Vote            `cbor:"3,keyasint,omitempty"`
+	Height    uint32                `cbor:"4,keyasint,omitempty"`
+	Round     int16                 `cbor:"5,keyasint,omitempty"`
+	BlockHash hash.Hash             `cbor:"6,keyasint,omitempty"`
+	Seed      []byte                `cbor:"7,keyasint,omitempty"`
+	Total     int64                 `cbor:"8,keyasint,omitempty"`
+	Power     int64                 `cbor:"9,keyasint,omitempty"`
+	Tx        []byte                `cbor:"10,keyasint,omitempty"`
+	Hello     *message.HelloMessage `cbor:"11,keyasint,omitempty"`
+}
+
+type response struct {
+	Code  errorCode `cbor:"1,keyasint"`
+	Error string    `cbor:"2,keyasint,omitempty"`
+	Data  []byte    `cbor:"3,keyasint,omitempty"` // Public key, signature, seed or sortition proof
+}
+
+type handshake struct {
+	Nonce []byte `cbor:"1,keyasint,omitempty"`
+	MAC   []byte `cbor:"2,keyasint,omitempty"`
+}
+
+type envelope struct {
+	Seq  uint64 `cbor:"1,keyasint"`
+	Body []byte `cbor:"2,keyasint"`
+	MAC  []byte `cbor:"3,keyasint"`
+}
+
+func writeFrame(w io.Writer, obj any) error {
+	data, err := cbor.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	if len(data) > maxFrameSize {
+		return fmt.Errorf("frame is too large: %d", len(data))
+	}
+
+	buf := make([]byte, 4+len(data))
+	binary.BigEndian.PutUint32(buf, uint32(len(data)))
+	copy(buf[4:], data)
+	_, err = w.Write(buf)
+
+	return err
+}
+
+func readFrame(r io.Reader, obj any) error {
+	var lenBuf [4]byte
+	if _, err := io.ReadFull(r, lenBuf[:]); err != nil {
+		return err
+	}
+	size := binary.BigEndian.Uint32(lenBuf[:])
+	if size > maxFrameSize {
+		return fmt.Errorf("frame is too large: %d", size)
+	}
+
+	data := make([]byte, size)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return err
+	}
+
+	return cbor.Unmarshal(data, obj)
+}
+
+func computeMAC(key []byte, parts ...[]byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	for _, p := range parts {
+		mac.Write(p)
+	}
+
+	return mac.Sum(nil)
+}
+
+func randomNonce() ([]byte, error) {
+	nonce := make([]byte, nonceSize)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	return nonce, nil
+}
+
+// session is an authenticated connection between the node and the remote signer.
+type session struct {
+	conn       net.Conn
+	key        []byte
+	sendLabel  []byte
+	recvLabel  []byte
+	sendSeq    uint64
+	recvSeq    uint64
+	msgTimeout time.Duration
+}
+
+func newSession(conn net.Conn, authKey string, serverNonce, clientNonce []byte,
+	isServer bool, timeout time.Duration,
+) *session {
+	s := &session{
+		conn:       conn,
+		key:        computeMAC([]byte(authKey), []byte(labelSession), serverNonce, clientNonce),
+		sendLabel:  []byte(labelClient),
+		recvLabel:  []byte(labelServer),
+		msgTimeout: timeout,
+	}
+	if isServer {
+		s.sendLabel, s.recvLabel = s.recvLabel, s.sendLabel
+	}
+
+	return s
+}
+
+// clientHandshake authenticates the signer and the node to each other from the node side.
+func clientHandshake(conn net.Conn, authKey string, timeout time.Duration) (*session, error) {
+	if err := conn.SetDeadline(time.Now().Add(timeout)); err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = conn.SetDeadline(time.Time{})
+	}()
+
+	challenge := handshake{}
+	if err := readFrame(conn, &challenge); err != nil {
+		return nil, err
+	}
+	if len(challenge.Nonce) != nonceSize {
+		return nil, AuthError{Reason: "invalid nonce"}
+	}
+
+	clientNonce, err := randomNonce()
+	if err != nil {
+		return nil, err
+	}
+	answer := handshake{
+		Nonce: clientNonce,
+		MAC:   computeMAC([]byte(authKey), []byte(labelClient), challenge.Nonce, clientNonce),
+	}
+	if err := writeFrame(conn, &answer); err != nil {
+		return nil, err
+	}
+
+	confirm := handshake{}
+	if err := readFrame(conn, &confirm); err != nil {
+		return nil, err
+	}
+	expectedMAC := computeMAC([]byte(authKey), []byte(labelServer), challenge.Nonce, clientNonce)
+	if !hmac.Equal(confirm.MAC, expectedMAC) {
+		return nil, AuthError{Reason: "invalid signer MAC"}
+	}
+
+	return newSession(conn, authKey, challenge.Nonce, clientNonce, false, timeout), nil
+}
+
+// serverHandshake authenticates the signer and the node to each other from the signer side.
+func serverHandshake(conn net.Conn, authKey string, timeout time.Duration) (*session, error) {
+	if err := conn.SetDeadline(time.Now().Add(timeout)); err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = conn.SetDeadline(time.Time{})
+	}()
+
+	serverNonce, err := randomNonce()
+	if err != nil {
+		return nil, err
+	}
+	if err := writeFrame(conn, &handshake{Nonce: serverNonce}); err != nil {
+		return nil, err
+	}
+
+	answer := handshake{}
+	if err := readFrame(conn, &answer); err != nil {
+		return nil, err
+	}
+	if len(answer.Nonce) != nonceSize {
+		return nil, AuthError{Reason: "invalid nonce"}
+	}
+	expectedMAC := computeMAC([]byte(authKey), []byte(labelClient), serverNonce, answer.Nonce)
+	if !hmac.Equal(answer.MAC, expectedMAC) {
+		return nil, AuthError{Reason: "invalid node MAC"}
+	}
+
+	confirm := handshake{
+		MAC: computeMAC([]byte(authKey), []byte(labelServer), serverNonce, answer.Nonce),
+	}
+	if err := writeFrame(conn, &confirm); err != nil {
+		return nil, err
+	}
+
+	return newSession(conn, authKey, serverNonce, answer.Nonce, true, timeout), nil
+}
+
+func seqBytes(seq uint64) []byte {
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], seq)
+
+	return buf[:]
+}
+
+func (s *session) send(obj any) error {
+	body, err := cbor.Marshal(obj)
+	if err != nil {
+		return err
+	}
+
+	env := envelope{
+		Seq:  s.sendSeq,
+		Body: body,
+		MAC:  computeMAC(s.key, s.sendLabel, seqBytes(s.sendSeq), body),
+	}
+	if err := s.conn.SetWriteDeadline(time.Now().Add(s.msgTimeout)); err != nil {
+		return err
+	}
+	if err := writeFrame(s.conn, &env); err != nil {
+		return err
+	}
+	s.sendSeq++
+
+	return nil
+}
+
+// recv reads the next message. If the timeout is zero, it waits until a message arrives.
+func (s *session) recv(obj any, timeout time.Duration) error {
+	deadline := time.Time{}
+	if timeout > 0 {
+		deadline = time.Now().Add(timeout)
+	}
+	if err := s.conn.SetReadDeadline(deadline); err != nil {
+		return err
+	}
+
+	env := envelope{}
+	if err := readFrame(s.conn, &env); err != nil {
+		return err
+	}
+	if env.Seq != s.recvSeq {
+		return AuthError{Reason: fmt.Sprintf("unexpected sequence number %d", env.Seq)}
+	}
+	expectedMAC := computeMAC(s.key, s.recvLabel, seqBytes(env.Seq), env.Body)
+	if !hmac.Equal(env.MAC, expectedMAC) {
+		return AuthError{Reason: "invalid message MAC"}
+	}
+	s.recvSeq++
+
+	return cbor.Unmarshal(env.Body, obj)
+}
+
+func (s *session) close() {
+	_ = s.conn.Close()
+}
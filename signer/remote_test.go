@@ -0,0 +1,221 @@
+package signer
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/sortition"
+	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/sync/peerset/peer/service"
+	"github.com/pactus-project/pactus/types/proposal"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/vote"
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testAuthKey = "0123456789abcdef"
+
+type testData struct {
+	*testsuite.TestSuite
+
+	valKeys []*bls.ValidatorKey
+	signers []*LocalSigner
+	server  *Server
+	client  *Client
+}
+
+func setup(t *testing.T, listen string) *testData {
+	t.Helper()
+
+	ts := testsuite.NewTestSuite(t)
+
+	valKeys := []*bls.ValidatorKey{ts.RandValKey(), ts.RandValKey()}
+	signers := []*LocalSigner{NewLocalSigner(valKeys[0]), NewLocalSigner(valKeys[1])}
+
+	server := NewServer(listen, testAuthKey, signers)
+	require.NoError(t, server.StartServer())
+	t.Cleanup(server.StopServer)
+
+	client := NewClient(&Config{
+		RemoteAddress: server.Address(),
+		AuthKey:       testAuthKey,
+		Timeout:       time.Second,
+	})
+	t.Cleanup(client.Close)
+
+	return &testData{
+		TestSuite: ts,
+		valKeys:   valKeys,
+		signers:   signers,
+		server:    server,
+		client:    client,
+	}
+}
+
+func unixAddress() string {
+	return "unix://" + filepath.Join(util.TempDirPath(), "signer.sock")
+}
+
+func TestRemoteSigner(t *testing.T) {
+	for _, listen := range []string{unixAddress(), "tcp://127.0.0.1:0"} {
+		td := setup(t, listen)
+
+		valKey := td.valKeys[0]
+		remote, err := td.client.Signer(valKey.Address())
+		require.NoError(t, err)
+		assert.Equal(t, valKey.Address(), remote.Address())
+		assert.True(t, valKey.PublicKey().EqualsTo(remote.PublicKey()))
+
+		v := vote.NewPrepareVote(td.RandHash(), 10, 1, valKey.Address())
+		require.NoError(t, remote.SignVote(v))
+		assert.NoError(t, v.Verify(valKey.PublicKey()))
+
+		cpVote := vote.NewCPPreVote(td.RandHash(), 10, 1, 0, vote.CPValueYes, &vote.JustInitYes{}, valKey.Address())
+		require.NoError(t, remote.SignVote(cpVote))
+		assert.NoError(t, cpVote.Verify(valKey.PublicKey()))
+
+		blk, _ := td.GenerateTestBlock(10, testsuite.BlockWithProposer(valKey.Address()))
+		prop := proposal.NewProposal(10, 2, blk)
+		require.NoError(t, remote.SignProposal(prop))
+		assert.NoError(t, prop.Verify(valKey.PublicKey()))
+
+		prevSeed := td.RandSeed()
+		seed, err := remote.SignSeed(prevSeed)
+		require.NoError(t, err)
+		assert.True(t, seed.Verify(valKey.PublicKey(), prevSeed))
+
+		// The high-water mark is kept by the signer.
+		h, r := td.signers[0].HighWaterMark()
+		assert.Equal(t, uint32(10), h)
+		assert.Equal(t, int16(2), r)
+	}
+}
+
+func TestRemoteDoubleSign(t *testing.T) {
+	td := setup(t, unixAddress())
+
+	valKey := td.valKeys[1]
+	remote, err := td.client.Signer(valKey.Address())
+	require.NoError(t, err)
+
+	v1 := vote.NewPrecommitVote(td.RandHash(), 10, 2, valKey.Address())
+	require.NoError(t, remote.SignVote(v1))
+
+	v2 := vote.NewPrecommitVote(td.RandHash(), 10, 1, valKey.Address())
+	assert.ErrorAs(t, remote.SignVote(v2), &DoubleSignError{})
+	assert.Nil(t, v2.Signature())
+
+	blk1, _ := td.GenerateTestBlock(10, testsuite.BlockWithProposer(valKey.Address()))
+	blk2, _ := td.GenerateTestBlock(10, testsuite.BlockWithProposer(valKey.Address()))
+	require.NoError(t, remote.SignProposal(proposal.NewProposal(10, 3, blk1)))
+	assert.ErrorAs(t, remote.SignProposal(proposal.NewProposal(10, 3, blk2)), &DoubleSignError{})
+
+	t.Run("Invalid signer", func(t *testing.T) {
+		v := vote.NewPrecommitVote(td.RandHash(), 11, 0, td.valKeys[0].Address())
+		assert.Error(t, remote.SignVote(v))
+	})
+}
+
+func TestRemoteSortitionAndHello(t *testing.T) {
+	td := setup(t, unixAddress())
+
+	valKey := td.valKeys[0]
+	remote, err := td.client.Signer(valKey.Address())
+	require.NoError(t, err)
+
+	t.Run("Evaluate sortition", func(t *testing.T) {
+		seed := td.RandSeed()
+		ok, proof, err := remote.EvaluateSortition(seed, 100, 100)
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.True(t, sortition.VerifyProof(seed, proof, valKey.PublicKey(), 100, 100))
+
+		ok, _, err = remote.EvaluateSortition(seed, 100, 0)
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("Sign sortition transaction", func(t *testing.T) {
+		trx := tx.NewSortitionTx(td.RandHeight(), valKey.Address(), td.RandProof())
+		require.NoError(t, remote.SignSortitionTx(trx))
+		assert.True(t, valKey.PublicKey().EqualsTo(trx.PublicKey()))
+		assert.NoError(t, valKey.PublicKey().Verify(trx.SignBytes(), trx.Signature()))
+	})
+
+	t.Run("Refuse other transactions", func(t *testing.T) {
+		trx := tx.NewUnbondTx(td.RandHeight(), valKey.Address())
+		assert.Error(t, remote.SignSortitionTx(trx))
+		assert.Nil(t, trx.Signature())
+	})
+
+	t.Run("Refuse sortition transaction of other validators", func(t *testing.T) {
+		trx := tx.NewSortitionTx(td.RandHeight(), td.valKeys[1].Address(), td.RandProof())
+		assert.Error(t, remote.SignSortitionTx(trx))
+		assert.Nil(t, trx.Signature())
+	})
+
+	t.Run("Sign hello message", func(t *testing.T) {
+		msg := message.NewHelloMessage(td.RandPeerID(), "Alice", service.New(service.FullNode),
+			td.RandHeight(), td.RandHash(), td.RandHash())
+		sig, err := remote.SignHello(msg)
+		require.NoError(t, err)
+		assert.NoError(t, valKey.PublicKey().Verify(msg.SignBytes(), sig))
+	})
+}
+
+func TestRemoteUnknownSigner(t *testing.T) {
+	td := setup(t, unixAddress())
+
+	_, err := td.client.Signer(td.RandValAddress())
+	assert.ErrorAs(t, err, &UnknownSignerError{})
+}
+
+func TestRemoteInvalidAuthKey(t *testing.T) {
+	td := setup(t, unixAddress())
+
+	client := NewClient(&Config{
+		RemoteAddress: td.server.Address(),
+		AuthKey:       "fedcba9876543210",
+		Timeout:       time.Second,
+	})
+	defer client.Close()
+
+	_, err := client.Signer(td.valKeys[0].Address())
+	assert.Error(t, err)
+}
+
+func TestRemoteReconnect(t *testing.T) {
+	td := setup(t, unixAddress())
+
+	valKey := td.valKeys[0]
+	remote, err := td.client.Signer(valKey.Address())
+	require.NoError(t, err)
+
+	// Restarting the signer, the client should reconnect.
+	td.server.StopServer()
+	server := NewServer(td.server.Address(), testAuthKey, td.signers)
+	require.NoError(t, server.StartServer())
+	defer server.StopServer()
+
+	v := vote.NewPrepareVote(td.RandHash(), 10, 1, valKey.Address())
+	require.NoError(t, remote.SignVote(v))
+	assert.NoError(t, v.Verify(valKey.PublicKey()))
+}
+
+func TestRemoteSignerNotRunning(t *testing.T) {
+	client := NewClient(&Config{
+		RemoteAddress: unixAddress(),
+		AuthKey:       testAuthKey,
+		Timeout:       time.Second,
+	})
+	defer client.Close()
+
+	ts := testsuite.NewTestSuite(t)
+	_, err := client.Signer(ts.RandValAddress())
+	assert.Error(t, err)
+}
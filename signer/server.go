@@ -0,0 +1,248 @@
+package signer
+
+import (
+	"context"
+	"errors"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/sortition"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/util/logger"
+)
+
+// Server holds the validator keys and serves the signing requests of the nodes.
+// Each validator key has its own high-water mark that prevents double-signing,
+// regardless of what the node asks for.
+type Server struct {
+	ctx      context.Context
+	cancel   context.CancelFunc
+	wg       sync.WaitGroup
+	listen   string
+	authKey  string
+	timeout  time.Duration
+	signers  map[crypto.Address]*LocalSigner
+	listener net.Listener
+	logger   *logger.SubLogger
+}
+
+// NewServer creates a new signer server that listens on the given address.
+func NewServer(listen, authKey string, signers []*LocalSigner) *Server {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	signerMap := make(map[crypto.Address]*LocalSigner, len(signers))
+	for _, s := range signers {
+		signerMap[s.Address()] = s
+	}
+
+	return &Server{
+		ctx:     ctx,
+		cancel:  cancel,
+		listen:  listen,
+		authKey: authKey,
+		timeout: DefaultConfig().Timeout,
+		signers: signerMap,
+		logger:  logger.NewSubLogger("_signer", nil),
+	}
+}
+
+func (s *Server) StartServer() error {
+	network, addr, err := ParseAddress(s.listen)
+	if err != nil {
+		return err
+	}
+
+	if network == "unix" {
+		// Remove the stale socket file from the previous run.
+		_ = os.Remove(addr)
+	}
+
+	listener, err := net.Listen(network, addr)
+	if err != nil {
+		return err
+	}
+	s.listener = listener
+
+	s.wg.Add(1)
+	go s.acceptLoop()
+
+	s.logger.Info("signer started listening",
+		"address", listener.Addr().String(), "validators", len(s.signers))
+
+	return nil
+}
+
+// Address returns the address that the server is listening on.
+func (s *Server) Address() string {
+	if s.listener == nil {
+		return ""
+	}
+
+	addr := s.listener.Addr()
+	if addr.Network() == "unix" {
+		return "unix://" + addr.String()
+	}
+
+	return "tcp://" + addr.String()
+}
+
+func (s *Server) StopServer() {
+	s.cancel()
+
+	if s.listener != nil {
+		_ = s.listener.Close()
+	}
+	s.wg.Wait()
+}
+
+func (s *Server) acceptLoop() {
+	defer s.wg.Done()
+
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			if s.ctx.Err() != nil {
+				return
+			}
+			s.logger.Warn("unable to accept connection", "error", err)
+
+			continue
+		}
+
+		s.wg.Add(1)
+		go s.handleConn(conn)
+	}
+}
+
+func (s *Server) handleConn(conn net.Conn) {
+	defer s.wg.Done()
+
+	// Close the connection when the server is stopped.
+	stop := context.AfterFunc(s.ctx, func() {
+		_ = conn.Close()
+	})
+	defer stop()
+
+	sess, err := serverHandshake(conn, s.authKey, s.timeout)
+	if err != nil {
+		s.logger.Warn("handshake failed", "remote", conn.RemoteAddr(), "error", err)
+		_ = conn.Close()
+
+		return
+	}
+	defer sess.close()
+
+	s.logger.Info("node connected", "remote", conn.RemoteAddr())
+
+	for {
+		req := request{}
+		if err := sess.recv(&req, 0); err != nil {
+			if s.ctx.Err() == nil {
+				s.logger.Debug("connection closed", "remote", conn.RemoteAddr(), "error", err)
+			}
+
+			return
+		}
+
+		res := s.handleRequest(&req)
+		if err := sess.send(res); err != nil {
+			s.logger.Warn("unable to send response", "remote", conn.RemoteAddr(), "error", err)
+
+			return
+		}
+	}
+}
+
+func (s *Server) handleRequest(req *request) *response {
+	signer, ok := s.signers[req.Address]
+	if !ok {
+		return errorResponse(UnknownSignerError{Address: req.Address})
+	}
+
+	switch req.Method {
+	case methodPublicKey:
+		return &response{Data: signer.PublicKey().Bytes()}
+
+	case methodSignVote:
+		if req.Vote == nil {
+			return &response{Code: codeFailed, Error: "no vote"}
+		}
+		if err := signer.SignVote(req.Vote); err != nil {
+			s.logger.Warn("refused to sign the vote", "vote", req.Vote, "error", err)
+
+			return errorResponse(err)
+		}
+
+		return &response{Data: req.Vote.Signature().Bytes()}
+
+	case methodSignProposal:
+		sig, err := signer.signProposal(req.BlockHash, req.Height, req.Round)
+		if err != nil {
+			s.logger.Warn("refused to sign the proposal",
+				"height", req.Height, "round", req.Round, "error", err)
+
+			return errorResponse(err)
+		}
+
+		return &response{Data: sig.Bytes()}
+
+	case methodSignSeed:
+		prevSeed, err := sortition.VerifiableSeedFromBytes(req.Seed)
+		if err != nil {
+			return errorResponse(err)
+		}
+		seed, _ := signer.SignSeed(prevSeed)
+
+		return &response{Data: seed[:]}
+
+	case methodSortition:
+		seed, err := sortition.VerifiableSeedFromBytes(req.Seed)
+		if err != nil {
+			return errorResponse(err)
+		}
+		ok, proof, _ := signer.EvaluateSortition(seed, req.Total, req.Power)
+		if !ok {
+			return &response{}
+		}
+
+		return &response{Data: proof[:]}
+
+	case methodSignTx:
+		trx, err := tx.FromBytes(req.Tx)
+		if err != nil {
+			return errorResponse(err)
+		}
+		if err := signer.SignSortitionTx(trx); err != nil {
+			s.logger.Warn("refused to sign the transaction", "tx", trx, "error", err)
+
+			return errorResponse(err)
+		}
+
+		return &response{Data: trx.Signature().Bytes()}
+
+	case methodSignHello:
+		if req.Hello == nil {
+			return &response{Code: codeFailed, Error: "no hello message"}
+		}
+		sig, _ := signer.SignHello(req.Hello)
+
+		return &response{Data: sig.Bytes()}
+
+	default:
+		return &response{Code: codeFailed, Error: "unknown method"}
+	}
+}
+
+func errorResponse(err error) *response {
+	code := codeFailed
+	if errors.As(err, &DoubleSignError{}) {
+		code = codeDoubleSign
+	} else if errors.As(err, &UnknownSignerError{}) {
+		code = codeUnknownSigner
+	}
+
+	return &response{Code: code, Error: err.Error()}
+}
@@ -0,0 +1,41 @@
+package signer
+
+import (
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/sortition"
+	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/types/proposal"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/vote"
+)
+
+// Signer signs the consensus messages on behalf of a validator.
+// The private key can be kept in the process memory, or by a remote signer.
+type Signer interface {
+	// Address returns the validator address of the signer.
+	Address() crypto.Address
+
+	// PublicKey returns the BLS public key of the signer.
+	PublicKey() *bls.PublicKey
+
+	// SignVote signs the vote and sets its signature.
+	SignVote(v *vote.Vote) error
+
+	// SignProposal signs the proposal and sets its signature.
+	SignProposal(p *proposal.Proposal) error
+
+	// SignSeed generates the next sortition seed from the previous seed.
+	SignSeed(prevSeed sortition.VerifiableSeed) (sortition.VerifiableSeed, error)
+
+	// EvaluateSortition evaluates the sortition for the given seed.
+	// It returns true and the proof if the validator is selected.
+	EvaluateSortition(seed sortition.VerifiableSeed, totalPower, power int64) (bool, sortition.Proof, error)
+
+	// SignSortitionTx signs the sortition transaction of the validator
+	// and sets its signature and public key.
+	SignSortitionTx(trx *tx.Tx) error
+
+	// SignHello signs the Hello message on behalf of the validator.
+	SignHello(msg *message.HelloMessage) (*bls.Signature, error)
+}
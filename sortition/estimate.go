@@ -0,0 +1,39 @@
+package sortition
+
+import "math"
+
+// The sortition is evaluated once per block, and a validator is selected if its index
+// is less than its power. The chance of being selected in each block is power/total,
+// so the number of blocks until the validator is selected has a geometric distribution.
+
+// SelectionProbability returns the chance of a validator with the given power
+// to be selected by the sortition in one block.
+func SelectionProbability(power, total int64) float64 {
+	if power <= 0 {
+		return 0
+	}
+	if total <= power {
+		return 1
+	}
+
+	return float64(power) / float64(total)
+}
+
+// ExpectedBlocks returns the expected number of blocks until a validator with the given power
+// is selected by the sortition. It returns +Inf if the validator has no power.
+func ExpectedBlocks(power, total int64) float64 {
+	prob := SelectionProbability(power, total)
+	if prob == 0 {
+		return math.Inf(1)
+	}
+
+	return 1 / prob
+}
+
+// SelectionProbabilityWithin returns the chance of a validator with the given power
+// to be selected by the sortition at least once in the given number of blocks.
+func SelectionProbabilityWithin(power, total int64, blocks uint32) float64 {
+	prob := SelectionProbability(power, total)
+
+	return 1 - math.Pow(1-prob, float64(blocks))
+}
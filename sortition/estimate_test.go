@@ -0,0 +1,35 @@
+package sortition_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/pactus-project/pactus/sortition"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEstimate(t *testing.T) {
+	tests := []struct {
+		power          int64
+		total          int64
+		probability    float64
+		expectedBlocks float64
+	}{
+		{0, 1000, 0, math.Inf(1)},
+		{-1, 1000, 0, math.Inf(1)},
+		{1000, 1000, 1, 1},
+		{1000, 0, 1, 1},
+		{1, 1000, 0.001, 1000},
+		{250, 1000, 0.25, 4},
+	}
+
+	for _, tt := range tests {
+		assert.InDelta(t, tt.probability, sortition.SelectionProbability(tt.power, tt.total), 1e-9)
+		assert.Equal(t, tt.expectedBlocks, sortition.ExpectedBlocks(tt.power, tt.total))
+	}
+
+	assert.InDelta(t, 0.4375, sortition.SelectionProbabilityWithin(250, 1000, 2), 1e-9)
+	assert.Zero(t, sortition.SelectionProbabilityWithin(0, 1000, 100))
+	assert.Zero(t, sortition.SelectionProbabilityWithin(250, 1000, 0))
+	assert.InDelta(t, 1, sortition.SelectionProbabilityWithin(1000, 1000, 1), 1e-9)
+}
@@ -27,3 +27,12 @@ func (e InvalidBlockCertificateError) Error() string {
 	return fmt.Sprintf("invalid certificate for block %d",
 		e.Cert.Height())
 }
+
+// InvalidStateSnapshotError is returned when the state snapshot can't be restored.
+type InvalidStateSnapshotError struct {
+	Reason string
+}
+
+func (e InvalidStateSnapshotError) Error() string {
+	return fmt.Sprintf("invalid state snapshot: %s", e.Reason)
+}
@@ -4,9 +4,9 @@ import (
 	"time"
 
 	"github.com/pactus-project/pactus/crypto"
-	"github.com/pactus-project/pactus/crypto/bls"
 	"github.com/pactus-project/pactus/crypto/hash"
 	"github.com/pactus-project/pactus/genesis"
+	"github.com/pactus-project/pactus/signer"
 	"github.com/pactus-project/pactus/state/param"
 	"github.com/pactus-project/pactus/store"
 	"github.com/pactus-project/pactus/types/account"
@@ -26,7 +26,7 @@ type Facade interface {
 	LastBlockTime() time.Time
 	LastCertificate() *certificate.BlockCertificate
 	UpdateLastCertificate(v *vote.Vote) error
-	ProposeBlock(valKey *bls.ValidatorKey, rewardAddr crypto.Address) (*block.Block, error)
+	ProposeBlock(valSigner signer.Signer, rewardAddr crypto.Address) (*block.Block, error)
 	ValidateBlock(blk *block.Block, round int16) error
 	CommitBlock(blk *block.Block, cert *certificate.BlockCertificate) error
 	CommitteeValidators() []*validator.Validator
@@ -38,16 +38,25 @@ type Facade interface {
 	TotalAccounts() int32
 	TotalValidators() int32
 	CommitteePower() int64
+	CommitteeInfo(height uint32) *store.CommitteeInfo
 	PendingTx(id tx.ID) *tx.Tx
 	AddPendingTx(trx *tx.Tx) error
 	AddPendingTxAndBroadcast(trx *tx.Tx) error
 	CommittedBlock(height uint32) *store.CommittedBlock
 	CommittedTx(id tx.ID) *store.CommittedTx
+	CommittedAnchor(h hash.Hash) *store.CommittedAnchor
 	BlockHash(height uint32) hash.Hash
 	BlockHeight(h hash.Hash) uint32
 	AccountByAddress(addr crypto.Address) *account.Account
 	ValidatorByAddress(addr crypto.Address) *validator.Validator
 	ValidatorByNumber(number int32) *validator.Validator
+	ValidatorMetadata(addr crypto.Address) *validator.Metadata
+	ValidatorRewards(valNum int32, from, to uint32) *store.RewardInfo
+	RewardAddressRewards(addr crypto.Address, from, to uint32) *store.RewardInfo
+	// HistoryHeight returns the first block height that the rewards and the committee history are recorded for.
+	// On a node that is restored from a state snapshot, the validator metadata and the anchors before it
+	// are not available either.
+	HistoryHeight() uint32
 	ValidatorAddresses() []crypto.Address
 	Params() *param.Params
 	Close()
@@ -57,4 +66,10 @@ type Facade interface {
 	AllPendingTxs() []*tx.Tx
 	IsPruned() bool
 	PruningHeight() uint32
+	// StateSnapshot returns the kept state snapshot at the given height, or the latest one if the height is zero.
+	// It returns nil if there is no such snapshot. The snapshot is owned by the state and should not be released.
+	StateSnapshot(height uint32) store.StateSnapshot
+	// RestoreStateSnapshot restores the state from a snapshot that is downloaded from other nodes.
+	// It only works at the genesis height. The blocks before the snapshot are not available afterward.
+	RestoreStateSnapshot(snapshot *Snapshot) error
 }
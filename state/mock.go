@@ -10,6 +10,7 @@ import (
 	"github.com/pactus-project/pactus/crypto/bls"
 	"github.com/pactus-project/pactus/crypto/hash"
 	"github.com/pactus-project/pactus/genesis"
+	"github.com/pactus-project/pactus/signer"
 	"github.com/pactus-project/pactus/state/param"
 	"github.com/pactus-project/pactus/store"
 	"github.com/pactus-project/pactus/txpool"
@@ -115,8 +116,8 @@ func (m *MockState) CommitBlock(b *block.Block, cert *certificate.BlockCertifica
 
 func (*MockState) Close() {}
 
-func (m *MockState) ProposeBlock(valKey *bls.ValidatorKey, _ crypto.Address) (*block.Block, error) {
-	blk, _ := m.ts.GenerateTestBlock(m.TestStore.LastHeight, testsuite.BlockWithProposer(valKey.Address()))
+func (m *MockState) ProposeBlock(valSigner signer.Signer, _ crypto.Address) (*block.Block, error) {
+	blk, _ := m.ts.GenerateTestBlock(m.TestStore.LastHeight, testsuite.BlockWithProposer(valSigner.Address()))
 
 	return blk, nil
 }
@@ -146,7 +147,7 @@ func (m *MockState) IsValidator(addr crypto.Address) bool {
 }
 
 func (m *MockState) TotalValidators() int32 {
-	return m.TestStore.TotalAccounts()
+	return m.TestStore.TotalValidators()
 }
 
 func (m *MockState) TotalAccounts() int32 {
@@ -186,6 +187,15 @@ func (m *MockState) CommittedTx(id tx.ID) *store.CommittedTx {
 	return trx
 }
 
+func (m *MockState) CommittedAnchor(h hash.Hash) *store.CommittedAnchor {
+	m.lk.RLock()
+	defer m.lk.RUnlock()
+
+	anc, _ := m.TestStore.Anchor(h)
+
+	return anc
+}
+
 func (m *MockState) BlockHash(height uint32) hash.Hash {
 	m.lk.RLock()
 	defer m.lk.RUnlock()
@@ -228,6 +238,30 @@ func (m *MockState) ValidatorByNumber(n int32) *validator.Validator {
 	return v
 }
 
+func (m *MockState) ValidatorMetadata(addr crypto.Address) *validator.Metadata {
+	meta, _ := m.TestStore.ValidatorMetadata(addr)
+
+	return meta
+}
+
+func (m *MockState) ValidatorRewards(valNum int32, from, to uint32) *store.RewardInfo {
+	info, _ := m.TestStore.ValidatorRewards(valNum, from, to)
+
+	return info
+}
+
+func (m *MockState) CommitteeInfo(height uint32) *store.CommitteeInfo {
+	info, _ := m.TestStore.Committee(height)
+
+	return info
+}
+
+func (m *MockState) RewardAddressRewards(addr crypto.Address, from, to uint32) *store.RewardInfo {
+	info, _ := m.TestStore.RewardAddressRewards(addr, from, to)
+
+	return info
+}
+
 func (m *MockState) PendingTx(id tx.ID) *tx.Tx {
 	return m.TestPool.PendingTx(id)
 }
@@ -268,6 +302,10 @@ func (*MockState) AllPendingTxs() []*tx.Tx {
 	return make([]*tx.Tx, 0)
 }
 
+func (m *MockState) HistoryHeight() uint32 {
+	return m.TestStore.HistoryHeight()
+}
+
 func (m *MockState) IsPruned() bool {
 	return m.TestStore.IsPruned()
 }
@@ -275,3 +313,40 @@ func (m *MockState) IsPruned() bool {
 func (m *MockState) PruningHeight() uint32 {
 	return m.TestStore.PruningHeight()
 }
+
+func (m *MockState) StateSnapshot(height uint32) store.StateSnapshot {
+	m.lk.RLock()
+	defer m.lk.RUnlock()
+
+	if m.TestStore.LastHeight == 0 || (height != 0 && height != m.TestStore.LastHeight) {
+		return nil
+	}
+	snap, _ := m.TestStore.StateSnapshot()
+
+	return snap
+}
+
+func (m *MockState) RestoreStateSnapshot(snapshot *Snapshot) error {
+	m.lk.Lock()
+	defer m.lk.Unlock()
+
+	for addr, acc := range snapshot.Accounts {
+		m.TestStore.UpdateAccount(addr, acc)
+	}
+	for _, val := range snapshot.Validators {
+		m.TestStore.UpdateValidator(val)
+	}
+	for _, pub := range snapshot.PublicKeys {
+		m.TestStore.SavePublicKey(pub.AccountAddress(), pub)
+	}
+	for i, blk := range snapshot.Blocks {
+		cert := snapshot.Certificate
+		if i < len(snapshot.Blocks)-1 {
+			cert = snapshot.Blocks[i+1].PrevCertificate()
+		}
+		m.TestStore.SaveBlock(blk, cert)
+	}
+	m.TestStore.MarkPruned()
+
+	return nil
+}
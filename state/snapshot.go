@@ -0,0 +1,229 @@
+package state
+
+import (
+	"fmt"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/store"
+	"github.com/pactus-project/pactus/types/account"
+	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/types/certificate"
+	"github.com/pactus-project/pactus/types/validator"
+	"github.com/pactus-project/pactus/util/persistentmerkle"
+	"github.com/pactus-project/pactus/util/simplemerkle"
+)
+
+// The state keeps a snapshot of the accounts and validators every `stateSnapshotInterval` blocks.
+// The nodes take the snapshots at the same heights, so a state syncing node can download
+// the chunks of a snapshot from several nodes.
+var stateSnapshotInterval = uint32(1000)
+
+// keptStateSnapshots is the number of the recent snapshots that the state keeps.
+// The previous snapshot is kept, so the state syncing nodes can finish downloading it.
+const keptStateSnapshots = 2
+
+// Snapshot contains the state at a certain height, downloaded from other nodes.
+type Snapshot struct {
+	// Blocks are the most recent blocks in order, up to the snapshot height.
+	// They should cover the transaction-to-live interval.
+	Blocks []*block.Block
+	// Certificate is the certificate of the last block.
+	Certificate *certificate.BlockCertificate
+	// StateRoot is the state root after committing the last block,
+	// taken from the header of the next block.
+	StateRoot  hash.Hash
+	Accounts   map[crypto.Address]*account.Account
+	Validators []*validator.Validator
+	// PublicKeys are the revealed public keys of the accounts.
+	PublicKeys []*bls.PublicKey
+
+	// The validator metadata, the anchors, the rewards and the committee history are not part of
+	// the state root, so they can't be verified and are not carried by the snapshot.
+	// The restored node records them from the next block on.
+}
+
+func (st *state) keepStateSnapshot() {
+	snap, err := st.store.StateSnapshot()
+	if err != nil {
+		st.logger.Warn("unable to take a state snapshot", "error", err)
+
+		return
+	}
+
+	st.snapshots = append(st.snapshots, snap)
+	if len(st.snapshots) > keptStateSnapshots {
+		st.snapshots[0].Release()
+		st.snapshots = st.snapshots[1:]
+	}
+}
+
+func (st *state) StateSnapshot(height uint32) store.StateSnapshot {
+	st.lk.RLock()
+	defer st.lk.RUnlock()
+
+	if len(st.snapshots) == 0 {
+		return nil
+	}
+
+	if height == 0 {
+		return st.snapshots[len(st.snapshots)-1]
+	}
+
+	for _, snap := range st.snapshots {
+		if snap.LastCertificate().Height() == height {
+			return snap
+		}
+	}
+
+	return nil
+}
+
+func (st *state) RestoreStateSnapshot(snapshot *Snapshot) error {
+	st.lk.Lock()
+	defer st.lk.Unlock()
+
+	if st.lastInfo.BlockHeight() != 0 {
+		return InvalidStateSnapshotError{
+			Reason: fmt.Sprintf("state is at height %d", st.lastInfo.BlockHeight()),
+		}
+	}
+
+	if err := st.checkSnapshot(snapshot); err != nil {
+		return err
+	}
+
+	for addr, acc := range snapshot.Accounts {
+		st.store.UpdateAccount(addr, acc)
+	}
+	for _, pub := range snapshot.PublicKeys {
+		st.store.SavePublicKey(pub.AccountAddress(), pub)
+	}
+	for _, val := range snapshot.Validators {
+		// The key of a genesis validator might be rotated.
+		genVal, err := st.store.ValidatorByNumber(val.Number())
+		if err == nil && genVal.Address() != val.Address() {
+			st.store.RotateValidatorKey(genVal.Address(), val)
+		} else {
+			st.store.UpdateValidator(val)
+		}
+		st.store.SavePublicKey(val.Address(), val.PublicKey())
+	}
+	for i, blk := range snapshot.Blocks {
+		cert := snapshot.Certificate
+		if i < len(snapshot.Blocks)-1 {
+			cert = snapshot.Blocks[i+1].PrevCertificate()
+		}
+		st.store.SaveBlock(blk, cert)
+	}
+	st.store.SetHistoryHeight(snapshot.Certificate.Height() + 1)
+	if err := st.store.WriteBatch(); err != nil {
+		return err
+	}
+
+	// The blocks before the snapshot are not available.
+	st.store.MarkPruned()
+
+	if err := st.tryLoadLastInfo(); err != nil {
+		return err
+	}
+
+	if err := st.initialize(); err != nil {
+		return err
+	}
+
+	st.logger.Info("state is restored from the snapshot",
+		"height", st.lastInfo.BlockHeight(), "state_root", st.stateRoot())
+
+	return nil
+}
+
+// checkSnapshot verifies the snapshot before writing it into the store.
+func (st *state) checkSnapshot(snapshot *Snapshot) error {
+	if len(snapshot.Blocks) == 0 || snapshot.Certificate == nil {
+		return InvalidStateSnapshotError{Reason: "no block"}
+	}
+
+	height := snapshot.Certificate.Height()
+	lastBlock := snapshot.Blocks[len(snapshot.Blocks)-1]
+	if lastBlock.Height() != height {
+		return InvalidStateSnapshotError{
+			Reason: fmt.Sprintf("last block height %d is not certified", lastBlock.Height()),
+		}
+	}
+
+	firstHeight := uint32(1)
+	if height > st.params.TransactionToLiveInterval {
+		firstHeight = height - st.params.TransactionToLiveInterval
+	}
+	if snapshot.Blocks[0].Height() > firstHeight {
+		return InvalidStateSnapshotError{
+			Reason: fmt.Sprintf("blocks before height %d are missing", snapshot.Blocks[0].Height()),
+		}
+	}
+
+	for i := 1; i < len(snapshot.Blocks); i++ {
+		prev := snapshot.Blocks[i-1]
+		blk := snapshot.Blocks[i]
+		if blk.Height() != prev.Height()+1 || blk.Header().PrevBlockHash() != prev.Hash() {
+			return InvalidStateSnapshotError{
+				Reason: fmt.Sprintf("block %d is not linked to the previous block", blk.Height()),
+			}
+		}
+	}
+
+	accountMerkle := persistentmerkle.New()
+	totalAccounts := int32(len(snapshot.Accounts))
+	seen := make(map[int32]bool, totalAccounts)
+	for _, acc := range snapshot.Accounts {
+		if acc.Number() < 0 || acc.Number() >= totalAccounts || seen[acc.Number()] {
+			return InvalidStateSnapshotError{Reason: fmt.Sprintf("invalid account number %d", acc.Number())}
+		}
+		seen[acc.Number()] = true
+		accountMerkle.SetHash(int(acc.Number()), acc.Hash())
+	}
+
+	validatorMerkle := persistentmerkle.New()
+	validators := make(map[int32]*validator.Validator, len(snapshot.Validators))
+	for _, val := range snapshot.Validators {
+		if val.Number() < 0 || val.Number() >= int32(len(snapshot.Validators)) || validators[val.Number()] != nil {
+			return InvalidStateSnapshotError{Reason: fmt.Sprintf("invalid validator number %d", val.Number())}
+		}
+		validators[val.Number()] = val
+		validatorMerkle.SetHash(int(val.Number()), val.Hash())
+	}
+
+	if totalAccounts == 0 || len(validators) == 0 {
+		return InvalidStateSnapshotError{Reason: "empty state"}
+	}
+
+	for addr := range st.genDoc.Accounts() {
+		if _, ok := snapshot.Accounts[addr]; !ok {
+			return InvalidStateSnapshotError{Reason: fmt.Sprintf("genesis account %s is missing", addr)}
+		}
+	}
+
+	accRoot := accountMerkle.Root()
+	valRoot := validatorMerkle.Root()
+	stateRoot := *simplemerkle.HashMerkleBranches(&accRoot, &valRoot)
+	if stateRoot != snapshot.StateRoot {
+		return InvalidStateSnapshotError{
+			Reason: fmt.Sprintf("state root mismatch, expected %s, got %s", snapshot.StateRoot, stateRoot),
+		}
+	}
+
+	committers := make([]*validator.Validator, 0, len(snapshot.Certificate.Committers()))
+	for _, num := range snapshot.Certificate.Committers() {
+		val, ok := validators[num]
+		if !ok {
+			return InvalidStateSnapshotError{Reason: fmt.Sprintf("unknown committer %d", num)}
+		}
+		committers = append(committers, val)
+	}
+	if err := snapshot.Certificate.Validate(committers, lastBlock.Hash()); err != nil {
+		return InvalidStateSnapshotError{Reason: err.Error()}
+	}
+
+	return nil
+}
@@ -0,0 +1,138 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/signer"
+	"github.com/pactus-project/pactus/store"
+	"github.com/pactus-project/pactus/txpool"
+	"github.com/pactus-project/pactus/types/account"
+	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/validator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeepStateSnapshot(t *testing.T) {
+	defaultInterval := stateSnapshotInterval
+	stateSnapshotInterval = 4
+	defer func() { stateSnapshotInterval = defaultInterval }()
+
+	td := setup(t)
+
+	assert.Equal(t, uint32(8), td.state.StateSnapshot(0).LastCertificate().Height())
+	assert.Equal(t, uint32(8), td.state.StateSnapshot(8).LastCertificate().Height())
+	assert.Nil(t, td.state.StateSnapshot(6))
+	snap4 := td.state.StateSnapshot(4)
+	require.NotNil(t, snap4)
+
+	td.commitBlocks(t, 4)
+
+	assert.Equal(t, uint32(12), td.state.StateSnapshot(0).LastCertificate().Height())
+	assert.Nil(t, td.state.StateSnapshot(4))
+	assert.True(t, snap4.(*store.MockStateSnapshot).Released)
+}
+
+func TestRestoreStateSnapshot(t *testing.T) {
+	td := setup(t)
+
+	// Revealing the public key of the genesis account and bonding a new validator
+	pub, _ := td.RandBLSKeyPair()
+	bondTrx := tx.NewBondTx(td.state.LastBlockHeight(), td.genAccKey.PublicKeyNative().AccountAddress(),
+		pub.ValidatorAddress(), pub, 1000000000, 100000)
+	td.HelperSignTransaction(td.genAccKey, bondTrx)
+	require.NoError(t, td.state.AddPendingTx(bondTrx))
+	td.commitBlocks(t, 1)
+
+	nextBlk, nextCert := td.makeBlockAndCertificate(t, 0)
+
+	srcStore := td.state.store.(*store.MockStore)
+	makeSnapshot := func() *Snapshot {
+		snapshot := &Snapshot{
+			Certificate: td.state.LastCertificate(),
+			StateRoot:   nextBlk.Header().StateRoot(),
+			Accounts:    make(map[crypto.Address]*account.Account),
+			Validators:  make([]*validator.Validator, srcStore.TotalValidators()),
+			PublicKeys:  []*bls.PublicKey{td.genAccKey.PublicKeyNative()},
+		}
+		for h := uint32(1); h <= td.state.LastBlockHeight(); h++ {
+			snapshot.Blocks = append(snapshot.Blocks, srcStore.Blocks[h])
+		}
+		for addr, acc := range srcStore.Accounts {
+			snapshot.Accounts[addr] = acc.Clone()
+		}
+		for _, val := range srcStore.Validators {
+			snapshot.Validators[val.Number()] = val.Clone()
+		}
+
+		return snapshot
+	}
+
+	newState := func() *state {
+		st, err := LoadOrNewState(td.state.genDoc, []signer.Signer{},
+			store.MockingStore(td.TestSuite), txpool.MockingTxPool(), nil)
+		require.NoError(t, err)
+
+		return st.(*state)
+	}
+
+	t.Run("State root mismatch", func(t *testing.T) {
+		snapshot := makeSnapshot()
+		snapshot.StateRoot = td.RandHash()
+
+		err := newState().RestoreStateSnapshot(snapshot)
+		assert.ErrorContains(t, err, "state root mismatch")
+	})
+
+	t.Run("Missing blocks", func(t *testing.T) {
+		snapshot := makeSnapshot()
+		snapshot.Blocks = snapshot.Blocks[1:]
+
+		err := newState().RestoreStateSnapshot(snapshot)
+		assert.ErrorIs(t, err, InvalidStateSnapshotError{Reason: "blocks before height 2 are missing"})
+	})
+
+	t.Run("Unlinked blocks", func(t *testing.T) {
+		snapshot := makeSnapshot()
+		snapshot.Blocks = append([]*block.Block{}, snapshot.Blocks...)
+		snapshot.Blocks[3], _ = td.GenerateTestBlock(4)
+
+		err := newState().RestoreStateSnapshot(snapshot)
+		assert.ErrorIs(t, err, InvalidStateSnapshotError{Reason: "block 4 is not linked to the previous block"})
+	})
+
+	t.Run("Invalid certificate", func(t *testing.T) {
+		snapshot := makeSnapshot()
+		snapshot.Certificate = td.makeCertificateAndSign(t, td.RandHash(), 0)
+		snapshot.Certificate = snapshot.Blocks[len(snapshot.Blocks)-1].PrevCertificate()
+
+		err := newState().RestoreStateSnapshot(snapshot)
+		assert.ErrorIs(t, err, InvalidStateSnapshotError{Reason: "last block height 9 is not certified"})
+	})
+
+	t.Run("Restoring the state", func(t *testing.T) {
+		st := newState()
+		require.NoError(t, st.RestoreStateSnapshot(makeSnapshot()))
+
+		assert.Equal(t, td.state.LastBlockHeight(), st.LastBlockHeight())
+		assert.Equal(t, td.state.LastBlockHash(), st.LastBlockHash())
+		assert.Equal(t, td.state.stateRoot(), st.stateRoot())
+		assert.Equal(t, td.state.TotalPower(), st.TotalPower())
+		assert.Equal(t, td.state.CommitteeValidators(), st.CommitteeValidators())
+		assert.True(t, st.IsPruned())
+		assert.Equal(t, td.state.LastBlockHeight()+1, st.HistoryHeight())
+
+		genAccPub, err := st.PublicKey(td.genAccKey.PublicKeyNative().AccountAddress())
+		require.NoError(t, err)
+		assert.Equal(t, td.genAccKey.PublicKey().Bytes(), genAccPub.Bytes())
+
+		// Continuing from the snapshot height
+		require.NoError(t, st.CommitBlock(nextBlk, nextCert))
+
+		err = st.RestoreStateSnapshot(makeSnapshot())
+		assert.ErrorIs(t, err, InvalidStateSnapshotError{Reason: "state is at height 10"})
+	})
+}
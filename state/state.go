@@ -8,12 +8,11 @@ import (
 
 	"github.com/pactus-project/pactus/committee"
 	"github.com/pactus-project/pactus/crypto"
-	"github.com/pactus-project/pactus/crypto/bls"
 	"github.com/pactus-project/pactus/crypto/hash"
 	"github.com/pactus-project/pactus/execution"
 	"github.com/pactus-project/pactus/genesis"
 	"github.com/pactus-project/pactus/sandbox"
-	"github.com/pactus-project/pactus/sortition"
+	"github.com/pactus-project/pactus/signer"
 	"github.com/pactus-project/pactus/state/lastinfo"
 	"github.com/pactus-project/pactus/state/param"
 	"github.com/pactus-project/pactus/state/score"
@@ -38,7 +37,7 @@ import (
 type state struct {
 	lk sync.RWMutex
 
-	valKeys         []*bls.ValidatorKey
+	signers         []signer.Signer
 	genDoc          *genesis.Genesis
 	store           store.Store
 	params          *param.Params
@@ -49,18 +48,19 @@ type state struct {
 	accountMerkle   *persistentmerkle.Tree
 	validatorMerkle *persistentmerkle.Tree
 	scoreMgr        *score.Manager
+	snapshots       []store.StateSnapshot
 	logger          *logger.SubLogger
 	eventCh         chan event.Event
 }
 
 func LoadOrNewState(
 	genDoc *genesis.Genesis,
-	valKeys []*bls.ValidatorKey,
+	signers []signer.Signer,
 	str store.Store,
 	txPool txpool.TxPool, eventCh chan event.Event,
 ) (Facade, error) {
 	st := &state{
-		valKeys:         valKeys,
+		signers:         signers,
 		genDoc:          genDoc,
 		txPool:          txPool,
 		params:          param.FromGenesis(genDoc.Params()),
@@ -88,11 +88,30 @@ func LoadOrNewState(
 		}
 	}
 
+	if err := st.initialize(); err != nil {
+		return nil, err
+	}
+
+	return st, nil
+}
+
+// initialize sets up the state after the last info and the committee are restored.
+func (st *state) initialize() error {
+	// Record the current committee, if the committee history has not been recorded yet.
+	st.store.SaveCommittee(st.lastInfo.BlockHeight()+1, st.committee.Committers())
+	// The nodes that are upgraded from an older version record the history from the next block on.
+	if st.store.HistoryHeight() == 0 {
+		st.store.SetHistoryHeight(st.lastInfo.BlockHeight() + 1)
+	}
+	if err := st.store.WriteBatch(); err != nil {
+		return err
+	}
+
 	st.totalPower = st.retrieveTotalPower()
 
 	st.loadMerkels()
 
-	txPool.SetNewSandboxAndRecheck(st.concreteSandbox())
+	st.txPool.SetNewSandboxAndRecheck(st.concreteSandbox())
 
 	// Restoring score manager
 	st.logger.Info("calculating the availability scores...")
@@ -107,7 +126,12 @@ func LoadOrNewState(
 	for h := startHeight; h <= endHeight; h++ {
 		cb, err := st.store.Block(h)
 		if err != nil {
-			return nil, err
+			if st.store.IsPruned() {
+				// The old blocks might not be available in prune mode.
+				continue
+			}
+
+			return err
 		}
 		// This code decodes the block certificate from the block data
 		// without decoding the header and transactions.
@@ -115,7 +139,7 @@ func LoadOrNewState(
 		cert := new(certificate.BlockCertificate)
 		err = cert.Decode(r)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		scoreMgr.SetCertificate(cert)
 	}
@@ -127,7 +151,7 @@ func LoadOrNewState(
 
 	st.logger.Debug("last info", "committers", st.committee.Committers(), "state_root", st.stateRoot())
 
-	return st, nil
+	return nil
 }
 
 func (st *state) concreteSandbox() sandbox.Sandbox {
@@ -227,6 +251,9 @@ func (st *state) Close() {
 	st.lk.RLock()
 	defer st.lk.RUnlock()
 
+	for _, snap := range st.snapshots {
+		snap.Release()
+	}
 	st.store.Close()
 }
 
@@ -312,7 +339,7 @@ func (st *state) createSubsidyTx(rewardAddr crypto.Address, fee amount.Amount) *
 	return transaction
 }
 
-func (st *state) ProposeBlock(valKey *bls.ValidatorKey, rewardAddr crypto.Address) (*block.Block, error) {
+func (st *state) ProposeBlock(valSigner signer.Signer, rewardAddr crypto.Address) (*block.Block, error) {
 	st.lk.Lock()
 	defer st.lk.Unlock()
 
@@ -349,6 +376,10 @@ func (st *state) ProposeBlock(valKey *bls.ValidatorKey, rewardAddr crypto.Addres
 	}
 	txs.Prepend(subsidyTx)
 	prevSeed := st.lastInfo.SortitionSeed()
+	newSeed, err := valSigner.SignSeed(prevSeed)
+	if err != nil {
+		return nil, err
+	}
 
 	blk := block.MakeBlock(
 		st.params.BlockVersion,
@@ -357,8 +388,8 @@ func (st *state) ProposeBlock(valKey *bls.ValidatorKey, rewardAddr crypto.Addres
 		st.lastInfo.BlockHash(),
 		st.stateRoot(),
 		st.lastInfo.Certificate(),
-		prevSeed.GenerateNext(valKey.PrivateKey()),
-		valKey.Address())
+		newSeed,
+		valSigner.Address())
 
 	return blk, nil
 }
@@ -432,8 +463,12 @@ func (st *state) CommitBlock(blk *block.Block, cert *certificate.BlockCertificat
 	st.lastInfo.UpdateCertificate(cert)
 	st.lastInfo.UpdateValidators(st.committee.Validators())
 
+	// Record the proposer's reward before updating the committee
+	st.saveReward(blk, sb, cert.Round())
+
 	// Commit and update the committee
 	st.commitSandbox(sb, cert.Round())
+	st.store.SaveCommittee(height+1, st.committee.Committers())
 
 	st.store.SaveBlock(blk, cert)
 
@@ -448,6 +483,10 @@ func (st *state) CommitBlock(blk *block.Block, cert *certificate.BlockCertificat
 
 	st.logger.Info("new block committed", "block", blk, "round", cert.Round())
 
+	if height%stateSnapshotInterval == 0 {
+		st.keepStateSnapshot()
+	}
+
 	st.evaluateSortition()
 
 	// -----------------------------------
@@ -472,10 +511,20 @@ func (st *state) CommitBlock(blk *block.Block, cert *certificate.BlockCertificat
 	return nil
 }
 
+// saveReward records the block reward and the fees earned by the proposer of the block.
+func (st *state) saveReward(blk *block.Block, sb sandbox.Sandbox, round int16) {
+	proposer := st.committee.Proposer(round)
+	subsidyTx := blk.Transactions()[0]
+	rewardAddr := *subsidyTx.Payload().Receiver()
+
+	st.store.SaveReward(blk.Height(), proposer.Number(), rewardAddr,
+		st.params.BlockReward, sb.AccumulatedFee())
+}
+
 func (st *state) evaluateSortition() bool {
 	evaluated := false
-	for _, key := range st.valKeys {
-		val, _ := st.store.Validator(key.Address())
+	for _, valSigner := range st.signers {
+		val, _ := st.store.Validator(valSigner.Address())
 		if val == nil {
 			// We are not a validator
 			continue
@@ -491,22 +540,31 @@ func (st *state) evaluateSortition() bool {
 			continue
 		}
 
-		ok, proof := sortition.EvaluateSortition(st.lastInfo.SortitionSeed(), key.PrivateKey(), st.totalPower, val.Power())
+		ok, proof, err := valSigner.EvaluateSortition(st.lastInfo.SortitionSeed(), st.totalPower, val.Power())
+		if err != nil {
+			st.logger.Error("unable to evaluate the sortition",
+				"address", valSigner.Address(), "error", err)
+
+			continue
+		}
 		if ok {
 			trx := tx.NewSortitionTx(st.lastInfo.BlockHeight(), val.Address(), proof)
-			sig := key.Sign(trx.SignBytes())
-			trx.SetSignature(sig)
-			trx.SetPublicKey(key.PublicKey())
+			if err := valSigner.SignSortitionTx(trx); err != nil {
+				st.logger.Error("unable to sign the sortition transaction",
+					"address", valSigner.Address(), "error", err)
+
+				continue
+			}
 
 			err := st.txPool.AppendTxAndBroadcast(trx)
 			if err == nil {
 				st.logger.Info("sortition transaction broadcasted",
-					"address", key.Address(), "power", val.Power(), "tx", trx)
+					"address", valSigner.Address(), "power", val.Power(), "tx", trx)
 
 				evaluated = true
 			} else {
 				st.logger.Error("our sortition transaction is invalid!",
-					"address", key.Address(), "power", val.Power(), "tx", trx, "error", err)
+					"address", valSigner.Address(), "power", val.Power(), "tx", trx, "error", err)
 			}
 		}
 	}
@@ -539,6 +597,14 @@ func (st *state) commitSandbox(sb sandbox.Sandbox, round int16) {
 		}
 	})
 
+	// Key rotations should be applied before updating the validators,
+	// so the rotated validators are moved to their new addresses.
+	sb.IterateRotatedValidators(func(oldAddr crypto.Address, val *validator.Validator) {
+		st.logger.Info("validator key rotated", "old", oldAddr, "new", val.Address())
+
+		st.store.RotateValidatorKey(oldAddr, val)
+	})
+
 	sb.IterateValidators(func(val *validator.Validator, updated bool, _ bool) {
 		if updated {
 			st.store.UpdateValidator(val)
@@ -546,6 +612,10 @@ func (st *state) commitSandbox(sb sandbox.Sandbox, round int16) {
 		}
 	})
 
+	sb.IterateValidatorMetadata(func(addr crypto.Address, meta *validator.Metadata) {
+		st.store.UpdateValidatorMetadata(addr, meta)
+	})
+
 	st.totalPower += sb.PowerDelta()
 }
 
@@ -655,6 +725,15 @@ func (st *state) CommittedTx(id tx.ID) *store.CommittedTx {
 	return transaction
 }
 
+func (st *state) CommittedAnchor(h hash.Hash) *store.CommittedAnchor {
+	anc, err := st.store.Anchor(h)
+	if err != nil {
+		st.logger.Trace("searching anchor in local store failed", "hash", h, "error", err)
+	}
+
+	return anc
+}
+
 func (st *state) BlockHash(height uint32) hash.Hash {
 	return st.store.BlockHash(height)
 }
@@ -695,6 +774,46 @@ func (st *state) ValidatorByNumber(n int32) *validator.Validator {
 	return val
 }
 
+// ValidatorMetadata returns the metadata published by the validator.
+// It returns nil if the validator has not published any metadata.
+func (st *state) ValidatorMetadata(addr crypto.Address) *validator.Metadata {
+	meta, err := st.store.ValidatorMetadata(addr)
+	if err != nil {
+		st.logger.Trace("error on retrieving validator metadata", "error", err)
+	}
+
+	return meta
+}
+
+func (st *state) ValidatorRewards(valNum int32, from, to uint32) *store.RewardInfo {
+	info, err := st.store.ValidatorRewards(valNum, from, to)
+	if err != nil {
+		st.logger.Trace("error on retrieving validator rewards", "error", err)
+	}
+
+	return info
+}
+
+func (st *state) RewardAddressRewards(addr crypto.Address, from, to uint32) *store.RewardInfo {
+	info, err := st.store.RewardAddressRewards(addr, from, to)
+	if err != nil {
+		st.logger.Trace("error on retrieving reward address rewards", "error", err)
+	}
+
+	return info
+}
+
+// CommitteeInfo returns the committee members that are in charge of the block at the given height.
+// It returns nil if the committee history is not recorded for the given height.
+func (st *state) CommitteeInfo(height uint32) *store.CommitteeInfo {
+	info, err := st.store.Committee(height)
+	if err != nil {
+		st.logger.Trace("error on retrieving committee", "height", height, "error", err)
+	}
+
+	return info
+}
+
 func (st *state) PendingTx(id tx.ID) *tx.Tx {
 	return st.txPool.PendingTx(id)
 }
@@ -760,6 +879,10 @@ func (st *state) AllPendingTxs() []*tx.Tx {
 	return st.txPool.AllPendingTxs()
 }
 
+func (st *state) HistoryHeight() uint32 {
+	return st.store.HistoryHeight()
+}
+
 func (st *state) IsPruned() bool {
 	return st.store.IsPruned()
 }
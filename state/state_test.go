@@ -7,7 +7,9 @@ import (
 	"github.com/pactus-project/pactus/crypto"
 	"github.com/pactus-project/pactus/crypto/bls"
 	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/execution/executor"
 	"github.com/pactus-project/pactus/genesis"
+	"github.com/pactus-project/pactus/signer"
 	"github.com/pactus-project/pactus/store"
 	"github.com/pactus-project/pactus/txpool"
 	"github.com/pactus-project/pactus/types/account"
@@ -28,6 +30,7 @@ type testData struct {
 	*testsuite.TestSuite
 
 	state        *state
+	valKeys      []*bls.ValidatorKey
 	genValKeys   []*bls.ValidatorKey
 	genAccKey    *bls.PrivateKey
 	commonTxPool *txpool.MockTxPool
@@ -73,7 +76,8 @@ func setup(t *testing.T) *testData {
 
 	// First validator is in the committee
 	valKeys := []*bls.ValidatorKey{genValKeys[0], ts.RandValKey()}
-	st1, err := LoadOrNewState(gnDoc, valKeys, mockStore, mockTxPool, nil)
+	signers := []signer.Signer{signer.NewLocalSigner(valKeys[0]), signer.NewLocalSigner(valKeys[1])}
+	st1, err := LoadOrNewState(gnDoc, signers, mockStore, mockTxPool, nil)
 	require.NoError(t, err)
 
 	state, _ := st1.(*state)
@@ -81,6 +85,7 @@ func setup(t *testing.T) *testData {
 	td := &testData{
 		TestSuite:    ts,
 		state:        state,
+		valKeys:      valKeys,
 		genValKeys:   genValKeys,
 		genAccKey:    genAccPrvKey,
 		commonTxPool: mockTxPool,
@@ -101,7 +106,7 @@ func (td *testData) makeBlockAndCertificate(t *testing.T, round int16) (
 		return e.Address() == blockProposer.Address()
 	})
 	valKey := td.genValKeys[valKeyIndex]
-	blk, _ := td.state.ProposeBlock(valKey, td.RandAccAddress())
+	blk, _ := td.state.ProposeBlock(signer.NewLocalSigner(valKey), td.RandAccAddress())
 	cert := td.makeCertificateAndSign(t, blk.Hash(), round)
 
 	return blk, cert
@@ -157,6 +162,26 @@ func TestBlockSubsidyTx(t *testing.T) {
 	assert.Equal(t, rewardAddr, trx.Payload().(*payload.TransferPayload).To)
 }
 
+func TestSaveReward(t *testing.T) {
+	td := setup(t)
+
+	blk, cert := td.makeBlockAndCertificate(t, 0)
+	proposer := td.state.Proposer(0)
+	rewardAddr := *blk.Transactions()[0].Payload().Receiver()
+	before := td.state.ValidatorRewards(proposer.Number(), 0, 0)
+
+	require.NoError(t, td.state.CommitBlock(blk, cert))
+
+	after := td.state.ValidatorRewards(proposer.Number(), 0, 0)
+	assert.Equal(t, before.BlocksProposed+1, after.BlocksProposed)
+	assert.Equal(t, before.BlockRewards+td.state.params.BlockReward, after.BlockRewards)
+
+	info := td.state.RewardAddressRewards(rewardAddr, cert.Height(), cert.Height())
+	assert.Equal(t, uint32(1), info.BlocksProposed)
+	assert.Equal(t, td.state.params.BlockReward, info.BlockRewards)
+	assert.Zero(t, info.Fees)
+}
+
 func TestGenesisHash(t *testing.T) {
 	td := setup(t)
 
@@ -337,7 +362,7 @@ func TestBlockProposal(t *testing.T) {
 	td := setup(t)
 
 	t.Run("validity of the proposed block", func(t *testing.T) {
-		b, err := td.state.ProposeBlock(td.state.valKeys[0], td.RandAccAddress())
+		b, err := td.state.ProposeBlock(signer.NewLocalSigner(td.valKeys[0]), td.RandAccAddress())
 		assert.NoError(t, err)
 		assert.NoError(t, td.state.ValidateBlock(b, 0))
 	})
@@ -346,7 +371,7 @@ func TestBlockProposal(t *testing.T) {
 		trx := td.state.createSubsidyTx(td.RandAccAddress(), 0)
 		assert.NoError(t, td.state.AddPendingTx(trx))
 
-		b, err := td.state.ProposeBlock(td.state.valKeys[0], td.RandAccAddress())
+		b, err := td.state.ProposeBlock(signer.NewLocalSigner(td.valKeys[0]), td.RandAccAddress())
 		assert.NoError(t, err)
 		assert.NoError(t, td.state.ValidateBlock(b, 0))
 		assert.Equal(t, 1, b.Transactions().Len())
@@ -386,7 +411,7 @@ func TestForkDetection(t *testing.T) {
 func TestSortition(t *testing.T) {
 	td := setup(t)
 
-	secValKey := td.state.valKeys[1]
+	secValKey := td.valKeys[1]
 	assert.False(t, td.state.evaluateSortition()) //  not a validator
 	assert.False(t, td.state.IsValidator(secValKey.Address()))
 	assert.Equal(t, int64(4), td.state.CommitteePower())
@@ -414,6 +439,18 @@ func TestSortition(t *testing.T) {
 	assert.True(t, td.state.IsValidator(secValKey.Address()))
 	assert.Equal(t, int64(1000000004), td.state.CommitteePower())
 	assert.True(t, td.state.committee.Contains(secValKey.Address())) // In the committee
+
+	// The committee history is recorded.
+	secVal := td.state.ValidatorByAddress(secValKey.Address())
+	genInfo := td.state.CommitteeInfo(1)
+	assert.Len(t, genInfo.Members, 4)
+	lastInfo := td.state.CommitteeInfo(td.state.LastBlockHeight() + 1)
+	assert.Equal(t, td.state.LastBlockHeight()+1, lastInfo.Height)
+	assert.Contains(t, lastInfo.Members, store.CommitteeMember{
+		ValidatorNumber: secVal.Number(),
+		JoinedHeight:    td.state.LastBlockHeight() + 1,
+	})
+	assert.Nil(t, td.state.CommitteeInfo(0))
 }
 
 func TestValidateBlockTime(t *testing.T) {
@@ -537,7 +574,7 @@ func TestLoadState(t *testing.T) {
 	blk6, cert6 := td.makeBlockAndCertificate(t, 0)
 
 	// Load last state info
-	newState, err := LoadOrNewState(td.state.genDoc, td.state.valKeys,
+	newState, err := LoadOrNewState(td.state.genDoc, td.state.signers,
 		td.state.store, td.commonTxPool, nil)
 	require.NoError(t, err)
 
@@ -556,6 +593,31 @@ func TestLoadState(t *testing.T) {
 	require.NoError(t, newState.CommitBlock(blk6, cert6))
 }
 
+func TestHistoryHeight(t *testing.T) {
+	td := setup(t)
+
+	// The history is recorded from the genesis block.
+	assert.Equal(t, uint32(1), td.state.HistoryHeight())
+
+	// An upgraded node has no history before the upgrade.
+	upgradeHeight := td.state.LastBlockHeight() + 1
+	td.state.store.(*store.MockStore).HistHeight = 0
+	newState, err := LoadOrNewState(td.state.genDoc, td.state.signers,
+		td.state.store, td.commonTxPool, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, upgradeHeight, newState.HistoryHeight())
+
+	// It is not changed afterward.
+	blk, cert := td.makeBlockAndCertificate(t, 0)
+	require.NoError(t, newState.CommitBlock(blk, cert))
+	newState, err = LoadOrNewState(td.state.genDoc, td.state.signers,
+		td.state.store, td.commonTxPool, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, upgradeHeight, newState.HistoryHeight())
+}
+
 func TestIsValidator(t *testing.T) {
 	td := setup(t)
 
@@ -595,7 +657,7 @@ func TestCheckMaximumTransactionPerBlock(t *testing.T) {
 		assert.NoError(t, err)
 	}
 
-	blk, err := td.state.ProposeBlock(td.state.valKeys[0], td.RandAccAddress())
+	blk, err := td.state.ProposeBlock(signer.NewLocalSigner(td.valKeys[0]), td.RandAccAddress())
 	assert.NoError(t, err)
 	assert.Equal(t, td.state.params.MaxTransactionsPerBlock, blk.Transactions().Len())
 }
@@ -624,3 +686,56 @@ func TestCommittedBlock(t *testing.T) {
 		assert.Equal(t, blkLast.Hash(), td.state.LastBlockHash())
 	})
 }
+
+func TestChainedKeyRotations(t *testing.T) {
+	td := setup(t)
+
+	// A validator outside the committee.
+	keyA := td.RandValKey()
+	sb := td.state.concreteSandbox()
+	valA := sb.MakeNewValidator(keyA.PublicKey())
+	valA.AddToStake(1e12)
+	sb.UpdateValidator(valA)
+	td.state.commitSandbox(sb, 0)
+
+	keyB := td.RandValKey()
+	pubC, prvC := td.RandBLSKeyPair()
+	lockTime := td.state.LastBlockHeight()
+	fee := td.state.CalculateFee(0, payload.TypeKeyRotation)
+
+	trx1 := tx.NewKeyRotationTx(lockTime, keyA.Address(), keyB.PublicKey(),
+		keyB.PrivateKey().SignNative(payload.KeyRotationProofBytes(keyA.Address(), keyB.PublicKey())), fee)
+	td.HelperSignTransaction(keyA.PrivateKey(), trx1)
+	trx2 := tx.NewKeyRotationTx(lockTime, keyB.Address(), pubC,
+		prvC.SignNative(payload.KeyRotationProofBytes(keyB.Address(), pubC)), fee)
+	td.HelperSignTransaction(keyB.PrivateKey(), trx2)
+
+	require.NoError(t, td.state.AddPendingTx(trx1))
+	require.NoError(t, td.state.AddPendingTx(trx2))
+
+	// The second rotation is not included in the proposed block.
+	blk, _ := td.makeBlockAndCertificate(t, 0)
+	require.Equal(t, 2, blk.Transactions().Len())
+	assert.Equal(t, trx1.ID(), blk.Transactions()[1].ID())
+
+	txs := block.Txs{td.state.createSubsidyTx(td.RandAccAddress(), 2*fee), trx1, trx2}
+	invBlk := block.NewBlock(blk.Header(), blk.PrevCertificate(), txs)
+
+	t.Run("Block with chained rotations should be rejected", func(t *testing.T) {
+		assert.ErrorIs(t, td.state.ValidateBlock(invBlk, 0), executor.ErrValidatorKeyRotated)
+	})
+
+	t.Run("Committing a certified block with chained rotations", func(t *testing.T) {
+		// Certified blocks are executed without checks, so the sandbox collapses
+		// the chained rotations instead of halting the chain.
+		invCert := td.makeCertificateAndSign(t, invBlk.Hash(), 0)
+		require.NoError(t, td.state.CommitBlock(invBlk, invCert))
+
+		assert.Nil(t, td.state.ValidatorByAddress(keyA.Address()))
+		assert.Nil(t, td.state.ValidatorByAddress(keyB.Address()))
+		valC := td.state.ValidatorByAddress(pubC.ValidatorAddress())
+		require.NotNil(t, valC)
+		assert.Equal(t, valA.Number(), valC.Number())
+		assert.Equal(t, valA.Stake()-2*fee, valC.Stake())
+	})
+}
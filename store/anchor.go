@@ -0,0 +1,74 @@
+package store
+
+import (
+	"bytes"
+
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/types/tx/payload"
+	"github.com/pactus-project/pactus/util/encoding"
+	"github.com/syndtr/goleveldb/leveldb"
+)
+
+func anchorKey(h hash.Hash) []byte { return append(anchorPrefix, h.Bytes()...) }
+
+type anchorStore struct {
+	db *leveldb.DB
+}
+
+func newAnchorStore(db *leveldb.DB) *anchorStore {
+	return &anchorStore{
+		db: db,
+	}
+}
+
+// saveAnchors indexes the anchored hashes of the block by their hash.
+// Only the first inclusion of a hash is indexed, since it proves the
+// earliest time that the document existed.
+func (as *anchorStore) saveAnchors(batch *leveldb.Batch, height uint32, blk *block.Block) {
+	blockTime := blk.Header().UnixTime()
+	indexed := make(map[hash.Hash]bool)
+	for _, trx := range blk.Transactions() {
+		if !trx.IsAnchorTx() {
+			continue
+		}
+
+		id := trx.ID()
+		pld := trx.Payload().(*payload.AnchorPayload)
+		for _, h := range pld.Hashes {
+			if indexed[h] || as.hasAnchor(h) {
+				continue
+			}
+
+			w := bytes.NewBuffer(make([]byte, 0, 4+4+32))
+			err := encoding.WriteElements(w, &height, &blockTime, &id)
+			if err != nil {
+				panic(err)
+			}
+
+			batch.Put(anchorKey(h), w.Bytes())
+			indexed[h] = true
+		}
+	}
+}
+
+func (as *anchorStore) hasAnchor(h hash.Hash) bool {
+	return tryHas(as.db, anchorKey(h))
+}
+
+func (as *anchorStore) anchor(h hash.Hash) (*CommittedAnchor, error) {
+	data, err := tryGet(as.db, anchorKey(h))
+	if err != nil {
+		return nil, err
+	}
+
+	anc := &CommittedAnchor{
+		AnchorHash: h,
+	}
+	r := bytes.NewReader(data)
+	if err := encoding.ReadElements(r, &anc.Height, &anc.BlockTime, &anc.TxID); err != nil {
+		return nil, err
+	}
+
+	return anc, nil
+}
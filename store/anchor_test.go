@@ -0,0 +1,60 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnchor(t *testing.T) {
+	td := setup(t, nil)
+
+	hash1 := td.RandHash()
+	hash2 := td.RandHash()
+	hash3 := td.RandHash()
+
+	trx1 := td.GenerateTestAnchorTx([]hash.Hash{hash1, hash2})
+	trx2 := td.GenerateTestAnchorTx([]hash.Hash{hash2, hash3})
+	height := td.store.LastCertificate().Height() + 1
+	blk, cert := td.GenerateTestBlock(height,
+		testsuite.BlockWithTransactions(block.Txs{trx1, trx2}))
+	td.store.SaveBlock(blk, cert)
+	require.NoError(t, td.store.WriteBatch())
+
+	t.Run("Unknown anchor", func(t *testing.T) {
+		anc, err := td.store.Anchor(td.RandHash())
+		assert.Error(t, err)
+		assert.Nil(t, anc)
+	})
+
+	t.Run("Should return the anchor information", func(t *testing.T) {
+		anc, err := td.store.Anchor(hash1)
+		require.NoError(t, err)
+
+		assert.Equal(t, hash1, anc.AnchorHash)
+		assert.Equal(t, trx1.ID(), anc.TxID)
+		assert.Equal(t, height, anc.Height)
+		assert.Equal(t, blk.Header().UnixTime(), anc.BlockTime)
+	})
+
+	t.Run("Should keep the first inclusion of a hash", func(t *testing.T) {
+		trx3 := td.GenerateTestAnchorTx([]hash.Hash{hash3})
+		blk, cert := td.GenerateTestBlock(height+1,
+			testsuite.BlockWithTransactions(block.Txs{trx3}))
+		td.store.SaveBlock(blk, cert)
+		require.NoError(t, td.store.WriteBatch())
+
+		anc2, err := td.store.Anchor(hash2)
+		require.NoError(t, err)
+		assert.Equal(t, trx1.ID(), anc2.TxID)
+
+		anc3, err := td.store.Anchor(hash3)
+		require.NoError(t, err)
+		assert.Equal(t, trx2.ID(), anc3.TxID)
+		assert.Equal(t, height, anc3.Height)
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/pactus-project/pactus/crypto/hash"
 	"github.com/pactus-project/pactus/sortition"
 	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/types/tx/payload"
 	"github.com/pactus-project/pactus/util"
 	"github.com/pactus-project/pactus/util/encoding"
 	"github.com/pactus-project/pactus/util/pairslice"
@@ -83,6 +84,16 @@ func (bs *blockStore) saveBlock(batch *leveldb.Batch, height uint32, blk *block.
 			}
 		}
 
+		if trx.IsKeyRotationTx() {
+			// Index the new public key of the validator, so it can be retrieved
+			// by its new address. The old public key is kept for historical transactions.
+			newPubKey := trx.Payload().(*payload.KeyRotationPayload).NewPublicKey
+			newAddr := newPubKey.ValidatorAddress()
+			if !bs.hasPublicKey(newAddr) {
+				batch.Put(publicKeyKey(newAddr), newPubKey.Bytes())
+			}
+		}
+
 		err := trx.Encode(w)
 		if err != nil {
 			panic(err) // Should we panic?
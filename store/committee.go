@@ -0,0 +1,195 @@
+package store
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"slices"
+
+	"github.com/pactus-project/pactus/util/encoding"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// The committee records are kept only for the heights that the committee members change.
+// The keys contain the height in big-endian format, so the committee at any height
+// is the last record at or before that height.
+
+func committeeKey(height uint32) []byte {
+	return binary.BigEndian.AppendUint32(append([]byte{}, committeePrefix...), height)
+}
+
+type committeeStore struct {
+	db *leveldb.DB
+	// last keeps the last saved record, since it might not be in the database yet.
+	last *CommitteeInfo
+}
+
+func newCommitteeStore(db *leveldb.DB) *committeeStore {
+	return &committeeStore{
+		db: db,
+	}
+}
+
+// saveCommittee records the committee members at the given height,
+// if they are different from the members of the last record.
+func (cs *committeeStore) saveCommittee(batch *leveldb.Batch, height uint32, members []int32) {
+	last, err := cs.lastCommittee()
+	if err != nil {
+		panic(err)
+	}
+
+	info := &CommitteeInfo{
+		Height:  height,
+		Members: make([]CommitteeMember, 0, len(members)),
+		Joined:  make([]int32, 0),
+		Left:    make([]CommitteeMember, 0),
+	}
+
+	joinedHeights := make(map[int32]uint32)
+	if last != nil {
+		for _, m := range last.Members {
+			joinedHeights[m.ValidatorNumber] = m.JoinedHeight
+		}
+	}
+
+	for _, num := range members {
+		joinedHeight, ok := joinedHeights[num]
+		if !ok {
+			joinedHeight = height
+			info.Joined = append(info.Joined, num)
+		}
+		info.Members = append(info.Members, CommitteeMember{
+			ValidatorNumber: num,
+			JoinedHeight:    joinedHeight,
+		})
+	}
+
+	if last != nil {
+		for _, m := range last.Members {
+			if !slices.Contains(members, m.ValidatorNumber) {
+				m.LeftHeight = height
+				info.Left = append(info.Left, m)
+			}
+		}
+
+		if len(info.Joined) == 0 && len(info.Left) == 0 {
+			return
+		}
+	}
+
+	batch.Put(committeeKey(height), encodeCommitteeInfo(info))
+	cs.last = info
+}
+
+func (cs *committeeStore) lastCommittee() (*CommitteeInfo, error) {
+	if cs.last != nil {
+		return cs.last, nil
+	}
+
+	iter := cs.db.NewIterator(util.BytesPrefix(committeePrefix), nil)
+	defer iter.Release()
+
+	if !iter.Last() {
+		return nil, iter.Error()
+	}
+
+	info, err := decodeCommitteeInfo(iter.Key(), iter.Value())
+	if err != nil {
+		return nil, err
+	}
+	cs.last = info
+
+	return info, nil
+}
+
+// committee returns the last record at or before the given height.
+func (cs *committeeStore) committee(height uint32) (*CommitteeInfo, error) {
+	rng := util.BytesPrefix(committeePrefix)
+	rng.Limit = committeeKey(height)
+	rng.Limit = append(rng.Limit, 0x00)
+
+	iter := cs.db.NewIterator(rng, nil)
+	defer iter.Release()
+
+	if !iter.Last() {
+		if err := iter.Error(); err != nil {
+			return nil, err
+		}
+
+		return nil, ErrNotFound
+	}
+
+	return decodeCommitteeInfo(iter.Key(), iter.Value())
+}
+
+func encodeCommitteeInfo(info *CommitteeInfo) []byte {
+	w := bytes.NewBuffer(make([]byte, 0, 2+(len(info.Members)+len(info.Left))*8))
+	writeMembers := func(members []CommitteeMember) {
+		err := encoding.WriteVarInt(w, uint64(len(members)))
+		if err != nil {
+			panic(err)
+		}
+		for _, m := range members {
+			err := encoding.WriteElements(w, m.ValidatorNumber, m.JoinedHeight)
+			if err != nil {
+				panic(err)
+			}
+		}
+	}
+
+	writeMembers(info.Members)
+	writeMembers(info.Left)
+
+	return w.Bytes()
+}
+
+func decodeCommitteeInfo(key, data []byte) (*CommitteeInfo, error) {
+	if len(key) != len(committeePrefix)+4 {
+		return nil, errors.New("invalid committee key")
+	}
+
+	info := &CommitteeInfo{
+		Height: binary.BigEndian.Uint32(key[len(committeePrefix):]),
+		Joined: make([]int32, 0),
+	}
+
+	r := bytes.NewReader(data)
+	readMembers := func() ([]CommitteeMember, error) {
+		count, err := encoding.ReadVarInt(r)
+		if err != nil {
+			return nil, err
+		}
+		members := make([]CommitteeMember, 0)
+		for i := uint64(0); i < count; i++ {
+			m := CommitteeMember{}
+			if err := encoding.ReadElements(r, &m.ValidatorNumber, &m.JoinedHeight); err != nil {
+				return nil, err
+			}
+			members = append(members, m)
+		}
+
+		return members, nil
+	}
+
+	var err error
+	info.Members, err = readMembers()
+	if err != nil {
+		return nil, err
+	}
+	info.Left, err = readMembers()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, m := range info.Members {
+		if m.JoinedHeight == info.Height {
+			info.Joined = append(info.Joined, m.ValidatorNumber)
+		}
+	}
+	for i := range info.Left {
+		info.Left[i].LeftHeight = info.Height
+	}
+
+	return info, nil
+}
@@ -0,0 +1,93 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommittee(t *testing.T) {
+	td := setup(t, nil)
+
+	_, err := td.store.Committee(1)
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	td.store.SaveCommittee(1, []int32{0, 1, 2, 3})
+	require.NoError(t, td.store.WriteBatch())
+
+	// Unchanged members are not recorded.
+	td.store.SaveCommittee(2, []int32{1, 2, 3, 0})
+	require.NoError(t, td.store.WriteBatch())
+
+	// Validator 4 joins and validator 0 leaves at height 5.
+	td.store.SaveCommittee(5, []int32{1, 2, 3, 4})
+	// Validator 5 joins and validator 1 leaves at height 7, before writing the batch.
+	td.store.SaveCommittee(7, []int32{2, 3, 4, 5})
+	require.NoError(t, td.store.WriteBatch())
+
+	t.Run("Genesis committee", func(t *testing.T) {
+		info, err := td.store.Committee(4)
+		require.NoError(t, err)
+
+		assert.Equal(t, uint32(1), info.Height)
+		assert.Equal(t, []int32{0, 1, 2, 3}, info.Joined)
+		assert.Empty(t, info.Left)
+		assert.Equal(t, []CommitteeMember{
+			{ValidatorNumber: 0, JoinedHeight: 1},
+			{ValidatorNumber: 1, JoinedHeight: 1},
+			{ValidatorNumber: 2, JoinedHeight: 1},
+			{ValidatorNumber: 3, JoinedHeight: 1},
+		}, info.Members)
+	})
+
+	t.Run("Changed committee", func(t *testing.T) {
+		info, err := td.store.Committee(5)
+		require.NoError(t, err)
+
+		assert.Equal(t, uint32(5), info.Height)
+		assert.Equal(t, []int32{4}, info.Joined)
+		assert.Equal(t, []CommitteeMember{
+			{ValidatorNumber: 0, JoinedHeight: 1, LeftHeight: 5},
+		}, info.Left)
+		assert.Equal(t, []CommitteeMember{
+			{ValidatorNumber: 1, JoinedHeight: 1},
+			{ValidatorNumber: 2, JoinedHeight: 1},
+			{ValidatorNumber: 3, JoinedHeight: 1},
+			{ValidatorNumber: 4, JoinedHeight: 5},
+		}, info.Members)
+	})
+
+	t.Run("Last committee", func(t *testing.T) {
+		info, err := td.store.Committee(100)
+		require.NoError(t, err)
+
+		assert.Equal(t, uint32(7), info.Height)
+		assert.Equal(t, []int32{5}, info.Joined)
+		assert.Equal(t, []CommitteeMember{
+			{ValidatorNumber: 1, JoinedHeight: 1, LeftHeight: 7},
+		}, info.Left)
+	})
+
+	t.Run("Reopen the store", func(t *testing.T) {
+		td.store.Close()
+		s, err := NewStore(td.store.config)
+		require.NoError(t, err)
+
+		// The join heights are kept for the remaining members.
+		s.SaveCommittee(9, []int32{3, 4, 5, 6})
+		require.NoError(t, s.WriteBatch())
+
+		info, err := s.Committee(9)
+		require.NoError(t, err)
+		assert.Equal(t, []CommitteeMember{
+			{ValidatorNumber: 3, JoinedHeight: 1},
+			{ValidatorNumber: 4, JoinedHeight: 5},
+			{ValidatorNumber: 5, JoinedHeight: 7},
+			{ValidatorNumber: 6, JoinedHeight: 9},
+		}, info.Members)
+		assert.Equal(t, []CommitteeMember{
+			{ValidatorNumber: 2, JoinedHeight: 1, LeftHeight: 9},
+		}, info.Left)
+	})
+}
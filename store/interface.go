@@ -6,6 +6,7 @@ import (
 	"github.com/pactus-project/pactus/crypto/hash"
 	"github.com/pactus-project/pactus/sortition"
 	"github.com/pactus-project/pactus/types/account"
+	"github.com/pactus-project/pactus/types/amount"
 	"github.com/pactus-project/pactus/types/block"
 	"github.com/pactus-project/pactus/types/certificate"
 	"github.com/pactus-project/pactus/types/tx"
@@ -78,6 +79,53 @@ func (s *CommittedTx) ToTx() (*tx.Tx, error) {
 	return trx, nil
 }
 
+// CommittedAnchor holds the information about the first inclusion of
+// an anchored hash in the blockchain.
+type CommittedAnchor struct {
+	AnchorHash hash.Hash
+	TxID       tx.ID
+	Height     uint32
+	BlockTime  uint32
+}
+
+// RewardInfo holds the accumulated rewards of a block proposer.
+type RewardInfo struct {
+	BlocksProposed uint32
+	BlockRewards   amount.Amount
+	Fees           amount.Amount
+}
+
+// CommitteeMember holds the membership of a validator in the committee.
+type CommitteeMember struct {
+	ValidatorNumber int32
+	// JoinedHeight is the first height that the validator is in the committee.
+	JoinedHeight uint32
+	// LeftHeight is the first height that the validator is not in the committee anymore.
+	// It is zero for the current members.
+	LeftHeight uint32
+}
+
+// CommitteeInfo holds the committee members since a height,
+// along with the changes from the previous committee.
+type CommitteeInfo struct {
+	// Height is the height that the committee members changed.
+	Height  uint32
+	Members []CommitteeMember
+	Joined  []int32
+	Left    []CommitteeMember
+}
+
+// StateSnapshot is a read-only and consistent view of the accounts and validators
+// at the height of its last certificate. It should be released when it is no longer needed.
+type StateSnapshot interface {
+	LastCertificate() *certificate.BlockCertificate
+	Account(addr crypto.Address) (*account.Account, error)
+	PublicKey(addr crypto.Address) (*bls.PublicKey, error)
+	IterateAccounts(consumer func(crypto.Address, *account.Account) (stop bool))
+	IterateValidators(consumer func(*validator.Validator) (stop bool))
+	Release()
+}
+
 type Reader interface {
 	Block(height uint32) (*CommittedBlock, error)
 	BlockHeight(h hash.Hash) uint32
@@ -85,6 +133,21 @@ type Reader interface {
 	SortitionSeed(blockHeight uint32) *sortition.VerifiableSeed
 	Transaction(id tx.ID) (*CommittedTx, error)
 	AnyRecentTransaction(id tx.ID) bool
+	Anchor(h hash.Hash) (*CommittedAnchor, error)
+	// ValidatorRewards returns the rewards earned by the validator in the
+	// inclusive height range. If both heights are zero, it returns the totals.
+	// If `to` is zero, there is no upper limit.
+	ValidatorRewards(valNum int32, from, to uint32) (*RewardInfo, error)
+	// RewardAddressRewards returns the rewards paid to the reward address in the
+	// inclusive height range, the same as ValidatorRewards.
+	RewardAddressRewards(addr crypto.Address, from, to uint32) (*RewardInfo, error)
+	// Committee returns the committee members that are in charge of the block at the given height.
+	Committee(height uint32) (*CommitteeInfo, error)
+	// HistoryHeight returns the first block height that the rewards and the committee history are recorded for.
+	// The nodes that are upgraded from an older version don't have the history before the upgrade,
+	// and the nodes that are restored from a state snapshot don't have the validator metadata
+	// and the anchors before the snapshot either.
+	HistoryHeight() uint32
 	PublicKey(addr crypto.Address) (*bls.PublicKey, error)
 	HasAccount(crypto.Address) bool
 	Account(addr crypto.Address) (*account.Account, error)
@@ -93,6 +156,7 @@ type Reader interface {
 	ValidatorAddresses() []crypto.Address
 	Validator(addr crypto.Address) (*validator.Validator, error)
 	ValidatorByNumber(num int32) (*validator.Validator, error)
+	ValidatorMetadata(addr crypto.Address) (*validator.Metadata, error)
 	IterateValidators(consumer func(*validator.Validator) (stop bool))
 	IterateAccounts(consumer func(crypto.Address, *account.Account) (stop bool))
 	TotalValidators() int32
@@ -100,6 +164,8 @@ type Reader interface {
 	IsBanned(addr crypto.Address) bool
 	IsPruned() bool
 	PruningHeight() uint32
+	// StateSnapshot takes a snapshot of the current accounts and validators.
+	StateSnapshot() (StateSnapshot, error)
 }
 
 type Store interface {
@@ -107,7 +173,16 @@ type Store interface {
 
 	UpdateAccount(addr crypto.Address, acc *account.Account)
 	UpdateValidator(val *validator.Validator)
+	RotateValidatorKey(oldAddr crypto.Address, val *validator.Validator)
+	UpdateValidatorMetadata(addr crypto.Address, meta *validator.Metadata)
 	SaveBlock(blk *block.Block, cert *certificate.BlockCertificate)
+	SaveReward(height uint32, valNum int32, rewardAddr crypto.Address, blockReward, fee amount.Amount)
+	// SaveCommittee records the committee members that are in charge of the block at the given height.
+	// Nothing is recorded if the members are not changed.
+	SaveCommittee(height uint32, members []int32)
+	SetHistoryHeight(height uint32)
+	SavePublicKey(addr crypto.Address, pub *bls.PublicKey)
+	MarkPruned()
 	Prune(callback func(pruned bool, pruningHeight uint32) bool) error
 	WriteBatch() error
 	Close()
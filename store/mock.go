@@ -2,15 +2,18 @@ package store
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/pactus-project/pactus/crypto"
 	"github.com/pactus-project/pactus/crypto/bls"
 	"github.com/pactus-project/pactus/crypto/hash"
 	"github.com/pactus-project/pactus/sortition"
 	"github.com/pactus-project/pactus/types/account"
+	"github.com/pactus-project/pactus/types/amount"
 	"github.com/pactus-project/pactus/types/block"
 	"github.com/pactus-project/pactus/types/certificate"
 	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/tx/payload"
 	"github.com/pactus-project/pactus/types/validator"
 	"github.com/pactus-project/pactus/util/testsuite"
 )
@@ -23,8 +26,23 @@ type MockStore struct {
 	Blocks     map[uint32]*block.Block
 	Accounts   map[crypto.Address]*account.Account
 	Validators map[crypto.Address]*validator.Validator
+	Metadata   map[crypto.Address]*validator.Metadata
+	PublicKeys map[crypto.Address]*bls.PublicKey
+	Rewards    []MockReward
+	Committees []*CommitteeInfo
 	LastCert   *certificate.BlockCertificate
 	LastHeight uint32
+	Pruned     bool
+	// HistHeight is the first height that the rewards and the committee history are recorded for.
+	HistHeight uint32
+}
+
+type MockReward struct {
+	Height      uint32
+	ValNum      int32
+	RewardAddr  crypto.Address
+	BlockReward amount.Amount
+	Fee         amount.Amount
 }
 
 func MockingStore(ts *testsuite.TestSuite) *MockStore {
@@ -33,6 +51,8 @@ func MockingStore(ts *testsuite.TestSuite) *MockStore {
 		Blocks:     make(map[uint32]*block.Block),
 		Accounts:   make(map[crypto.Address]*account.Account),
 		Validators: make(map[crypto.Address]*validator.Validator),
+		Metadata:   make(map[crypto.Address]*validator.Metadata),
+		PublicKeys: make(map[crypto.Address]*bls.PublicKey),
 	}
 }
 
@@ -81,6 +101,9 @@ func (m *MockStore) SortitionSeed(blockHeight uint32) *sortition.VerifiableSeed
 }
 
 func (m *MockStore) PublicKey(addr crypto.Address) (*bls.PublicKey, error) {
+	if pub, ok := m.PublicKeys[addr]; ok {
+		return pub, nil
+	}
 	for _, blk := range m.Blocks {
 		for _, trx := range blk.Transactions() {
 			if trx.Payload().Signer() == addr {
@@ -116,6 +139,115 @@ func (m *MockStore) Transaction(id tx.ID) (*CommittedTx, error) {
 	return nil, fmt.Errorf("not found")
 }
 
+func (m *MockStore) Anchor(h hash.Hash) (*CommittedAnchor, error) {
+	for height := uint32(1); height <= m.LastHeight; height++ {
+		blk, ok := m.Blocks[height]
+		if !ok {
+			continue
+		}
+		for _, trx := range blk.Transactions() {
+			if !trx.IsAnchorTx() {
+				continue
+			}
+			for _, anchored := range trx.Payload().(*payload.AnchorPayload).Hashes {
+				if anchored == h {
+					return &CommittedAnchor{
+						AnchorHash: h,
+						TxID:       trx.ID(),
+						Height:     height,
+						BlockTime:  blk.Header().UnixTime(),
+					}, nil
+				}
+			}
+		}
+	}
+
+	return nil, ErrNotFound
+}
+
+func (m *MockStore) SaveReward(height uint32, valNum int32, rewardAddr crypto.Address,
+	blockReward, fee amount.Amount,
+) {
+	m.Rewards = append(m.Rewards, MockReward{
+		Height:      height,
+		ValNum:      valNum,
+		RewardAddr:  rewardAddr,
+		BlockReward: blockReward,
+		Fee:         fee,
+	})
+}
+
+func (m *MockStore) ValidatorRewards(valNum int32, from, to uint32) (*RewardInfo, error) {
+	return m.sumRewards(func(r MockReward) bool { return r.ValNum == valNum }, from, to), nil
+}
+
+func (m *MockStore) RewardAddressRewards(addr crypto.Address, from, to uint32) (*RewardInfo, error) {
+	return m.sumRewards(func(r MockReward) bool { return r.RewardAddr == addr }, from, to), nil
+}
+
+func (m *MockStore) sumRewards(match func(MockReward) bool, from, to uint32) *RewardInfo {
+	info := new(RewardInfo)
+	for _, r := range m.Rewards {
+		if !match(r) || r.Height < from || (to != 0 && r.Height > to) {
+			continue
+		}
+		info.BlocksProposed++
+		info.BlockRewards += r.BlockReward
+		info.Fees += r.Fee
+	}
+
+	return info
+}
+
+func (m *MockStore) SaveCommittee(height uint32, members []int32) {
+	last := make([]int32, 0)
+	if len(m.Committees) > 0 {
+		last = m.Committees[len(m.Committees)-1].Joined
+	}
+	if len(last) == len(members) && len(m.Committees) > 0 {
+		changed := false
+		for _, num := range members {
+			if !slices.Contains(last, num) {
+				changed = true
+			}
+		}
+		if !changed {
+			return
+		}
+	}
+
+	// The mock doesn't keep the join heights, all members are treated as joined at this height.
+	info := &CommitteeInfo{
+		Height: height,
+		Joined: slices.Clone(members),
+	}
+	for _, num := range members {
+		info.Members = append(info.Members, CommitteeMember{
+			ValidatorNumber: num,
+			JoinedHeight:    height,
+		})
+	}
+	m.Committees = append(m.Committees, info)
+}
+
+func (m *MockStore) HistoryHeight() uint32 {
+	return m.HistHeight
+}
+
+func (m *MockStore) SetHistoryHeight(height uint32) {
+	m.HistHeight = height
+}
+
+func (m *MockStore) Committee(height uint32) (*CommitteeInfo, error) {
+	for i := len(m.Committees) - 1; i >= 0; i-- {
+		if m.Committees[i].Height <= height {
+			return m.Committees[i], nil
+		}
+	}
+
+	return nil, ErrNotFound
+}
+
 func (m *MockStore) AnyRecentTransaction(id tx.ID) bool {
 	for _, blk := range m.Blocks {
 		for _, trx := range blk.Transactions() {
@@ -199,6 +331,25 @@ func (m *MockStore) UpdateValidator(val *validator.Validator) {
 	m.Validators[val.Address()] = val
 }
 
+func (m *MockStore) RotateValidatorKey(oldAddr crypto.Address, val *validator.Validator) {
+	delete(m.Validators, oldAddr)
+	delete(m.Metadata, oldAddr)
+	m.Validators[val.Address()] = val
+}
+
+func (m *MockStore) ValidatorMetadata(addr crypto.Address) (*validator.Metadata, error) {
+	meta, ok := m.Metadata[addr]
+	if ok {
+		return meta.Clone(), nil
+	}
+
+	return nil, ErrNotFound
+}
+
+func (m *MockStore) UpdateValidatorMetadata(addr crypto.Address, meta *validator.Metadata) {
+	m.Metadata[addr] = meta
+}
+
 func (m *MockStore) TotalValidators() int32 {
 	return int32(len(m.Validators))
 }
@@ -294,10 +445,66 @@ func (*MockStore) Prune(_ func(_ bool, _ uint32) bool) error {
 	return nil
 }
 
-func (*MockStore) IsPruned() bool {
-	return false
+func (m *MockStore) SavePublicKey(addr crypto.Address, pub *bls.PublicKey) {
+	m.PublicKeys[addr] = pub
+}
+
+func (m *MockStore) IsPruned() bool {
+	return m.Pruned
+}
+
+func (m *MockStore) MarkPruned() {
+	m.Pruned = true
 }
 
 func (*MockStore) PruningHeight() uint32 {
 	return 0
 }
+
+func (m *MockStore) StateSnapshot() (StateSnapshot, error) {
+	snap := &MockStateSnapshot{
+		Store: MockingStore(m.ts),
+	}
+	for addr, acc := range m.Accounts {
+		snap.Store.Accounts[addr] = acc.Clone()
+	}
+	for addr, val := range m.Validators {
+		snap.Store.Validators[addr] = val.Clone()
+	}
+	for addr, pub := range m.PublicKeys {
+		snap.Store.PublicKeys[addr] = pub
+	}
+	snap.Store.LastCert = m.LastCertificate()
+
+	return snap, nil
+}
+
+// MockStateSnapshot is a state snapshot that keeps a copy of the mocked store.
+type MockStateSnapshot struct {
+	Store    *MockStore
+	Released bool
+}
+
+func (s *MockStateSnapshot) LastCertificate() *certificate.BlockCertificate {
+	return s.Store.LastCert
+}
+
+func (s *MockStateSnapshot) Account(addr crypto.Address) (*account.Account, error) {
+	return s.Store.Account(addr)
+}
+
+func (s *MockStateSnapshot) PublicKey(addr crypto.Address) (*bls.PublicKey, error) {
+	return s.Store.PublicKey(addr)
+}
+
+func (s *MockStateSnapshot) IterateAccounts(consumer func(crypto.Address, *account.Account) (stop bool)) {
+	s.Store.IterateAccounts(consumer)
+}
+
+func (s *MockStateSnapshot) IterateValidators(consumer func(*validator.Validator) (stop bool)) {
+	s.Store.IterateValidators(consumer)
+}
+
+func (s *MockStateSnapshot) Release() {
+	s.Released = true
+}
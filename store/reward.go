@@ -0,0 +1,196 @@
+package store
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/types/amount"
+	"github.com/pactus-project/pactus/util/encoding"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// The keys of the reward records contain the block height in big-endian format,
+// so the records are sorted by height and can be iterated over a height range.
+
+func validatorRewardPrefixKey(valNum int32) []byte {
+	return binary.BigEndian.AppendUint32(append([]byte{}, validatorRewardPrefix...), uint32(valNum))
+}
+
+func validatorRewardKey(valNum int32, height uint32) []byte {
+	return binary.BigEndian.AppendUint32(validatorRewardPrefixKey(valNum), height)
+}
+
+func addressRewardPrefixKey(addr crypto.Address) []byte {
+	return append(append([]byte{}, addressRewardPrefix...), addr.Bytes()...)
+}
+
+func addressRewardKey(addr crypto.Address, height uint32) []byte {
+	return binary.BigEndian.AppendUint32(addressRewardPrefixKey(addr), height)
+}
+
+func validatorRewardTotalKey(valNum int32) []byte {
+	return binary.BigEndian.AppendUint32(append([]byte{}, rewardTotalPrefix...), uint32(valNum))
+}
+
+func addressRewardTotalKey(addr crypto.Address) []byte {
+	return append(append([]byte{}, rewardTotalPrefix...), addr.Bytes()...)
+}
+
+type rewardStore struct {
+	db *leveldb.DB
+	// pendingTotals keeps the totals that are updated in the current batch,
+	// since they are not in the database yet.
+	pendingTotals map[string]*RewardInfo
+}
+
+func newRewardStore(db *leveldb.DB) *rewardStore {
+	return &rewardStore{
+		db:            db,
+		pendingTotals: make(map[string]*RewardInfo),
+	}
+}
+
+// batchWritten should be called once the batch is written into the database.
+func (rs *rewardStore) batchWritten() {
+	clear(rs.pendingTotals)
+}
+
+// saveReward records the reward of the block proposer at the given height.
+// It updates both the per-validator and the per-reward-address totals.
+func (rs *rewardStore) saveReward(batch *leveldb.Batch, height uint32, valNum int32,
+	rewardAddr crypto.Address, blockReward, fee amount.Amount,
+) {
+	rec := encodeRewardRecord(blockReward, fee)
+	batch.Put(validatorRewardKey(valNum, height), rec)
+	batch.Put(addressRewardKey(rewardAddr, height), rec)
+
+	rs.addToTotal(batch, validatorRewardTotalKey(valNum), blockReward, fee)
+	rs.addToTotal(batch, addressRewardTotalKey(rewardAddr), blockReward, fee)
+}
+
+func (rs *rewardStore) addToTotal(batch *leveldb.Batch, key []byte, blockReward, fee amount.Amount) {
+	total, ok := rs.pendingTotals[string(key)]
+	if !ok {
+		var err error
+		total, err = rs.total(key)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	total.BlocksProposed++
+	total.BlockRewards += blockReward
+	total.Fees += fee
+
+	rs.pendingTotals[string(key)] = total
+	batch.Put(key, encodeRewardInfo(total))
+}
+
+func (rs *rewardStore) validatorRewards(valNum int32, from, to uint32) (*RewardInfo, error) {
+	if from == 0 && to == 0 {
+		return rs.total(validatorRewardTotalKey(valNum))
+	}
+
+	return rs.sumRange(validatorRewardPrefixKey(valNum), from, to)
+}
+
+func (rs *rewardStore) addressRewards(addr crypto.Address, from, to uint32) (*RewardInfo, error) {
+	if from == 0 && to == 0 {
+		return rs.total(addressRewardTotalKey(addr))
+	}
+
+	return rs.sumRange(addressRewardPrefixKey(addr), from, to)
+}
+
+func (rs *rewardStore) total(key []byte) (*RewardInfo, error) {
+	data, err := tryGet(rs.db, key)
+	if err != nil {
+		if errors.Is(err, leveldb.ErrNotFound) {
+			return new(RewardInfo), nil
+		}
+
+		return nil, err
+	}
+
+	return decodeRewardInfo(data)
+}
+
+// sumRange sums up the reward records in the given inclusive height range.
+// If `to` is zero, there is no upper limit.
+func (rs *rewardStore) sumRange(prefix []byte, from, to uint32) (*RewardInfo, error) {
+	rng := &util.Range{
+		Start: binary.BigEndian.AppendUint32(append([]byte{}, prefix...), from),
+	}
+	if to == 0 {
+		rng.Limit = util.BytesPrefix(prefix).Limit
+	} else {
+		rng.Limit = binary.BigEndian.AppendUint32(append([]byte{}, prefix...), to)
+		rng.Limit = append(rng.Limit, 0x00)
+	}
+
+	info := new(RewardInfo)
+	iter := rs.db.NewIterator(rng, nil)
+	defer iter.Release()
+
+	for iter.Next() {
+		blockReward, fee, err := decodeRewardRecord(iter.Value())
+		if err != nil {
+			return nil, err
+		}
+
+		info.BlocksProposed++
+		info.BlockRewards += blockReward
+		info.Fees += fee
+	}
+
+	return info, iter.Error()
+}
+
+func encodeRewardRecord(blockReward, fee amount.Amount) []byte {
+	w := bytes.NewBuffer(make([]byte, 0, 16))
+	err := encoding.WriteElements(w, uint64(blockReward), uint64(fee))
+	if err != nil {
+		panic(err)
+	}
+
+	return w.Bytes()
+}
+
+func decodeRewardRecord(data []byte) (amount.Amount, amount.Amount, error) {
+	var blockReward, fee uint64
+	r := bytes.NewReader(data)
+	if err := encoding.ReadElements(r, &blockReward, &fee); err != nil {
+		return 0, 0, err
+	}
+
+	return amount.Amount(blockReward), amount.Amount(fee), nil
+}
+
+func encodeRewardInfo(info *RewardInfo) []byte {
+	w := bytes.NewBuffer(make([]byte, 0, 4+16))
+	err := encoding.WriteElements(w, info.BlocksProposed,
+		uint64(info.BlockRewards), uint64(info.Fees))
+	if err != nil {
+		panic(err)
+	}
+
+	return w.Bytes()
+}
+
+func decodeRewardInfo(data []byte) (*RewardInfo, error) {
+	var blocksProposed uint32
+	var blockRewards, fees uint64
+	r := bytes.NewReader(data)
+	if err := encoding.ReadElements(r, &blocksProposed, &blockRewards, &fees); err != nil {
+		return nil, err
+	}
+
+	return &RewardInfo{
+		BlocksProposed: blocksProposed,
+		BlockRewards:   amount.Amount(blockRewards),
+		Fees:           amount.Amount(fees),
+	}, nil
+}
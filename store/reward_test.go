@@ -0,0 +1,71 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/types/amount"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRewards(t *testing.T) {
+	td := setup(t, nil)
+
+	valNum1 := td.RandInt32(1000)
+	valNum2 := valNum1 + 1
+	rewardAddr1 := td.RandAccAddress()
+	rewardAddr2 := td.RandAccAddress()
+	blockReward := amount.Amount(1e9)
+
+	// Validator 1 proposes at heights 1, 3, 5 and validator 2 at heights 2, 4.
+	// Both validators share the first reward address, but validator 2 switches
+	// to the second reward address at height 4.
+	td.store.SaveReward(1, valNum1, rewardAddr1, blockReward, 10)
+	td.store.SaveReward(2, valNum2, rewardAddr1, blockReward, 20)
+	td.store.SaveReward(3, valNum1, rewardAddr1, blockReward, 30)
+	td.store.SaveReward(4, valNum2, rewardAddr2, blockReward, 40)
+	td.store.SaveReward(5, valNum1, rewardAddr1, blockReward, 50)
+	require.NoError(t, td.store.WriteBatch())
+
+	t.Run("Totals", func(t *testing.T) {
+		info, err := td.store.ValidatorRewards(valNum1, 0, 0)
+		require.NoError(t, err)
+		assert.Equal(t, &RewardInfo{BlocksProposed: 3, BlockRewards: 3 * blockReward, Fees: 90}, info)
+
+		info, err = td.store.ValidatorRewards(valNum2, 0, 0)
+		require.NoError(t, err)
+		assert.Equal(t, &RewardInfo{BlocksProposed: 2, BlockRewards: 2 * blockReward, Fees: 60}, info)
+
+		info, err = td.store.RewardAddressRewards(rewardAddr1, 0, 0)
+		require.NoError(t, err)
+		assert.Equal(t, &RewardInfo{BlocksProposed: 4, BlockRewards: 4 * blockReward, Fees: 110}, info)
+
+		info, err = td.store.RewardAddressRewards(rewardAddr2, 0, 0)
+		require.NoError(t, err)
+		assert.Equal(t, &RewardInfo{BlocksProposed: 1, BlockRewards: blockReward, Fees: 40}, info)
+	})
+
+	t.Run("Height range", func(t *testing.T) {
+		info, err := td.store.ValidatorRewards(valNum1, 2, 3)
+		require.NoError(t, err)
+		assert.Equal(t, &RewardInfo{BlocksProposed: 1, BlockRewards: blockReward, Fees: 30}, info)
+
+		info, err = td.store.ValidatorRewards(valNum1, 3, 0)
+		require.NoError(t, err)
+		assert.Equal(t, &RewardInfo{BlocksProposed: 2, BlockRewards: 2 * blockReward, Fees: 80}, info)
+
+		info, err = td.store.RewardAddressRewards(rewardAddr1, 1, 2)
+		require.NoError(t, err)
+		assert.Equal(t, &RewardInfo{BlocksProposed: 2, BlockRewards: 2 * blockReward, Fees: 30}, info)
+	})
+
+	t.Run("Unknown validator", func(t *testing.T) {
+		info, err := td.store.ValidatorRewards(valNum2+1, 0, 0)
+		require.NoError(t, err)
+		assert.Zero(t, info.BlocksProposed)
+
+		info, err = td.store.RewardAddressRewards(td.RandAccAddress(), 1, 10)
+		require.NoError(t, err)
+		assert.Zero(t, info.BlocksProposed)
+	})
+}
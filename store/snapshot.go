@@ -0,0 +1,102 @@
+package store
+
+import (
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/types/account"
+	"github.com/pactus-project/pactus/types/certificate"
+	"github.com/pactus-project/pactus/types/validator"
+	"github.com/pactus-project/pactus/util/logger"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// stateSnapshot is backed by a LevelDB snapshot. The blocks are committed in a single batch,
+// so the snapshot never contains a partially committed block.
+type stateSnapshot struct {
+	snap     *leveldb.Snapshot
+	lastCert *certificate.BlockCertificate
+}
+
+func (s *store) StateSnapshot() (StateSnapshot, error) {
+	s.lk.RLock()
+	defer s.lk.RUnlock()
+
+	snap, err := s.db.GetSnapshot()
+	if err != nil {
+		return nil, err
+	}
+
+	ss := &stateSnapshot{
+		snap: snap,
+	}
+	data, _ := snap.Get(lastInfoKey, nil)
+	if data != nil {
+		ss.lastCert = lastCertificateFromBytes(data)
+	}
+
+	return ss, nil
+}
+
+func (ss *stateSnapshot) LastCertificate() *certificate.BlockCertificate {
+	return ss.lastCert
+}
+
+func (ss *stateSnapshot) Account(addr crypto.Address) (*account.Account, error) {
+	data, err := ss.snap.Get(accountKey(addr), nil)
+	if err != nil {
+		return nil, ErrNotFound
+	}
+
+	return account.FromBytes(data)
+}
+
+func (ss *stateSnapshot) PublicKey(addr crypto.Address) (*bls.PublicKey, error) {
+	data, err := ss.snap.Get(publicKeyKey(addr), nil)
+	if err != nil {
+		return nil, ErrNotFound
+	}
+
+	return bls.PublicKeyFromBytes(data)
+}
+
+func (ss *stateSnapshot) IterateAccounts(consumer func(crypto.Address, *account.Account) (stop bool)) {
+	iter := ss.snap.NewIterator(util.BytesPrefix(accountPrefix), nil)
+	defer iter.Release()
+
+	for iter.Next() {
+		acc, err := account.FromBytes(iter.Value())
+		if err != nil {
+			logger.Panic("unable to decode account", "error", err)
+		}
+
+		var addr crypto.Address
+		copy(addr[:], iter.Key()[1:])
+
+		stopped := consumer(addr, acc)
+		if stopped {
+			return
+		}
+	}
+}
+
+func (ss *stateSnapshot) IterateValidators(consumer func(*validator.Validator) (stop bool)) {
+	iter := ss.snap.NewIterator(util.BytesPrefix(validatorPrefix), nil)
+	defer iter.Release()
+
+	for iter.Next() {
+		val, err := validator.FromBytes(iter.Value())
+		if err != nil {
+			logger.Panic("unable to decode validator", "error", err)
+		}
+
+		stopped := consumer(val)
+		if stopped {
+			return
+		}
+	}
+}
+
+func (ss *stateSnapshot) Release() {
+	ss.snap.Release()
+}
@@ -0,0 +1,74 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/types/account"
+	"github.com/pactus-project/pactus/types/validator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStateSnapshot(t *testing.T) {
+	td := setup(t, nil)
+
+	acc, addr := td.GenerateTestAccount(0)
+	val, _ := td.GenerateTestValidator(0)
+	pub, _ := td.RandBLSKeyPair()
+	td.store.UpdateAccount(addr, acc)
+	td.store.UpdateValidator(val)
+	td.store.SavePublicKey(pub.AccountAddress(), pub)
+	require.NoError(t, td.store.WriteBatch())
+
+	snap, err := td.store.StateSnapshot()
+	require.NoError(t, err)
+	defer snap.Release()
+
+	// Changing the store after taking the snapshot.
+	acc2, addr2 := td.GenerateTestAccount(1)
+	td.store.UpdateAccount(addr2, acc2)
+	acc.AddToBalance(1)
+	td.store.UpdateAccount(addr, acc)
+	blk, cert := td.GenerateTestBlock(11)
+	td.store.SaveBlock(blk, cert)
+	require.NoError(t, td.store.WriteBatch())
+
+	assert.Equal(t, uint32(10), snap.LastCertificate().Height())
+
+	accs := make(map[crypto.Address]*account.Account)
+	snap.IterateAccounts(func(addr crypto.Address, acc *account.Account) bool {
+		accs[addr] = acc
+
+		return false
+	})
+	require.Len(t, accs, 1)
+	assert.Equal(t, acc.Balance()-1, accs[addr].Balance())
+
+	snapAcc, err := snap.Account(addr)
+	require.NoError(t, err)
+	assert.Equal(t, accs[addr], snapAcc)
+
+	_, err = snap.Account(addr2)
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	vals := make([]*validator.Validator, 0)
+	snap.IterateValidators(func(val *validator.Validator) bool {
+		vals = append(vals, val)
+
+		return false
+	})
+	require.Len(t, vals, 1)
+	assert.Equal(t, val.Hash(), vals[0].Hash())
+
+	snapPub, err := snap.PublicKey(pub.AccountAddress())
+	require.NoError(t, err)
+	assert.Equal(t, pub.Bytes(), snapPub.Bytes())
+}
+
+func TestMarkPruned(t *testing.T) {
+	td := setup(t, nil)
+
+	td.store.MarkPruned()
+	assert.True(t, td.store.IsPruned())
+}
@@ -2,6 +2,7 @@ package store
 
 import (
 	"bytes"
+	"encoding/binary"
 	"errors"
 	"sync"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/pactus-project/pactus/crypto/hash"
 	"github.com/pactus-project/pactus/sortition"
 	"github.com/pactus-project/pactus/types/account"
+	"github.com/pactus-project/pactus/types/amount"
 	"github.com/pactus-project/pactus/types/block"
 	"github.com/pactus-project/pactus/types/certificate"
 	"github.com/pactus-project/pactus/types/tx"
@@ -31,13 +33,20 @@ const (
 )
 
 var (
-	lastInfoKey       = []byte{0x00}
-	blockPrefix       = []byte{0x01}
-	txPrefix          = []byte{0x03}
-	accountPrefix     = []byte{0x05}
-	validatorPrefix   = []byte{0x07}
-	blockHeightPrefix = []byte{0x09}
-	publicKeyPrefix   = []byte{0x0b}
+	lastInfoKey           = []byte{0x00}
+	blockPrefix           = []byte{0x01}
+	txPrefix              = []byte{0x03}
+	accountPrefix         = []byte{0x05}
+	validatorPrefix       = []byte{0x07}
+	blockHeightPrefix     = []byte{0x09}
+	publicKeyPrefix       = []byte{0x0b}
+	metadataPrefix        = []byte{0x0d}
+	anchorPrefix          = []byte{0x0f}
+	validatorRewardPrefix = []byte{0x11}
+	addressRewardPrefix   = []byte{0x13}
+	rewardTotalPrefix     = []byte{0x15}
+	committeePrefix       = []byte{0x17}
+	historyHeightKey      = []byte{0x19}
 )
 
 func tryGet(db *leveldb.DB, key []byte) ([]byte, error) {
@@ -73,6 +82,9 @@ type store struct {
 	txStore        *txStore
 	accountStore   *accountStore
 	validatorStore *validatorStore
+	anchorStore    *anchorStore
+	rewardStore    *rewardStore
+	committeeStore *committeeStore
 	isPruned       bool
 }
 
@@ -94,6 +106,9 @@ func NewStore(conf *Config) (Store, error) {
 		txStore:        newTxStore(db, conf.TxCacheWindow),
 		accountStore:   newAccountStore(db, conf.AccountCacheSize),
 		validatorStore: newValidatorStore(db),
+		anchorStore:    newAnchorStore(db),
+		rewardStore:    newRewardStore(db),
+		committeeStore: newCommitteeStore(db),
 		isPruned:       false,
 	}
 
@@ -153,6 +168,7 @@ func (s *store) SaveBlock(blk *block.Block, cert *certificate.BlockCertificate)
 	height := cert.Height()
 	regs := s.blockStore.saveBlock(s.batch, height, blk)
 	s.txStore.saveTxs(s.batch, blk.Transactions(), regs)
+	s.anchorStore.saveAnchors(s.batch, height, blk)
 	s.txStore.pruneCache(height)
 
 	// Removing old block from prune node store.
@@ -246,6 +262,15 @@ func (s *store) PublicKey(addr crypto.Address) (*bls.PublicKey, error) {
 	return s.blockStore.publicKey(addr)
 }
 
+// SavePublicKey indexes the public key for the given address,
+// so that the public key can be stripped from the transactions signed by this address.
+func (s *store) SavePublicKey(addr crypto.Address, pub *bls.PublicKey) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	s.batch.Put(publicKeyKey(addr), pub.Bytes())
+}
+
 func (s *store) Transaction(id tx.ID) (*CommittedTx, error) {
 	s.lk.Lock()
 	defer s.lk.Unlock()
@@ -274,6 +299,71 @@ func (s *store) Transaction(id tx.ID) (*CommittedTx, error) {
 	}, nil
 }
 
+func (s *store) Anchor(h hash.Hash) (*CommittedAnchor, error) {
+	s.lk.RLock()
+	defer s.lk.RUnlock()
+
+	return s.anchorStore.anchor(h)
+}
+
+func (s *store) SaveReward(height uint32, valNum int32, rewardAddr crypto.Address,
+	blockReward, fee amount.Amount,
+) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	s.rewardStore.saveReward(s.batch, height, valNum, rewardAddr, blockReward, fee)
+}
+
+func (s *store) ValidatorRewards(valNum int32, from, to uint32) (*RewardInfo, error) {
+	s.lk.RLock()
+	defer s.lk.RUnlock()
+
+	return s.rewardStore.validatorRewards(valNum, from, to)
+}
+
+func (s *store) RewardAddressRewards(addr crypto.Address, from, to uint32) (*RewardInfo, error) {
+	s.lk.RLock()
+	defer s.lk.RUnlock()
+
+	return s.rewardStore.addressRewards(addr, from, to)
+}
+
+func (s *store) SaveCommittee(height uint32, members []int32) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	s.committeeStore.saveCommittee(s.batch, height, members)
+}
+
+func (s *store) Committee(height uint32) (*CommitteeInfo, error) {
+	s.lk.RLock()
+	defer s.lk.RUnlock()
+
+	return s.committeeStore.committee(height)
+}
+
+// HistoryHeight returns the first block height that the rewards and the committee history are recorded for.
+// It returns zero if the height is not set yet.
+func (s *store) HistoryHeight() uint32 {
+	s.lk.RLock()
+	defer s.lk.RUnlock()
+
+	data, err := tryGet(s.db, historyHeightKey)
+	if err != nil || len(data) != 4 {
+		return 0
+	}
+
+	return binary.BigEndian.Uint32(data)
+}
+
+func (s *store) SetHistoryHeight(height uint32) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	s.batch.Put(historyHeightKey, binary.BigEndian.AppendUint32(nil, height))
+}
+
 func (s *store) AnyRecentTransaction(id tx.ID) bool {
 	s.lk.Lock()
 	defer s.lk.Unlock()
@@ -365,6 +455,29 @@ func (s *store) UpdateValidator(acc *validator.Validator) {
 	s.validatorStore.updateValidator(s.batch, acc)
 }
 
+// RotateValidatorKey replaces the validator registered at oldAddr with val,
+// which has the same number but a new public key.
+func (s *store) RotateValidatorKey(oldAddr crypto.Address, val *validator.Validator) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	s.validatorStore.rotateValidatorKey(s.batch, oldAddr, val)
+}
+
+func (s *store) ValidatorMetadata(addr crypto.Address) (*validator.Metadata, error) {
+	s.lk.RLock()
+	defer s.lk.RUnlock()
+
+	return s.validatorStore.metadata(addr)
+}
+
+func (s *store) UpdateValidatorMetadata(addr crypto.Address, meta *validator.Metadata) {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	s.validatorStore.updateMetadata(s.batch, addr, meta)
+}
+
 func (s *store) LastCertificate() *certificate.BlockCertificate {
 	s.lk.Lock()
 	defer s.lk.Unlock()
@@ -378,6 +491,11 @@ func (s *store) lastCertificate() *certificate.BlockCertificate {
 		// Genesis block
 		return nil
 	}
+
+	return lastCertificateFromBytes(data)
+}
+
+func lastCertificateFromBytes(data []byte) *certificate.BlockCertificate {
 	r := bytes.NewReader(data)
 	version := int32(0)
 	cert := new(certificate.BlockCertificate)
@@ -407,6 +525,7 @@ func (s *store) writeBatch() error {
 		return err
 	}
 	s.batch.Reset()
+	s.rewardStore.batchWritten()
 
 	return nil
 }
@@ -420,6 +539,15 @@ func (s *store) IsPruned() bool {
 	return s.isPruned
 }
 
+// MarkPruned puts the store in prune mode.
+// It is used when the store is restored from a state snapshot and the old blocks are not available.
+func (s *store) MarkPruned() {
+	s.lk.Lock()
+	defer s.lk.Unlock()
+
+	s.isPruned = true
+}
+
 // PruningHeight returns the height at which blocks will be pruned if the store is in prune mode.
 // If the store is not in prune mode, it returns 0.
 func (s *store) PruningHeight() uint32 {
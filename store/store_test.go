@@ -238,6 +238,18 @@ func TestIsBanned(t *testing.T) {
 	assert.True(t, td.store.IsBanned(bannedAddr))
 }
 
+func TestHistoryHeight(t *testing.T) {
+	td := setup(t, nil)
+
+	assert.Zero(t, td.store.HistoryHeight())
+
+	td.store.SetHistoryHeight(7)
+	assert.Zero(t, td.store.HistoryHeight(), "not written yet")
+
+	require.NoError(t, td.store.WriteBatch())
+	assert.Equal(t, uint32(7), td.store.HistoryHeight())
+}
+
 func TestPruneBlock(t *testing.T) {
 	conf := testConfig()
 	td := setup(t, conf)
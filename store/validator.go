@@ -16,6 +16,9 @@ type validatorStore struct {
 }
 
 func valKey(addr crypto.Address) []byte { return append(validatorPrefix, addr.Bytes()...) }
+func metadataKey(addr crypto.Address) []byte {
+	return append(metadataPrefix, addr.Bytes()...)
+}
 
 func newValidatorStore(db *leveldb.DB) *validatorStore {
 	total := int32(0)
@@ -103,3 +106,41 @@ func (vs *validatorStore) updateValidator(batch *leveldb.Batch, val *validator.V
 
 	batch.Put(valKey(val.Address()), data)
 }
+
+// rotateValidatorKey replaces the validator registered at oldAddr with val.
+// Both share the same number, but val has a new public key and address.
+// The metadata of the old address is removed as well.
+// This function takes ownership of the validator pointer.
+func (vs *validatorStore) rotateValidatorKey(batch *leveldb.Batch, oldAddr crypto.Address, val *validator.Validator) {
+	data, err := val.Bytes()
+	if err != nil {
+		logger.Panic("unable to encode validator", "error", err)
+	}
+
+	delete(vs.addressMap, oldAddr)
+	batch.Delete(valKey(oldAddr))
+	batch.Delete(metadataKey(oldAddr))
+
+	vs.numberMap[val.Number()] = val
+	vs.addressMap[val.Address()] = val
+
+	batch.Put(valKey(val.Address()), data)
+}
+
+func (vs *validatorStore) metadata(addr crypto.Address) (*validator.Metadata, error) {
+	data, err := tryGet(vs.db, metadataKey(addr))
+	if err != nil {
+		return nil, ErrNotFound
+	}
+
+	return validator.MetadataFromBytes(data)
+}
+
+func (*validatorStore) updateMetadata(batch *leveldb.Batch, addr crypto.Address, meta *validator.Metadata) {
+	data, err := meta.Bytes()
+	if err != nil {
+		logger.Panic("unable to encode validator metadata", "error", err)
+	}
+
+	batch.Put(metadataKey(addr), data)
+}
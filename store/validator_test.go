@@ -65,6 +65,55 @@ func TestValidatorBatchSaving(t *testing.T) {
 	})
 }
 
+func TestRotateValidatorKey(t *testing.T) {
+	td := setup(t, nil)
+
+	num := td.RandInt32(1000)
+	val, _ := td.GenerateTestValidator(num)
+	td.store.UpdateValidator(val)
+	td.store.UpdateValidatorMetadata(val.Address(), &validator.Metadata{Moniker: "moniker"})
+	assert.NoError(t, td.store.WriteBatch())
+	_, err := td.store.ValidatorMetadata(val.Address())
+	require.NoError(t, err)
+
+	pub, _ := td.RandBLSKeyPair()
+	rotated := val.Clone()
+	rotated.UpdatePublicKey(pub)
+	td.store.RotateValidatorKey(val.Address(), rotated)
+	assert.NoError(t, td.store.WriteBatch())
+
+	t.Run("Old address should be removed", func(t *testing.T) {
+		assert.False(t, td.store.HasValidator(val.Address()))
+		_, err := td.store.Validator(val.Address())
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("Old metadata should be removed", func(t *testing.T) {
+		_, err := td.store.ValidatorMetadata(val.Address())
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("Validator should be moved to the new address", func(t *testing.T) {
+		val1, err := td.store.Validator(pub.ValidatorAddress())
+		require.NoError(t, err)
+		val2, err := td.store.ValidatorByNumber(num)
+		require.NoError(t, err)
+
+		assert.Equal(t, rotated.Hash(), val1.Hash())
+		assert.Equal(t, rotated.Hash(), val2.Hash())
+		assert.Equal(t, int32(1), td.store.TotalValidators())
+	})
+
+	t.Run("Close and load db", func(t *testing.T) {
+		td.store.Close()
+		store, _ := NewStore(td.store.config)
+
+		assert.Equal(t, int32(1), store.TotalValidators())
+		assert.False(t, store.HasValidator(val.Address()))
+		assert.True(t, store.HasValidator(pub.ValidatorAddress()))
+	})
+}
+
 func TestValidatorAddresses(t *testing.T) {
 	td := setup(t, nil)
 
@@ -221,3 +270,41 @@ func TestValidatorDeepCopy(t *testing.T) {
 	val3.AddToStake(1)
 	assert.NotEqual(t, td.store.validatorStore.numberMap[num].Hash(), val3.Hash())
 }
+
+func TestValidatorMetadata(t *testing.T) {
+	td := setup(t, nil)
+
+	val, _ := td.GenerateTestValidator(td.RandInt32(1000))
+	td.store.UpdateValidator(val)
+
+	t.Run("No metadata", func(t *testing.T) {
+		meta, err := td.store.ValidatorMetadata(val.Address())
+		assert.ErrorIs(t, err, ErrNotFound)
+		assert.Nil(t, meta)
+	})
+
+	t.Run("Update metadata", func(t *testing.T) {
+		meta1 := &validator.Metadata{
+			Moniker:       td.RandString(16),
+			Website:       "https://pactus.org",
+			Contact:       td.RandString(32),
+			RewardAddress: td.RandAccAddress(),
+		}
+		td.store.UpdateValidatorMetadata(val.Address(), meta1)
+		assert.NoError(t, td.store.WriteBatch())
+
+		meta2, err := td.store.ValidatorMetadata(val.Address())
+		assert.NoError(t, err)
+		assert.Equal(t, meta1, meta2)
+	})
+
+	t.Run("Reopen the store", func(t *testing.T) {
+		td.store.Close()
+		store, _ := NewStore(td.store.config)
+
+		meta, err := store.ValidatorMetadata(val.Address())
+		assert.NoError(t, err)
+		assert.Equal(t, "https://pactus.org", meta.Website)
+		assert.Equal(t, int32(1), store.TotalValidators())
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"io"
 
 	"github.com/fxamacker/cbor/v2"
+	"github.com/pactus-project/pactus/sync/bundle/codec"
 	"github.com/pactus-project/pactus/sync/bundle/message"
 	"github.com/pactus-project/pactus/util"
 	"github.com/pactus-project/pactus/util/errors"
@@ -17,12 +18,74 @@ const (
 	BundleFlagCompressed     = 0x0100
 	BundleFlagBroadcasted    = 0x0200
 	BundleFlagHandshaking    = 0x0400
+	BundleFlagCompressedZstd = 0x0800
 )
 
+// compressionFlags are the flags of the compression codecs.
+// The gzip codec uses `BundleFlagCompressed`, so the old peers can still decompress it.
+var compressionFlags = []int{BundleFlagCompressed, BundleFlagCompressedZstd}
+
+// defaultCodecs are used to encode and decode the bundles when no codecs are given.
+// They are never modified.
+var defaultCodecs = NewCodecs()
+
+// Codecs holds the compression codecs of the bundles, one for each compression flag.
+type Codecs struct {
+	codecs map[int]codec.Codec
+}
+
+// NewCodecs creates the default codecs: gzip and zstd without a dictionary.
+func NewCodecs() *Codecs {
+	// Creating a zstd codec without a dictionary doesn't fail.
+	zstd, _ := codec.NewZstd(nil)
+
+	return &Codecs{
+		codecs: map[int]codec.Codec{
+			BundleFlagCompressed:     codec.Gzip{},
+			BundleFlagCompressedZstd: zstd,
+		},
+	}
+}
+
+// SetCodec sets the codec of a compression flag, e.g. a zstd codec with a shared dictionary.
+// It should be called before the codecs are used to send or receive any bundle.
+func (cs *Codecs) SetCodec(flag int, c codec.Codec) {
+	cs.codecs[flag] = c
+}
+
+// findCodec returns the codec whose flag is set, or nil if the flags have no compression flag.
+// It returns an error if more than one compression flag is set.
+func (cs *Codecs) findCodec(flags int) (codec.Codec, error) {
+	var found codec.Codec
+	for _, flag := range compressionFlags {
+		if !util.IsFlagSet(flags, flag) {
+			continue
+		}
+		if found != nil {
+			return nil, errors.Errorf(errors.ErrInvalidMessage, "more than one compression flag is set")
+		}
+		found = cs.codecs[flag]
+	}
+
+	return found, nil
+}
+
+// unsetCompressionFlags removes all the compression flags.
+func unsetCompressionFlags(flags int) int {
+	for _, flag := range compressionFlags {
+		flags = util.UnsetFlag(flags, flag)
+	}
+
+	return flags
+}
+
 type Bundle struct {
 	Flags      int
 	SequenceNo int
 	Message    message.Message
+
+	// savedBytes is the number of bytes that the compression saved in the last encoding or decoding.
+	savedBytes int
 }
 
 func NewBundle(msg message.Message) *Bundle {
@@ -40,8 +103,23 @@ func (b *Bundle) String() string {
 	return fmt.Sprintf("%s%s", b.Message.Type(), b.Message.String())
 }
 
+// CompressIt compresses the bundle with the gzip codec, which all peers support.
 func (b *Bundle) CompressIt() {
-	b.Flags = util.SetFlag(b.Flags, BundleFlagCompressed)
+	b.CompressWith(BundleFlagCompressed)
+}
+
+// CompressWith compresses the bundle with the codec of the given compression flag.
+func (b *Bundle) CompressWith(flag int) {
+	b.Flags = util.SetFlag(unsetCompressionFlags(b.Flags), flag)
+}
+
+func (b *Bundle) IsCompressed() bool {
+	return unsetCompressionFlags(b.Flags) != b.Flags
+}
+
+// SavedBytes returns the number of bytes that the compression saved in the last encoding or decoding.
+func (b *Bundle) SavedBytes() int {
+	return b.savedBytes
 }
 
 func (b *Bundle) SetSequenceNo(seqNo int) {
@@ -55,21 +133,37 @@ type _Bundle struct {
 	SequenceNo  int          `cbor:"4,keyasint"`
 }
 
+// Encode encodes the bundle with the default codecs.
 func (b *Bundle) Encode() ([]byte, error) {
+	return b.EncodeWith(defaultCodecs)
+}
+
+// EncodeWith encodes the bundle and compresses it with the given codecs.
+func (b *Bundle) EncodeWith(codecs *Codecs) ([]byte, error) {
 	data, err := cbor.Marshal(b.Message)
 	if err != nil {
 		return nil, err
 	}
 
-	if util.IsFlagSet(b.Flags, BundleFlagCompressed) {
-		c, err := util.CompressBuffer(data)
+	flags := b.Flags
+	b.savedBytes = 0
+	c, err := codecs.findCodec(flags)
+	if err != nil {
+		return nil, err
+	}
+	if c != nil {
+		compressed, err := c.Compress(data)
 		if err == nil {
-			data = c
+			b.savedBytes = len(data) - len(compressed)
+			data = compressed
+		} else {
+			// Sending the message uncompressed
+			flags = unsetCompressionFlags(flags)
 		}
 	}
 
 	msg := &_Bundle{
-		Flags:       b.Flags,
+		Flags:       flags,
 		MessageType: b.Message.Type(),
 		MessageData: data,
 		SequenceNo:  b.SequenceNo,
@@ -78,7 +172,13 @@ func (b *Bundle) Encode() ([]byte, error) {
 	return cbor.Marshal(msg)
 }
 
+// Decode decodes the bundle with the default codecs.
 func (b *Bundle) Decode(r io.Reader) (int, error) {
+	return b.DecodeWith(r, defaultCodecs)
+}
+
+// DecodeWith decodes the bundle and decompresses it with the given codecs.
+func (b *Bundle) DecodeWith(r io.Reader, codecs *Codecs) (int, error) {
 	var bdl _Bundle
 	d := cbor.NewDecoder(r)
 	err := d.Decode(&bdl)
@@ -93,12 +193,18 @@ func (b *Bundle) Decode(r io.Reader) (int, error) {
 		return bytesRead, errors.Errorf(errors.ErrInvalidMessage, "invalid data")
 	}
 
-	if util.IsFlagSet(bdl.Flags, BundleFlagCompressed) {
-		c, err := util.DecompressBuffer(bdl.MessageData)
+	c, err := codecs.findCodec(bdl.Flags)
+	if err != nil {
+		return bytesRead, err
+	}
+	b.savedBytes = 0
+	if c != nil {
+		decompressed, err := c.Decompress(bdl.MessageData)
 		if err != nil {
 			return bytesRead, errors.Errorf(errors.ErrInvalidMessage, err.Error())
 		}
-		data = c
+		b.savedBytes = len(decompressed) - len(data)
+		data = decompressed
 	}
 
 	b.Flags = bdl.Flags
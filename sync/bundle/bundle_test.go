@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/fxamacker/cbor/v2"
 	"github.com/pactus-project/pactus/sync/bundle/message"
 	"github.com/pactus-project/pactus/util"
 	"github.com/pactus-project/pactus/util/testsuite"
@@ -57,6 +58,97 @@ func TestMessageCompress(t *testing.T) {
 	assert.True(t, util.IsFlagSet(bdl.Flags, BundleFlagCompressed))
 }
 
+func TestZstdCompress(t *testing.T) {
+	blocksData := [][]byte{}
+	for i := 0; i < 10; i++ {
+		blocksData = append(blocksData, bytes.Repeat([]byte{byte(i)}, 1024))
+	}
+	msg := message.NewBlocksResponseMessage(message.ResponseCodeOK, message.ResponseCodeOK.String(),
+		1234, 888, blocksData, nil)
+	bdl := NewBundle(msg)
+	assert.False(t, bdl.IsCompressed())
+
+	bdl.CompressIt()
+	bdl.CompressWith(BundleFlagCompressedZstd)
+	assert.True(t, bdl.IsCompressed())
+	assert.False(t, util.IsFlagSet(bdl.Flags, BundleFlagCompressed))
+	assert.True(t, util.IsFlagSet(bdl.Flags, BundleFlagCompressedZstd))
+
+	data, err := bdl.Encode()
+	assert.NoError(t, err)
+	assert.Positive(t, bdl.SavedBytes())
+
+	decoded := new(Bundle)
+	_, err = decoded.Decode(bytes.NewReader(data))
+	assert.NoError(t, err)
+	assert.NoError(t, decoded.BasicCheck())
+	assert.Equal(t, bdl.SavedBytes(), decoded.SavedBytes())
+	assert.Equal(t, msg.BlocksData, decoded.Message.(*message.BlocksResponseMessage).BlocksData)
+}
+
+// xorCodec is a codec that is not compatible with the default codecs.
+type xorCodec struct{}
+
+func (xorCodec) Name() string { return "xor" }
+
+func (xorCodec) Compress(data []byte) ([]byte, error) {
+	out := make([]byte, len(data))
+	for i, b := range data {
+		out[i] = b ^ 0xFF
+	}
+
+	return out, nil
+}
+
+func (c xorCodec) Decompress(data []byte) ([]byte, error) {
+	return c.Compress(data)
+}
+
+func TestCodecsPerInstance(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	codecs := NewCodecs()
+	codecs.SetCodec(BundleFlagCompressedZstd, xorCodec{})
+
+	msg := message.NewQueryProposalMessage(ts.RandHeight(), ts.RandRound(), ts.RandValAddress())
+	bdl := NewBundle(msg)
+	bdl.CompressWith(BundleFlagCompressedZstd)
+	data, err := bdl.EncodeWith(codecs)
+	require.NoError(t, err)
+
+	decoded := new(Bundle)
+	_, err = decoded.DecodeWith(bytes.NewReader(data), codecs)
+	assert.NoError(t, err)
+	assert.Equal(t, msg, decoded.Message)
+
+	// Setting a codec doesn't change the default codecs or the other instances.
+	_, err = new(Bundle).Decode(bytes.NewReader(data))
+	assert.Error(t, err)
+	_, err = new(Bundle).DecodeWith(bytes.NewReader(data), NewCodecs())
+	assert.Error(t, err)
+}
+
+func TestInvalidCompressionFlags(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	msg := message.NewQueryProposalMessage(ts.RandHeight(), ts.RandRound(), ts.RandValAddress())
+	bdl := NewBundle(msg)
+	bdl.Flags = BundleFlagCompressed | BundleFlagCompressedZstd
+
+	_, err := bdl.Encode()
+	assert.Error(t, err)
+
+	bdl.Flags = BundleFlagCompressedZstd
+	data, _ := bdl.Encode()
+	raw := new(_Bundle)
+	require.NoError(t, cbor.Unmarshal(data, raw))
+	raw.Flags = BundleFlagCompressed | BundleFlagCompressedZstd
+	data, _ = cbor.Marshal(raw)
+
+	_, err = new(Bundle).Decode(bytes.NewReader(data))
+	assert.Error(t, err)
+}
+
 func TestDecodeVoteMessage(t *testing.T) {
 	ts := testsuite.NewTestSuite(t)
 
@@ -0,0 +1,9 @@
+// Package codec provides the compression codecs of the bundles.
+package codec
+
+// Codec compresses and decompresses the message data of a bundle.
+type Codec interface {
+	Name() string
+	Compress(data []byte) ([]byte, error)
+	Decompress(data []byte) ([]byte, error)
+}
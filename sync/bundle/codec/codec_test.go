@@ -0,0 +1,79 @@
+package codec
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func blockSamples(ts *testsuite.TestSuite, count int) [][]byte {
+	samples := make([][]byte, 0, count)
+	for i := 0; i < count; i++ {
+		blk, _ := ts.GenerateTestBlock(ts.RandHeight())
+		data, _ := blk.Bytes()
+		samples = append(samples, data)
+	}
+
+	return samples
+}
+
+func TestCodecs(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+	data := blockSamples(ts, 1)[0]
+
+	zstdCodec, err := NewZstd(nil)
+	require.NoError(t, err)
+	assert.Zero(t, zstdCodec.DictionaryID())
+
+	for _, c := range []Codec{Gzip{}, zstdCodec} {
+		compressed, err := c.Compress(data)
+		assert.NoError(t, err)
+
+		decompressed, err := c.Decompress(compressed)
+		assert.NoError(t, err)
+		assert.Equal(t, data, decompressed, c.Name())
+
+		_, err = c.Decompress(ts.RandBytes(32))
+		assert.Error(t, err, c.Name())
+	}
+}
+
+func TestZstdDictionary(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+	samples := blockSamples(ts, 64)
+
+	_, err := TrainDictionary(1234, samples[:1])
+	assert.Error(t, err)
+
+	dict, err := TrainDictionary(1234, samples[:60])
+	require.NoError(t, err)
+
+	_, err = NewZstd(ts.RandBytes(32))
+	assert.Error(t, err)
+
+	withDict, err := NewZstd(dict)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1234), withDict.DictionaryID())
+
+	withoutDict, err := NewZstd(nil)
+	require.NoError(t, err)
+
+	data := samples[63]
+	compressedWithDict, _ := withDict.Compress(data)
+	compressedWithoutDict, _ := withoutDict.Compress(data)
+
+	decompressed, err := withDict.Decompress(compressedWithDict)
+	assert.NoError(t, err)
+	assert.Equal(t, data, decompressed)
+
+	// The codec with the dictionary can decompress the data compressed without it.
+	decompressed, err = withDict.Decompress(compressedWithoutDict)
+	assert.NoError(t, err)
+	assert.Equal(t, data, decompressed)
+
+	// The codec without the dictionary can't decompress the data compressed with it.
+	_, err = withoutDict.Decompress(compressedWithDict)
+	assert.Error(t, err)
+}
@@ -0,0 +1,18 @@
+package codec
+
+import "github.com/pactus-project/pactus/util"
+
+// Gzip is the default codec that all peers support.
+type Gzip struct{}
+
+func (Gzip) Name() string {
+	return "gzip"
+}
+
+func (Gzip) Compress(data []byte) ([]byte, error) {
+	return util.CompressBuffer(data)
+}
+
+func (Gzip) Decompress(data []byte) ([]byte, error) {
+	return util.DecompressBuffer(data)
+}
@@ -0,0 +1,101 @@
+package codec
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// maxDecompressedSize limits the memory that decompressing a bundle may use.
+const maxDecompressedSize = 64 << 20
+
+// maxDictionarySize is the maximum size of the history of a trained dictionary.
+const maxDictionarySize = 112 << 10
+
+// Zstd compresses the data with zstd, optionally using a shared dictionary.
+// The data compressed with a dictionary can only be decompressed by the peers that have the same dictionary,
+// but the data compressed without a dictionary can be decompressed by all of them.
+type Zstd struct {
+	dictID  uint32
+	encoder *zstd.Encoder
+	decoder *zstd.Decoder
+}
+
+// NewZstd creates a zstd codec. The dictionary is optional and can be nil.
+func NewZstd(dict []byte) (*Zstd, error) {
+	encOpts := []zstd.EOption{zstd.WithEncoderConcurrency(1)}
+	decOpts := []zstd.DOption{zstd.WithDecoderConcurrency(0), zstd.WithDecoderMaxMemory(maxDecompressedSize)}
+
+	dictID := uint32(0)
+	if len(dict) > 0 {
+		info, err := zstd.InspectDictionary(dict)
+		if err != nil {
+			return nil, err
+		}
+		dictID = info.ID()
+		encOpts = append(encOpts, zstd.WithEncoderDict(dict))
+		decOpts = append(decOpts, zstd.WithDecoderDicts(dict))
+	}
+
+	encoder, err := zstd.NewWriter(nil, encOpts...)
+	if err != nil {
+		return nil, err
+	}
+	decoder, err := zstd.NewReader(nil, decOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Zstd{
+		dictID:  dictID,
+		encoder: encoder,
+		decoder: decoder,
+	}, nil
+}
+
+func (*Zstd) Name() string {
+	return "zstd"
+}
+
+// DictionaryID returns the ID of the dictionary, or zero if the codec has no dictionary.
+func (z *Zstd) DictionaryID() uint32 {
+	return z.dictID
+}
+
+func (z *Zstd) Compress(data []byte) ([]byte, error) {
+	return z.encoder.EncodeAll(data, nil), nil
+}
+
+func (z *Zstd) Decompress(data []byte) ([]byte, error) {
+	return z.decoder.DecodeAll(data, nil)
+}
+
+// TrainDictionary builds a zstd dictionary from the samples, like the encoded blocks.
+// The recent half of the samples makes the dictionary history,
+// and the older half is used to build the entropy tables.
+func TrainDictionary(id uint32, samples [][]byte) (dict []byte, err error) {
+	if len(samples) < 2 {
+		return nil, errors.New("not enough samples")
+	}
+
+	history := bytes.Join(samples[len(samples)/2:], nil)
+	if len(history) > maxDictionarySize {
+		history = history[len(history)-maxDictionarySize:]
+	}
+
+	// BuildDict panics on some degenerate samples.
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("unable to build the dictionary: %v", r)
+		}
+	}()
+
+	return zstd.BuildDict(zstd.BuildDictOptions{
+		ID:       id,
+		Contents: samples[:len(samples)/2],
+		History:  history,
+		Offsets:  [3]int{1, 4, 8},
+	})
+}
@@ -14,6 +14,7 @@ type BlocksResponseMessage struct {
 	BlocksData      [][]byte                      `cbor:"4,keyasint"`
 	LastCertificate *certificate.BlockCertificate `cbor:"5,keyasint"`
 	Reason          string                        `cbor:"6,keyasint"`
+	RetryAfter      uint32                        `cbor:"7,keyasint"` // Seconds to wait before a new request
 }
 
 func NewBlocksResponseMessage(code ResponseCode, reason string, sid int, from uint32,
@@ -0,0 +1,46 @@
+package message
+
+import (
+	"github.com/pactus-project/pactus/network"
+	"github.com/pactus-project/pactus/types/evidence"
+)
+
+type EvidenceMessage struct {
+	Evidence *evidence.Evidence `cbor:"1,keyasint"`
+}
+
+func NewEvidenceMessage(evd *evidence.Evidence) *EvidenceMessage {
+	return &EvidenceMessage{
+		Evidence: evd,
+	}
+}
+
+func (m *EvidenceMessage) BasicCheck() error {
+	if m.Evidence == nil {
+		return evidence.BasicCheckError{
+			Reason: "no evidence",
+		}
+	}
+
+	return m.Evidence.BasicCheck()
+}
+
+func (*EvidenceMessage) Type() Type {
+	return TypeEvidence
+}
+
+func (*EvidenceMessage) TopicID() network.TopicID {
+	return network.TopicIDConsensus
+}
+
+func (*EvidenceMessage) ShouldBroadcast() bool {
+	return true
+}
+
+func (m *EvidenceMessage) String() string {
+	if m.Evidence == nil {
+		return "{}"
+	}
+
+	return m.Evidence.String()
+}
@@ -0,0 +1,49 @@
+package message
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/types/evidence"
+	"github.com/pactus-project/pactus/types/vote"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvidenceType(t *testing.T) {
+	m := &EvidenceMessage{}
+	assert.Equal(t, TypeEvidence, m.Type())
+}
+
+func TestEvidenceMessage(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	t.Run("No evidence", func(t *testing.T) {
+		m := &EvidenceMessage{}
+
+		assert.ErrorIs(t, m.BasicCheck(), evidence.BasicCheckError{Reason: "no evidence"})
+		assert.Equal(t, "{}", m.String())
+	})
+
+	t.Run("Invalid evidence", func(t *testing.T) {
+		v, _ := ts.GenerateTestPrepareVote(100, 0)
+		m := NewEvidenceMessage(evidence.NewDuplicateVoteEvidence(v, v))
+
+		assert.ErrorIs(t, m.BasicCheck(), evidence.BasicCheckError{Reason: "votes are not conflicting"})
+	})
+
+	t.Run("Invalid vote", func(t *testing.T) {
+		v1 := vote.NewPrepareVote(ts.RandHash(), ts.RandHeight(), -1, ts.RandValAddress())
+		v2, _ := ts.GenerateTestPrepareVote(100, 0)
+		m := NewEvidenceMessage(evidence.NewDuplicateVoteEvidence(v1, v2))
+
+		assert.Error(t, m.BasicCheck())
+	})
+
+	t.Run("OK", func(t *testing.T) {
+		evd, _ := ts.GenerateTestEvidence(100, 0)
+		m := NewEvidenceMessage(evd)
+
+		assert.NoError(t, m.BasicCheck())
+		assert.Contains(t, m.String(), evd.String())
+	})
+}
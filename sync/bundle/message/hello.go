@@ -8,6 +8,7 @@ import (
 	"github.com/pactus-project/pactus/crypto/bls"
 	"github.com/pactus-project/pactus/crypto/hash"
 	"github.com/pactus-project/pactus/network"
+	"github.com/pactus-project/pactus/sync/peerset/peer/feature"
 	"github.com/pactus-project/pactus/sync/peerset/peer/service"
 	"github.com/pactus-project/pactus/util/errors"
 	"github.com/pactus-project/pactus/version"
@@ -24,6 +25,8 @@ type HelloMessage struct {
 	GenesisHash     hash.Hash        `cbor:"8,keyasint"`
 	BlockHash       hash.Hash        `cbor:"9,keyasint"`
 	MyTimeUnixMilli int64            `cbor:"10,keyasint"`
+	Features        feature.Features `cbor:"11,keyasint"`
+	DictionaryID    uint32           `cbor:"12,keyasint"` // ID of the zstd dictionary, zero if not set
 }
 
 func NewHelloMessage(pid peer.ID, moniker string,
@@ -38,6 +41,7 @@ func NewHelloMessage(pid peer.ID, moniker string,
 		Height:          height,
 		Services:        services,
 		MyTimeUnixMilli: time.Now().UnixMilli(),
+		Features:        feature.Supported(),
 	}
 }
 
@@ -85,7 +89,11 @@ func (m *HelloMessage) Sign(valKeys []*bls.ValidatorKey) {
 		signatures[i] = key.Sign(signBytes)
 		publicKeys[i] = key.PublicKey()
 	}
-	aggSignature := bls.SignatureAggregate(signatures...)
-	m.Signature = aggSignature
+	m.SetSignatures(publicKeys, signatures)
+}
+
+// SetSignatures sets the public keys of the validators and aggregates their signatures.
+func (m *HelloMessage) SetSignatures(publicKeys []*bls.PublicKey, signatures []*bls.Signature) {
+	m.Signature = bls.SignatureAggregate(signatures...)
 	m.PublicKeys = publicKeys
 }
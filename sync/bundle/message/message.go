@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/pactus-project/pactus/network"
+	"github.com/pactus-project/pactus/sync/peerset/peer/feature"
 )
 
 type ResponseCode int
@@ -50,6 +51,9 @@ const (
 	TypeBlockAnnounce  = Type(8)
 	TypeBlocksRequest  = Type(9)
 	TypeBlocksResponse = Type(10)
+	TypeEvidence       = Type(11)
+	TypeStateRequest   = Type(12)
+	TypeStateResponse  = Type(13)
 )
 
 func (t Type) String() string {
@@ -84,11 +88,49 @@ func (t Type) String() string {
 	case TypeBlocksResponse:
 		return "blocks-response"
 
+	case TypeEvidence:
+		return "evidence"
+
+	case TypeStateRequest:
+		return "state-request"
+
+	case TypeStateResponse:
+		return "state-response"
+
 	default:
 		return fmt.Sprintf("%d", t)
 	}
 }
 
+// ParseType returns the message type by its name, like "blocks-response".
+func ParseType(name string) (Type, error) {
+	for t := TypeHello; t <= TypeStateResponse; t++ {
+		if t.String() == name {
+			return t, nil
+		}
+	}
+
+	return 0, fmt.Errorf("unknown message type: %s", name)
+}
+
+// optionalTypes are the message types that are sent only to the peers that support their feature.
+// A new message type that the old peers don't understand should be registered here.
+// The responses are not registered, since they are sent only to the peers that requested them.
+// The state requests are not registered, since they are sent only to the peers
+// that advertise the state sync service.
+var optionalTypes = map[Type]feature.Feature{}
+
+// RequiredFeature returns the feature that a peer should support to receive the message type.
+// It returns None for the message types that all peers understand.
+func RequiredFeature(t Type) feature.Feature {
+	f, ok := optionalTypes[t]
+	if !ok {
+		return feature.None
+	}
+
+	return f
+}
+
 func MakeMessage(t Type) Message {
 	switch t {
 	case TypeHello:
@@ -120,6 +162,15 @@ func MakeMessage(t Type) Message {
 
 	case TypeBlocksResponse:
 		return &BlocksResponseMessage{}
+
+	case TypeEvidence:
+		return &EvidenceMessage{}
+
+	case TypeStateRequest:
+		return &StateRequestMessage{}
+
+	case TypeStateResponse:
+		return &StateResponseMessage{}
 	}
 
 	//
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/pactus-project/pactus/network"
+	"github.com/pactus-project/pactus/sync/peerset/peer/feature"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -24,6 +25,9 @@ func TestMessage(t *testing.T) {
 		{TypeBlockAnnounce, "block-announce", network.TopicIDBlock, true},
 		{TypeBlocksRequest, "blocks-request", network.TopicIDUnspecified, false},
 		{TypeBlocksResponse, "blocks-response", network.TopicIDUnspecified, false},
+		{TypeEvidence, "evidence", network.TopicIDConsensus, true},
+		{TypeStateRequest, "state-request", network.TopicIDUnspecified, false},
+		{TypeStateResponse, "state-response", network.TopicIDUnspecified, false},
 	}
 
 	for _, tc := range testCases {
@@ -31,5 +35,19 @@ func TestMessage(t *testing.T) {
 		assert.Equal(t, tc.typeName, msg.Type().String())
 		assert.Equal(t, tc.topicID, msg.TopicID())
 		assert.Equal(t, tc.shouldBroadcast, msg.ShouldBroadcast())
+
+		parsed, err := ParseType(tc.typeName)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.msgType, parsed)
 	}
+
+	_, err := ParseType("unknown")
+	assert.Error(t, err)
+}
+
+func TestRequiredFeature(t *testing.T) {
+	assert.Equal(t, feature.None, RequiredFeature(TypeHello))
+	assert.Equal(t, feature.None, RequiredFeature(TypeBlocksRequest))
+	assert.Equal(t, feature.None, RequiredFeature(TypeStateRequest))
+	assert.Equal(t, feature.None, RequiredFeature(TypeStateResponse))
 }
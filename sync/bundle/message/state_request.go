@@ -0,0 +1,83 @@
+package message
+
+import (
+	"fmt"
+
+	"github.com/pactus-project/pactus/network"
+	"github.com/pactus-project/pactus/util/errors"
+)
+
+// StatePart defines which part of a state snapshot is requested.
+type StatePart int
+
+const (
+	StatePartManifest   = StatePart(1)
+	StatePartAccounts   = StatePart(2)
+	StatePartValidators = StatePart(3)
+)
+
+func (p StatePart) String() string {
+	switch p {
+	case StatePartManifest:
+		return "manifest"
+
+	case StatePartAccounts:
+		return "accounts"
+
+	case StatePartValidators:
+		return "validators"
+	}
+
+	return fmt.Sprintf("%d", p)
+}
+
+type StateRequestMessage struct {
+	SessionID int `cbor:"1,keyasint"`
+	// Height is the height of the state snapshot.
+	// It can be zero when requesting the manifest, which means the latest snapshot.
+	Height uint32    `cbor:"2,keyasint"`
+	Part   StatePart `cbor:"3,keyasint"`
+	Chunk  uint32    `cbor:"4,keyasint"`
+}
+
+func NewStateRequestMessage(sid int, height uint32, part StatePart, chunk uint32) *StateRequestMessage {
+	return &StateRequestMessage{
+		SessionID: sid,
+		Height:    height,
+		Part:      part,
+		Chunk:     chunk,
+	}
+}
+
+func (m *StateRequestMessage) BasicCheck() error {
+	switch m.Part {
+	case StatePartManifest:
+		return nil
+
+	case StatePartAccounts, StatePartValidators:
+		if m.Height == 0 {
+			return errors.Errorf(errors.ErrInvalidHeight, "height is zero")
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf(errors.ErrInvalidMessage, "invalid state part: %d", m.Part)
+	}
+}
+
+func (*StateRequestMessage) Type() Type {
+	return TypeStateRequest
+}
+
+func (*StateRequestMessage) TopicID() network.TopicID {
+	return network.TopicIDUnspecified
+}
+
+func (*StateRequestMessage) ShouldBroadcast() bool {
+	return false
+}
+
+func (m *StateRequestMessage) String() string {
+	return fmt.Sprintf("{⚓ %d %v %s:%d}", m.SessionID, m.Height, m.Part, m.Chunk)
+}
@@ -0,0 +1,40 @@
+package message
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/util/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStateRequestType(t *testing.T) {
+	m := &StateRequestMessage{}
+	assert.Equal(t, TypeStateRequest, m.Type())
+}
+
+func TestStateRequestMessage(t *testing.T) {
+	t.Run("Invalid part", func(t *testing.T) {
+		m := NewStateRequestMessage(1, 100, StatePart(4), 0)
+
+		assert.Equal(t, errors.ErrInvalidMessage, errors.Code(m.BasicCheck()))
+	})
+
+	t.Run("Requesting a chunk without height", func(t *testing.T) {
+		m := NewStateRequestMessage(1, 0, StatePartAccounts, 2)
+
+		assert.Equal(t, errors.ErrInvalidHeight, errors.Code(m.BasicCheck()))
+	})
+
+	t.Run("Requesting the latest manifest", func(t *testing.T) {
+		m := NewStateRequestMessage(1, 0, StatePartManifest, 0)
+
+		assert.NoError(t, m.BasicCheck())
+	})
+
+	t.Run("OK", func(t *testing.T) {
+		m := NewStateRequestMessage(1, 100, StatePartValidators, 2)
+
+		assert.NoError(t, m.BasicCheck())
+		assert.Contains(t, m.String(), "validators:2")
+	})
+}
@@ -0,0 +1,159 @@
+package message
+
+import (
+	"fmt"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/network"
+	"github.com/pactus-project/pactus/util/errors"
+)
+
+// StateChunkSize is the maximum number of accounts or validators in a chunk of a state snapshot.
+// It is a power of two, so each chunk is a subtree of the account or validator merkle tree.
+const StateChunkSize = 512
+
+// NumberOfStateChunks returns the number of chunks for the given number of accounts or validators.
+func NumberOfStateChunks(total int32) int {
+	return int((total + StateChunkSize - 1) / StateChunkSize)
+}
+
+// StateManifest describes a state snapshot. It contains the merkle roots of the chunks,
+// so each chunk can be verified on its own.
+// The account addresses and public keys are not part of the state root,
+// therefore the hash of each account chunk is included as well.
+type StateManifest struct {
+	TotalAccounts       int32       `cbor:"1,keyasint"`
+	TotalValidators     int32       `cbor:"2,keyasint"`
+	AccountChunkRoots   []hash.Hash `cbor:"3,keyasint"`
+	AccountChunkHashes  []hash.Hash `cbor:"4,keyasint"`
+	ValidatorChunkRoots []hash.Hash `cbor:"5,keyasint"`
+}
+
+// Hash returns the hash of the manifest, which is used to compare the manifests of different peers.
+func (m *StateManifest) Hash() hash.Hash {
+	data, _ := cbor.Marshal(m)
+
+	return hash.CalcHash(data)
+}
+
+func (m *StateManifest) BasicCheck() error {
+	if m.TotalAccounts <= 0 || m.TotalValidators <= 0 {
+		return errors.Errorf(errors.ErrInvalidMessage, "empty state")
+	}
+	if len(m.AccountChunkRoots) != NumberOfStateChunks(m.TotalAccounts) {
+		return errors.Errorf(errors.ErrInvalidMessage, "invalid number of account chunks: %d",
+			len(m.AccountChunkRoots))
+	}
+	if len(m.AccountChunkHashes) != len(m.AccountChunkRoots) {
+		return errors.Errorf(errors.ErrInvalidMessage, "invalid number of account chunk hashes: %d",
+			len(m.AccountChunkHashes))
+	}
+	if len(m.ValidatorChunkRoots) != NumberOfStateChunks(m.TotalValidators) {
+		return errors.Errorf(errors.ErrInvalidMessage, "invalid number of validator chunks: %d",
+			len(m.ValidatorChunkRoots))
+	}
+
+	return nil
+}
+
+// StateAccount is an account inside a chunk of a state snapshot.
+// The public key is set if it has been revealed before.
+type StateAccount struct {
+	Address   crypto.Address `cbor:"1,keyasint"`
+	Data      []byte         `cbor:"2,keyasint"`
+	PublicKey *bls.PublicKey `cbor:"3,keyasint"`
+}
+
+type StateResponseMessage struct {
+	ResponseCode ResponseCode    `cbor:"1,keyasint"`
+	SessionID    int             `cbor:"2,keyasint"`
+	Height       uint32          `cbor:"3,keyasint"`
+	Part         StatePart       `cbor:"4,keyasint"`
+	Chunk        uint32          `cbor:"5,keyasint"`
+	Manifest     *StateManifest  `cbor:"6,keyasint"`
+	Accounts     []*StateAccount `cbor:"7,keyasint"`
+	Validators   [][]byte        `cbor:"8,keyasint"`
+	Reason       string          `cbor:"9,keyasint"`
+}
+
+func NewStateResponseMessage(code ResponseCode, reason string, sid int,
+	height uint32, part StatePart, chunk uint32,
+) *StateResponseMessage {
+	return &StateResponseMessage{
+		ResponseCode: code,
+		SessionID:    sid,
+		Height:       height,
+		Part:         part,
+		Chunk:        chunk,
+		Reason:       reason,
+	}
+}
+
+func (m *StateResponseMessage) BasicCheck() error {
+	if m.ResponseCode != ResponseCodeOK {
+		return nil
+	}
+
+	if m.Height == 0 {
+		return errors.Errorf(errors.ErrInvalidHeight, "height is zero")
+	}
+
+	switch m.Part {
+	case StatePartManifest:
+		if m.Manifest == nil {
+			return errors.Errorf(errors.ErrInvalidMessage, "no manifest")
+		}
+
+		return m.Manifest.BasicCheck()
+
+	case StatePartAccounts:
+		if len(m.Accounts) == 0 || len(m.Accounts) > StateChunkSize {
+			return errors.Errorf(errors.ErrInvalidMessage, "invalid number of accounts: %d", len(m.Accounts))
+		}
+		for i, acc := range m.Accounts {
+			if acc == nil {
+				return errors.Errorf(errors.ErrInvalidMessage, "account %d is nil", i)
+			}
+		}
+
+		return nil
+
+	case StatePartValidators:
+		if len(m.Validators) == 0 || len(m.Validators) > StateChunkSize {
+			return errors.Errorf(errors.ErrInvalidMessage, "invalid number of validators: %d", len(m.Validators))
+		}
+		for i, val := range m.Validators {
+			if len(val) == 0 {
+				return errors.Errorf(errors.ErrInvalidMessage, "validator %d is empty", i)
+			}
+		}
+
+		return nil
+
+	default:
+		return errors.Errorf(errors.ErrInvalidMessage, "invalid state part: %d", m.Part)
+	}
+}
+
+func (*StateResponseMessage) Type() Type {
+	return TypeStateResponse
+}
+
+func (*StateResponseMessage) TopicID() network.TopicID {
+	return network.TopicIDUnspecified
+}
+
+func (*StateResponseMessage) ShouldBroadcast() bool {
+	return false
+}
+
+func (m *StateResponseMessage) String() string {
+	return fmt.Sprintf("{⚓ %d %s %v %s:%d}", m.SessionID, m.ResponseCode, m.Height, m.Part, m.Chunk)
+}
+
+func (m *StateResponseMessage) IsRequestRejected() bool {
+	return m.ResponseCode == ResponseCodeRejected
+}
@@ -0,0 +1,151 @@
+package message
+
+import (
+	"testing"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/util/errors"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStateResponseType(t *testing.T) {
+	m := &StateResponseMessage{}
+	assert.Equal(t, TypeStateResponse, m.Type())
+}
+
+func TestNumberOfStateChunks(t *testing.T) {
+	assert.Equal(t, 0, NumberOfStateChunks(0))
+	assert.Equal(t, 1, NumberOfStateChunks(1))
+	assert.Equal(t, 1, NumberOfStateChunks(StateChunkSize))
+	assert.Equal(t, 2, NumberOfStateChunks(StateChunkSize+1))
+}
+
+func TestStateResponseMessage(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	sid := 123
+	manifest := &StateManifest{
+		TotalAccounts:       StateChunkSize + 1,
+		TotalValidators:     1,
+		AccountChunkRoots:   []hash.Hash{ts.RandHash(), ts.RandHash()},
+		AccountChunkHashes:  []hash.Hash{ts.RandHash(), ts.RandHash()},
+		ValidatorChunkRoots: []hash.Hash{ts.RandHash()},
+	}
+
+	t.Run("Rejected", func(t *testing.T) {
+		m := NewStateResponseMessage(ResponseCodeRejected, "no snapshot", sid, 0, StatePartManifest, 0)
+
+		assert.NoError(t, m.BasicCheck())
+		assert.True(t, m.IsRequestRejected())
+	})
+
+	t.Run("Without height", func(t *testing.T) {
+		m := NewStateResponseMessage(ResponseCodeOK, "ok", sid, 0, StatePartManifest, 0)
+		m.Manifest = manifest
+
+		assert.Equal(t, errors.ErrInvalidHeight, errors.Code(m.BasicCheck()))
+	})
+
+	t.Run("Without manifest", func(t *testing.T) {
+		m := NewStateResponseMessage(ResponseCodeOK, "ok", sid, 100, StatePartManifest, 0)
+
+		assert.Equal(t, errors.ErrInvalidMessage, errors.Code(m.BasicCheck()))
+	})
+
+	t.Run("Invalid number of chunk roots", func(t *testing.T) {
+		m := NewStateResponseMessage(ResponseCodeOK, "ok", sid, 100, StatePartManifest, 0)
+		m.Manifest = &StateManifest{
+			TotalAccounts:       StateChunkSize + 1,
+			TotalValidators:     1,
+			AccountChunkRoots:   []hash.Hash{ts.RandHash()},
+			AccountChunkHashes:  []hash.Hash{ts.RandHash()},
+			ValidatorChunkRoots: []hash.Hash{ts.RandHash()},
+		}
+
+		assert.Equal(t, errors.ErrInvalidMessage, errors.Code(m.BasicCheck()))
+	})
+
+	t.Run("Invalid number of chunk hashes", func(t *testing.T) {
+		m := NewStateResponseMessage(ResponseCodeOK, "ok", sid, 100, StatePartManifest, 0)
+		m.Manifest = &StateManifest{
+			TotalAccounts:       StateChunkSize + 1,
+			TotalValidators:     1,
+			AccountChunkRoots:   manifest.AccountChunkRoots,
+			AccountChunkHashes:  []hash.Hash{ts.RandHash()},
+			ValidatorChunkRoots: manifest.ValidatorChunkRoots,
+		}
+
+		assert.Equal(t, errors.ErrInvalidMessage, errors.Code(m.BasicCheck()))
+	})
+
+	t.Run("Empty chunk", func(t *testing.T) {
+		m := NewStateResponseMessage(ResponseCodeOK, "ok", sid, 100, StatePartAccounts, 1)
+
+		assert.Equal(t, errors.ErrInvalidMessage, errors.Code(m.BasicCheck()))
+	})
+
+	t.Run("Nil account", func(t *testing.T) {
+		m := NewStateResponseMessage(ResponseCodeOK, "ok", sid, 100, StatePartAccounts, 0)
+		m.Accounts = []*StateAccount{nil}
+
+		assert.Equal(t, errors.ErrInvalidMessage, errors.Code(m.BasicCheck()))
+
+		// A peer can send a nil account in CBOR.
+		data, err := cbor.Marshal(m)
+		require.NoError(t, err)
+
+		decoded := new(StateResponseMessage)
+		require.NoError(t, cbor.Unmarshal(data, decoded))
+		assert.Equal(t, errors.ErrInvalidMessage, errors.Code(decoded.BasicCheck()))
+	})
+
+	t.Run("Empty validator", func(t *testing.T) {
+		m := NewStateResponseMessage(ResponseCodeOK, "ok", sid, 100, StatePartValidators, 0)
+		m.Validators = [][]byte{nil}
+
+		assert.Equal(t, errors.ErrInvalidMessage, errors.Code(m.BasicCheck()))
+	})
+
+	t.Run("OK", func(t *testing.T) {
+		m := NewStateResponseMessage(ResponseCodeOK, "ok", sid, 100, StatePartManifest, 0)
+		m.Manifest = manifest
+
+		assert.NoError(t, m.BasicCheck())
+		assert.Contains(t, m.String(), "manifest")
+
+		data, err := cbor.Marshal(m)
+		require.NoError(t, err)
+
+		decoded := new(StateResponseMessage)
+		require.NoError(t, cbor.Unmarshal(data, decoded))
+		assert.Equal(t, m, decoded)
+		assert.Equal(t, manifest.Hash(), decoded.Manifest.Hash())
+	})
+
+	t.Run("Encoding accounts", func(t *testing.T) {
+		acc, addr := ts.GenerateTestAccount(ts.RandInt32(1000))
+		pub, _ := ts.RandBLSKeyPair()
+		accData, _ := acc.Bytes()
+
+		m := NewStateResponseMessage(ResponseCodeOK, "ok", sid, 100, StatePartAccounts, 0)
+		m.Accounts = []*StateAccount{
+			{Address: addr, Data: accData, PublicKey: pub},
+			{Address: ts.RandAccAddress(), Data: accData},
+		}
+		assert.NoError(t, m.BasicCheck())
+
+		data, err := cbor.Marshal(m)
+		require.NoError(t, err)
+
+		decoded := new(StateResponseMessage)
+		require.NoError(t, cbor.Unmarshal(data, decoded))
+		require.Len(t, decoded.Accounts, 2)
+		assert.Equal(t, addr, decoded.Accounts[0].Address)
+		assert.Equal(t, accData, decoded.Accounts[0].Data)
+		assert.Equal(t, pub.Bytes(), decoded.Accounts[0].PublicKey.Bytes())
+		assert.Nil(t, decoded.Accounts[1].PublicKey)
+	})
+}
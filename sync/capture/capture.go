@@ -0,0 +1,115 @@
+// Package capture records the raw bundles that are exchanged with the peers,
+// so the malformed or unexpected bundles can be inspected later.
+package capture
+
+import (
+	"io"
+	"time"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/pactus-project/pactus/network"
+	"github.com/pactus-project/pactus/sync/peerset/peer"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+type Direction int
+
+const (
+	DirectionInbound  = Direction(1)
+	DirectionOutbound = Direction(2)
+)
+
+func (d Direction) String() string {
+	switch d {
+	case DirectionInbound:
+		return "inbound"
+	case DirectionOutbound:
+		return "outbound"
+	default:
+		return "unknown"
+	}
+}
+
+// Record is a captured bundle.
+// The peer ID of a broadcasted bundle is empty.
+type Record struct {
+	Time      time.Time
+	Direction Direction
+	PeerID    peer.ID
+	TopicID   network.TopicID
+	Data      []byte
+}
+
+type _Record struct {
+	Time      int64           `cbor:"1,keyasint"` // Unix time in nanoseconds
+	Direction Direction       `cbor:"2,keyasint"`
+	PeerID    []byte          `cbor:"3,keyasint"`
+	TopicID   network.TopicID `cbor:"4,keyasint"`
+	Data      []byte          `cbor:"5,keyasint"`
+}
+
+// Writer appends the records to a rotating capture file.
+type Writer struct {
+	file *lumberjack.Logger
+}
+
+func NewWriter(conf *Config) *Writer {
+	return &Writer{
+		file: &lumberjack.Logger{
+			Filename:   conf.Path,
+			MaxSize:    conf.MaxSize,
+			MaxBackups: conf.MaxBackups,
+		},
+	}
+}
+
+// Write appends the record to the capture file.
+// Each record is written at once, so a record is never split between the rotated files.
+// It is safe to call Write concurrently.
+func (w *Writer) Write(rec *Record) error {
+	data, err := cbor.Marshal(&_Record{
+		Time:      rec.Time.UnixNano(),
+		Direction: rec.Direction,
+		PeerID:    []byte(rec.PeerID),
+		TopicID:   rec.TopicID,
+		Data:      rec.Data,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = w.file.Write(data)
+
+	return err
+}
+
+func (w *Writer) Close() error {
+	return w.file.Close()
+}
+
+// Reader reads the records of a capture file.
+type Reader struct {
+	decoder *cbor.Decoder
+}
+
+func NewReader(r io.Reader) *Reader {
+	return &Reader{
+		decoder: cbor.NewDecoder(r),
+	}
+}
+
+// Next returns the next record. It returns io.EOF when there are no more records.
+func (r *Reader) Next() (*Record, error) {
+	var rec _Record
+	if err := r.decoder.Decode(&rec); err != nil {
+		return nil, err
+	}
+
+	return &Record{
+		Time:      time.Unix(0, rec.Time),
+		Direction: rec.Direction,
+		PeerID:    peer.ID(rec.PeerID),
+		TopicID:   rec.TopicID,
+		Data:      rec.Data,
+	}, nil
+}
@@ -0,0 +1,130 @@
+package capture
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pactus-project/pactus/network"
+	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigBasicCheck(t *testing.T) {
+	conf := DefaultConfig()
+	assert.NoError(t, conf.BasicCheck())
+
+	conf.Enable = true
+	assert.NoError(t, conf.BasicCheck())
+
+	conf.MaxSize = 0
+	assert.Error(t, conf.BasicCheck())
+
+	conf.MaxSize = 1
+	conf.MaxBackups = -1
+	assert.Error(t, conf.BasicCheck())
+
+	conf.MaxBackups = 0
+	conf.Path = ""
+	assert.Error(t, conf.BasicCheck())
+}
+
+func TestWriteAndRead(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	conf := DefaultConfig()
+	conf.Enable = true
+	conf.Path = util.TempFilePath()
+
+	recs := []*Record{
+		{
+			Time:      time.Now(),
+			Direction: DirectionInbound,
+			PeerID:    ts.RandPeerID(),
+			TopicID:   network.TopicIDConsensus,
+			Data:      ts.RandBytes(32),
+		},
+		{
+			Time:      time.Now(),
+			Direction: DirectionOutbound,
+			PeerID:    "",
+			TopicID:   network.TopicIDBlock,
+			Data:      ts.RandBytes(64),
+		},
+	}
+
+	w := NewWriter(conf)
+	for _, rec := range recs {
+		require.NoError(t, w.Write(rec))
+	}
+	require.NoError(t, w.Close())
+
+	f, err := os.Open(conf.Path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	r := NewReader(f)
+	for _, expected := range recs {
+		rec, err := r.Next()
+		require.NoError(t, err)
+
+		assert.True(t, expected.Time.Equal(rec.Time))
+		assert.Equal(t, expected.Direction, rec.Direction)
+		assert.Equal(t, expected.PeerID, rec.PeerID)
+		assert.Equal(t, expected.TopicID, rec.TopicID)
+		assert.Equal(t, expected.Data, rec.Data)
+	}
+
+	_, err = r.Next()
+	assert.ErrorIs(t, err, io.EOF)
+}
+
+func TestRotation(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	conf := DefaultConfig()
+	conf.Enable = true
+	conf.Path = filepath.Join(util.TempDirPath(), "bundles.cap")
+	conf.MaxSize = 1
+	conf.MaxBackups = 1
+
+	w := NewWriter(conf)
+	for i := 0; i < 5; i++ {
+		rec := &Record{
+			Time:      time.Now(),
+			Direction: DirectionInbound,
+			PeerID:    ts.RandPeerID(),
+			Data:      ts.RandBytes(600 * 1024),
+		}
+		require.NoError(t, w.Write(rec))
+	}
+	require.NoError(t, w.Close())
+
+	// The old backups are removed in background.
+	assert.Eventually(t, func() bool {
+		files, _ := filepath.Glob(filepath.Join(filepath.Dir(conf.Path), "*.cap"))
+
+		return len(files) == 2
+	}, time.Second, 10*time.Millisecond)
+
+	files, err := filepath.Glob(filepath.Join(filepath.Dir(conf.Path), "*.cap"))
+	require.NoError(t, err)
+	for _, file := range files {
+		f, err := os.Open(file)
+		require.NoError(t, err)
+
+		// Each file contains the whole records.
+		r := NewReader(f)
+		rec, err := r.Next()
+		require.NoError(t, err)
+		assert.Len(t, rec.Data, 600*1024)
+
+		_, err = r.Next()
+		assert.ErrorIs(t, err, io.EOF)
+		f.Close()
+	}
+}
@@ -0,0 +1,41 @@
+package capture
+
+import "fmt"
+
+// Config configures capturing the raw bundles.
+// The capture file is rotated once it reaches MaxSize megabytes,
+// and at most MaxBackups rotated files are retained.
+type Config struct {
+	Enable     bool   `toml:"enable"`
+	Path       string `toml:"path"`
+	MaxSize    int    `toml:"max_size"`
+	MaxBackups int    `toml:"max_backups"`
+}
+
+func DefaultConfig() *Config {
+	return &Config{
+		Enable:     false,
+		Path:       "capture/bundles.cap",
+		MaxSize:    100,
+		MaxBackups: 5,
+	}
+}
+
+// BasicCheck performs basic checks on the configuration.
+func (conf *Config) BasicCheck() error {
+	if !conf.Enable {
+		return nil
+	}
+
+	if conf.Path == "" {
+		return fmt.Errorf("capture path is not set")
+	}
+	if conf.MaxSize <= 0 {
+		return fmt.Errorf("capture max size should be positive")
+	}
+	if conf.MaxBackups < 0 {
+		return fmt.Errorf("capture max backups should not be negative")
+	}
+
+	return nil
+}
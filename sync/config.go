@@ -1,8 +1,12 @@
 package sync
 
 import (
+	"fmt"
+	"runtime"
 	"time"
 
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/sync/capture"
 	"github.com/pactus-project/pactus/sync/firewall"
 	"github.com/pactus-project/pactus/sync/peerset/peer/service"
 	"github.com/pactus-project/pactus/util"
@@ -10,28 +14,38 @@ import (
 )
 
 type Config struct {
-	Moniker        string           `toml:"moniker"`
-	SessionTimeout time.Duration    `toml:"session_timeout"`
-	Firewall       *firewall.Config `toml:"firewall"`
+	Moniker                   string           `toml:"moniker"`
+	SessionTimeout            time.Duration    `toml:"session_timeout"`
+	StateSync                 bool             `toml:"state_sync"`
+	StateSyncCheckpointHeight uint32           `toml:"state_sync_checkpoint_height"`
+	StateSyncCheckpointHash   string           `toml:"state_sync_checkpoint_hash"`
+	Dictionary                string           `toml:"compression_dictionary"`
+	Firewall                  *firewall.Config `toml:"firewall"`
+	Capture                   *capture.Config  `toml:"capture"`
 
 	// Private configs
-	MaxSessions         int              `toml:"-"`
-	BlockPerSession     uint32           `toml:"-"`
-	BlockPerMessage     uint32           `toml:"-"`
-	PruneWindow         uint32           `toml:"-"`
-	LatestSupportingVer version.Version  `toml:"-"`
-	Services            service.Services `toml:"-"`
+	MaxSessions          int              `toml:"-"`
+	BlockPerSession      uint32           `toml:"-"`
+	BlockPerMessage      uint32           `toml:"-"`
+	PruneWindow          uint32           `toml:"-"`
+	CertificateVerifiers int              `toml:"-"`
+	StateSyncPeers       int              `toml:"-"`
+	LatestSupportingVer  version.Version  `toml:"-"`
+	Services             service.Services `toml:"-"`
 }
 
 func DefaultConfig() *Config {
 	return &Config{
-		SessionTimeout:  time.Second * 10,
-		Services:        service.New(service.PrunedNode),
-		MaxSessions:     8,
-		BlockPerSession: 720,
-		BlockPerMessage: 60,
-		PruneWindow:     86_400, // Default retention blocks in prune mode
-		Firewall:        firewall.DefaultConfig(),
+		SessionTimeout:       time.Second * 10,
+		Services:             service.New(service.PrunedNode, service.StateSync),
+		MaxSessions:          8,
+		BlockPerSession:      720,
+		BlockPerMessage:      60,
+		PruneWindow:          86_400, // Default retention blocks in prune mode
+		Firewall:             firewall.DefaultConfig(),
+		Capture:              capture.DefaultConfig(),
+		CertificateVerifiers: runtime.NumCPU(), // Workers that verify the certificates ahead of commit
+		StateSyncPeers:       2,                // Peers that should agree on the state snapshot
 		LatestSupportingVer: version.Version{
 			Major: 1,
 			Minor: 1,
@@ -42,7 +56,28 @@ func DefaultConfig() *Config {
 
 // BasicCheck performs basic checks on the configuration.
 func (conf *Config) BasicCheck() error {
-	return conf.Firewall.BasicCheck()
+	if conf.StateSync {
+		if conf.StateSyncCheckpointHeight == 0 {
+			return fmt.Errorf("state sync needs a trusted checkpoint height")
+		}
+		if _, err := hash.FromString(conf.StateSyncCheckpointHash); err != nil {
+			return fmt.Errorf("invalid state sync checkpoint hash: %w", err)
+		}
+	}
+
+	if err := conf.Firewall.BasicCheck(); err != nil {
+		return err
+	}
+
+	return conf.Capture.BasicCheck()
+}
+
+// StateSyncCheckpoint returns the height and the hash of the trusted block
+// that the state snapshot is anchored to.
+func (conf *Config) StateSyncCheckpoint() (uint32, hash.Hash) {
+	checkpointHash, _ := hash.FromString(conf.StateSyncCheckpointHash)
+
+	return conf.StateSyncCheckpointHeight, checkpointHash
 }
 
 func (conf *Config) CacheSize() int {
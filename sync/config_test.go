@@ -3,6 +3,7 @@ package sync
 import (
 	"testing"
 
+	"github.com/pactus-project/pactus/util/testsuite"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -10,3 +11,37 @@ func TestDefaultConfigCheck(t *testing.T) {
 	c := DefaultConfig()
 	assert.NoError(t, c.BasicCheck())
 }
+
+func TestStateSyncConfigCheck(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	t.Run("No checkpoint height", func(t *testing.T) {
+		c := DefaultConfig()
+		c.StateSync = true
+		c.StateSyncCheckpointHash = ts.RandHash().String()
+
+		assert.ErrorContains(t, c.BasicCheck(), "state sync needs a trusted checkpoint height")
+	})
+
+	t.Run("Invalid checkpoint hash", func(t *testing.T) {
+		c := DefaultConfig()
+		c.StateSync = true
+		c.StateSyncCheckpointHeight = 1000
+		c.StateSyncCheckpointHash = "invalid"
+
+		assert.ErrorContains(t, c.BasicCheck(), "invalid state sync checkpoint hash")
+	})
+
+	t.Run("Valid checkpoint", func(t *testing.T) {
+		c := DefaultConfig()
+		c.StateSync = true
+		c.StateSyncCheckpointHeight = 1000
+		checkpointHash := ts.RandHash()
+		c.StateSyncCheckpointHash = checkpointHash.String()
+
+		assert.NoError(t, c.BasicCheck())
+		height, hash := c.StateSyncCheckpoint()
+		assert.Equal(t, uint32(1000), height)
+		assert.Equal(t, checkpointHash, hash)
+	})
+}
@@ -0,0 +1,177 @@
+package firewall
+
+import (
+	"sync"
+	"time"
+
+	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/sync/peerset/peer"
+)
+
+// usage keeps the bytes that are transferred with a peer in the current window.
+// A peer that is denied once stays exhausted until the next window.
+type usage struct {
+	start          time.Time
+	total          int64
+	perType        map[message.Type]int64
+	exhausted      bool
+	exhaustedTypes map[message.Type]bool
+}
+
+func (u *usage) isExhausted(msgType message.Type) bool {
+	return u.exhausted || u.exhaustedTypes[msgType]
+}
+
+// bandwidthLimiter limits the bytes that are transferred with each peer within a time window.
+// If the limited types are set, the other message types are neither limited nor counted.
+type bandwidthLimiter struct {
+	lk sync.Mutex
+
+	window  time.Duration
+	perPeer int64
+	perType map[message.Type]int64
+	limited map[message.Type]bool
+	usages  map[peer.ID]*usage
+}
+
+func newBandwidthLimiter(window time.Duration, perPeer int64,
+	perMessage map[string]int64, limitedMessages []string,
+) *bandwidthLimiter {
+	// The names are checked in the config's BasicCheck.
+	perType := make(map[message.Type]int64, len(perMessage))
+	for name, limit := range perMessage {
+		t, err := message.ParseType(name)
+		if err == nil && limit > 0 {
+			perType[t] = limit
+		}
+	}
+
+	var limited map[message.Type]bool
+	if limitedMessages != nil {
+		limited = make(map[message.Type]bool, len(limitedMessages))
+		for _, name := range limitedMessages {
+			t, err := message.ParseType(name)
+			if err == nil {
+				limited[t] = true
+			}
+		}
+	}
+
+	return &bandwidthLimiter{
+		window:  window,
+		perPeer: perPeer,
+		perType: perType,
+		limited: limited,
+		usages:  make(map[peer.ID]*usage),
+	}
+}
+
+func (b *bandwidthLimiter) isUnlimited(msgType message.Type) bool {
+	if b.limited != nil && !b.limited[msgType] {
+		return true
+	}
+
+	return b.perPeer == 0 && len(b.perType) == 0
+}
+
+// currentUsage returns the usage of the peer in the current window.
+// The caller should hold the lock.
+func (b *bandwidthLimiter) currentUsage(pid peer.ID, now time.Time) *usage {
+	u, ok := b.usages[pid]
+	if !ok {
+		b.prune(now)
+
+		u = &usage{
+			start:          now,
+			perType:        make(map[message.Type]int64),
+			exhaustedTypes: make(map[message.Type]bool),
+		}
+		b.usages[pid] = u
+	} else if now.Sub(u.start) >= b.window {
+		u.start = now
+		u.total = 0
+		u.exhausted = false
+		clear(u.perType)
+		clear(u.exhaustedTypes)
+	}
+
+	return u
+}
+
+// prune removes the usages with expired windows, like the usages of the disconnected peers.
+func (b *bandwidthLimiter) prune(now time.Time) {
+	for pid, u := range b.usages {
+		if now.Sub(u.start) >= b.window {
+			delete(b.usages, pid)
+		}
+	}
+}
+
+// allow records the transferred bytes if the peer is within its budget.
+// The first message of each window is always allowed, so a message larger than the budget
+// doesn't block the peer forever.
+// If the peer is over its budget, it returns false and the time to wait for the next window.
+func (b *bandwidthLimiter) allow(pid peer.ID, msgType message.Type, size int64) (bool, time.Duration) {
+	if b.isUnlimited(msgType) {
+		return true, 0
+	}
+
+	b.lk.Lock()
+	defer b.lk.Unlock()
+
+	now := time.Now()
+	u := b.currentUsage(pid, now)
+	if u.isExhausted(msgType) {
+		return false, u.start.Add(b.window).Sub(now)
+	}
+
+	if u.total > 0 {
+		if b.perPeer > 0 && u.total+size > b.perPeer {
+			u.exhausted = true
+
+			return false, u.start.Add(b.window).Sub(now)
+		}
+
+		limit, ok := b.perType[msgType]
+		if ok && u.perType[msgType]+size > limit {
+			u.exhaustedTypes[msgType] = true
+
+			return false, u.start.Add(b.window).Sub(now)
+		}
+	}
+
+	u.total += size
+	u.perType[msgType] += size
+
+	return true, 0
+}
+
+// retryAfter returns the time to wait for the next window if the peer has exhausted its budget
+// for the message type. It returns zero if the peer still has a budget.
+func (b *bandwidthLimiter) retryAfter(pid peer.ID, msgType message.Type) time.Duration {
+	if b.isUnlimited(msgType) {
+		return 0
+	}
+
+	b.lk.Lock()
+	defer b.lk.Unlock()
+
+	u, ok := b.usages[pid]
+	if !ok {
+		return 0
+	}
+
+	now := time.Now()
+	if now.Sub(u.start) >= b.window {
+		return 0
+	}
+
+	limit, ok := b.perType[msgType]
+	if u.isExhausted(msgType) ||
+		(b.perPeer > 0 && u.total >= b.perPeer) ||
+		(ok && u.perType[msgType] >= limit) {
+		return u.start.Add(b.window).Sub(now)
+	}
+
+	return 0
+}
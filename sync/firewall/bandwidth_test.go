@@ -0,0 +1,188 @@
+package firewall
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBandwidthConfig(t *testing.T) {
+	testCases := []struct {
+		name    string
+		update  func(b *Bandwidth)
+		wantErr bool
+	}{
+		{
+			name:   "default",
+			update: func(*Bandwidth) {},
+		},
+		{
+			name:   "zero window without limits",
+			update: func(b *Bandwidth) { b.Window = 0 },
+		},
+		{
+			name: "zero window with limits",
+			update: func(b *Bandwidth) {
+				b.Window = 0
+				b.UploadPerPeer = 1000
+			},
+			wantErr: true,
+		},
+		{
+			name:    "negative limit",
+			update:  func(b *Bandwidth) { b.UploadPerPeer = -1 },
+			wantErr: true,
+		},
+		{
+			name:    "unknown message type",
+			update:  func(b *Bandwidth) { b.UploadPerMessage["unknown"] = 1 },
+			wantErr: true,
+		},
+		{
+			name:    "negative message limit",
+			update:  func(b *Bandwidth) { b.DownloadPerMessage["blocks-response"] = -1 },
+			wantErr: true,
+		},
+		{
+			name:   "valid message limit",
+			update: func(b *Bandwidth) { b.UploadPerMessage["blocks-response"] = 1000 },
+		},
+		{
+			name:    "unknown bulk message",
+			update:  func(b *Bandwidth) { b.UploadBulkMessages = []string{"unknown"} },
+			wantErr: true,
+		},
+		{
+			name:    "upload limit of a non-bulk message",
+			update:  func(b *Bandwidth) { b.UploadPerMessage["hello"] = 1000 },
+			wantErr: true,
+		},
+		{
+			name:    "unknown download bulk message",
+			update:  func(b *Bandwidth) { b.DownloadBulkMessages = []string{"unknown"} },
+			wantErr: true,
+		},
+		{
+			name:    "download limit of a non-bulk message",
+			update:  func(b *Bandwidth) { b.DownloadPerMessage["query-vote"] = 1000 },
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			conf := DefaultConfig()
+			tt.update(&conf.Bandwidth)
+
+			err := conf.BasicCheck()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestBandwidthLimiterUnlimited(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	limiter := newBandwidthLimiter(time.Minute, 0, map[string]int64{}, nil)
+	pid := ts.RandPeerID()
+
+	for i := 0; i < 10; i++ {
+		ok, _ := limiter.allow(pid, message.TypeBlocksResponse, 1_000_000)
+		assert.True(t, ok)
+	}
+	assert.Zero(t, limiter.retryAfter(pid, message.TypeBlocksResponse))
+	assert.Empty(t, limiter.usages)
+}
+
+func TestBandwidthLimiterPerMessage(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	limiter := newBandwidthLimiter(time.Minute, 0, map[string]int64{"blocks-response": 100}, nil)
+	pid := ts.RandPeerID()
+
+	t.Run("First message of the window is always allowed", func(t *testing.T) {
+		ok, _ := limiter.allow(pid, message.TypeBlocksResponse, 200)
+		assert.True(t, ok)
+	})
+
+	t.Run("Over the budget", func(t *testing.T) {
+		ok, retryAfter := limiter.allow(pid, message.TypeBlocksResponse, 1)
+		assert.False(t, ok)
+		assert.LessOrEqual(t, retryAfter, time.Minute)
+		assert.Positive(t, limiter.retryAfter(pid, message.TypeBlocksResponse))
+	})
+
+	t.Run("Other message types are not limited", func(t *testing.T) {
+		ok, _ := limiter.allow(pid, message.TypeHello, 1000)
+		assert.True(t, ok)
+		assert.Zero(t, limiter.retryAfter(pid, message.TypeHello))
+	})
+}
+
+func TestBandwidthLimiterExhausted(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	limiter := newBandwidthLimiter(time.Minute, 100, map[string]int64{}, nil)
+	pid := ts.RandPeerID()
+
+	ok, _ := limiter.allow(pid, message.TypeBlocksResponse, 60)
+	assert.True(t, ok)
+	assert.Zero(t, limiter.retryAfter(pid, message.TypeBlocksResponse))
+
+	ok, _ = limiter.allow(pid, message.TypeBlocksResponse, 60)
+	assert.False(t, ok)
+
+	// The peer stays exhausted, even for smaller messages.
+	ok, _ = limiter.allow(pid, message.TypeHello, 10)
+	assert.False(t, ok)
+	assert.Positive(t, limiter.retryAfter(pid, message.TypeHello))
+}
+
+func TestBandwidthLimiterWindow(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	window := 50 * time.Millisecond
+	limiter := newBandwidthLimiter(window, 100, map[string]int64{}, nil)
+	pid1 := ts.RandPeerID()
+	pid2 := ts.RandPeerID()
+
+	ok, _ := limiter.allow(pid1, message.TypeBlocksResponse, 100)
+	assert.True(t, ok)
+	ok, _ = limiter.allow(pid1, message.TypeBlocksResponse, 100)
+	assert.False(t, ok)
+
+	time.Sleep(window + 10*time.Millisecond)
+
+	assert.Zero(t, limiter.retryAfter(pid1, message.TypeBlocksResponse))
+
+	// The expired usages are pruned.
+	ok, _ = limiter.allow(pid2, message.TypeBlocksResponse, 100)
+	assert.True(t, ok)
+	assert.NotContains(t, limiter.usages, pid1)
+}
+
+func TestBandwidthLimiterBulkMessages(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	limiter := newBandwidthLimiter(time.Minute, 100, map[string]int64{}, []string{"blocks-response"})
+	pid := ts.RandPeerID()
+
+	ok, _ := limiter.allow(pid, message.TypeBlocksResponse, 100)
+	assert.True(t, ok)
+	ok, _ = limiter.allow(pid, message.TypeBlocksResponse, 100)
+	assert.False(t, ok)
+
+	// The other messages are neither limited nor counted.
+	for _, msgType := range []message.Type{message.TypeHello, message.TypeHelloAck, message.TypeVote} {
+		ok, _ = limiter.allow(pid, msgType, 1000)
+		assert.True(t, ok)
+		assert.Zero(t, limiter.retryAfter(pid, msgType))
+	}
+}
@@ -1,7 +1,12 @@
 package firewall
 
 import (
+	"fmt"
 	"net"
+	"slices"
+	"time"
+
+	"github.com/pactus-project/pactus/sync/bundle/message"
 )
 
 type RateLimit struct {
@@ -10,9 +15,25 @@ type RateLimit struct {
 	ConsensusTopic   int `toml:"consensus_topic"`
 }
 
+// Bandwidth limits the bytes that are sent to or received from each peer within a time window.
+// The per-message limits are keyed by the message type name, like "blocks-response".
+// The upload and download limits only apply to the bulk messages, so the handshake and
+// consensus messages are never held back.
+// A zero limit means no limit.
+type Bandwidth struct {
+	Window               time.Duration    `toml:"window"`
+	UploadPerPeer        int64            `toml:"upload_per_peer"`
+	DownloadPerPeer      int64            `toml:"download_per_peer"`
+	UploadBulkMessages   []string         `toml:"upload_bulk_messages"`
+	DownloadBulkMessages []string         `toml:"download_bulk_messages"`
+	UploadPerMessage     map[string]int64 `toml:"upload_per_message"`
+	DownloadPerMessage   map[string]int64 `toml:"download_per_message"`
+}
+
 type Config struct {
 	BannedNets []string  `toml:"banned_nets"`
 	RateLimit  RateLimit `toml:"rate_limit"`
+	Bandwidth  Bandwidth `toml:"bandwidth"`
 }
 
 func DefaultConfig() *Config {
@@ -23,6 +44,15 @@ func DefaultConfig() *Config {
 			TransactionTopic: 5,
 			ConsensusTopic:   0,
 		},
+		Bandwidth: Bandwidth{
+			Window:               time.Minute,
+			UploadPerPeer:        0,
+			DownloadPerPeer:      0,
+			UploadBulkMessages:   []string{"blocks-response", "state-response"},
+			DownloadBulkMessages: []string{"blocks-response", "state-response"},
+			UploadPerMessage:     make(map[string]int64),
+			DownloadPerMessage:   make(map[string]int64),
+		},
 	}
 }
 
@@ -35,5 +65,52 @@ func (conf *Config) BasicCheck() error {
 		}
 	}
 
+	return conf.Bandwidth.BasicCheck()
+}
+
+// BasicCheck performs basic checks on the bandwidth configuration.
+func (b *Bandwidth) BasicCheck() error {
+	if b.UploadPerPeer < 0 || b.DownloadPerPeer < 0 {
+		return fmt.Errorf("bandwidth limits should not be negative")
+	}
+
+	for _, limits := range []map[string]int64{b.UploadPerMessage, b.DownloadPerMessage} {
+		for name, limit := range limits {
+			if _, err := message.ParseType(name); err != nil {
+				return err
+			}
+			if limit < 0 {
+				return fmt.Errorf("bandwidth limit of %s should not be negative", name)
+			}
+		}
+	}
+
+	for _, names := range [][]string{b.UploadBulkMessages, b.DownloadBulkMessages} {
+		for _, name := range names {
+			if _, err := message.ParseType(name); err != nil {
+				return err
+			}
+		}
+	}
+	for name := range b.UploadPerMessage {
+		if !slices.Contains(b.UploadBulkMessages, name) {
+			return fmt.Errorf("upload limit of %s is not applied, it is not a bulk message", name)
+		}
+	}
+	for name := range b.DownloadPerMessage {
+		if !slices.Contains(b.DownloadBulkMessages, name) {
+			return fmt.Errorf("download limit of %s is not applied, it is not a bulk message", name)
+		}
+	}
+
+	if b.hasLimit() && b.Window <= 0 {
+		return fmt.Errorf("bandwidth window should be positive: %v", b.Window)
+	}
+
 	return nil
 }
+
+func (b *Bandwidth) hasLimit() bool {
+	return b.UploadPerPeer > 0 || b.DownloadPerPeer > 0 ||
+		len(b.UploadPerMessage) > 0 || len(b.DownloadPerMessage) > 0
+}
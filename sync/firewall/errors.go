@@ -3,6 +3,7 @@ package firewall
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	lp2pcore "github.com/libp2p/go-libp2p/core"
 )
@@ -17,6 +18,17 @@ func (e PeerBannedError) Error() string {
 	return fmt.Sprintf("peer is banned, peer-id: %s, remote-address: %s", e.PeerID, e.Address)
 }
 
+// BandwidthExceededError is returned when a peer sends more data than its download budget.
+type BandwidthExceededError struct {
+	PeerID     lp2pcore.PeerID
+	RetryAfter time.Duration
+}
+
+func (e BandwidthExceededError) Error() string {
+	return fmt.Sprintf("peer exceeded the bandwidth limit, peer-id: %s, retry-after: %v",
+		e.PeerID, e.RetryAfter)
+}
+
 // ErrGossipMessage is returned when a stream message sends as gossip message.
 var ErrGossipMessage = errors.New("receive stream message as gossip message")
 
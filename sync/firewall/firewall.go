@@ -10,49 +10,59 @@ import (
 	"github.com/pactus-project/pactus/network"
 	"github.com/pactus-project/pactus/state"
 	"github.com/pactus-project/pactus/sync/bundle"
+	"github.com/pactus-project/pactus/sync/bundle/message"
 	"github.com/pactus-project/pactus/sync/peerset"
 	"github.com/pactus-project/pactus/sync/peerset/peer"
 	"github.com/pactus-project/pactus/sync/peerset/peer/status"
 	"github.com/pactus-project/pactus/util/errors"
-	"github.com/pactus-project/pactus/util/ipblocker"
 	"github.com/pactus-project/pactus/util/logger"
 	"github.com/pactus-project/pactus/util/ratelimit"
 )
 
+// The penalty scores of the misbehaving peers.
+// The network bans a peer once its accumulated score reaches the ban score.
+const (
+	penaltyInvalidBundle     = 10
+	penaltyUnexpectedMessage = 20
+)
+
 // Firewall check packets before passing them to sync module.
 type Firewall struct {
 	config               *Config
 	network              network.Network
 	peerSet              *peerset.PeerSet
 	state                state.Facade
-	ipBlocker            *ipblocker.IPBlocker
+	codecs               *bundle.Codecs
 	blockRateLimit       *ratelimit.RateLimit
 	transactionRateLimit *ratelimit.RateLimit
 	consensusRateLimit   *ratelimit.RateLimit
+	uploadLimiter        *bandwidthLimiter
+	downloadLimiter      *bandwidthLimiter
 	logger               *logger.SubLogger
 }
 
 func NewFirewall(conf *Config, net network.Network, peerSet *peerset.PeerSet, st state.Facade,
-	log *logger.SubLogger,
+	codecs *bundle.Codecs, log *logger.SubLogger,
 ) (*Firewall, error) {
-	blocker, err := ipblocker.New(conf.BannedNets)
-	if err != nil {
-		return nil, err
-	}
-
 	blockRateLimit := ratelimit.NewRateLimit(conf.RateLimit.BlockTopic, time.Second)
 	transactionRateLimit := ratelimit.NewRateLimit(conf.RateLimit.TransactionTopic, time.Second)
 	consensusRateLimit := ratelimit.NewRateLimit(conf.RateLimit.ConsensusTopic, time.Second)
+	uploadLimiter := newBandwidthLimiter(conf.Bandwidth.Window,
+		conf.Bandwidth.UploadPerPeer, conf.Bandwidth.UploadPerMessage, conf.Bandwidth.UploadBulkMessages)
+	downloadLimiter := newBandwidthLimiter(conf.Bandwidth.Window,
+		conf.Bandwidth.DownloadPerPeer, conf.Bandwidth.DownloadPerMessage, conf.Bandwidth.DownloadBulkMessages)
 
 	return &Firewall{
 		config:               conf,
 		network:              net,
 		peerSet:              peerSet,
 		state:                st,
-		ipBlocker:            blocker,
+		codecs:               codecs,
 		blockRateLimit:       blockRateLimit,
 		transactionRateLimit: transactionRateLimit,
 		consensusRateLimit:   consensusRateLimit,
+		uploadLimiter:        uploadLimiter,
+		downloadLimiter:      downloadLimiter,
 		logger:               log,
 	}, nil
 }
@@ -67,6 +77,7 @@ func (f *Firewall) OpenGossipBundle(data []byte, from peer.ID) (*bundle.Bundle,
 		f.logger.Warn("firewall: receive stream message as gossip message",
 			"error", err, "bundle", bdl, "from", from)
 
+		f.network.PenalizePeer(from, penaltyUnexpectedMessage, ErrGossipMessage.Error())
 		f.closeConnection(from)
 
 		return nil, ErrGossipMessage
@@ -84,7 +95,16 @@ func (f *Firewall) IsBannedAddress(remoteAddr string) bool {
 		return false
 	}
 
-	return f.ipBlocker.IsBanned(ip)
+	return f.network.IsIPBanned(ip)
+}
+
+// IsBannedPeer checks if the peer is banned, either by its reputation or by its remote address.
+func (f *Firewall) IsBannedPeer(pid peer.ID, remoteAddr string) bool {
+	if f.network.IsPeerBanned(pid) {
+		return true
+	}
+
+	return remoteAddr != "" && f.IsBannedAddress(remoteAddr)
 }
 
 func (f *Firewall) OpenStreamBundle(r io.Reader, from peer.ID) (*bundle.Bundle, error) {
@@ -100,6 +120,7 @@ func (f *Firewall) OpenStreamBundle(r io.Reader, from peer.ID) (*bundle.Bundle,
 		f.logger.Warn("firewall: receive gossip message as stream message",
 			"error", err, "bundle", bdl, "from", from)
 
+		f.network.PenalizePeer(from, penaltyUnexpectedMessage, ErrStreamMessage.Error())
 		f.closeConnection(from)
 
 		return nil, ErrStreamMessage
@@ -122,7 +143,7 @@ func (f *Firewall) openBundle(r io.Reader, from peer.ID) (*bundle.Bundle, error)
 		}
 	}
 
-	if f.IsBannedAddress(p.Address) {
+	if f.IsBannedPeer(from, p.Address) {
 		f.closeConnection(from)
 		f.peerSet.UpdateStatus(from, status.StatusBanned)
 
@@ -132,15 +153,26 @@ func (f *Firewall) openBundle(r io.Reader, from peer.ID) (*bundle.Bundle, error)
 		}
 	}
 
-	bdl, err := f.decodeBundle(r, from)
+	bdl, bytesRead, err := f.decodeBundle(r, from)
 	if err != nil {
 		f.peerSet.IncreaseInvalidBundlesCounter(from)
+		f.network.PenalizePeer(from, penaltyInvalidBundle, err.Error())
 
 		return nil, err
 	}
 
+	// The peer might be sending the data that we have requested,
+	// so the bundle is dropped without penalizing the peer.
+	if ok, retryAfter := f.downloadLimiter.allow(from, bdl.Message.Type(), int64(bytesRead)); !ok {
+		return nil, BandwidthExceededError{
+			PeerID:     from,
+			RetryAfter: retryAfter,
+		}
+	}
+
 	if err := f.checkBundle(bdl); err != nil {
 		f.peerSet.IncreaseInvalidBundlesCounter(from)
+		f.network.PenalizePeer(from, penaltyInvalidBundle, err.Error())
 
 		return bdl, err
 	}
@@ -148,15 +180,16 @@ func (f *Firewall) openBundle(r io.Reader, from peer.ID) (*bundle.Bundle, error)
 	return bdl, nil
 }
 
-func (f *Firewall) decodeBundle(r io.Reader, pid peer.ID) (*bundle.Bundle, error) {
+func (f *Firewall) decodeBundle(r io.Reader, pid peer.ID) (*bundle.Bundle, int, error) {
 	bdl := new(bundle.Bundle)
-	bytesRead, err := bdl.Decode(r)
+	bytesRead, err := bdl.DecodeWith(r, f.codecs)
 	if err != nil {
-		return nil, errors.Errorf(errors.ErrInvalidMessage, err.Error())
+		return nil, bytesRead, errors.Errorf(errors.ErrInvalidMessage, err.Error())
 	}
 	f.peerSet.IncreaseReceivedBytesCounter(pid, bdl.Message.Type(), int64(bytesRead))
+	f.peerSet.IncreaseReceivedSavedBytes(bdl.Message.Type(), int64(bdl.SavedBytes()))
 
-	return bdl, nil
+	return bdl, bytesRead, nil
 }
 
 func (f *Firewall) checkBundle(bdl *bundle.Bundle) error {
@@ -215,6 +248,19 @@ func (*Firewall) getIPFromMultiAddress(address string) (string, error) {
 	return ip, nil
 }
 
+// AllowUpload records the bytes sent to the peer if the peer is within its upload budget.
+// Otherwise, it returns false and the time to wait before sending more data to the peer.
+// Only the bulk messages are limited, the other messages are always allowed.
+func (f *Firewall) AllowUpload(pid peer.ID, msgType message.Type, size int) (bool, time.Duration) {
+	return f.uploadLimiter.allow(pid, msgType, int64(size))
+}
+
+// UploadRetryAfter returns the time to wait if the peer has exhausted its upload budget
+// for the message type, or zero if the peer still has a budget.
+func (f *Firewall) UploadRetryAfter(pid peer.ID, msgType message.Type) time.Duration {
+	return f.uploadLimiter.retryAfter(pid, msgType)
+}
+
 func (f *Firewall) AllowBlockRequest() bool {
 	return f.blockRateLimit.AllowRequest()
 }
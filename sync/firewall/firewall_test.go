@@ -45,7 +45,7 @@ func setup(t *testing.T, conf *Config) *testData {
 		conf = DefaultConfig()
 	}
 	require.NoError(t, conf.BasicCheck())
-	firewall, err := NewFirewall(conf, net, peerSet, st, subLogger)
+	firewall, err := NewFirewall(conf, net, peerSet, st, bundle.NewCodecs(), subLogger)
 	if err != nil {
 		return nil
 	}
@@ -165,6 +165,7 @@ func TestDecodeBundles(t *testing.T) {
 	p := td.firewall.peerSet.GetPeer(td.unknownPeerID)
 	assert.Equal(t, 5, p.ReceivedBundles)
 	assert.Equal(t, 4, p.InvalidBundles)
+	assert.Equal(t, 4*penaltyInvalidBundle, td.network.Penalties[td.unknownPeerID])
 }
 
 func TestGossipMessage(t *testing.T) {
@@ -199,6 +200,22 @@ func TestGossipMessage(t *testing.T) {
 		_, err := td.firewall.OpenGossipBundle(data, td.unknownPeerID)
 		require.ErrorIs(t, err, ErrGossipMessage)
 		assert.True(t, td.network.IsClosed(td.unknownPeerID))
+		assert.Equal(t, penaltyUnexpectedMessage, td.network.Penalties[td.unknownPeerID])
+	})
+
+	t.Run("Message from a peer banned by its reputation", func(t *testing.T) {
+		td := setup(t, nil)
+
+		data := td.testGossipBundle()
+		td.network.Banned[td.goodPeerID] = true
+
+		_, err := td.firewall.OpenGossipBundle(data, td.goodPeerID)
+		require.ErrorIs(t, err, PeerBannedError{
+			PeerID:  td.goodPeerID,
+			Address: "",
+		})
+		assert.True(t, td.network.IsClosed(td.goodPeerID))
+		assert.True(t, td.firewall.peerSet.GetPeerStatus(td.goodPeerID).IsBanned())
 	})
 
 	t.Run("Ok", func(t *testing.T) {
@@ -246,6 +263,7 @@ func TestStreamMessage(t *testing.T) {
 		_, err := td.firewall.OpenStreamBundle(bytes.NewReader(data), td.unknownPeerID)
 		require.ErrorIs(t, err, ErrStreamMessage)
 		assert.True(t, td.network.IsClosed(td.unknownPeerID))
+		assert.Equal(t, penaltyUnexpectedMessage, td.network.Penalties[td.unknownPeerID])
 	})
 
 	t.Run("Ok", func(t *testing.T) {
@@ -273,13 +291,11 @@ func TestUpdateLastReceived(t *testing.T) {
 }
 
 func TestBannedAddress(t *testing.T) {
-	conf := &Config{
-		BannedNets: []string{
-			"115.193.0.0/16",
-			"240e:390:8a1:ae80:0000:0000:0000:0000/64",
-		},
-	}
-	td := setup(t, conf)
+	td := setup(t, nil)
+
+	// The banned networks are kept by the network.
+	require.NoError(t, td.network.BanNet("115.193.0.0/16", 0, ""))
+	require.NoError(t, td.network.BanNet("240e:390:8a1:ae80:0000:0000:0000:0000/64", 0, ""))
 
 	testCases := []struct {
 		addr   string
@@ -323,6 +339,18 @@ func TestBannedAddress(t *testing.T) {
 	}
 }
 
+func TestIsBannedPeer(t *testing.T) {
+	td := setup(t, nil)
+
+	td.network.Banned[td.bannedPeerID] = true
+	require.NoError(t, td.network.BanNet("115.193.0.0/16", 0, ""))
+
+	assert.True(t, td.firewall.IsBannedPeer(td.bannedPeerID, ""))
+	assert.True(t, td.firewall.IsBannedPeer(td.goodPeerID, "/ip4/115.193.157.138/tcp/21888"))
+	assert.False(t, td.firewall.IsBannedPeer(td.goodPeerID, "/ip4/10.10.10.10/tcp/21888"))
+	assert.False(t, td.firewall.IsBannedPeer(td.goodPeerID, ""))
+}
+
 func TestNetworkFlagsMainnet(t *testing.T) {
 	td := setup(t, nil)
 
@@ -441,3 +469,66 @@ func TestAllowConsensusRequest(t *testing.T) {
 	assert.True(t, td.firewall.AllowConsensusRequest())
 	assert.False(t, td.firewall.AllowConsensusRequest())
 }
+
+func TestAllowUpload(t *testing.T) {
+	conf := DefaultConfig()
+	conf.Bandwidth.UploadPerPeer = 100
+
+	td := setup(t, conf)
+	pid := td.RandPeerID()
+
+	ok, _ := td.firewall.AllowUpload(pid, message.TypeBlocksResponse, 60)
+	assert.True(t, ok)
+	assert.Zero(t, td.firewall.UploadRetryAfter(pid, message.TypeBlocksResponse))
+
+	ok, retryAfter := td.firewall.AllowUpload(pid, message.TypeBlocksResponse, 60)
+	assert.False(t, ok)
+	assert.Positive(t, retryAfter)
+	assert.Positive(t, td.firewall.UploadRetryAfter(pid, message.TypeBlocksResponse))
+
+	// Other peers have their own budget.
+	ok, _ = td.firewall.AllowUpload(td.RandPeerID(), message.TypeBlocksResponse, 60)
+	assert.True(t, ok)
+
+	// The handshake and consensus messages are not limited.
+	ok, _ = td.firewall.AllowUpload(pid, message.TypeHello, 60)
+	assert.True(t, ok)
+	ok, _ = td.firewall.AllowUpload(pid, message.TypeQueryVote, 60)
+	assert.True(t, ok)
+}
+
+func TestDownloadLimit(t *testing.T) {
+	conf := DefaultConfig()
+	conf.Bandwidth.DownloadPerPeer = 1
+
+	td := setup(t, conf)
+
+	blocksResponse := func() []byte {
+		msg := message.NewBlocksResponseMessage(message.ResponseCodeMoreBlocks,
+			message.ResponseCodeMoreBlocks.String(), td.RandInt(100), td.RandHeight(), [][]byte{td.RandBytes(64)}, nil)
+		bdl := bundle.NewBundle(msg)
+		bdl.Flags = util.SetFlag(bdl.Flags, bundle.BundleFlagNetworkMainnet)
+		d, _ := bdl.Encode()
+
+		return d
+	}
+
+	// The first message of the window is always allowed.
+	_, err := td.firewall.OpenStreamBundle(bytes.NewReader(blocksResponse()), td.goodPeerID)
+	assert.NoError(t, err)
+
+	_, err = td.firewall.OpenStreamBundle(bytes.NewReader(blocksResponse()), td.goodPeerID)
+	assert.ErrorAs(t, err, &BandwidthExceededError{})
+
+	// The peer is not penalized for exceeding its budget.
+	assert.Zero(t, td.network.Penalties[td.goodPeerID])
+	assert.Zero(t, td.firewall.peerSet.GetPeer(td.goodPeerID).InvalidBundles)
+
+	// The other messages, like the requests and consensus messages, are not limited.
+	_, err = td.firewall.OpenStreamBundle(bytes.NewReader(td.testStreamBundle()), td.goodPeerID)
+	assert.NoError(t, err)
+	_, err = td.firewall.OpenGossipBundle(td.testGossipBundle(), td.goodPeerID)
+	assert.NoError(t, err)
+	_, err = td.firewall.OpenGossipBundle(td.testGossipBundle(), td.goodPeerID)
+	assert.NoError(t, err)
+}
@@ -2,6 +2,7 @@ package sync
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/pactus-project/pactus/sync/bundle"
 	"github.com/pactus-project/pactus/sync/bundle/message"
@@ -42,6 +43,12 @@ func (handler *blocksRequestHandler) ParseMessage(m message.Message, pid peer.ID
 		return
 	}
 
+	if retryAfter := handler.firewall.UploadRetryAfter(pid, message.TypeBlocksResponse); retryAfter > 0 {
+		handler.rejectThrottled(msg.SessionID, retryAfter, pid)
+
+		return
+	}
+
 	ourHeight := handler.state.LastBlockHeight()
 	if msg.From > ourHeight {
 		response := message.NewBlocksResponseMessage(message.ResponseCodeRejected,
@@ -77,6 +84,13 @@ func (handler *blocksRequestHandler) ParseMessage(m message.Message, pid peer.ID
 			message.ResponseCodeMoreBlocks.String(), msg.SessionID, height, blocksData, nil)
 		handler.respond(response, pid)
 
+		// The peer should request the remaining blocks after the bandwidth window is reset.
+		if retryAfter := handler.firewall.UploadRetryAfter(pid, message.TypeBlocksResponse); retryAfter > 0 {
+			handler.rejectThrottled(msg.SessionID, retryAfter, pid)
+
+			return
+		}
+
 		height += uint32(len(blocksData))
 		count -= uint32(len(blocksData))
 		if count <= 0 {
@@ -104,6 +118,18 @@ func (*blocksRequestHandler) PrepareBundle(m message.Message) *bundle.Bundle {
 	return bundle.NewBundle(m)
 }
 
+// rejectThrottled rejects the request of the peer that has exhausted its upload budget.
+// The response includes the time that the peer should wait before the next request.
+func (handler *blocksRequestHandler) rejectThrottled(sid int, retryAfter time.Duration, to peer.ID) {
+	response := message.NewBlocksResponseMessage(message.ResponseCodeRejected,
+		fmt.Sprintf("bandwidth limit exceeded, retry after %v", retryAfter.Round(time.Second)),
+		sid, 0, nil, nil)
+	// Round up, so the peer doesn't retry before the window is reset.
+	response.RetryAfter = uint32((retryAfter + time.Second - 1) / time.Second)
+
+	handler.respond(response, to)
+}
+
 func (handler *blocksRequestHandler) respond(msg *message.BlocksResponseMessage, to peer.ID) {
 	if msg.ResponseCode == message.ResponseCodeRejected {
 		handler.logger.Debug("rejecting block request message", "msg", msg,
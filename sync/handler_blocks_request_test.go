@@ -128,4 +128,51 @@ func TestBlocksRequestMessages(t *testing.T) {
 			assert.Equal(t, message.ResponseCodeNoMoreBlocks, msg2.Message.(*message.BlocksResponseMessage).ResponseCode)
 		})
 	})
+	t.Run("Bandwidth limit is enabled", func(t *testing.T) {
+		config := testConfig()
+		config.Firewall.Bandwidth.UploadPerMessage = map[string]int64{"blocks-response": 1}
+
+		td := setup(t, config)
+		sid := td.RandInt(100)
+
+		td.state.CommitTestBlocks(31)
+		pid := td.addPeer(t, status.StatusKnown, service.New(service.None))
+
+		t.Run("Reject the remaining blocks when the budget is exhausted", func(t *testing.T) {
+			msg := message.NewBlocksRequestMessage(sid, 1, config.BlockPerSession)
+			td.receivingNewMessage(td.sync, msg, pid)
+
+			bdl1 := td.shouldPublishMessageWithThisType(t, message.TypeBlocksResponse)
+			res1 := bdl1.Message.(*message.BlocksResponseMessage)
+			assert.Equal(t, message.ResponseCodeMoreBlocks, res1.ResponseCode)
+			assert.Equal(t, config.BlockPerMessage, res1.Count())
+
+			bdl2 := td.shouldPublishMessageWithThisType(t, message.TypeBlocksResponse)
+			res2 := bdl2.Message.(*message.BlocksResponseMessage)
+			assert.Equal(t, message.ResponseCodeRejected, res2.ResponseCode)
+			assert.Contains(t, res2.Reason, "bandwidth limit exceeded")
+			assert.Equal(t, uint32(60), res2.RetryAfter)
+			assert.Equal(t, sid, res2.SessionID)
+		})
+
+		t.Run("Reject new requests until the budget is reset", func(t *testing.T) {
+			msg := message.NewBlocksRequestMessage(sid, 12, 1)
+			td.receivingNewMessage(td.sync, msg, pid)
+
+			bdl := td.shouldPublishMessageWithThisType(t, message.TypeBlocksResponse)
+			res := bdl.Message.(*message.BlocksResponseMessage)
+			assert.Equal(t, message.ResponseCodeRejected, res.ResponseCode)
+			assert.Positive(t, res.RetryAfter)
+		})
+
+		t.Run("Other peers are not limited", func(t *testing.T) {
+			other := td.addPeer(t, status.StatusKnown, service.New(service.None))
+			msg := message.NewBlocksRequestMessage(sid, 12, 1)
+			td.receivingNewMessage(td.sync, msg, other)
+
+			bdl := td.shouldPublishMessageWithThisType(t, message.TypeBlocksResponse)
+			res := bdl.Message.(*message.BlocksResponseMessage)
+			assert.Equal(t, message.ResponseCodeMoreBlocks, res.ResponseCode)
+		})
+	})
 }
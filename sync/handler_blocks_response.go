@@ -1,6 +1,8 @@
 package sync
 
 import (
+	"time"
+
 	"github.com/pactus-project/pactus/sync/bundle"
 	"github.com/pactus-project/pactus/sync/bundle/message"
 	"github.com/pactus-project/pactus/sync/peerset/peer"
@@ -24,6 +26,11 @@ func (handler *blocksResponseHandler) ParseMessage(m message.Message, pid peer.I
 	if msg.IsRequestRejected() {
 		handler.logger.Warn("blocks request is rejected", "pid", pid,
 			"reason", msg.Reason, "sid", msg.SessionID)
+
+		if msg.RetryAfter > 0 {
+			until := time.Now().Add(time.Duration(msg.RetryAfter) * time.Second)
+			handler.peerSet.UpdateThrottledUntil(pid, until)
+		}
 	} else {
 		handler.logger.Info("blocks received", "from", msg.From, "count", msg.Count(),
 			"pid", pid, "sid", msg.SessionID)
@@ -43,6 +50,15 @@ func (handler *blocksResponseHandler) ParseMessage(m message.Message, pid peer.I
 		}
 		handler.cache.AddCertificate(msg.LastCertificate)
 		handler.tryCommitBlocks()
+
+		// The blocks that can't be committed yet are waiting for the blocks of other sessions.
+		// Their certificates can be verified in the meantime.
+		// The certificate of the previous block is received within the first block.
+		if msg.From > 0 {
+			for height := msg.From - 1; height < msg.From+msg.Count(); height++ {
+				handler.queueCertificateVerification(height)
+			}
+		}
 	}
 
 	handler.updateSession(msg.SessionID, msg.ResponseCode)
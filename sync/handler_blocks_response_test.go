@@ -7,7 +7,9 @@ import (
 
 	"github.com/pactus-project/pactus/consensus"
 	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/evidencepool"
 	"github.com/pactus-project/pactus/network"
+	"github.com/pactus-project/pactus/signer"
 	"github.com/pactus-project/pactus/state"
 	"github.com/pactus-project/pactus/sync/bundle/message"
 	"github.com/pactus-project/pactus/sync/peerset/peer/service"
@@ -125,6 +127,23 @@ func TestStrippedPublicKey(t *testing.T) {
 	}
 }
 
+func TestThrottledPeer(t *testing.T) {
+	td := setup(t, nil)
+
+	pid := td.addPeer(t, status.StatusKnown, service.New(service.FullNode))
+	sid := td.sync.peerSet.OpenSession(pid, 1, 10)
+
+	msg := message.NewBlocksResponseMessage(message.ResponseCodeRejected,
+		"bandwidth limit exceeded", sid, 0, nil, nil)
+	msg.RetryAfter = 60
+	td.receivingNewMessage(td.sync, msg, pid)
+
+	p := td.sync.peerSet.GetPeer(pid)
+	assert.True(t, p.IsThrottled(time.Now()))
+	assert.False(t, p.IsThrottled(time.Now().Add(61*time.Second)))
+	assert.Empty(t, td.sync.peerSet.GetDownloadPeers())
+}
+
 func shouldPublishBlockRequest(t *testing.T, net *network.MockNetwork, from uint32) {
 	t.Helper()
 
@@ -178,9 +197,10 @@ func makeAliceAndBobNetworks(t *testing.T) *networkAliceBob {
 	networkBob.AddAnotherNetwork(networkAlice)
 
 	sync1, err := NewSynchronizer(configAlice,
-		valKeyAlice,
+		[]signer.Signer{signer.NewLocalSigner(valKeyAlice[0])},
 		stateAlice,
 		consMgrAlice,
+		evidencepool.MockingEvidencePool(),
 		networkAlice,
 		internalMessageCh,
 	)
@@ -188,9 +208,10 @@ func makeAliceAndBobNetworks(t *testing.T) *networkAliceBob {
 	syncAlice := sync1.(*synchronizer)
 
 	sync2, err := NewSynchronizer(configBob,
-		valKeyBob,
+		[]signer.Signer{signer.NewLocalSigner(valKeyBob[0])},
 		stateBob,
 		consMgrBob,
+		evidencepool.MockingEvidencePool(),
 		networkBob,
 		internalMessageCh,
 	)
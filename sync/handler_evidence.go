@@ -0,0 +1,30 @@
+package sync
+
+import (
+	"github.com/pactus-project/pactus/sync/bundle"
+	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/sync/peerset/peer"
+)
+
+type evidenceHandler struct {
+	*synchronizer
+}
+
+func newEvidenceHandler(sync *synchronizer) messageHandler {
+	return &evidenceHandler{
+		sync,
+	}
+}
+
+func (handler *evidenceHandler) ParseMessage(m message.Message, _ peer.ID) {
+	msg := m.(*message.EvidenceMessage)
+	handler.logger.Trace("parsing Evidence message", "msg", msg)
+
+	if err := handler.evdPool.AddEvidence(msg.Evidence); err != nil {
+		handler.logger.Debug("cannot add evidence", "evidence", msg.Evidence, "error", err)
+	}
+}
+
+func (*evidenceHandler) PrepareBundle(m message.Message) *bundle.Bundle {
+	return bundle.NewBundle(m)
+}
@@ -0,0 +1,21 @@
+package sync
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsingEvidenceMessages(t *testing.T) {
+	td := setup(t, nil)
+
+	t.Run("Parsing evidence message", func(t *testing.T) {
+		evd, _ := td.GenerateTestEvidence(1, 0)
+		msg := message.NewEvidenceMessage(evd)
+		pid := td.RandPeerID()
+
+		td.receivingNewMessage(td.sync, msg, pid)
+		assert.True(t, td.evdPool.HasEvidence(evd.Hash()))
+	})
+}
@@ -30,13 +30,15 @@ func (handler *helloHandler) ParseMessage(m message.Message, pid peer.ID) {
 	handler.logger.Debug("updating peer info",
 		"pid", msg.PeerID,
 		"moniker", msg.Moniker,
-		"services", msg.Services)
+		"services", msg.Services,
+		"features", msg.Features)
 
 	handler.peerSet.UpdateInfo(pid,
 		msg.Moniker,
 		msg.Agent,
 		msg.PublicKeys,
 		msg.Services)
+	handler.peerSet.UpdateFeatures(pid, msg.Features, msg.DictionaryID)
 
 	if msg.PeerID != pid {
 		response := message.NewHelloAckMessage(message.ResponseCodeRejected,
@@ -7,6 +7,7 @@ import (
 	"github.com/pactus-project/pactus/crypto/bls"
 	"github.com/pactus-project/pactus/sync/bundle"
 	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/sync/peerset/peer/feature"
 	"github.com/pactus-project/pactus/sync/peerset/peer/service"
 	"github.com/pactus-project/pactus/sync/peerset/peer/status"
 	"github.com/pactus-project/pactus/util"
@@ -139,7 +140,41 @@ func TestParsingHelloMessages(t *testing.T) {
 			assert.Equal(t, pid, p.PeerID)
 			assert.Equal(t, peerHeight, p.Height)
 			assert.True(t, p.IsFullNode())
+			assert.Equal(t, feature.Supported(), p.Features)
 		})
+
+	t.Run("Receiving Hello message from an old peer without features", func(t *testing.T) {
+		valKey := td.RandValKey()
+		pid := td.RandPeerID()
+		msg := message.NewHelloMessage(pid, "old-kitty", service.New(service.FullNode),
+			td.RandHeight(), td.RandHash(), td.state.Genesis().Hash())
+		msg.Features = feature.New()
+		msg.Sign([]*bls.ValidatorKey{valKey})
+
+		td.receivingNewMessage(td.sync, msg, pid)
+
+		bdl := td.shouldPublishMessageWithThisType(t, message.TypeHelloAck)
+		assert.Equal(t, message.ResponseCodeOK, bdl.Message.(*message.HelloAckMessage).ResponseCode)
+
+		p := td.sync.peerSet.GetPeer(pid)
+		assert.Equal(t, status.StatusConnected, p.Status)
+		assert.False(t, p.HasFeature(feature.Zstd))
+	})
+
+	t.Run("Receiving Hello message with a compression dictionary", func(t *testing.T) {
+		valKey := td.RandValKey()
+		pid := td.RandPeerID()
+		msg := message.NewHelloMessage(pid, "kitty", service.New(service.FullNode),
+			td.RandHeight(), td.RandHash(), td.state.Genesis().Hash())
+		msg.DictionaryID = 7
+		msg.Sign([]*bls.ValidatorKey{valKey})
+
+		td.receivingNewMessage(td.sync, msg, pid)
+		td.shouldPublishMessageWithThisType(t, message.TypeHelloAck)
+
+		p := td.sync.peerSet.GetPeer(pid)
+		assert.Equal(t, uint32(7), p.DictionaryID)
+	})
 }
 
 func TestSendingHelloMessage(t *testing.T) {
@@ -151,4 +186,5 @@ func TestSendingHelloMessage(t *testing.T) {
 	bdl := td.shouldPublishMessageWithThisType(t, message.TypeHello)
 	assert.True(t, util.IsFlagSet(bdl.Flags, bundle.BundleFlagHandshaking))
 	assert.True(t, util.IsFlagSet(bdl.Message.(*message.HelloMessage).Services, service.New(service.FullNode)))
+	assert.Equal(t, feature.Supported(), bdl.Message.(*message.HelloMessage).Features)
 }
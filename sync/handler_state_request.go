@@ -0,0 +1,119 @@
+package sync
+
+import (
+	"fmt"
+
+	"github.com/pactus-project/pactus/sync/bundle"
+	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/sync/peerset/peer"
+	"github.com/pactus-project/pactus/sync/statesync"
+)
+
+type stateRequestHandler struct {
+	*synchronizer
+}
+
+func newStateRequestHandler(sync *synchronizer) messageHandler {
+	return &stateRequestHandler{
+		sync,
+	}
+}
+
+func (handler *stateRequestHandler) ParseMessage(m message.Message, pid peer.ID) {
+	msg := m.(*message.StateRequestMessage)
+	handler.logger.Trace("parsing StateRequest message", "msg", msg)
+
+	reject := func(reason string) {
+		response := message.NewStateResponseMessage(message.ResponseCodeRejected, reason,
+			msg.SessionID, msg.Height, msg.Part, msg.Chunk)
+
+		handler.respond(response, pid)
+	}
+
+	p := handler.peerSet.GetPeer(pid)
+	if p == nil {
+		reject(fmt.Sprintf("unknown peer (%s)", pid.String()))
+
+		return
+	}
+
+	if !p.Status.IsKnown() {
+		reject(fmt.Sprintf("not handshaked (%s)", p.Status.String()))
+
+		return
+	}
+
+	snapshot, err := handler.servingSnapshot(msg.Height)
+	if err != nil {
+		reject(err.Error())
+
+		return
+	}
+
+	response := message.NewStateResponseMessage(message.ResponseCodeOK, message.ResponseCodeOK.String(),
+		msg.SessionID, snapshot.Height(), msg.Part, msg.Chunk)
+
+	switch msg.Part {
+	case message.StatePartManifest:
+		response.Manifest = snapshot.Manifest()
+
+	case message.StatePartAccounts:
+		response.Accounts, err = snapshot.AccountChunk(msg.Chunk)
+
+	case message.StatePartValidators:
+		response.Validators, err = snapshot.ValidatorChunk(msg.Chunk)
+	}
+
+	if err != nil {
+		reject(err.Error())
+
+		return
+	}
+
+	handler.respond(response, pid)
+}
+
+// servingSnapshot returns the state snapshot at the given height, or the latest one if the height is zero.
+// The manifests of the served snapshots are kept, since building them requires reading the whole state.
+func (handler *stateRequestHandler) servingSnapshot(height uint32) (*statesync.Snapshot, error) {
+	snap := handler.state.StateSnapshot(height)
+	if snap == nil {
+		return nil, fmt.Errorf("no state snapshot at height %v", height)
+	}
+
+	snapHeight := snap.LastCertificate().Height()
+	snapshot, ok := handler.servingSnapshots[snapHeight]
+	if ok {
+		return snapshot, nil
+	}
+
+	snapshot, err := statesync.NewSnapshot(snap)
+	if err != nil {
+		return nil, err
+	}
+
+	// The state keeps a few recent snapshots, so the older ones are not available anymore.
+	for h := range handler.servingSnapshots {
+		if handler.state.StateSnapshot(h) == nil {
+			delete(handler.servingSnapshots, h)
+		}
+	}
+	handler.servingSnapshots[snapHeight] = snapshot
+
+	return snapshot, nil
+}
+
+func (*stateRequestHandler) PrepareBundle(m message.Message) *bundle.Bundle {
+	return bundle.NewBundle(m)
+}
+
+func (handler *stateRequestHandler) respond(msg *message.StateResponseMessage, to peer.ID) {
+	if msg.ResponseCode == message.ResponseCodeRejected {
+		handler.logger.Debug("rejecting state request message", "msg", msg,
+			"to", to, "reason", msg.Reason)
+	} else {
+		handler.logger.Info("responding state request message", "msg", msg, "to", to)
+	}
+
+	handler.sendTo(msg, to)
+}
@@ -0,0 +1,131 @@
+package sync
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/state"
+	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/sync/peerset/peer/service"
+	"github.com/pactus-project/pactus/sync/peerset/peer/status"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fillTestState fills the mock state with the given number of accounts and validators,
+// and returns the keys of the validators.
+func fillTestState(ts *testsuite.TestSuite, st *state.MockState,
+	totalAccounts, totalValidators int32,
+) []*bls.ValidatorKey {
+	for i := int32(0); i < totalAccounts; i++ {
+		acc, addr := ts.GenerateTestAccount(i)
+		st.TestStore.UpdateAccount(addr, acc)
+	}
+	valKeys := make([]*bls.ValidatorKey, 0, totalValidators)
+	for i := int32(0); i < totalValidators; i++ {
+		val, valKey := ts.GenerateTestValidator(i)
+		st.TestStore.UpdateValidator(val)
+		valKeys = append(valKeys, valKey)
+	}
+
+	return valKeys
+}
+
+func TestStateRequestMessages(t *testing.T) {
+	td := setup(t, nil)
+	sid := td.RandInt(100)
+
+	t.Run("Reject request from unknown peers", func(t *testing.T) {
+		pid := td.RandPeerID()
+		msg := message.NewStateRequestMessage(sid, 0, message.StatePartManifest, 0)
+		td.receivingNewMessage(td.sync, msg, pid)
+
+		bdl := td.shouldPublishMessageWithThisType(t, message.TypeStateResponse)
+		res := bdl.Message.(*message.StateResponseMessage)
+		assert.Equal(t, message.ResponseCodeRejected, res.ResponseCode)
+		assert.Contains(t, res.Reason, "unknown peer")
+		assert.Equal(t, sid, res.SessionID)
+	})
+
+	t.Run("Reject request from peers without handshaking", func(t *testing.T) {
+		pid := td.addPeer(t, status.StatusConnected, service.New(service.None))
+		msg := message.NewStateRequestMessage(sid, 0, message.StatePartManifest, 0)
+		td.receivingNewMessage(td.sync, msg, pid)
+
+		bdl := td.shouldPublishMessageWithThisType(t, message.TypeStateResponse)
+		res := bdl.Message.(*message.StateResponseMessage)
+		assert.Equal(t, message.ResponseCodeRejected, res.ResponseCode)
+		assert.Contains(t, res.Reason, "not handshaked")
+	})
+
+	pid := td.addPeer(t, status.StatusKnown, service.New(service.None))
+
+	t.Run("No state snapshot", func(t *testing.T) {
+		msg := message.NewStateRequestMessage(sid, 0, message.StatePartManifest, 0)
+		td.receivingNewMessage(td.sync, msg, pid)
+
+		bdl := td.shouldPublishMessageWithThisType(t, message.TypeStateResponse)
+		res := bdl.Message.(*message.StateResponseMessage)
+		assert.Equal(t, message.ResponseCodeRejected, res.ResponseCode)
+		assert.Contains(t, res.Reason, "no state snapshot at height 0")
+	})
+
+	fillTestState(td.TestSuite, td.state, message.StateChunkSize+1, 5)
+	td.state.CommitTestBlocks(5)
+
+	t.Run("Serving the manifest", func(t *testing.T) {
+		msg := message.NewStateRequestMessage(sid, 0, message.StatePartManifest, 0)
+		td.receivingNewMessage(td.sync, msg, pid)
+
+		bdl := td.shouldPublishMessageWithThisType(t, message.TypeStateResponse)
+		res := bdl.Message.(*message.StateResponseMessage)
+		assert.Equal(t, message.ResponseCodeOK, res.ResponseCode)
+		assert.Equal(t, uint32(5), res.Height)
+		require.NotNil(t, res.Manifest)
+		assert.Equal(t, int32(message.StateChunkSize+1), res.Manifest.TotalAccounts)
+		assert.Equal(t, int32(5), res.Manifest.TotalValidators)
+		assert.Contains(t, td.sync.servingSnapshots, uint32(5))
+	})
+
+	t.Run("Serving the account chunks", func(t *testing.T) {
+		msg := message.NewStateRequestMessage(sid, 5, message.StatePartAccounts, 1)
+		td.receivingNewMessage(td.sync, msg, pid)
+
+		bdl := td.shouldPublishMessageWithThisType(t, message.TypeStateResponse)
+		res := bdl.Message.(*message.StateResponseMessage)
+		assert.Equal(t, message.ResponseCodeOK, res.ResponseCode)
+		assert.Equal(t, uint32(1), res.Chunk)
+		assert.Len(t, res.Accounts, 1)
+	})
+
+	t.Run("Serving the validator chunks", func(t *testing.T) {
+		msg := message.NewStateRequestMessage(sid, 5, message.StatePartValidators, 0)
+		td.receivingNewMessage(td.sync, msg, pid)
+
+		bdl := td.shouldPublishMessageWithThisType(t, message.TypeStateResponse)
+		res := bdl.Message.(*message.StateResponseMessage)
+		assert.Equal(t, message.ResponseCodeOK, res.ResponseCode)
+		assert.Len(t, res.Validators, 5)
+	})
+
+	t.Run("Chunk out of range", func(t *testing.T) {
+		msg := message.NewStateRequestMessage(sid, 5, message.StatePartValidators, 1)
+		td.receivingNewMessage(td.sync, msg, pid)
+
+		bdl := td.shouldPublishMessageWithThisType(t, message.TypeStateResponse)
+		res := bdl.Message.(*message.StateResponseMessage)
+		assert.Equal(t, message.ResponseCodeRejected, res.ResponseCode)
+		assert.Contains(t, res.Reason, "out of range")
+	})
+
+	t.Run("Snapshot is not available at the requested height", func(t *testing.T) {
+		msg := message.NewStateRequestMessage(sid, 4, message.StatePartAccounts, 0)
+		td.receivingNewMessage(td.sync, msg, pid)
+
+		bdl := td.shouldPublishMessageWithThisType(t, message.TypeStateResponse)
+		res := bdl.Message.(*message.StateResponseMessage)
+		assert.Equal(t, message.ResponseCodeRejected, res.ResponseCode)
+		assert.Contains(t, res.Reason, "no state snapshot at height 4")
+	})
+}
@@ -0,0 +1,86 @@
+package sync
+
+import (
+	"github.com/pactus-project/pactus/sync/bundle"
+	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/sync/peerset/peer"
+	"github.com/pactus-project/pactus/sync/statesync"
+)
+
+type stateResponseHandler struct {
+	*synchronizer
+}
+
+func newStateResponseHandler(sync *synchronizer) messageHandler {
+	return &stateResponseHandler{
+		sync,
+	}
+}
+
+func (handler *stateResponseHandler) ParseMessage(m message.Message, pid peer.ID) {
+	msg := m.(*message.StateResponseMessage)
+	handler.logger.Trace("parsing StateResponse message", "msg", msg)
+
+	req, ok := handler.stateSync.requests[msg.SessionID]
+	if !ok || req.pid != pid || req.part != msg.Part || req.chunk != msg.Chunk {
+		handler.logger.Debug("unexpected state response", "msg", msg, "pid", pid)
+
+		return
+	}
+	delete(handler.stateSync.requests, msg.SessionID)
+
+	if msg.IsRequestRejected() {
+		handler.logger.Warn("state request is rejected", "pid", pid,
+			"reason", msg.Reason, "sid", msg.SessionID)
+
+		if msg.Part == message.StatePartManifest {
+			handler.stateSync.manifests[pid] = &peerManifest{height: msg.Height}
+			handler.peerSet.SetSessionCompleted(msg.SessionID)
+		} else {
+			handler.peerSet.SetSessionUncompleted(msg.SessionID)
+		}
+
+		return
+	}
+
+	if err := handler.addStateResponse(msg, pid); err != nil {
+		handler.logger.Warn("invalid state response", "pid", pid,
+			"msg", msg, "error", err)
+
+		handler.peerSet.SetSessionUncompleted(msg.SessionID)
+
+		return
+	}
+
+	handler.logger.Info("state response received", "part", msg.Part, "chunk", msg.Chunk,
+		"pid", pid, "sid", msg.SessionID)
+
+	handler.peerSet.SetSessionCompleted(msg.SessionID)
+	handler.updateBlockchain()
+}
+
+func (handler *stateResponseHandler) addStateResponse(msg *message.StateResponseMessage, pid peer.ID) error {
+	if msg.Part == message.StatePartManifest {
+		handler.addStateManifest(pid, msg.Height, msg.Manifest)
+
+		return nil
+	}
+
+	restorer := handler.stateSync.restorer
+	if restorer == nil || restorer.Height() != msg.Height {
+		return statesync.InvalidChunkError{Part: msg.Part, Chunk: msg.Chunk, Reason: "unexpected snapshot height"}
+	}
+
+	if msg.Part == message.StatePartAccounts {
+		return restorer.AddAccountChunk(msg.Chunk, msg.Accounts)
+	}
+
+	return restorer.AddValidatorChunk(msg.Chunk, msg.Validators)
+}
+
+func (*stateResponseHandler) PrepareBundle(m message.Message) *bundle.Bundle {
+	bdl := bundle.NewBundle(m)
+	bdl.CompressIt()
+
+	return bdl
+}
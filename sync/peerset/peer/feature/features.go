@@ -0,0 +1,77 @@
+package feature
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pactus-project/pactus/util"
+)
+
+// Features are the protocol features that a node supports.
+// They are advertised in the Hello message, so a new wire feature, like a new message type,
+// can be rolled out without rejecting the peers that don't support it yet.
+type (
+	Features int
+	Feature  int
+)
+
+const (
+	None Feature = 0x00
+
+	// Zstd indicates that the node can decompress the bundles compressed with zstd.
+	Zstd Feature = 0x01
+)
+
+// registered holds the features that this node supports, in the order of their bits.
+// A new feature is registered by defining its bit above and adding it here.
+var registered = []struct {
+	feature Feature
+	name    string
+}{
+	{Zstd, "ZSTD"},
+}
+
+func New(flags ...Feature) Features {
+	f := None
+	for _, flag := range flags {
+		f = util.SetFlag(f, flag)
+	}
+
+	return Features(f)
+}
+
+// Supported returns all the registered features.
+func Supported() Features {
+	f := New()
+	for _, r := range registered {
+		f.Append(r.feature)
+	}
+
+	return f
+}
+
+func (f *Features) Append(flag Feature) {
+	*f = util.SetFlag(*f, Features(flag))
+}
+
+// Has checks if the feature is supported. Every peer supports the None feature.
+func (f Features) Has(flag Feature) bool {
+	return util.IsFlagSet(f, Features(flag))
+}
+
+func (f Features) String() string {
+	names := make([]string, 0)
+	flags := f
+	for _, r := range registered {
+		if util.IsFlagSet(flags, Features(r.feature)) {
+			names = append(names, r.name)
+			flags = util.UnsetFlag(flags, Features(r.feature))
+		}
+	}
+
+	if flags != 0 {
+		names = append(names, fmt.Sprintf("%d", flags))
+	}
+
+	return strings.Join(names, " | ")
+}
@@ -0,0 +1,30 @@
+package feature
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFeaturesString(t *testing.T) {
+	assert.Equal(t, "", New(None).String())
+	assert.Equal(t, "ZSTD", New(Zstd).String())
+	assert.Equal(t, "ZSTD | 2", New(3).String())
+	assert.Equal(t, "4", New(4).String())
+}
+
+func TestHasFeature(t *testing.T) {
+	f := New()
+	assert.True(t, f.Has(None))
+	assert.False(t, f.Has(Zstd))
+
+	f.Append(Zstd)
+	assert.True(t, f.Has(Zstd))
+}
+
+func TestSupported(t *testing.T) {
+	f := Supported()
+	for _, r := range registered {
+		assert.True(t, f.Has(r.feature))
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/pactus-project/pactus/crypto/bls"
 	"github.com/pactus-project/pactus/crypto/hash"
 	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/sync/peerset/peer/feature"
 	"github.com/pactus-project/pactus/sync/peerset/peer/service"
 	"github.com/pactus-project/pactus/sync/peerset/peer/status"
 )
@@ -23,6 +24,8 @@ type Peer struct {
 	PeerID            ID
 	ConsensusKeys     []*bls.PublicKey
 	Services          service.Services
+	Features          feature.Features
+	DictionaryID      uint32
 	LastSent          time.Time
 	LastReceived      time.Time
 	LastBlockHash     hash.Hash
@@ -31,6 +34,8 @@ type Peer struct {
 	InvalidBundles    int
 	TotalSessions     int
 	CompletedSessions int
+	DownloadLatency   time.Duration
+	ThrottledUntil    time.Time
 	ReceivedBytes     map[message.Type]int64
 	SentBytes         map[message.Type]int64
 }
@@ -50,6 +55,31 @@ func (p *Peer) IsFullNode() bool {
 	return p.Services.IsFullNode()
 }
 
+func (p *Peer) IsStateSync() bool {
+	return p.Services.IsStateSync()
+}
+
+// HasFeature checks if the peer has advertised the feature in its Hello message.
+func (p *Peer) HasFeature(f feature.Feature) bool {
+	return p.Features.Has(f)
+}
+
+// IsThrottled checks if the peer has asked us to wait before sending more block requests.
+func (p *Peer) IsThrottled(now time.Time) bool {
+	return now.Before(p.ThrottledUntil)
+}
+
 func (p *Peer) DownloadScore() int {
 	return (p.CompletedSessions + 1) * 100 / (p.TotalSessions + 1)
 }
+
+// UpdateDownloadLatency updates the average latency of the block responses of the peer.
+func (p *Peer) UpdateDownloadLatency(latency time.Duration) {
+	if p.DownloadLatency == 0 {
+		p.DownloadLatency = latency
+
+		return
+	}
+
+	p.DownloadLatency = (3*p.DownloadLatency + latency) / 4
+}
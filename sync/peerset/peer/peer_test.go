@@ -2,7 +2,9 @@ package peer
 
 import (
 	"testing"
+	"time"
 
+	"github.com/pactus-project/pactus/sync/peerset/peer/feature"
 	"github.com/pactus-project/pactus/sync/peerset/peer/service"
 	"github.com/pactus-project/pactus/sync/peerset/peer/status"
 	"github.com/stretchr/testify/assert"
@@ -72,3 +74,22 @@ func TestDownloadScore(t *testing.T) {
 			i+1, testCase.expectedScore, score)
 	}
 }
+
+func TestUpdateDownloadLatency(t *testing.T) {
+	p := NewPeer("peer-1")
+	assert.Zero(t, p.DownloadLatency)
+
+	p.UpdateDownloadLatency(100 * time.Millisecond)
+	assert.Equal(t, 100*time.Millisecond, p.DownloadLatency)
+
+	p.UpdateDownloadLatency(500 * time.Millisecond)
+	assert.Equal(t, 200*time.Millisecond, p.DownloadLatency)
+}
+
+func TestHasFeature(t *testing.T) {
+	p := NewPeer("")
+	assert.False(t, p.HasFeature(feature.Zstd))
+
+	p.Features = feature.New(feature.Zstd)
+	assert.True(t, p.HasFeature(feature.Zstd))
+}
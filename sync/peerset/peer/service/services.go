@@ -19,6 +19,9 @@ const (
 
 	// PrunedNode indicates that the node has a pruned blockchain history.
 	PrunedNode Service = 0x02
+
+	// StateSync indicates that the node serves the state snapshots to the state syncing nodes.
+	StateSync Service = 0x04
 )
 
 func New(flags ...Service) Services {
@@ -47,6 +50,11 @@ func (s Services) String() string {
 		flags = util.UnsetFlag(flags, Services(PrunedNode))
 	}
 
+	if util.IsFlagSet(flags, Services(StateSync)) {
+		services += "STATE-SYNC | "
+		flags = util.UnsetFlag(flags, Services(StateSync))
+	}
+
 	if flags != 0 {
 		services += fmt.Sprintf("%d", flags)
 	} else if services != "" {
@@ -63,3 +71,7 @@ func (s Services) IsFullNode() bool {
 func (s Services) IsPrunedNode() bool {
 	return util.IsFlagSet(s, Services(PrunedNode))
 }
+
+func (s Services) IsStateSync() bool {
+	return util.IsFlagSet(s, Services(StateSync))
+}
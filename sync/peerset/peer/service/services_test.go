@@ -11,8 +11,10 @@ func TestServicesString(t *testing.T) {
 	assert.Equal(t, "FULL", New(FullNode).String())
 	assert.Equal(t, "PRUNED", New(PrunedNode).String())
 	assert.Equal(t, "FULL | PRUNED", New(FullNode, PrunedNode).String())
-	assert.Equal(t, "FULL | 4", New(5).String())
-	assert.Equal(t, "PRUNED | 4", New(6).String())
+	assert.Equal(t, "STATE-SYNC", New(StateSync).String())
+	assert.Equal(t, "PRUNED | STATE-SYNC", New(PrunedNode, StateSync).String())
+	assert.Equal(t, "FULL | 8", New(9).String())
+	assert.Equal(t, "PRUNED | 8", New(10).String())
 }
 
 func TestAppend(t *testing.T) {
@@ -23,4 +25,8 @@ func TestAppend(t *testing.T) {
 	s.Append(PrunedNode)
 	assert.True(t, s.IsFullNode())
 	assert.True(t, s.IsPrunedNode())
+	assert.False(t, s.IsStateSync())
+
+	s.Append(StateSync)
+	assert.True(t, s.IsStateSync())
 }
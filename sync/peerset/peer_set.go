@@ -1,7 +1,9 @@
 package peerset
 
 import (
+	"cmp"
 	"maps"
+	"slices"
 	"sync"
 	"time"
 
@@ -9,6 +11,7 @@ import (
 	"github.com/pactus-project/pactus/crypto/hash"
 	"github.com/pactus-project/pactus/sync/bundle/message"
 	"github.com/pactus-project/pactus/sync/peerset/peer"
+	"github.com/pactus-project/pactus/sync/peerset/peer/feature"
 	"github.com/pactus-project/pactus/sync/peerset/peer/service"
 	"github.com/pactus-project/pactus/sync/peerset/peer/status"
 	"github.com/pactus-project/pactus/sync/peerset/session"
@@ -25,17 +28,21 @@ type PeerSet struct {
 	totalReceivedBytes int64
 	sentBytes          map[message.Type]int64
 	receivedBytes      map[message.Type]int64
+	sentSavedBytes     map[message.Type]int64
+	receivedSavedBytes map[message.Type]int64
 	startedAt          time.Time
 }
 
 // NewPeerSet constructs a new PeerSet for managing peer information.
 func NewPeerSet(sessionTimeout time.Duration) *PeerSet {
 	return &PeerSet{
-		peers:          make(map[peer.ID]*peer.Peer),
-		sessionManager: session.NewManager(sessionTimeout),
-		sentBytes:      make(map[message.Type]int64),
-		receivedBytes:  make(map[message.Type]int64),
-		startedAt:      time.Now(),
+		peers:              make(map[peer.ID]*peer.Peer),
+		sessionManager:     session.NewManager(sessionTimeout),
+		sentBytes:          make(map[message.Type]int64),
+		receivedBytes:      make(map[message.Type]int64),
+		sentSavedBytes:     make(map[message.Type]int64),
+		receivedSavedBytes: make(map[message.Type]int64),
+		startedAt:          time.Now(),
 	}
 }
 
@@ -87,9 +94,20 @@ func (ps *PeerSet) UpdateSessionLastActivity(sid int) {
 	ps.lk.Lock()
 	defer ps.lk.Unlock()
 
+	ps.updateDownloadLatency(sid)
 	ps.sessionManager.UpdateSessionLastActivity(sid)
 }
 
+// updateDownloadLatency updates the download latency of the session's peer,
+// based on the time elapsed since the last activity of the session.
+func (ps *PeerSet) updateDownloadLatency(sid int) {
+	ssn := ps.sessionManager.Session(sid)
+	if ssn != nil {
+		p := ps.findOrCreatePeer(ssn.PeerID)
+		p.UpdateDownloadLatency(time.Since(ssn.LastActivity))
+	}
+}
+
 func (ps *PeerSet) SetExpiredSessionsAsUncompleted() {
 	ps.lk.Lock()
 	defer ps.lk.Unlock()
@@ -108,6 +126,7 @@ func (ps *PeerSet) SetSessionCompleted(sid int) {
 	ps.lk.Lock()
 	defer ps.lk.Unlock()
 
+	ps.updateDownloadLatency(sid)
 	ssn := ps.sessionManager.SetSessionCompleted(sid)
 	if ssn != nil {
 		p := ps.findOrCreatePeer(ssn.PeerID)
@@ -115,6 +134,13 @@ func (ps *PeerSet) SetSessionCompleted(sid int) {
 	}
 }
 
+func (ps *PeerSet) RemoveSession(sid int) {
+	ps.lk.Lock()
+	defer ps.lk.Unlock()
+
+	ps.sessionManager.RemoveSession(sid)
+}
+
 func (ps *PeerSet) RemoveAllSessions() {
 	ps.lk.Lock()
 	defer ps.lk.Unlock()
@@ -194,6 +220,25 @@ func (ps *PeerSet) UpdateInfo(
 	p.Services = services
 }
 
+func (ps *PeerSet) UpdateFeatures(pid peer.ID, features feature.Features, dictionaryID uint32) {
+	ps.lk.Lock()
+	defer ps.lk.Unlock()
+
+	p := ps.findOrCreatePeer(pid)
+	p.Features = features
+	p.DictionaryID = dictionaryID
+}
+
+// UpdateThrottledUntil sets the time until the peer doesn't accept our block requests,
+// because we have exceeded its bandwidth limits.
+func (ps *PeerSet) UpdateThrottledUntil(pid peer.ID, until time.Time) {
+	ps.lk.Lock()
+	defer ps.lk.Unlock()
+
+	p := ps.findOrCreatePeer(pid)
+	p.ThrottledUntil = until
+}
+
 func (ps *PeerSet) UpdateHeight(pid peer.ID, height uint32, lastBlockHash hash.Hash) {
 	ps.lk.Lock()
 	defer ps.lk.Unlock()
@@ -298,6 +343,30 @@ func (ps *PeerSet) IncreaseSentCounters(msgType message.Type, c int64, pid *peer
 	}
 }
 
+// IncreaseSentSavedBytes adds the bytes that the compression saved in sending the message type.
+func (ps *PeerSet) IncreaseSentSavedBytes(msgType message.Type, c int64) {
+	if c == 0 {
+		return
+	}
+
+	ps.lk.Lock()
+	defer ps.lk.Unlock()
+
+	ps.sentSavedBytes[msgType] += c
+}
+
+// IncreaseReceivedSavedBytes adds the bytes that the compression saved in receiving the message type.
+func (ps *PeerSet) IncreaseReceivedSavedBytes(msgType message.Type, c int64) {
+	if c == 0 {
+		return
+	}
+
+	ps.lk.Lock()
+	defer ps.lk.Unlock()
+
+	ps.receivedSavedBytes[msgType] += c
+}
+
 func (ps *PeerSet) TotalSentBundles() int {
 	ps.lk.RLock()
 	defer ps.lk.RUnlock()
@@ -349,6 +418,22 @@ func (ps *PeerSet) ReceivedBytes() map[message.Type]int64 {
 	return maps.Clone(ps.receivedBytes)
 }
 
+// SentSavedBytes returns the bytes that the compression saved in sending, per message type.
+func (ps *PeerSet) SentSavedBytes() map[message.Type]int64 {
+	ps.lk.RLock()
+	defer ps.lk.RUnlock()
+
+	return maps.Clone(ps.sentSavedBytes)
+}
+
+// ReceivedSavedBytes returns the bytes that the compression saved in receiving, per message type.
+func (ps *PeerSet) ReceivedSavedBytes() map[message.Type]int64 {
+	ps.lk.RLock()
+	defer ps.lk.RUnlock()
+
+	return maps.Clone(ps.receivedSavedBytes)
+}
+
 func (ps *PeerSet) StartedAt() time.Time {
 	ps.lk.RLock()
 	defer ps.lk.RUnlock()
@@ -375,6 +460,35 @@ func (ps *PeerSet) Sessions() []*session.Session {
 	return ps.sessionManager.Sessions()
 }
 
+// GetDownloadPeers returns the connected peers ranked for downloading blocks.
+// The throttled peers are skipped.
+// Peers with higher download score come first, and peers with the same score
+// are ranked by their download latency.
+func (ps *PeerSet) GetDownloadPeers() []*peer.Peer {
+	ps.lk.RLock()
+	defer ps.lk.RUnlock()
+
+	now := time.Now()
+	peers := make([]*peer.Peer, 0, len(ps.peers))
+	for _, p := range ps.peers {
+		if !p.Status.IsConnectedOrKnown() || p.IsThrottled(now) {
+			continue
+		}
+
+		peers = append(peers, p)
+	}
+
+	slices.SortStableFunc(peers, func(a, b *peer.Peer) int {
+		if scoreA, scoreB := a.DownloadScore(), b.DownloadScore(); scoreA != scoreB {
+			return cmp.Compare(scoreB, scoreA)
+		}
+
+		return cmp.Compare(a.DownloadLatency, b.DownloadLatency)
+	})
+
+	return peers
+}
+
 // GetRandomPeer selects a random peer from the peer set based on their download score.
 // Peers with higher score are more likely to be selected.
 func (ps *PeerSet) GetRandomPeer() *peer.Peer {
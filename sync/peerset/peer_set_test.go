@@ -8,11 +8,13 @@ import (
 	"github.com/pactus-project/pactus/crypto/bls"
 	"github.com/pactus-project/pactus/sync/bundle/message"
 	"github.com/pactus-project/pactus/sync/peerset/peer"
+	"github.com/pactus-project/pactus/sync/peerset/peer/feature"
 	"github.com/pactus-project/pactus/sync/peerset/peer/service"
 	"github.com/pactus-project/pactus/sync/peerset/peer/status"
 	"github.com/pactus-project/pactus/sync/peerset/session"
 	"github.com/pactus-project/pactus/util/testsuite"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func getSessionByID(ps *PeerSet, sid int) *session.Session {
@@ -378,3 +380,77 @@ func TestUpdateProtocols(t *testing.T) {
 	p := ps.GetPeer(pid)
 	assert.Equal(t, protocols, p.Protocols)
 }
+
+func TestUpdateFeatures(t *testing.T) {
+	ps := NewPeerSet(time.Minute)
+
+	pid := peer.ID("peer-1")
+	ps.UpdateFeatures(pid, feature.New(feature.Zstd), 0)
+
+	p := ps.GetPeer(pid)
+	assert.True(t, p.HasFeature(feature.Zstd))
+}
+
+func TestSavedBytes(t *testing.T) {
+	ps := NewPeerSet(time.Minute)
+
+	ps.IncreaseSentSavedBytes(message.TypeBlocksResponse, 100)
+	ps.IncreaseSentSavedBytes(message.TypeBlocksResponse, 50)
+	ps.IncreaseSentSavedBytes(message.TypeTransaction, 0)
+	ps.IncreaseReceivedSavedBytes(message.TypeBlocksResponse, 20)
+
+	assert.Equal(t, map[message.Type]int64{message.TypeBlocksResponse: 150}, ps.SentSavedBytes())
+	assert.Equal(t, map[message.Type]int64{message.TypeBlocksResponse: 20}, ps.ReceivedSavedBytes())
+}
+
+func TestRemoveSession(t *testing.T) {
+	ps := NewPeerSet(time.Minute)
+
+	sid1 := ps.OpenSession("peer1", 100, 101)
+	sid2 := ps.OpenSession("peer2", 200, 201)
+	ps.RemoveSession(sid1)
+
+	assert.Equal(t, 1, ps.NumberOfSessions())
+	assert.Nil(t, getSessionByID(ps, sid1))
+	assert.NotNil(t, getSessionByID(ps, sid2))
+	assert.False(t, ps.HasOpenSession("peer1"))
+}
+
+func TestSessionDownloadLatency(t *testing.T) {
+	ps := NewPeerSet(time.Minute)
+
+	sid := ps.OpenSession("peer1", 100, 101)
+	time.Sleep(10 * time.Millisecond)
+	ps.UpdateSessionLastActivity(sid)
+
+	latency := ps.GetPeer("peer1").DownloadLatency
+	assert.GreaterOrEqual(t, latency, 10*time.Millisecond)
+
+	ps.SetSessionCompleted(sid)
+	assert.NotEqual(t, latency, ps.GetPeer("peer1").DownloadLatency)
+}
+
+func TestGetDownloadPeers(t *testing.T) {
+	ps := NewPeerSet(time.Minute)
+
+	// peer_1 has score 100 with high latency,
+	// peer_2 has score 100 with low latency,
+	// peer_3 has score 50,
+	// peer_4 is disconnected.
+	for i := 0; i < 4; i++ {
+		pid := peer.ID(fmt.Sprintf("peer_%v", i+1))
+		ps.UpdateInfo(pid, fmt.Sprintf("Moniker_%v", i+1), "Agent1", nil, service.New())
+		ps.UpdateStatus(pid, status.StatusKnown)
+	}
+	ps.UpdateStatus("peer_4", status.StatusDisconnected)
+
+	ps.findPeer("peer_1").DownloadLatency = 2 * time.Second
+	ps.findPeer("peer_2").DownloadLatency = 1 * time.Second
+	ps.OpenSession("peer_3", 0, 0)
+
+	peers := ps.GetDownloadPeers()
+	require.Len(t, peers, 3)
+	assert.Equal(t, peer.ID("peer_2"), peers[0].PeerID)
+	assert.Equal(t, peer.ID("peer_1"), peers[1].PeerID)
+	assert.Equal(t, peer.ID("peer_3"), peers[2].PeerID)
+}
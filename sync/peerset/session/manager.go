@@ -108,6 +108,14 @@ func (sm *Manager) SetSessionCompleted(sid int) *Session {
 	return ssn
 }
 
+func (sm *Manager) Session(sid int) *Session {
+	return sm.sessions[sid]
+}
+
+func (sm *Manager) RemoveSession(sid int) {
+	delete(sm.sessions, sid)
+}
+
 func (sm *Manager) RemoveAllSessions() {
 	sm.sessions = make(map[int]*Session)
 }
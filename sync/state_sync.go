@@ -0,0 +1,405 @@
+package sync
+
+import (
+	"fmt"
+
+	"github.com/pactus-project/pactus/state"
+	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/sync/peerset/peer"
+	"github.com/pactus-project/pactus/sync/statesync"
+	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/types/certificate"
+	"github.com/pactus-project/pactus/types/validator"
+)
+
+// A node with state sync enabled bootstraps from a recent state snapshot of other nodes,
+// instead of committing all the blocks since genesis:
+//
+// 1. The manifest of the latest snapshot is requested from the peers that support state sync.
+// The snapshot is trusted once `StateSyncPeers` peers agree on the same manifest.
+//
+// 2. The chunks of the accounts and validators are downloaded in parallel and verified against the manifest.
+// The recent blocks up to the snapshot height, and the two blocks after it, are downloaded into the cache.
+// The trusted checkpoint, which is set in the config, should be after the snapshot height,
+// and the blocks are downloaded up to the checkpoint. They should be linked back to the checkpoint
+// by their hashes, so the peers can't forge them. The state root of the manifest is checked against
+// the header of the block after the snapshot height, and that block should be certified
+// by the validators of the snapshot.
+//
+// 3. The state is restored from the snapshot, and the node continues syncing the blocks as usual.
+
+// stateSyncer keeps the progress of bootstrapping the state from a state snapshot.
+type stateSyncer struct {
+	// manifests are the answers of the peers to the manifest request.
+	// The manifest is nil if the peer has rejected the request.
+	manifests map[peer.ID]*peerManifest
+	restorer  *statesync.Restorer
+	// requests are the pending state requests, by their session IDs.
+	requests map[int]stateRequest
+}
+
+type peerManifest struct {
+	height   uint32
+	manifest *message.StateManifest
+}
+
+type stateRequest struct {
+	pid   peer.ID
+	part  message.StatePart
+	chunk uint32
+}
+
+func newStateSyncer() *stateSyncer {
+	return &stateSyncer{
+		manifests: make(map[peer.ID]*peerManifest),
+		requests:  make(map[int]stateRequest),
+	}
+}
+
+// isBootstrapping returns true if a state snapshot is being downloaded.
+// The downloaded blocks are not committed in the meantime.
+func (sync *synchronizer) isBootstrapping() bool {
+	return sync.stateSync.restorer != nil
+}
+
+// updateStateSync moves the bootstrapping forward.
+// It returns false if no peer can serve a state snapshot,
+// so the node should download the blocks from the genesis instead.
+func (sync *synchronizer) updateStateSync(stalledPeers map[peer.ID]bool) bool {
+	sync.removeFinishedStateRequests()
+
+	if sync.stateSync.restorer == nil {
+		return sync.requestStateManifests(stalledPeers)
+	}
+
+	if sync.tryRestoreState() || sync.stateSync.restorer == nil {
+		return true
+	}
+
+	sync.downloadStateChunks(stalledPeers)
+
+	from, to := sync.stateSyncBlockRange(sync.stateSync.restorer.Height())
+	for sync.cache.HasBlockInCache(from) {
+		from++
+	}
+	sync.downloadBlocks(from, to, false, stalledPeers)
+
+	return true
+}
+
+// removeFinishedStateRequests removes the requests whose sessions are closed or expired.
+func (sync *synchronizer) removeFinishedStateRequests() {
+	openSessions := make(map[int]bool)
+	for _, ssn := range sync.peerSet.Sessions() {
+		openSessions[ssn.SessionID] = true
+	}
+
+	for sid := range sync.stateSync.requests {
+		if !openSessions[sid] {
+			delete(sync.stateSync.requests, sid)
+		}
+	}
+}
+
+// firstSnapshotBlockHeight returns the height of the first block that should be kept with the snapshot.
+// The recent blocks are needed to check the duplicated transactions.
+func (sync *synchronizer) firstSnapshotBlockHeight(height uint32) uint32 {
+	ttl := sync.state.Params().TransactionToLiveInterval
+	if height <= ttl {
+		return 1
+	}
+
+	return height - ttl
+}
+
+// stateSyncBlockRange returns the range of the blocks that should be downloaded with the snapshot.
+// The block after the snapshot height contains the state root and the certificate of the snapshot,
+// and the block after it contains the certificate of that block.
+// The range is extended to the checkpoint, so the blocks can be linked back to it.
+func (sync *synchronizer) stateSyncBlockRange(height uint32) (uint32, uint32) {
+	checkpointHeight, _ := sync.config.StateSyncCheckpoint()
+
+	return sync.firstSnapshotBlockHeight(height), max(height+2, checkpointHeight)
+}
+
+// requestStateManifests asks the state sync peers for the manifest of their latest snapshot.
+func (sync *synchronizer) requestStateManifests(stalledPeers map[peer.ID]bool) bool {
+	peers := make([]*peer.Peer, 0)
+	answeredAll := true
+	for _, p := range sync.peerSet.GetDownloadPeers() {
+		if !p.Status.IsKnown() || !p.IsStateSync() ||
+			stalledPeers[p.PeerID] {
+			continue
+		}
+		peers = append(peers, p)
+
+		if _, answered := sync.stateSync.manifests[p.PeerID]; !answered {
+			answeredAll = false
+		}
+	}
+
+	if len(peers) == 0 {
+		sync.logger.Debug("waiting for state sync peers")
+
+		return true
+	}
+
+	if answeredAll {
+		hasSnapshot := false
+		for _, pm := range sync.stateSync.manifests {
+			if pm.manifest != nil {
+				hasSnapshot = true
+
+				break
+			}
+		}
+		if !hasSnapshot {
+			sync.logger.Warn("no peer has a state snapshot, downloading blocks from the genesis")
+
+			return false
+		}
+
+		sync.logger.Info("peers don't agree on the state snapshot, asking again",
+			"answers", len(sync.stateSync.manifests))
+		sync.stateSync.manifests = make(map[peer.ID]*peerManifest)
+	}
+
+	for _, p := range peers {
+		if _, answered := sync.stateSync.manifests[p.PeerID]; answered {
+			continue
+		}
+		if sync.peerSet.HasOpenSession(p.PeerID) {
+			continue
+		}
+		if sync.peerSet.SessionStats().Open >= sync.config.MaxSessions {
+			break
+		}
+
+		sync.sendStateRequest(p.PeerID, 0, message.StatePartManifest, 0)
+	}
+
+	return true
+}
+
+// addStateManifest keeps the manifest of the peer,
+// and starts downloading the snapshot if enough peers agree on it.
+func (sync *synchronizer) addStateManifest(pid peer.ID, height uint32, manifest *message.StateManifest) {
+	if manifest != nil {
+		// The snapshot can only be trusted if the block after it is linked back to the checkpoint,
+		// and all the blocks up to the checkpoint fit in the cache.
+		checkpointHeight, _ := sync.config.StateSyncCheckpoint()
+		from, to := sync.stateSyncBlockRange(height)
+		if height >= checkpointHeight {
+			sync.logger.Warn("the state snapshot is not before the checkpoint, ignoring it",
+				"pid", pid, "height", height, "checkpoint", checkpointHeight)
+
+			manifest = nil
+		} else if to-from+1 > uint32(sync.config.CacheSize()) {
+			sync.logger.Warn("the state snapshot is too far from the checkpoint, ignoring it",
+				"pid", pid, "height", height, "checkpoint", checkpointHeight)
+
+			manifest = nil
+		}
+	}
+
+	sync.stateSync.manifests[pid] = &peerManifest{height: height, manifest: manifest}
+	if sync.stateSync.restorer != nil {
+		return
+	}
+
+	manifestHash := manifest.Hash()
+	agreed := 0
+	for _, pm := range sync.stateSync.manifests {
+		if pm.manifest != nil && pm.height == height && pm.manifest.Hash() == manifestHash {
+			agreed++
+		}
+	}
+
+	if agreed >= sync.config.StateSyncPeers {
+		sync.logger.Info("downloading the state snapshot", "height", height,
+			"accounts", manifest.TotalAccounts, "validators", manifest.TotalValidators)
+
+		sync.stateSync.restorer = statesync.NewRestorer(height, manifest)
+	}
+}
+
+// downloadStateChunks requests the missing chunks of the snapshot from the state sync peers,
+// one chunk per peer, keeping at most `MaxSessions` open sessions.
+func (sync *synchronizer) downloadStateChunks(stalledPeers map[peer.ID]bool) {
+	type chunk struct {
+		part  message.StatePart
+		index uint32
+	}
+
+	requested := make(map[chunk]bool)
+	for _, req := range sync.stateSync.requests {
+		requested[chunk{part: req.part, index: req.chunk}] = true
+	}
+
+	missing := make([]chunk, 0)
+	for _, part := range []message.StatePart{message.StatePartAccounts, message.StatePartValidators} {
+		for _, index := range sync.stateSync.restorer.MissingChunks(part) {
+			c := chunk{part: part, index: index}
+			if !requested[c] {
+				missing = append(missing, c)
+			}
+		}
+	}
+
+	for _, p := range sync.peerSet.GetDownloadPeers() {
+		if len(missing) == 0 || sync.peerSet.SessionStats().Open >= sync.config.MaxSessions {
+			return
+		}
+
+		if !p.Status.IsKnown() || !p.IsStateSync() ||
+			stalledPeers[p.PeerID] {
+			continue
+		}
+		if sync.peerSet.HasOpenSession(p.PeerID) {
+			continue
+		}
+
+		sync.sendStateRequest(p.PeerID, sync.stateSync.restorer.Height(), missing[0].part, missing[0].index)
+		missing = missing[1:]
+	}
+}
+
+func (sync *synchronizer) sendStateRequest(pid peer.ID, height uint32, part message.StatePart, chunk uint32) {
+	sid := sync.peerSet.OpenSession(pid, 0, 0)
+	sync.stateSync.requests[sid] = stateRequest{pid: pid, part: part, chunk: chunk}
+
+	msg := message.NewStateRequestMessage(sid, height, part, chunk)
+	sync.sendTo(msg, pid)
+
+	sync.logger.Info("state request sent", "part", part, "chunk", chunk, "pid", pid, "sid", sid)
+}
+
+// tryRestoreState restores the state once the snapshot and its blocks are downloaded.
+// It returns true if the state is restored.
+func (sync *synchronizer) tryRestoreState() bool {
+	restorer := sync.stateSync.restorer
+	height := restorer.Height()
+
+	from, to := sync.stateSyncBlockRange(height)
+	blocks := make([]*block.Block, 0, to-from+1)
+	for h := from; h <= to; h++ {
+		blk := sync.cache.GetBlock(h)
+		if blk == nil {
+			return false
+		}
+		blocks = append(blocks, blk)
+	}
+
+	if err := sync.checkAnchoredBlocks(blocks); err != nil {
+		sync.logger.Warn("blocks of the snapshot are not anchored to the checkpoint, discarding them",
+			"height", height, "error", err)
+		for h := from; h <= to; h++ {
+			sync.cache.RemoveBlock(h)
+		}
+		sync.resetStateSync()
+
+		return false
+	}
+
+	nextBlk := blocks[height+1-from]
+	stateRoot := nextBlk.Header().StateRoot()
+	if statesync.StateRoot(restorer.Manifest()) != stateRoot {
+		sync.logger.Warn("state root of the snapshot doesn't match, discarding the snapshot",
+			"height", height, "state_root", stateRoot)
+		sync.resetStateSync()
+
+		return false
+	}
+
+	if !restorer.IsComplete() {
+		return false
+	}
+
+	nextCert := blocks[height+2-from].PrevCertificate()
+	if err := checkNextCertificate(nextBlk, nextCert, restorer.Validators()); err != nil {
+		sync.logger.Warn("block after the snapshot is not certified by its validators, discarding the snapshot",
+			"height", height, "error", err)
+		sync.resetStateSync()
+
+		return false
+	}
+
+	snapshot := &state.Snapshot{
+		Blocks:      blocks[sync.firstSnapshotBlockHeight(height)-from : height+1-from],
+		Certificate: nextBlk.PrevCertificate(),
+		StateRoot:   stateRoot,
+		Accounts:    restorer.Accounts(),
+		Validators:  restorer.Validators(),
+		PublicKeys:  restorer.PublicKeys(),
+	}
+
+	if err := sync.state.RestoreStateSnapshot(snapshot); err != nil {
+		sync.logger.Warn("unable to restore the state snapshot", "height", height, "error", err)
+		sync.resetStateSync()
+
+		return false
+	}
+
+	sync.logger.Info("state is restored from the snapshot", "height", height)
+	sync.stateSync = newStateSyncer()
+	sync.tryCommitBlocks()
+
+	return true
+}
+
+// checkAnchoredBlocks checks that the blocks are linked by their hashes,
+// and the block at the checkpoint height has the trusted hash.
+// Since each block contains the hash of its previous block, all the blocks before
+// the checkpoint are trusted.
+func (sync *synchronizer) checkAnchoredBlocks(blocks []*block.Block) error {
+	checkpointHeight, checkpointHash := sync.config.StateSyncCheckpoint()
+	anchored := false
+	for i, blk := range blocks {
+		if i > 0 && blk.Header().PrevBlockHash() != blocks[i-1].Hash() {
+			return fmt.Errorf("block %d is not linked to the previous block", blk.Height())
+		}
+		if blk.Height() == checkpointHeight {
+			if blk.Hash() != checkpointHash {
+				return fmt.Errorf("block %d doesn't match the checkpoint %s", blk.Height(), checkpointHash)
+			}
+			anchored = true
+		}
+	}
+
+	if !anchored {
+		return fmt.Errorf("checkpoint block %d is missing", checkpointHeight)
+	}
+
+	return nil
+}
+
+// checkNextCertificate checks that the block after the snapshot height is certified
+// by the validators of the snapshot.
+func checkNextCertificate(nextBlk *block.Block, nextCert *certificate.BlockCertificate,
+	validators []*validator.Validator,
+) error {
+	if nextCert == nil || nextCert.Height() != nextBlk.Height() {
+		return fmt.Errorf("no certificate for block %d", nextBlk.Height())
+	}
+
+	validatorByNumber := make(map[int32]*validator.Validator, len(validators))
+	for _, val := range validators {
+		validatorByNumber[val.Number()] = val
+	}
+
+	committers := make([]*validator.Validator, 0, len(nextCert.Committers()))
+	for _, num := range nextCert.Committers() {
+		val, ok := validatorByNumber[num]
+		if !ok {
+			return fmt.Errorf("unknown committer %d", num)
+		}
+		committers = append(committers, val)
+	}
+
+	return nextCert.Validate(committers, nextBlk.Hash())
+}
+
+// resetStateSync discards the snapshot, so the manifests are requested again.
+func (sync *synchronizer) resetStateSync() {
+	sync.stateSync = newStateSyncer()
+}
@@ -0,0 +1,299 @@
+package sync
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/state"
+	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/sync/peerset/peer"
+	"github.com/pactus-project/pactus/sync/peerset/peer/service"
+	"github.com/pactus-project/pactus/sync/peerset/peer/status"
+	"github.com/pactus-project/pactus/sync/statesync"
+	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/types/certificate"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stateSyncTestData struct {
+	*testData
+
+	source   *state.MockState
+	snapshot *statesync.Snapshot
+	valKeys  []*bls.ValidatorKey
+	peers    []peer.ID
+}
+
+func setupStateSync(t *testing.T) *stateSyncTestData {
+	t.Helper()
+
+	conf := testConfig()
+	conf.StateSync = true
+	conf.StateSyncPeers = 2
+	// The checkpoint is three blocks after the snapshot.
+	conf.StateSyncCheckpointHeight = 13
+	td := setup(t, conf)
+
+	source := state.MockingState(td.TestSuite)
+	valKeys := fillTestState(td.TestSuite, source, message.StateChunkSize+10, 7)
+	source.CommitTestBlocks(10)
+
+	snap, err := source.TestStore.StateSnapshot()
+	require.NoError(t, err)
+	snapshot, err := statesync.NewSnapshot(snap)
+	require.NoError(t, err)
+
+	peers := []peer.ID{
+		td.addPeer(t, status.StatusKnown, service.New(service.PrunedNode, service.StateSync)),
+		td.addPeer(t, status.StatusKnown, service.New(service.PrunedNode, service.StateSync)),
+	}
+
+	return &stateSyncTestData{
+		testData: td,
+		source:   source,
+		snapshot: snapshot,
+		valKeys:  valKeys,
+		peers:    peers,
+	}
+}
+
+// respondStateRequests responds to the pending state requests from the source snapshot.
+func (td *stateSyncTestData) respondStateRequests(t *testing.T) {
+	t.Helper()
+
+	requests := make(map[int]stateRequest)
+	for sid, req := range td.sync.stateSync.requests {
+		requests[sid] = req
+	}
+
+	for sid, req := range requests {
+		res := message.NewStateResponseMessage(message.ResponseCodeOK, message.ResponseCodeOK.String(),
+			sid, td.snapshot.Height(), req.part, req.chunk)
+
+		var err error
+		switch req.part {
+		case message.StatePartManifest:
+			res.Manifest = td.snapshot.Manifest()
+		case message.StatePartAccounts:
+			res.Accounts, err = td.snapshot.AccountChunk(req.chunk)
+		case message.StatePartValidators:
+			res.Validators, err = td.snapshot.ValidatorChunk(req.chunk)
+		}
+		require.NoError(t, err)
+
+		td.receivingNewMessage(td.sync, res, req.pid)
+	}
+}
+
+// makeSnapshotChain makes a chain of linked blocks up to the checkpoint.
+// The block after the snapshot has the given state root, and its certificate
+// is signed by the validators of the snapshot. If signed is false, they sign another block.
+func (td *stateSyncTestData) makeSnapshotChain(stateRoot hash.Hash, signed bool) []*block.Block {
+	height := td.snapshot.Height()
+	checkpointHeight, _ := td.sync.config.StateSyncCheckpoint()
+
+	chain := make([]*block.Block, 0, checkpointHeight)
+	prevHash := hash.UndefHash
+	for h := uint32(1); h <= checkpointHeight; h++ {
+		opts := []func(bm *testsuite.BlockMaker){testsuite.BlockWithPrevHash(prevHash)}
+		switch h {
+		case height + 1:
+			opts = append(opts, testsuite.BlockWithStateHash(stateRoot))
+		case height + 2:
+			signedHash := td.RandHash()
+			if signed {
+				signedHash = chain[height].Hash()
+			}
+			opts = append(opts, testsuite.BlockWithPrevCert(td.signBlock(signedHash)))
+		}
+
+		blk, _ := td.GenerateTestBlock(h, opts...)
+		chain = append(chain, blk)
+		prevHash = blk.Hash()
+	}
+
+	return chain
+}
+
+// signBlock makes a certificate for the block after the snapshot,
+// signed by all the validators of the snapshot.
+func (td *stateSyncTestData) signBlock(blockHash hash.Hash) *certificate.BlockCertificate {
+	cert := certificate.NewBlockCertificate(td.snapshot.Height()+1, 0)
+	signBytes := cert.SignBytes(blockHash)
+
+	committers := make([]int32, 0, len(td.valKeys))
+	sigs := make([]*bls.Signature, 0, len(td.valKeys))
+	for i, valKey := range td.valKeys {
+		committers = append(committers, int32(i))
+		sigs = append(sigs, valKey.Sign(signBytes))
+	}
+	cert.SetSignature(committers, []int32{}, bls.SignatureAggregate(sigs...))
+
+	return cert
+}
+
+// cacheSnapshotBlocks puts the chain into the cache, and trusts its last block as the checkpoint.
+func (td *stateSyncTestData) cacheSnapshotBlocks(chain []*block.Block) {
+	for _, blk := range chain {
+		td.sync.cache.AddBlock(blk)
+	}
+	td.sync.config.StateSyncCheckpointHash = chain[len(chain)-1].Hash().String()
+}
+
+// respondAndUpdate responds to the state requests and updates the blockchain a few times,
+// until the state sync finishes.
+func (td *stateSyncTestData) respondAndUpdate(t *testing.T) {
+	t.Helper()
+
+	for i := 0; i < 5 && td.sync.isBootstrapping(); i++ {
+		td.respondStateRequests(t)
+		td.sync.updateBlockchain()
+	}
+}
+
+func TestStateSync(t *testing.T) {
+	td := setupStateSync(t)
+
+	td.sync.updateBlockchain()
+	assert.Len(t, td.sync.stateSync.requests, 2)
+	td.shouldPublishMessageWithThisType(t, message.TypeStateRequest)
+
+	td.respondStateRequests(t)
+	require.True(t, td.sync.isBootstrapping())
+	assert.Equal(t, td.snapshot.Height(), td.sync.stateSync.restorer.Height())
+
+	td.cacheSnapshotBlocks(td.makeSnapshotChain(statesync.StateRoot(td.snapshot.Manifest()), true))
+	td.respondAndUpdate(t)
+
+	assert.False(t, td.sync.isBootstrapping())
+	// The blocks after the snapshot, except the checkpoint, are committed from the cache.
+	assert.Equal(t, td.snapshot.Height()+2, td.state.LastBlockHeight())
+	assert.Equal(t, td.snapshot.Manifest().TotalAccounts, td.state.TotalAccounts())
+	assert.Equal(t, td.snapshot.Manifest().TotalValidators, td.state.TotalValidators())
+	assert.True(t, td.state.IsPruned())
+}
+
+func TestStateSyncRootMismatch(t *testing.T) {
+	td := setupStateSync(t)
+
+	td.sync.updateBlockchain()
+	td.respondStateRequests(t)
+	require.True(t, td.sync.isBootstrapping())
+
+	td.cacheSnapshotBlocks(td.makeSnapshotChain(td.RandHash(), true))
+	td.sync.updateBlockchain()
+
+	assert.False(t, td.sync.isBootstrapping())
+	assert.Zero(t, td.state.LastBlockHeight())
+}
+
+func TestStateSyncCheckpointMismatch(t *testing.T) {
+	td := setupStateSync(t)
+
+	td.sync.updateBlockchain()
+	td.respondStateRequests(t)
+	require.True(t, td.sync.isBootstrapping())
+
+	chain := td.makeSnapshotChain(statesync.StateRoot(td.snapshot.Manifest()), true)
+	td.cacheSnapshotBlocks(chain)
+	td.sync.config.StateSyncCheckpointHash = td.RandHash().String()
+	td.sync.updateBlockchain()
+
+	assert.False(t, td.sync.isBootstrapping())
+	assert.Zero(t, td.state.LastBlockHeight())
+	for _, blk := range chain {
+		assert.False(t, td.sync.cache.HasBlockInCache(blk.Height()))
+	}
+}
+
+func TestStateSyncUnlinkedBlocks(t *testing.T) {
+	td := setupStateSync(t)
+
+	td.sync.updateBlockchain()
+	td.respondStateRequests(t)
+	require.True(t, td.sync.isBootstrapping())
+
+	// The block after the snapshot is replaced by a forged one.
+	chain := td.makeSnapshotChain(statesync.StateRoot(td.snapshot.Manifest()), true)
+	height := td.snapshot.Height()
+	chain[height], _ = td.GenerateTestBlock(height+1,
+		testsuite.BlockWithPrevHash(chain[height-1].Hash()),
+		testsuite.BlockWithStateHash(statesync.StateRoot(td.snapshot.Manifest())))
+	td.cacheSnapshotBlocks(chain)
+	td.sync.updateBlockchain()
+
+	assert.False(t, td.sync.isBootstrapping())
+	assert.Zero(t, td.state.LastBlockHeight())
+}
+
+func TestStateSyncInvalidCertificate(t *testing.T) {
+	td := setupStateSync(t)
+
+	td.sync.updateBlockchain()
+	td.respondStateRequests(t)
+	require.True(t, td.sync.isBootstrapping())
+
+	td.cacheSnapshotBlocks(td.makeSnapshotChain(statesync.StateRoot(td.snapshot.Manifest()), false))
+	td.respondAndUpdate(t)
+
+	// The state is not restored from the snapshot.
+	assert.False(t, td.sync.isBootstrapping())
+	assert.False(t, td.state.IsPruned())
+}
+
+func TestStateSyncSnapshotAfterCheckpoint(t *testing.T) {
+	td := setupStateSync(t)
+	td.sync.config.StateSyncCheckpointHeight = td.snapshot.Height()
+
+	td.sync.updateBlockchain()
+	td.respondStateRequests(t)
+
+	// The snapshot is ignored, and the peers are not asked again.
+	assert.False(t, td.sync.isBootstrapping())
+	assert.Empty(t, td.sync.stateSync.requests)
+}
+
+func TestStateSyncDisagreement(t *testing.T) {
+	td := setupStateSync(t)
+
+	td.sync.updateBlockchain()
+	require.Len(t, td.sync.stateSync.requests, 2)
+
+	// The second peer has a different snapshot.
+	for sid, req := range td.sync.stateSync.requests {
+		manifest := *td.snapshot.Manifest()
+		if req.pid == td.peers[1] {
+			manifest.TotalValidators++
+		}
+		res := message.NewStateResponseMessage(message.ResponseCodeOK, message.ResponseCodeOK.String(),
+			sid, td.snapshot.Height(), message.StatePartManifest, 0)
+		res.Manifest = &manifest
+
+		td.receivingNewMessage(td.sync, res, req.pid)
+	}
+
+	assert.False(t, td.sync.isBootstrapping())
+	// The peers are asked again.
+	assert.Len(t, td.sync.stateSync.requests, 2)
+}
+
+func TestStateSyncNoSnapshot(t *testing.T) {
+	td := setupStateSync(t)
+
+	td.sync.updateBlockchain()
+	for sid, req := range td.sync.stateSync.requests {
+		res := message.NewStateResponseMessage(message.ResponseCodeRejected, "no state snapshot",
+			sid, 0, message.StatePartManifest, 0)
+
+		td.receivingNewMessage(td.sync, res, req.pid)
+	}
+
+	// Falling back to download the blocks from the genesis
+	td.addPeer(t, status.StatusKnown, service.New(service.FullNode))
+	td.sync.updateBlockchain()
+	td.shouldPublishMessageWithThisType(t, message.TypeBlocksRequest)
+	assert.False(t, td.sync.isBootstrapping())
+}
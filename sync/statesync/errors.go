@@ -0,0 +1,27 @@
+package statesync
+
+import (
+	"fmt"
+
+	"github.com/pactus-project/pactus/sync/bundle/message"
+)
+
+// InvalidChunkError is returned when a chunk is out of range or doesn't match the manifest.
+type InvalidChunkError struct {
+	Part   message.StatePart
+	Chunk  uint32
+	Reason string
+}
+
+func (e InvalidChunkError) Error() string {
+	return fmt.Sprintf("invalid %s chunk %d: %s", e.Part, e.Chunk, e.Reason)
+}
+
+// InvalidSnapshotError is returned when the state snapshot is not consistent.
+type InvalidSnapshotError struct {
+	Reason string
+}
+
+func (e InvalidSnapshotError) Error() string {
+	return fmt.Sprintf("invalid state snapshot: %s", e.Reason)
+}
@@ -0,0 +1,82 @@
+package statesync
+
+import (
+	"bytes"
+	"math/bits"
+
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/util/encoding"
+	"github.com/pactus-project/pactus/util/simplemerkle"
+)
+
+// The account and validator merkle trees are built by the state, where the leaves are the hashes
+// of the accounts or validators, ordered by their numbers, and a node without the right child
+// is paired with itself. A chunk holds `StateChunkSize` leaves, so its root is a node of the tree.
+
+// chunkLevels is the number of levels in a chunk subtree.
+var chunkLevels = bits.Len(message.StateChunkSize - 1)
+
+// treeDepth returns the number of levels above the leaves of a merkle tree with the given number of leaves.
+func treeDepth(total int32) int {
+	return bits.Len32(uint32(total - 1))
+}
+
+// reduce returns the nodes that are the given number of levels above the given nodes.
+func reduce(nodes []hash.Hash, levels int) []hash.Hash {
+	for i := 0; i < levels; i++ {
+		parents := make([]hash.Hash, 0, (len(nodes)+1)/2)
+		for j := 0; j < len(nodes); j += 2 {
+			left := nodes[j]
+			right := left
+			if j+1 < len(nodes) {
+				right = nodes[j+1]
+			}
+			parents = append(parents, *simplemerkle.HashMerkleBranches(&left, &right))
+		}
+		nodes = parents
+	}
+
+	return nodes
+}
+
+// chunkRoot returns the root of a chunk, given its leaves and the total number of leaves in the tree.
+// If the whole tree fits in one chunk, the chunk root is the tree root.
+func chunkRoot(leaves []hash.Hash, total int32) hash.Hash {
+	levels := min(chunkLevels, treeDepth(total))
+
+	return reduce(leaves, levels)[0]
+}
+
+// merkleRoot returns the root of the tree, given the roots of its chunks.
+func merkleRoot(chunkRoots []hash.Hash, total int32) hash.Hash {
+	depth := treeDepth(total)
+	levels := depth - min(chunkLevels, depth)
+
+	return reduce(chunkRoots, levels)[0]
+}
+
+// StateRoot returns the state root of the snapshot described by the manifest.
+// It should match the state root in the header of the block after the snapshot height.
+func StateRoot(manifest *message.StateManifest) hash.Hash {
+	accRoot := merkleRoot(manifest.AccountChunkRoots, manifest.TotalAccounts)
+	valRoot := merkleRoot(manifest.ValidatorChunkRoots, manifest.TotalValidators)
+
+	return *simplemerkle.HashMerkleBranches(&accRoot, &valRoot)
+}
+
+// accountChunkHash returns the hash of an account chunk, including the addresses and public keys.
+func accountChunkHash(accs []*message.StateAccount) hash.Hash {
+	w := new(bytes.Buffer)
+	for _, acc := range accs {
+		w.Write(acc.Address.Bytes())
+		_ = encoding.WriteVarBytes(w, acc.Data)
+		if acc.PublicKey != nil {
+			_ = encoding.WriteVarBytes(w, acc.PublicKey.Bytes())
+		} else {
+			_ = encoding.WriteVarBytes(w, nil)
+		}
+	}
+
+	return hash.CalcHash(w.Bytes())
+}
@@ -0,0 +1,42 @@
+package statesync
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/util/persistentmerkle"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTreeDepth(t *testing.T) {
+	assert.Equal(t, 0, treeDepth(1))
+	assert.Equal(t, 1, treeDepth(2))
+	assert.Equal(t, 2, treeDepth(3))
+	assert.Equal(t, 9, treeDepth(512))
+	assert.Equal(t, 10, treeDepth(513))
+	assert.Equal(t, 9, chunkLevels)
+}
+
+// TestMerkleRoot checks the root built from the chunks against the state merkle tree.
+func TestMerkleRoot(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	for _, total := range []int32{1, 2, 3, 7, 511, 512, 513, 1024, 1500} {
+		tree := persistentmerkle.New()
+		leaves := make([]hash.Hash, total)
+		for i := range leaves {
+			leaves[i] = ts.RandHash()
+			tree.SetHash(i, leaves[i])
+		}
+
+		chunkRoots := make([]hash.Hash, message.NumberOfStateChunks(total))
+		for c := range chunkRoots {
+			from, to := chunkRange(uint32(c), total)
+			chunkRoots[c] = chunkRoot(leaves[from:to], total)
+		}
+
+		assert.Equal(t, tree.Root(), merkleRoot(chunkRoots, total), "total: %d", total)
+	}
+}
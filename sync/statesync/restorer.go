@@ -0,0 +1,181 @@
+package statesync
+
+import (
+	"fmt"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/types/account"
+	"github.com/pactus-project/pactus/types/validator"
+)
+
+// Restorer collects the chunks of a state snapshot and verifies them against the manifest.
+// The manifest itself should be verified against the state root before restoring the state.
+type Restorer struct {
+	height          uint32
+	manifest        *message.StateManifest
+	accounts        map[crypto.Address]*account.Account
+	publicKeys      []*bls.PublicKey
+	validators      []*validator.Validator
+	accountChunks   []bool
+	validatorChunks []bool
+}
+
+func NewRestorer(height uint32, manifest *message.StateManifest) *Restorer {
+	return &Restorer{
+		height:          height,
+		manifest:        manifest,
+		accounts:        make(map[crypto.Address]*account.Account, manifest.TotalAccounts),
+		publicKeys:      make([]*bls.PublicKey, 0),
+		validators:      make([]*validator.Validator, manifest.TotalValidators),
+		accountChunks:   make([]bool, len(manifest.AccountChunkRoots)),
+		validatorChunks: make([]bool, len(manifest.ValidatorChunkRoots)),
+	}
+}
+
+func (r *Restorer) Height() uint32 {
+	return r.height
+}
+
+func (r *Restorer) Manifest() *message.StateManifest {
+	return r.manifest
+}
+
+// AddAccountChunk verifies the accounts of the chunk and keeps them.
+// A chunk that is received before is ignored.
+func (r *Restorer) AddAccountChunk(chunk uint32, accs []*message.StateAccount) error {
+	invalidChunkError := func(reason string) error {
+		return InvalidChunkError{Part: message.StatePartAccounts, Chunk: chunk, Reason: reason}
+	}
+
+	if int(chunk) >= len(r.accountChunks) {
+		return invalidChunkError("out of range")
+	}
+	if r.accountChunks[chunk] {
+		return nil
+	}
+
+	from, to := chunkRange(chunk, r.manifest.TotalAccounts)
+	if len(accs) != to-from {
+		return invalidChunkError(fmt.Sprintf("expected %d accounts, got %d", to-from, len(accs)))
+	}
+
+	if accountChunkHash(accs) != r.manifest.AccountChunkHashes[chunk] {
+		return invalidChunkError("chunk hash mismatch")
+	}
+
+	decoded := make([]*account.Account, len(accs))
+	leaves := make([]hash.Hash, len(accs))
+	for i, stateAcc := range accs {
+		acc, err := account.FromBytes(stateAcc.Data)
+		if err != nil {
+			return invalidChunkError(err.Error())
+		}
+		if acc.Number() != int32(from+i) {
+			return invalidChunkError(fmt.Sprintf("unexpected account number %d", acc.Number()))
+		}
+		if _, ok := r.accounts[stateAcc.Address]; ok {
+			return invalidChunkError(fmt.Sprintf("duplicated account %s", stateAcc.Address))
+		}
+		if stateAcc.PublicKey != nil && stateAcc.PublicKey.AccountAddress() != stateAcc.Address {
+			return invalidChunkError(fmt.Sprintf("public key mismatch for %s", stateAcc.Address))
+		}
+		decoded[i] = acc
+		leaves[i] = acc.Hash()
+	}
+
+	if chunkRoot(leaves, r.manifest.TotalAccounts) != r.manifest.AccountChunkRoots[chunk] {
+		return invalidChunkError("chunk root mismatch")
+	}
+
+	for i, stateAcc := range accs {
+		r.accounts[stateAcc.Address] = decoded[i]
+		if stateAcc.PublicKey != nil {
+			r.publicKeys = append(r.publicKeys, stateAcc.PublicKey)
+		}
+	}
+	r.accountChunks[chunk] = true
+
+	return nil
+}
+
+// AddValidatorChunk verifies the validators of the chunk and keeps them.
+// A chunk that is received before is ignored.
+func (r *Restorer) AddValidatorChunk(chunk uint32, vals [][]byte) error {
+	invalidChunkError := func(reason string) error {
+		return InvalidChunkError{Part: message.StatePartValidators, Chunk: chunk, Reason: reason}
+	}
+
+	if int(chunk) >= len(r.validatorChunks) {
+		return invalidChunkError("out of range")
+	}
+	if r.validatorChunks[chunk] {
+		return nil
+	}
+
+	from, to := chunkRange(chunk, r.manifest.TotalValidators)
+	if len(vals) != to-from {
+		return invalidChunkError(fmt.Sprintf("expected %d validators, got %d", to-from, len(vals)))
+	}
+
+	decoded := make([]*validator.Validator, len(vals))
+	leaves := make([]hash.Hash, len(vals))
+	for i, data := range vals {
+		val, err := validator.FromBytes(data)
+		if err != nil {
+			return invalidChunkError(err.Error())
+		}
+		if val.Number() != int32(from+i) {
+			return invalidChunkError(fmt.Sprintf("unexpected validator number %d", val.Number()))
+		}
+		decoded[i] = val
+		leaves[i] = val.Hash()
+	}
+
+	if chunkRoot(leaves, r.manifest.TotalValidators) != r.manifest.ValidatorChunkRoots[chunk] {
+		return invalidChunkError("chunk root mismatch")
+	}
+
+	copy(r.validators[from:to], decoded)
+	r.validatorChunks[chunk] = true
+
+	return nil
+}
+
+// MissingChunks returns the chunks of the given part that are not received yet.
+func (r *Restorer) MissingChunks(part message.StatePart) []uint32 {
+	received := r.accountChunks
+	if part == message.StatePartValidators {
+		received = r.validatorChunks
+	}
+
+	missing := make([]uint32, 0)
+	for c, ok := range received {
+		if !ok {
+			missing = append(missing, uint32(c))
+		}
+	}
+
+	return missing
+}
+
+// IsComplete returns true if all the chunks are received.
+func (r *Restorer) IsComplete() bool {
+	return len(r.MissingChunks(message.StatePartAccounts)) == 0 &&
+		len(r.MissingChunks(message.StatePartValidators)) == 0
+}
+
+func (r *Restorer) Accounts() map[crypto.Address]*account.Account {
+	return r.accounts
+}
+
+// PublicKeys returns the revealed public keys of the accounts.
+func (r *Restorer) PublicKeys() []*bls.PublicKey {
+	return r.publicKeys
+}
+
+func (r *Restorer) Validators() []*validator.Validator {
+	return r.validators
+}
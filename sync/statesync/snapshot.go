@@ -0,0 +1,174 @@
+package statesync
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/store"
+	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/types/account"
+	"github.com/pactus-project/pactus/types/validator"
+)
+
+// Snapshot serves the manifest and the chunks of a state snapshot to the state syncing peers.
+type Snapshot struct {
+	height     uint32
+	snap       store.StateSnapshot
+	accounts   []crypto.Address
+	validators []*validator.Validator
+	manifest   *message.StateManifest
+}
+
+// NewSnapshot indexes the accounts and validators of the state snapshot by their numbers
+// and builds the manifest. The state snapshot is owned by the caller.
+func NewSnapshot(snap store.StateSnapshot) (*Snapshot, error) {
+	lastCert := snap.LastCertificate()
+	if lastCert == nil {
+		return nil, InvalidSnapshotError{Reason: "no block is committed"}
+	}
+
+	type indexedAccount struct {
+		addr crypto.Address
+		num  int32
+		hash hash.Hash
+	}
+	accs := make([]indexedAccount, 0)
+	snap.IterateAccounts(func(addr crypto.Address, acc *account.Account) bool {
+		accs = append(accs, indexedAccount{addr: addr, num: acc.Number(), hash: acc.Hash()})
+
+		return false
+	})
+	slices.SortFunc(accs, func(a, b indexedAccount) int { return int(a.num - b.num) })
+
+	vals := make([]*validator.Validator, 0)
+	snap.IterateValidators(func(val *validator.Validator) bool {
+		vals = append(vals, val)
+
+		return false
+	})
+	slices.SortFunc(vals, func(a, b *validator.Validator) int { return int(a.Number() - b.Number()) })
+
+	if len(accs) == 0 || len(vals) == 0 {
+		return nil, InvalidSnapshotError{Reason: "empty state"}
+	}
+
+	s := &Snapshot{
+		height:     lastCert.Height(),
+		snap:       snap,
+		accounts:   make([]crypto.Address, len(accs)),
+		validators: vals,
+	}
+
+	accLeaves := make([]hash.Hash, len(accs))
+	for i, acc := range accs {
+		if acc.num != int32(i) {
+			return nil, InvalidSnapshotError{Reason: fmt.Sprintf("account number %d is not expected", acc.num)}
+		}
+		s.accounts[i] = acc.addr
+		accLeaves[i] = acc.hash
+	}
+
+	valLeaves := make([]hash.Hash, len(vals))
+	for i, val := range vals {
+		if val.Number() != int32(i) {
+			return nil, InvalidSnapshotError{Reason: fmt.Sprintf("validator number %d is not expected", val.Number())}
+		}
+		valLeaves[i] = val.Hash()
+	}
+
+	totalAccounts := int32(len(accs))
+	totalValidators := int32(len(vals))
+	manifest := &message.StateManifest{
+		TotalAccounts:       totalAccounts,
+		TotalValidators:     totalValidators,
+		AccountChunkRoots:   make([]hash.Hash, message.NumberOfStateChunks(totalAccounts)),
+		AccountChunkHashes:  make([]hash.Hash, message.NumberOfStateChunks(totalAccounts)),
+		ValidatorChunkRoots: make([]hash.Hash, message.NumberOfStateChunks(totalValidators)),
+	}
+	for c := range manifest.AccountChunkRoots {
+		from, to := chunkRange(uint32(c), totalAccounts)
+		manifest.AccountChunkRoots[c] = chunkRoot(accLeaves[from:to], totalAccounts)
+
+		chunk, err := s.AccountChunk(uint32(c))
+		if err != nil {
+			return nil, err
+		}
+		manifest.AccountChunkHashes[c] = accountChunkHash(chunk)
+	}
+	for c := range manifest.ValidatorChunkRoots {
+		from, to := chunkRange(uint32(c), totalValidators)
+		manifest.ValidatorChunkRoots[c] = chunkRoot(valLeaves[from:to], totalValidators)
+	}
+	s.manifest = manifest
+
+	return s, nil
+}
+
+// chunkRange returns the range of the leaves inside the chunk.
+func chunkRange(chunk uint32, total int32) (int, int) {
+	from := int(chunk) * message.StateChunkSize
+	to := min(from+message.StateChunkSize, int(total))
+
+	return from, to
+}
+
+func (s *Snapshot) Height() uint32 {
+	return s.height
+}
+
+func (s *Snapshot) Manifest() *message.StateManifest {
+	return s.manifest
+}
+
+// AccountChunk returns the accounts inside the chunk, ordered by their numbers.
+func (s *Snapshot) AccountChunk(chunk uint32) ([]*message.StateAccount, error) {
+	if int(chunk) >= message.NumberOfStateChunks(int32(len(s.accounts))) {
+		return nil, InvalidChunkError{Part: message.StatePartAccounts, Chunk: chunk, Reason: "out of range"}
+	}
+
+	from, to := chunkRange(chunk, int32(len(s.accounts)))
+	accs := make([]*message.StateAccount, 0, to-from)
+	for _, addr := range s.accounts[from:to] {
+		acc, err := s.snap.Account(addr)
+		if err != nil {
+			return nil, err
+		}
+		data, err := acc.Bytes()
+		if err != nil {
+			return nil, err
+		}
+
+		stateAcc := &message.StateAccount{
+			Address: addr,
+			Data:    data,
+		}
+		pub, err := s.snap.PublicKey(addr)
+		if err == nil {
+			stateAcc.PublicKey = pub
+		}
+		accs = append(accs, stateAcc)
+	}
+
+	return accs, nil
+}
+
+// ValidatorChunk returns the encoded validators inside the chunk, ordered by their numbers.
+func (s *Snapshot) ValidatorChunk(chunk uint32) ([][]byte, error) {
+	if int(chunk) >= message.NumberOfStateChunks(int32(len(s.validators))) {
+		return nil, InvalidChunkError{Part: message.StatePartValidators, Chunk: chunk, Reason: "out of range"}
+	}
+
+	from, to := chunkRange(chunk, int32(len(s.validators)))
+	vals := make([][]byte, 0, to-from)
+	for _, val := range s.validators[from:to] {
+		data, err := val.Bytes()
+		if err != nil {
+			return nil, err
+		}
+		vals = append(vals, data)
+	}
+
+	return vals, nil
+}
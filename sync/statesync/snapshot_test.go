@@ -0,0 +1,214 @@
+package statesync
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/store"
+	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/util/persistentmerkle"
+	"github.com/pactus-project/pactus/util/simplemerkle"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testData struct {
+	*testsuite.TestSuite
+
+	store     *store.MockStore
+	snapshot  *Snapshot
+	stateRoot hash.Hash
+}
+
+func setup(t *testing.T, totalAccounts, totalValidators int32) *testData {
+	t.Helper()
+
+	ts := testsuite.NewTestSuite(t)
+	str := store.MockingStore(ts)
+	accTree := persistentmerkle.New()
+	valTree := persistentmerkle.New()
+
+	for i := int32(0); i < totalAccounts; i++ {
+		acc, addr := ts.GenerateTestAccount(i)
+		if i%3 == 0 {
+			// An account with a revealed public key
+			pub, _ := ts.RandBLSKeyPair()
+			addr = pub.AccountAddress()
+			str.SavePublicKey(addr, pub)
+		}
+		str.UpdateAccount(addr, acc)
+		accTree.SetHash(int(i), acc.Hash())
+	}
+	for i := int32(0); i < totalValidators; i++ {
+		val, _ := ts.GenerateTestValidator(i)
+		str.UpdateValidator(val)
+		valTree.SetHash(int(i), val.Hash())
+	}
+	blk, cert := ts.GenerateTestBlock(ts.RandHeight())
+	str.SaveBlock(blk, cert)
+
+	stateSnap, err := str.StateSnapshot()
+	require.NoError(t, err)
+
+	snapshot, err := NewSnapshot(stateSnap)
+	require.NoError(t, err)
+
+	accRoot := accTree.Root()
+	valRoot := valTree.Root()
+
+	return &testData{
+		TestSuite: ts,
+		store:     str,
+		snapshot:  snapshot,
+		stateRoot: *simplemerkle.HashMerkleBranches(&accRoot, &valRoot),
+	}
+}
+
+func (td *testData) restore(t *testing.T, restorer *Restorer) {
+	t.Helper()
+
+	for c := range restorer.Manifest().AccountChunkRoots {
+		accs, err := td.snapshot.AccountChunk(uint32(c))
+		require.NoError(t, err)
+		require.NoError(t, restorer.AddAccountChunk(uint32(c), accs))
+	}
+	for c := range restorer.Manifest().ValidatorChunkRoots {
+		vals, err := td.snapshot.ValidatorChunk(uint32(c))
+		require.NoError(t, err)
+		require.NoError(t, restorer.AddValidatorChunk(uint32(c), vals))
+	}
+}
+
+func TestSnapshotManifest(t *testing.T) {
+	td := setup(t, 1100, 3)
+
+	manifest := td.snapshot.Manifest()
+	assert.Equal(t, td.store.LastHeight, td.snapshot.Height())
+	assert.Equal(t, int32(1100), manifest.TotalAccounts)
+	assert.Equal(t, int32(3), manifest.TotalValidators)
+	assert.Len(t, manifest.AccountChunkRoots, 3)
+	assert.Len(t, manifest.ValidatorChunkRoots, 1)
+	assert.NoError(t, manifest.BasicCheck())
+	assert.Equal(t, td.stateRoot, StateRoot(manifest))
+
+	_, err := td.snapshot.AccountChunk(3)
+	assert.ErrorIs(t, err, InvalidChunkError{Part: message.StatePartAccounts, Chunk: 3, Reason: "out of range"})
+
+	_, err = td.snapshot.ValidatorChunk(1)
+	assert.ErrorIs(t, err, InvalidChunkError{Part: message.StatePartValidators, Chunk: 1, Reason: "out of range"})
+}
+
+func TestInvalidSnapshot(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	t.Run("No committed block", func(t *testing.T) {
+		stateSnap, _ := store.MockingStore(ts).StateSnapshot()
+		_, err := NewSnapshot(stateSnap)
+		assert.ErrorIs(t, err, InvalidSnapshotError{Reason: "no block is committed"})
+	})
+
+	t.Run("Missing account number", func(t *testing.T) {
+		str := store.MockingStore(ts)
+		acc, addr := ts.GenerateTestAccount(1)
+		str.UpdateAccount(addr, acc)
+		str.AddTestValidator()
+		blk, cert := ts.GenerateTestBlock(ts.RandHeight())
+		str.SaveBlock(blk, cert)
+
+		stateSnap, _ := str.StateSnapshot()
+		_, err := NewSnapshot(stateSnap)
+		assert.ErrorIs(t, err, InvalidSnapshotError{Reason: "account number 1 is not expected"})
+	})
+}
+
+func TestRestorer(t *testing.T) {
+	td := setup(t, 1100, 3)
+
+	t.Run("Restoring all chunks", func(t *testing.T) {
+		restorer := NewRestorer(td.snapshot.Height(), td.snapshot.Manifest())
+		assert.False(t, restorer.IsComplete())
+		assert.Equal(t, []uint32{0, 1, 2}, restorer.MissingChunks(message.StatePartAccounts))
+		assert.Equal(t, []uint32{0}, restorer.MissingChunks(message.StatePartValidators))
+
+		td.restore(t, restorer)
+		assert.True(t, restorer.IsComplete())
+
+		for addr, acc := range td.store.Accounts {
+			assert.Equal(t, acc, restorer.Accounts()[addr])
+		}
+		assert.Len(t, restorer.Accounts(), 1100)
+		assert.Len(t, restorer.PublicKeys(), 367)
+		for _, pub := range restorer.PublicKeys() {
+			assert.Equal(t, td.store.PublicKeys[pub.AccountAddress()], pub)
+		}
+		for _, val := range restorer.Validators() {
+			assert.Equal(t, td.store.Validators[val.Address()].Hash(), val.Hash())
+		}
+
+		// Adding a chunk again is ignored.
+		accs, _ := td.snapshot.AccountChunk(1)
+		assert.NoError(t, restorer.AddAccountChunk(1, accs))
+	})
+
+	t.Run("Out of range chunk", func(t *testing.T) {
+		restorer := NewRestorer(td.snapshot.Height(), td.snapshot.Manifest())
+
+		accs, _ := td.snapshot.AccountChunk(2)
+		err := restorer.AddAccountChunk(3, accs)
+		assert.ErrorIs(t, err, InvalidChunkError{Part: message.StatePartAccounts, Chunk: 3, Reason: "out of range"})
+	})
+
+	t.Run("Missing an account", func(t *testing.T) {
+		restorer := NewRestorer(td.snapshot.Height(), td.snapshot.Manifest())
+
+		accs, _ := td.snapshot.AccountChunk(0)
+		err := restorer.AddAccountChunk(0, accs[1:])
+		assert.ErrorIs(t, err, InvalidChunkError{
+			Part: message.StatePartAccounts, Chunk: 0,
+			Reason: "expected 512 accounts, got 511",
+		})
+	})
+
+	t.Run("Swapping account addresses", func(t *testing.T) {
+		restorer := NewRestorer(td.snapshot.Height(), td.snapshot.Manifest())
+
+		accs, _ := td.snapshot.AccountChunk(0)
+		accs[1].Address, accs[2].Address = accs[2].Address, accs[1].Address
+		err := restorer.AddAccountChunk(0, accs)
+		assert.ErrorIs(t, err, InvalidChunkError{
+			Part: message.StatePartAccounts, Chunk: 0,
+			Reason: "chunk hash mismatch",
+		})
+	})
+
+	t.Run("Wrong account data", func(t *testing.T) {
+		manifest := *td.snapshot.Manifest()
+		manifest.AccountChunkHashes = append([]hash.Hash{}, manifest.AccountChunkHashes...)
+
+		accs, _ := td.snapshot.AccountChunk(0)
+		acc, _ := td.GenerateTestAccount(1)
+		accs[1].Data, _ = acc.Bytes()
+		manifest.AccountChunkHashes[0] = accountChunkHash(accs)
+
+		restorer := NewRestorer(td.snapshot.Height(), &manifest)
+		err := restorer.AddAccountChunk(0, accs)
+		assert.ErrorIs(t, err, InvalidChunkError{
+			Part: message.StatePartAccounts, Chunk: 0,
+			Reason: "chunk root mismatch",
+		})
+	})
+
+	t.Run("Wrong validator", func(t *testing.T) {
+		restorer := NewRestorer(td.snapshot.Height(), td.snapshot.Manifest())
+
+		vals, _ := td.snapshot.ValidatorChunk(0)
+		val, _ := td.GenerateTestValidator(1)
+		vals[1], _ = val.Bytes()
+		err := restorer.AddValidatorChunk(0, vals)
+		assert.ErrorIs(t, err, InvalidChunkError{
+			Part: message.StatePartValidators, Chunk: 0,
+			Reason: "chunk root mismatch",
+		})
+	})
+}
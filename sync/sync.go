@@ -1,24 +1,32 @@
 package sync
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/pactus-project/pactus/consensus"
 	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/evidencepool"
 	"github.com/pactus-project/pactus/genesis"
 	"github.com/pactus-project/pactus/network"
+	"github.com/pactus-project/pactus/signer"
 	"github.com/pactus-project/pactus/state"
 	"github.com/pactus-project/pactus/sync/bundle"
+	"github.com/pactus-project/pactus/sync/bundle/codec"
 	"github.com/pactus-project/pactus/sync/bundle/message"
 	"github.com/pactus-project/pactus/sync/cache"
+	"github.com/pactus-project/pactus/sync/capture"
 	"github.com/pactus-project/pactus/sync/firewall"
 	"github.com/pactus-project/pactus/sync/peerset"
 	"github.com/pactus-project/pactus/sync/peerset/peer"
+	"github.com/pactus-project/pactus/sync/peerset/peer/feature"
 	"github.com/pactus-project/pactus/sync/peerset/peer/service"
 	"github.com/pactus-project/pactus/sync/peerset/peer/status"
 	"github.com/pactus-project/pactus/sync/peerset/session"
+	"github.com/pactus-project/pactus/sync/statesync"
 	"github.com/pactus-project/pactus/util"
 	"github.com/pactus-project/pactus/util/logger"
 	"github.com/pactus-project/pactus/util/ntp"
@@ -36,25 +44,38 @@ type synchronizer struct {
 	ctx         context.Context
 	cancel      context.CancelFunc
 	config      *Config
-	valKeys     []*bls.ValidatorKey
+	signers     []signer.Signer
 	state       state.Facade
 	consMgr     consensus.Manager
+	evdPool     evidencepool.EvidencePool
 	peerSet     *peerset.PeerSet
 	firewall    *firewall.Firewall
 	cache       *cache.Cache
+	verifyCh    chan verifyingCertificate
+	stateSync   *stateSyncer
 	handlers    map[message.Type]messageHandler
 	broadcastCh <-chan message.Message
 	networkCh   <-chan network.Event
 	network     network.Network
 	logger      *logger.SubLogger
 	ntp         *ntp.Checker
+
+	// capture is nil if capturing the bundles is disabled.
+	capture *capture.Writer
+
+	// codecs are the compression codecs of the bundles, shared with the firewall.
+	codecs *bundle.Codecs
+	// dictionaryID is the ID of the zstd dictionary, zero if no dictionary is loaded.
+	dictionaryID     uint32
+	servingSnapshots map[uint32]*statesync.Snapshot
 }
 
 func NewSynchronizer(
 	conf *Config,
-	valKeys []*bls.ValidatorKey,
+	signers []signer.Signer,
 	st state.Facade,
 	consMgr consensus.Manager,
+	evdPool evidencepool.EvidencePool,
 	net network.Network,
 	broadcastCh <-chan message.Message,
 ) (Synchronizer, error) {
@@ -63,18 +84,38 @@ func NewSynchronizer(
 		ctx:         ctx,
 		cancel:      cancel,
 		config:      conf,
-		valKeys:     valKeys,
+		signers:     signers,
 		state:       st,
 		consMgr:     consMgr,
+		evdPool:     evdPool,
 		network:     net,
 		broadcastCh: broadcastCh,
 		networkCh:   net.EventChannel(),
 		ntp:         ntp.NewNtpChecker(),
+		verifyCh:    make(chan verifyingCertificate, conf.BlockPerSession),
+		stateSync:   newStateSyncer(),
+
+		servingSnapshots: make(map[uint32]*statesync.Snapshot),
 	}
 
 	sync.peerSet = peerset.NewPeerSet(conf.SessionTimeout)
 	sync.logger = logger.NewSubLogger("_sync", sync)
-	fw, err := firewall.NewFirewall(conf.Firewall, net, sync.peerSet, st, sync.logger)
+	sync.codecs = bundle.NewCodecs()
+	if conf.Dictionary != "" {
+		dict, err := util.ReadFile(conf.Dictionary)
+		if err != nil {
+			return nil, err
+		}
+		zstd, err := codec.NewZstd(dict)
+		if err != nil {
+			return nil, err
+		}
+		sync.codecs.SetCodec(bundle.BundleFlagCompressedZstd, zstd)
+		sync.dictionaryID = zstd.DictionaryID()
+		sync.logger.Info("compression dictionary loaded", "id", sync.dictionaryID)
+	}
+
+	fw, err := firewall.NewFirewall(conf.Firewall, net, sync.peerSet, st, sync.codecs, sync.logger)
 	if err != nil {
 		return nil, err
 	}
@@ -89,6 +130,11 @@ func NewSynchronizer(
 	sync.cache = ca
 	sync.logger.Info("cache setup", "size", cacheSize)
 
+	if conf.Capture.Enable {
+		sync.capture = capture.NewWriter(conf.Capture)
+		sync.logger.Info("capturing the bundles", "path", conf.Capture.Path)
+	}
+
 	handlers := make(map[message.Type]messageHandler)
 
 	handlers[message.TypeHello] = newHelloHandler(sync)
@@ -101,6 +147,9 @@ func NewSynchronizer(
 	handlers[message.TypeBlockAnnounce] = newBlockAnnounceHandler(sync)
 	handlers[message.TypeBlocksRequest] = newBlocksRequestHandler(sync)
 	handlers[message.TypeBlocksResponse] = newBlocksResponseHandler(sync)
+	handlers[message.TypeEvidence] = newEvidenceHandler(sync)
+	handlers[message.TypeStateRequest] = newStateRequestHandler(sync)
+	handlers[message.TypeStateResponse] = newStateResponseHandler(sync)
 
 	sync.handlers = handlers
 
@@ -122,6 +171,9 @@ func (sync *synchronizer) Start() error {
 	go sync.ntp.Start()
 	go sync.receiveLoop()
 	go sync.broadcastLoop()
+	for i := 0; i < sync.config.CertificateVerifiers; i++ {
+		go sync.certificateVerifier()
+	}
 
 	return nil
 }
@@ -130,6 +182,10 @@ func (sync *synchronizer) Stop() {
 	sync.cancel()
 	sync.ntp.Stop()
 
+	if sync.capture != nil {
+		_ = sync.capture.Close()
+	}
+
 	sync.logger.Debug("context closed", "reason", sync.ctx.Err())
 }
 
@@ -186,18 +242,67 @@ func (sync *synchronizer) prepareBundle(msg message.Message) *bundle.Bundle {
 }
 
 func (sync *synchronizer) sendTo(msg message.Message, to peer.ID) {
+	if f := message.RequiredFeature(msg.Type()); f != feature.None {
+		p := sync.peerSet.GetPeer(to)
+		if p == nil || !p.HasFeature(f) {
+			sync.logger.Debug("peer doesn't support the message", "msg", msg, "to", to)
+
+			return
+		}
+	}
+
 	bdl := sync.prepareBundle(msg)
 	if bdl != nil {
-		data, _ := bdl.Encode()
+		sync.selectCodec(bdl, to)
+		data, _ := bdl.EncodeWith(sync.codecs)
+
+		// Rejections are not limited, so the peer knows when it can retry.
+		if !isRejection(msg) {
+			if ok, retryAfter := sync.firewall.AllowUpload(to, msg.Type(), len(data)); !ok {
+				sync.logger.Debug("upload bandwidth limit exceeded", "msg", msg, "to", to,
+					"retry_after", retryAfter)
+
+				return
+			}
+		}
 
+		sync.captureBundle(capture.DirectionOutbound, to, network.TopicIDUnspecified, data)
 		sync.network.SendTo(data, to)
 		sync.peerSet.UpdateLastSent(to)
 		sync.peerSet.IncreaseSentCounters(msg.Type(), int64(len(data)), &to)
+		sync.peerSet.IncreaseSentSavedBytes(msg.Type(), int64(bdl.SavedBytes()))
 
 		sync.logger.Debug("bundle sent", "bundle", bdl, "to", to)
 	}
 }
 
+// isRejection checks if the message rejects a request of the peer.
+func isRejection(msg message.Message) bool {
+	switch m := msg.(type) {
+	case *message.BlocksResponseMessage:
+		return m.IsRequestRejected()
+	case *message.StateResponseMessage:
+		return m.IsRequestRejected()
+	default:
+		return false
+	}
+}
+
+// selectCodec compresses the bundle with zstd if the peer supports it and has the same dictionary.
+// Otherwise, the bundle is compressed with gzip that all peers support.
+func (sync *synchronizer) selectCodec(bdl *bundle.Bundle, to peer.ID) {
+	if !bdl.IsCompressed() {
+		return
+	}
+
+	p := sync.peerSet.GetPeer(to)
+	if p == nil || !p.HasFeature(feature.Zstd) || p.DictionaryID != sync.dictionaryID {
+		return
+	}
+
+	bdl.CompressWith(bundle.BundleFlagCompressedZstd)
+}
+
 func (sync *synchronizer) broadcast(msg message.Message) {
 	if msg.Type() == message.TypeBlockAnnounce {
 		m := msg.(*message.BlockAnnounceMessage)
@@ -213,9 +318,11 @@ func (sync *synchronizer) broadcast(msg message.Message) {
 	if bdl != nil {
 		bdl.Flags = util.SetFlag(bdl.Flags, bundle.BundleFlagBroadcasted)
 
-		data, _ := bdl.Encode()
+		data, _ := bdl.EncodeWith(sync.codecs)
+		sync.captureBundle(capture.DirectionOutbound, "", msg.TopicID(), data)
 		sync.network.Broadcast(data, msg.TopicID())
 		sync.peerSet.IncreaseSentCounters(msg.Type(), int64(len(data)), nil)
+		sync.peerSet.IncreaseSentSavedBytes(msg.Type(), int64(bdl.SavedBytes()))
 
 		sync.logger.Debug("bundle broadcasted", "bundle", bdl)
 	}
@@ -233,8 +340,16 @@ func (sync *synchronizer) PeerSet() *peerset.PeerSet {
 	return sync.peerSet
 }
 
+// Services returns the services that the node provides.
+// The snapshots are kept in memory, so after a restart the state sync service is
+// not advertised until the state takes its first snapshot.
 func (sync *synchronizer) Services() service.Services {
-	return sync.config.Services
+	services := sync.config.Services
+	if services.IsStateSync() && sync.state.StateSnapshot(0) == nil {
+		services = util.UnsetFlag(services, service.Services(service.StateSync))
+	}
+
+	return services
 }
 
 func (sync *synchronizer) sayHello(to peer.ID) {
@@ -246,17 +361,39 @@ func (sync *synchronizer) sayHello(to peer.ID) {
 	msg := message.NewHelloMessage(
 		sync.SelfID(),
 		sync.config.Moniker,
-		sync.config.Services,
+		sync.Services(),
 		sync.stateHeight(),
 		sync.state.LastBlockHash(),
 		sync.state.Genesis().Hash(),
 	)
-	msg.Sign(sync.valKeys)
+	msg.DictionaryID = sync.dictionaryID
+	if err := sync.signHello(msg); err != nil {
+		sync.logger.Error("unable to sign the Hello message", "error", err)
+
+		return
+	}
 
 	sync.logger.Info("sending Hello message", "to", to)
 	sync.sendTo(msg, to)
 }
 
+// signHello signs the Hello message by all the validators of the node.
+func (sync *synchronizer) signHello(msg *message.HelloMessage) error {
+	publicKeys := make([]*bls.PublicKey, len(sync.signers))
+	signatures := make([]*bls.Signature, len(sync.signers))
+	for i, valSigner := range sync.signers {
+		sig, err := valSigner.SignHello(msg)
+		if err != nil {
+			return err
+		}
+		publicKeys[i] = valSigner.PublicKey()
+		signatures[i] = sig
+	}
+	msg.SetSignatures(publicKeys, signatures)
+
+	return nil
+}
+
 func (sync *synchronizer) broadcastLoop() {
 	for {
 		select {
@@ -304,6 +441,8 @@ func (sync *synchronizer) receiveLoop() {
 func (sync *synchronizer) processGossipMessage(msg *network.GossipMessage) {
 	sync.logger.Debug("processing gossip message", "pid", msg.From)
 
+	sync.captureBundle(capture.DirectionInbound, msg.From, msg.TopicID, msg.Data)
+
 	bdl, err := sync.firewall.OpenGossipBundle(msg.Data, msg.From)
 	if err != nil {
 		sync.logger.Debug("error on parsing a Gossip bundle",
@@ -317,7 +456,15 @@ func (sync *synchronizer) processGossipMessage(msg *network.GossipMessage) {
 func (sync *synchronizer) processStreamMessage(msg *network.StreamMessage) {
 	sync.logger.Debug("processing stream message", "pid", msg.From)
 
-	bdl, err := sync.firewall.OpenStreamBundle(msg.Reader, msg.From)
+	// Capture the bytes that are read from the stream, even if the bundle is malformed.
+	var r io.Reader = msg.Reader
+	raw := new(bytes.Buffer)
+	if sync.capture != nil {
+		r = io.TeeReader(msg.Reader, raw)
+	}
+
+	bdl, err := sync.firewall.OpenStreamBundle(r, msg.From)
+	sync.captureBundle(capture.DirectionInbound, msg.From, network.TopicIDUnspecified, raw.Bytes())
 	if err != nil {
 		sync.logger.Debug("error on parsing a Stream bundle",
 			"from", msg.From, "bundle", bdl, "error", err)
@@ -333,10 +480,38 @@ func (sync *synchronizer) processStreamMessage(msg *network.StreamMessage) {
 	sync.processIncomingBundle(bdl, msg.From)
 }
 
+// captureBundle writes the raw bundle to the capture file, if capturing is enabled.
+func (sync *synchronizer) captureBundle(dir capture.Direction, pid peer.ID, topicID network.TopicID, data []byte) {
+	if sync.capture == nil || len(data) == 0 {
+		return
+	}
+
+	rec := &capture.Record{
+		Time:      time.Now(),
+		Direction: dir,
+		PeerID:    pid,
+		TopicID:   topicID,
+		Data:      data,
+	}
+	if err := sync.capture.Write(rec); err != nil {
+		sync.logger.Warn("unable to capture the bundle", "error", err)
+	}
+}
+
 func (sync *synchronizer) processConnectEvent(ce *network.ConnectEvent) {
 	sync.logger.Debug("processing connect event", "pid", ce.PeerID)
 
 	sync.peerSet.UpdateAddress(ce.PeerID, ce.RemoteAddress, ce.Direction)
+
+	if sync.firewall.IsBannedPeer(ce.PeerID, ce.RemoteAddress) {
+		sync.logger.Info("banned peer connected, closing the connection", "pid", ce.PeerID)
+
+		sync.peerSet.UpdateStatus(ce.PeerID, status.StatusBanned)
+		sync.network.CloseConnection(ce.PeerID)
+
+		return
+	}
+
 	sync.peerSet.UpdateStatus(ce.PeerID, status.StatusConnected)
 }
 
@@ -351,6 +526,13 @@ func (sync *synchronizer) processProtocolsEvent(pe *network.ProtocolsEvents) {
 func (sync *synchronizer) processDisconnectEvent(de *network.DisconnectEvent) {
 	sync.logger.Debug("processing disconnect event", "pid", de.PeerID)
 
+	// The banned peers are disconnected by the network, keep them as banned.
+	if sync.network.IsPeerBanned(de.PeerID) {
+		sync.peerSet.UpdateStatus(de.PeerID, status.StatusBanned)
+
+		return
+	}
+
 	sync.peerSet.UpdateStatus(de.PeerID, status.StatusDisconnected)
 }
 
@@ -383,34 +565,26 @@ func (sync *synchronizer) updateBlockchain() {
 	// Check if we have any expired sessions
 	sync.peerSet.SetExpiredSessionsAsUncompleted()
 
-	// Try to re-download the blocks for uncompleted sessions
-	sessions := sync.peerSet.Sessions()
-	for _, ssn := range sessions {
-		if ssn.Status == session.Uncompleted {
+	// Remove the finished sessions.
+	// The missing blocks of the uncompleted sessions are requested again from other peers.
+	stalledPeers := make(map[peer.ID]bool)
+	for _, ssn := range sync.peerSet.Sessions() {
+		switch ssn.Status {
+		case session.Uncompleted:
 			sync.logger.Info("uncompleted block request, re-download",
 				"sid", ssn.SessionID, "pid", ssn.PeerID,
 				"stats", sync.peerSet.SessionStats())
 
-			sent := sync.sendBlockRequestToRandomPeer(ssn.From, ssn.Count, false)
-			if !sent {
-				break
-			}
-		}
-	}
+			stalledPeers[ssn.PeerID] = true
+			sync.peerSet.RemoveSession(ssn.SessionID)
 
-	// Check if there are any open sessions.
-	// If open sessions exist, we should wait for them to close.
-	// Otherwise, we might request to download the same blocks from different peers.
-	// TODO: write test for me
-	if sync.peerSet.HasAnyOpenSession() {
-		sync.logger.Debug("we have open session",
-			"stats", sync.peerSet.SessionStats())
+		case session.Completed:
+			sync.peerSet.RemoveSession(ssn.SessionID)
 
-		return
+		case session.Open:
+		}
 	}
 
-	sync.peerSet.RemoveAllSessions()
-
 	blockInterval := sync.state.Params().BlockInterval()
 	curTime := util.RoundNow(int(blockInterval.Seconds()))
 	lastBlockTime := sync.state.LastBlockTime()
@@ -422,33 +596,44 @@ func (sync *synchronizer) updateBlockchain() {
 		return
 	}
 
-	downloadHeight := sync.state.LastBlockHeight()
-	downloadHeight++
-
-	if sync.cache.HasBlockInCache(downloadHeight) {
-		// The last block exists inside the cache, without the certificate.
-		// Ignore downloading this block again.
-		downloadHeight++
+	if sync.config.StateSync && sync.stateHeight() == 0 {
+		// Bootstrapping from a state snapshot
+		if sync.updateStateSync(stalledPeers) {
+			return
+		}
 	}
 
-	sync.logger.Info("start syncing with the network",
-		"numOfBlocks", numOfBlocks, "height", downloadHeight)
-
+	from := sync.stateHeight() + 1
+	targetHeight := sync.stateHeight() + numOfBlocks
 	if numOfBlocks > sync.config.PruneWindow {
 		// Don't have blocks for mre than 10 days
-		sync.downloadBlocks(downloadHeight, true)
+		sync.downloadBlocks(from, targetHeight, true, stalledPeers)
 	} else {
-		sync.downloadBlocks(downloadHeight, false)
+		sync.downloadBlocks(from, targetHeight, false, stalledPeers)
 	}
 }
 
-// downloadBlocks starts downloading blocks from the network.
-func (sync *synchronizer) downloadBlocks(from uint32, onlyFullNodes bool) {
-	sync.logger.Debug("downloading blocks", "from", from)
+// downloadBlocks splits the missing blocks, starting from the given height, into windows
+// and requests them in parallel from the best ranked peers, keeping at most `MaxSessions` open sessions.
+// The stalled peers are not asked for blocks in this round.
+func (sync *synchronizer) downloadBlocks(from, targetHeight uint32, onlyFullNodes bool,
+	stalledPeers map[peer.ID]bool,
+) {
+	// Don't download too far ahead, otherwise the downloaded blocks might be
+	// evicted from the cache before committing them.
+	maxHeight := from - 1 + uint32(sync.config.MaxSessions)*sync.config.BlockPerSession
+	maxHeight = min(maxHeight, targetHeight)
+
+	for sync.peerSet.SessionStats().Open < sync.config.MaxSessions {
+		var count uint32
+		from, count = sync.nextDownloadWindow(from, maxHeight)
+		if count == 0 {
+			return
+		}
+
+		sync.logger.Debug("downloading blocks", "from", from, "count", count)
 
-	for i := sync.peerSet.NumberOfSessions(); i < sync.config.MaxSessions; i++ {
-		count := sync.config.BlockPerSession
-		sent := sync.sendBlockRequestToRandomPeer(from, count, onlyFullNodes)
+		sent := sync.sendBlockRequest(from, count, onlyFullNodes, stalledPeers)
 		if !sent {
 			return
 		}
@@ -457,7 +642,57 @@ func (sync *synchronizer) downloadBlocks(from uint32, onlyFullNodes bool) {
 	}
 }
 
-func (sync *synchronizer) sendBlockRequestToRandomPeer(from, count uint32, onlyFullNodes bool) bool {
+// nextDownloadWindow returns the first range of blocks, starting from the given height,
+// that are neither inside the cache nor requested in an open session.
+// The returned count is zero if there are no such blocks up to the maximum height.
+func (sync *synchronizer) nextDownloadWindow(from, maxHeight uint32) (uint32, uint32) {
+	openSessions := make([]*session.Session, 0)
+	for _, ssn := range sync.peerSet.Sessions() {
+		if ssn.Status == session.Open {
+			openSessions = append(openSessions, ssn)
+		}
+	}
+
+	for from <= maxHeight {
+		if sync.cache.HasBlockInCache(from) {
+			from++
+
+			continue
+		}
+
+		covered := false
+		for _, ssn := range openSessions {
+			if from >= ssn.From && from < ssn.From+ssn.Count {
+				from = ssn.From + ssn.Count
+				covered = true
+
+				break
+			}
+		}
+
+		if !covered {
+			break
+		}
+	}
+
+	if from > maxHeight {
+		return from, 0
+	}
+
+	to := min(from+sync.config.BlockPerSession-1, maxHeight)
+	for _, ssn := range openSessions {
+		if ssn.From > from && ssn.From <= to {
+			to = ssn.From - 1
+		}
+	}
+
+	return from, to - from + 1
+}
+
+// sendBlockRequest requests the blocks from the best ranked peer that has no open session.
+func (sync *synchronizer) sendBlockRequest(from, count uint32, onlyFullNodes bool,
+	stalledPeers map[peer.ID]bool,
+) bool {
 	// Prevent downloading blocks that might be cached before
 	for sync.cache.HasBlockInCache(from) {
 		from++
@@ -471,10 +706,9 @@ func (sync *synchronizer) sendBlockRequestToRandomPeer(from, count uint32, onlyF
 		}
 	}
 
-	for i := sync.peerSet.NumberOfSessions(); i < sync.config.MaxSessions; i++ {
-		p := sync.peerSet.GetRandomPeer()
-		if p == nil {
-			break
+	for _, p := range sync.peerSet.GetDownloadPeers() {
+		if stalledPeers[p.PeerID] {
+			continue
 		}
 
 		// Don't open a new session if we already have an open session with the same peer.
@@ -493,9 +727,7 @@ func (sync *synchronizer) sendBlockRequestToRandomPeer(from, count uint32, onlyF
 		}
 
 		if onlyFullNodes && !p.IsFullNode() {
-			if onlyFullNodes {
-				sync.network.CloseConnection(p.PeerID)
-			}
+			sync.network.CloseConnection(p.PeerID)
 
 			continue
 		}
@@ -505,18 +737,23 @@ func (sync *synchronizer) sendBlockRequestToRandomPeer(from, count uint32, onlyF
 		sync.sendTo(msg, p.PeerID)
 
 		sync.logger.Info("blocks request sent",
-			"from", from+1, "count", count, "pid", p.PeerID, "sid", sid)
+			"from", from, "count", count, "pid", p.PeerID, "sid", sid)
 
 		return true
 	}
 
-	sync.logger.Warn("unable to open a new session",
+	sync.logger.Debug("unable to open a new session",
 		"stats", sync.peerSet.SessionStats())
 
 	return false
 }
 
 func (sync *synchronizer) tryCommitBlocks() {
+	if sync.isBootstrapping() {
+		// The blocks are kept in the cache until the state is restored.
+		return
+	}
+
 	onError := func(height uint32, err error) {
 		sync.logger.Warn("committing block failed, removing block from the cache",
 			"height", height, "error", err)
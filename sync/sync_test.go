@@ -3,21 +3,29 @@ package sync
 import (
 	"bytes"
 	"fmt"
+	"io"
+	"os"
 	"testing"
 	"time"
 
 	"github.com/pactus-project/pactus/consensus"
 	"github.com/pactus-project/pactus/crypto"
 	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/evidencepool"
 	"github.com/pactus-project/pactus/genesis"
 	"github.com/pactus-project/pactus/network"
+	"github.com/pactus-project/pactus/signer"
 	"github.com/pactus-project/pactus/state"
 	"github.com/pactus-project/pactus/sync/bundle"
 	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/sync/capture"
 	"github.com/pactus-project/pactus/sync/firewall"
 	"github.com/pactus-project/pactus/sync/peerset/peer"
+	"github.com/pactus-project/pactus/sync/peerset/peer/feature"
 	"github.com/pactus-project/pactus/sync/peerset/peer/service"
 	"github.com/pactus-project/pactus/sync/peerset/peer/status"
+	"github.com/pactus-project/pactus/sync/peerset/session"
+	"github.com/pactus-project/pactus/types/certificate"
 	"github.com/pactus-project/pactus/types/validator"
 	"github.com/pactus-project/pactus/util"
 	"github.com/pactus-project/pactus/util/logger"
@@ -34,6 +42,7 @@ type testData struct {
 	state       *state.MockState
 	consMgr     consensus.Manager
 	consMocks   []*consensus.MockConsensus
+	evdPool     *evidencepool.MockEvidencePool
 	network     *network.MockNetwork
 	sync        *synchronizer
 	broadcastCh chan message.Message
@@ -48,6 +57,7 @@ func testConfig() *Config {
 		BlockPerSession:     23,
 		PruneWindow:         13,
 		Firewall:            firewall.DefaultConfig(),
+		Capture:             capture.DefaultConfig(),
 		LatestSupportingVer: DefaultConfig().LatestSupportingVer,
 		Services:            service.New(service.FullNode, service.PrunedNode),
 	}
@@ -68,13 +78,16 @@ func setup(t *testing.T, config *Config) *testData {
 	consMgr, consMocks := consensus.MockingManager(ts, mockState, []*bls.ValidatorKey{valKeys[0], valKeys[1]})
 	consMgr.MoveToNewHeight()
 
+	evdPool := evidencepool.MockingEvidencePool()
 	broadcastCh := make(chan message.Message, 1000)
 	mockNetwork := network.MockingNetwork(ts, ts.RandPeerID())
 
+	signers := []signer.Signer{signer.NewLocalSigner(valKeys[0]), signer.NewLocalSigner(valKeys[1])}
 	syncInst, err := NewSynchronizer(config,
-		valKeys,
+		signers,
 		mockState,
 		consMgr,
+		evdPool,
 		mockNetwork,
 		broadcastCh,
 	)
@@ -87,6 +100,7 @@ func setup(t *testing.T, config *Config) *testData {
 		state:       mockState,
 		consMgr:     consMgr,
 		consMocks:   consMocks,
+		evdPool:     evdPool,
 		network:     mockNetwork,
 		sync:        sync,
 		broadcastCh: broadcastCh,
@@ -196,6 +210,7 @@ func (td *testData) addPeer(t *testing.T, s status.Status, services service.Serv
 
 	td.sync.peerSet.UpdateInfo(pid, t.Name(),
 		version.NodeAgent.String(), []*bls.PublicKey{pub}, services)
+	td.sync.peerSet.UpdateFeatures(pid, feature.Supported(), 0)
 	td.sync.peerSet.UpdateStatus(pid, s)
 
 	return pid
@@ -233,14 +248,12 @@ func TestStop(t *testing.T) {
 }
 
 func TestConnectEvent(t *testing.T) {
-	conf := testConfig()
-	conf.Firewall.BannedNets = []string{
-		"84.247.0.0/24",
-		"115.193.0.0/16",
-		"240e:390:8a1:ae80:7dbc:64b6:e84c:d2bf/64",
-	}
+	td := setup(t, nil)
 
-	td := setup(t, conf)
+	// The banned networks are kept by the network.
+	require.NoError(t, td.network.BanNet("84.247.0.0/24", 0, ""))
+	require.NoError(t, td.network.BanNet("115.193.0.0/16", 0, ""))
+	require.NoError(t, td.network.BanNet("240e:390:8a1:ae80:7dbc:64b6:e84c:d2bf/64", 0, ""))
 
 	pid := td.RandPeerID()
 	ce := &network.ConnectEvent{
@@ -261,6 +274,23 @@ func TestConnectEvent(t *testing.T) {
 
 	p1 := td.sync.peerSet.GetPeer(pid)
 	assert.Equal(t, status.StatusConnected, p1.Status)
+
+	t.Run("Banned peers", func(t *testing.T) {
+		pid1 := td.RandPeerID()
+		td.network.Banned[pid1] = true
+		td.sync.processConnectEvent(&network.ConnectEvent{
+			PeerID:        pid1,
+			RemoteAddress: "/ip4/2.2.2.2/tcp/21888",
+		})
+		assert.Equal(t, status.StatusBanned, td.sync.peerSet.GetPeerStatus(pid1))
+
+		pid2 := td.RandPeerID()
+		td.sync.processConnectEvent(&network.ConnectEvent{
+			PeerID:        pid2,
+			RemoteAddress: "/ip4/115.193.157.138/tcp/21888",
+		})
+		assert.Equal(t, status.StatusBanned, td.sync.peerSet.GetPeerStatus(pid2))
+	})
 }
 
 func TestDisconnectEvent(t *testing.T) {
@@ -275,6 +305,20 @@ func TestDisconnectEvent(t *testing.T) {
 
 		return s.IsDisconnected()
 	}, time.Second, 100*time.Millisecond)
+
+	t.Run("Banned peers", func(t *testing.T) {
+		pidBanned := td.RandPeerID()
+		td.network.Banned[pidBanned] = true
+		td.network.EventCh <- &network.DisconnectEvent{
+			PeerID: pidBanned,
+		}
+
+		assert.Eventually(t, func() bool {
+			s := td.sync.peerSet.GetPeerStatus(pidBanned)
+
+			return s.IsBanned()
+		}, time.Second, 100*time.Millisecond)
+	})
 }
 
 func TestProtocolsEvent(t *testing.T) {
@@ -292,13 +336,27 @@ func TestTestNetFlags(t *testing.T) {
 	td := setup(t, nil)
 
 	td.state.TestGenesis = genesis.TestnetGenesis()
-	td.addValidatorToCommittee(t, td.sync.valKeys[0].PublicKey())
+	td.addValidatorToCommittee(t, td.sync.signers[0].PublicKey())
 	bdl := td.sync.prepareBundle(message.NewQueryProposalMessage(td.RandHeight(), td.RandRound(), td.RandValAddress()))
 
 	require.False(t, util.IsFlagSet(bdl.Flags, bundle.BundleFlagNetworkMainnet), "invalid flag: %v", bdl)
 	require.True(t, util.IsFlagSet(bdl.Flags, bundle.BundleFlagNetworkTestnet), "invalid flag: %v", bdl)
 }
 
+func TestStateSyncService(t *testing.T) {
+	conf := testConfig()
+	conf.Services = service.New(service.PrunedNode, service.StateSync)
+	td := setup(t, conf)
+
+	// No snapshot is kept yet, so the state sync service should not be advertised.
+	assert.False(t, td.sync.Services().IsStateSync())
+	assert.True(t, td.sync.Services().IsPrunedNode())
+
+	td.state.CommitTestBlocks(1)
+
+	assert.True(t, td.sync.Services().IsStateSync())
+}
+
 func TestDownload(t *testing.T) {
 	conf := testConfig()
 	// Let's not allow `GetRandomPeer` to disappoint us!
@@ -392,6 +450,38 @@ func TestBundleSequenceNo(t *testing.T) {
 	assert.Equal(t, 1, bdl2.SequenceNo)
 }
 
+func TestSelectCodec(t *testing.T) {
+	td := setup(t, nil)
+
+	pidZstd := td.addPeer(t, status.StatusKnown, service.New(service.FullNode))
+	pidGzip := td.addPeer(t, status.StatusKnown, service.New(service.FullNode))
+	td.sync.peerSet.UpdateFeatures(pidGzip, feature.New(), 0)
+	pidDict := td.addPeer(t, status.StatusKnown, service.New(service.FullNode))
+	td.sync.peerSet.UpdateFeatures(pidDict, feature.Supported(), 7)
+
+	blocksData := [][]byte{bytes.Repeat([]byte{1}, 1024)}
+	msg := message.NewBlocksResponseMessage(message.ResponseCodeMoreBlocks,
+		message.ResponseCodeMoreBlocks.String(), td.RandInt(1000), 1, blocksData, nil)
+
+	td.sync.sendTo(msg, pidZstd)
+	bdl := td.shouldPublishMessageWithThisType(t, message.TypeBlocksResponse)
+	assert.True(t, util.IsFlagSet(bdl.Flags, bundle.BundleFlagCompressedZstd))
+	assert.False(t, util.IsFlagSet(bdl.Flags, bundle.BundleFlagCompressed))
+
+	td.sync.sendTo(msg, pidGzip)
+	bdl = td.shouldPublishMessageWithThisType(t, message.TypeBlocksResponse)
+	assert.True(t, util.IsFlagSet(bdl.Flags, bundle.BundleFlagCompressed))
+	assert.False(t, util.IsFlagSet(bdl.Flags, bundle.BundleFlagCompressedZstd))
+
+	// The peer has a different dictionary.
+	td.sync.sendTo(msg, pidDict)
+	bdl = td.shouldPublishMessageWithThisType(t, message.TypeBlocksResponse)
+	assert.True(t, util.IsFlagSet(bdl.Flags, bundle.BundleFlagCompressed))
+
+	saved := td.sync.peerSet.SentSavedBytes()[message.TypeBlocksResponse]
+	assert.Positive(t, saved)
+}
+
 func TestAllBlocksInCache(t *testing.T) {
 	td := setup(t, nil)
 
@@ -403,6 +493,194 @@ func TestAllBlocksInCache(t *testing.T) {
 	td.sync.cache.AddBlock(blk101)
 	td.sync.cache.AddBlock(blk102)
 
-	res := td.sync.sendBlockRequestToRandomPeer(100, 3, true)
+	res := td.sync.sendBlockRequest(100, 3, true, nil)
 	assert.True(t, res)
 }
+
+func TestParallelDownload(t *testing.T) {
+	td := setup(t, nil)
+
+	pid1 := td.addPeer(t, status.StatusKnown, service.New(service.FullNode))
+	pid2 := td.addPeer(t, status.StatusKnown, service.New(service.FullNode))
+	pid3 := td.addPeer(t, status.StatusKnown, service.New(service.FullNode))
+
+	// Block 24 is inside the cache
+	blk, _ := td.GenerateTestBlock(24)
+	td.sync.cache.AddBlock(blk)
+
+	td.sync.updateBlockchain()
+
+	sessions := td.sync.peerSet.Sessions()
+	require.Len(t, sessions, 3)
+	windows := make(map[uint32]uint32)
+	peers := make(map[peer.ID]bool)
+	for _, ssn := range sessions {
+		windows[ssn.From] = ssn.Count
+		peers[ssn.PeerID] = true
+	}
+	assert.Equal(t, map[uint32]uint32{1: 23, 25: 23, 48: 23}, windows)
+	assert.Equal(t, map[peer.ID]bool{pid1: true, pid2: true, pid3: true}, peers)
+
+	t.Run("Stalled window is requested from another peer", func(t *testing.T) {
+		pid4 := td.addPeer(t, status.StatusKnown, service.New(service.FullNode))
+
+		var stalled *session.Session
+		for _, ssn := range td.sync.peerSet.Sessions() {
+			if ssn.From == 25 {
+				stalled = ssn
+			}
+		}
+		td.sync.peerSet.SetSessionUncompleted(stalled.SessionID)
+
+		td.sync.updateBlockchain()
+
+		sessions := td.sync.peerSet.Sessions()
+		require.Len(t, sessions, 3)
+		for _, ssn := range sessions {
+			assert.NotEqual(t, stalled.SessionID, ssn.SessionID)
+			if ssn.From == 25 {
+				assert.Equal(t, pid4, ssn.PeerID)
+			}
+		}
+		assert.Equal(t, 1, td.sync.peerSet.GetPeer(stalled.PeerID).TotalSessions)
+		assert.Zero(t, td.sync.peerSet.GetPeer(stalled.PeerID).CompletedSessions)
+	})
+}
+
+func TestNextDownloadWindow(t *testing.T) {
+	td := setup(t, nil)
+
+	blk, _ := td.GenerateTestBlock(5)
+	td.sync.cache.AddBlock(blk)
+	td.sync.peerSet.OpenSession(td.RandPeerID(), 1, 4)
+	td.sync.peerSet.OpenSession(td.RandPeerID(), 20, 10)
+
+	from, count := td.sync.nextDownloadWindow(1, 100)
+	assert.Equal(t, uint32(6), from)
+	assert.Equal(t, uint32(14), count) // 6-19
+
+	from, count = td.sync.nextDownloadWindow(20, 100)
+	assert.Equal(t, uint32(30), from)
+	assert.Equal(t, uint32(23), count) // 30-52
+
+	from, count = td.sync.nextDownloadWindow(20, 40)
+	assert.Equal(t, uint32(30), from)
+	assert.Equal(t, uint32(11), count) // 30-40
+
+	_, count = td.sync.nextDownloadWindow(20, 29)
+	assert.Zero(t, count)
+}
+
+func TestCertificateVerification(t *testing.T) {
+	conf := testConfig()
+	conf.CertificateVerifiers = 0
+	td := setup(t, conf)
+
+	height := td.sync.stateHeight() + 2
+	blk1, _ := td.GenerateTestBlock(height)
+	blk2, _ := td.GenerateTestBlock(height + 1)
+
+	// The certificate of the first block is received within the second block.
+	td.sync.cache.AddBlock(blk1)
+	td.sync.queueCertificateVerification(height)
+	assert.Empty(t, td.sync.verifyCh)
+
+	td.sync.cache.AddBlock(blk2)
+	td.sync.queueCertificateVerification(height)
+	require.Len(t, td.sync.verifyCh, 1)
+
+	vc := <-td.sync.verifyCh
+	assert.Equal(t, blk1.Hash(), vc.blockHash)
+	assert.Equal(t, blk2.PrevCertificate(), vc.cert)
+
+	// Committed blocks are not queued.
+	td.sync.queueCertificateVerification(td.sync.stateHeight())
+	assert.Empty(t, td.sync.verifyCh)
+
+	t.Run("Verifying the certificate signed by the known validators", func(t *testing.T) {
+		valKeys := td.state.TestValKeys[:4]
+		validators := make([]*validator.Validator, 0, len(valKeys))
+		for i, key := range valKeys {
+			val := validator.NewValidator(key.PublicKey(), int32(i))
+			td.state.TestStore.UpdateValidator(val)
+			validators = append(validators, val)
+		}
+
+		blockHash := td.RandHash()
+		cert := certificate.NewBlockCertificate(height, 0)
+		signBytes := cert.SignBytes(blockHash)
+		sig := bls.SignatureAggregate(valKeys[0].Sign(signBytes), valKeys[1].Sign(signBytes),
+			valKeys[2].Sign(signBytes))
+		cert.SetSignature([]int32{0, 1, 2, 3}, []int32{3}, sig)
+
+		td.sync.verifyCertificate(cert, blockHash)
+		assert.NoError(t, cert.Validate(validators, blockHash))
+	})
+}
+
+func TestCaptureBundles(t *testing.T) {
+	conf := testConfig()
+	conf.Capture.Enable = true
+	conf.Capture.Path = util.TempFilePath()
+	td := setup(t, conf)
+
+	pid := td.addPeer(t, status.StatusKnown, service.New(service.FullNode))
+
+	td.sync.sendTo(message.NewBlocksRequestMessage(td.RandInt(1000), 1, 10), pid)
+	sent := td.shouldPublishMessageWithThisType(t, message.TypeBlocksRequest)
+
+	td.sync.broadcast(message.NewQueryProposalMessage(td.RandHeight(), td.RandRound(), td.RandValAddress()))
+	broadcasted := td.shouldPublishMessageWithThisType(t, message.TypeQueryProposal)
+
+	// The malformed bundles are captured as well.
+	malformed := []byte{0x01, 0x02, 0x03}
+	td.sync.processGossipMessage(&network.GossipMessage{
+		From:    pid,
+		Data:    malformed,
+		TopicID: network.TopicIDConsensus,
+	})
+	td.sync.processStreamMessage(&network.StreamMessage{
+		From:   pid,
+		Reader: io.NopCloser(bytes.NewReader(malformed)),
+	})
+
+	td.sync.Stop()
+
+	f, err := os.Open(conf.Capture.Path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	r := capture.NewReader(f)
+	expected := []struct {
+		direction capture.Direction
+		peerID    peer.ID
+		topicID   network.TopicID
+		msgType   message.Type
+	}{
+		{capture.DirectionOutbound, pid, network.TopicIDUnspecified, sent.Message.Type()},
+		{capture.DirectionOutbound, "", network.TopicIDConsensus, broadcasted.Message.Type()},
+		{capture.DirectionInbound, pid, network.TopicIDConsensus, 0},
+		{capture.DirectionInbound, pid, network.TopicIDUnspecified, 0},
+	}
+	for _, e := range expected {
+		rec, err := r.Next()
+		require.NoError(t, err)
+
+		assert.Equal(t, e.direction, rec.Direction)
+		assert.Equal(t, e.peerID, rec.PeerID)
+		assert.Equal(t, e.topicID, rec.TopicID)
+
+		bdl := new(bundle.Bundle)
+		_, err = bdl.Decode(bytes.NewReader(rec.Data))
+		if e.msgType == 0 {
+			assert.Error(t, err)
+			assert.Equal(t, malformed, rec.Data)
+		} else {
+			assert.NoError(t, err)
+			assert.Equal(t, e.msgType, bdl.Message.Type())
+		}
+	}
+
+	_, err = r.Next()
+	assert.ErrorIs(t, err, io.EOF)
+}
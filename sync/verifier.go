@@ -0,0 +1,82 @@
+package sync
+
+import (
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/types/certificate"
+	"github.com/pactus-project/pactus/util"
+)
+
+// The downloaded blocks are committed one by one, and verifying the certificate signatures
+// is the most expensive part of committing them. The certificate verifiers verify the
+// signatures of the downloaded certificates in parallel, ahead of committing the blocks.
+// A verified certificate remembers its signature, so the state doesn't verify it again.
+
+type verifyingCertificate struct {
+	cert      *certificate.BlockCertificate
+	blockHash hash.Hash
+}
+
+func (sync *synchronizer) certificateVerifier() {
+	for {
+		select {
+		case <-sync.ctx.Done():
+			return
+
+		case vc := <-sync.verifyCh:
+			sync.verifyCertificate(vc.cert, vc.blockHash)
+		}
+	}
+}
+
+// queueCertificateVerification queues the certificate of the cached block at the given height,
+// if the block is not committed yet and both the block and its certificate are inside the cache.
+func (sync *synchronizer) queueCertificateVerification(height uint32) {
+	if height <= sync.stateHeight() {
+		return
+	}
+
+	blk := sync.cache.GetBlock(height)
+	if blk == nil {
+		return
+	}
+	cert := sync.cache.GetCertificate(height)
+	if cert == nil {
+		return
+	}
+
+	select {
+	case sync.verifyCh <- verifyingCertificate{cert: cert, blockHash: blk.Hash()}:
+	default:
+		// The verifiers are busy.
+		// The certificate will be verified by the state when committing the block.
+	}
+}
+
+// verifyCertificate verifies the certificate signature against the public keys of the signers.
+// The committee might change before committing the block, so the committers are not checked here,
+// and a failed verification is left for the state to decide on.
+func (sync *synchronizer) verifyCertificate(cert *certificate.BlockCertificate, blockHash hash.Hash) {
+	if cert.Height() <= sync.stateHeight() {
+		return
+	}
+
+	pubs := make([]*bls.PublicKey, 0, len(cert.Committers()))
+	for _, num := range cert.Committers() {
+		if util.Contains(cert.Absentees(), num) {
+			continue
+		}
+
+		val := sync.state.ValidatorByNumber(num)
+		if val == nil {
+			// The validator is not known yet.
+			return
+		}
+		pubs = append(pubs, val.PublicKey())
+	}
+
+	if err := cert.VerifySignature(pubs, blockHash); err != nil {
+		sync.logger.Debug("unable to verify certificate ahead of commit",
+			"height", cert.Height(), "error", err)
+	}
+}
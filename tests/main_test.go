@@ -12,7 +12,9 @@ import (
 	"github.com/pactus-project/pactus/crypto/bls"
 	"github.com/pactus-project/pactus/crypto/hash"
 	"github.com/pactus-project/pactus/genesis"
+	"github.com/pactus-project/pactus/network"
 	"github.com/pactus-project/pactus/node"
+	"github.com/pactus-project/pactus/signer"
 	"github.com/pactus-project/pactus/store"
 	"github.com/pactus-project/pactus/types/account"
 	"github.com/pactus-project/pactus/types/amount"
@@ -35,6 +37,7 @@ var (
 	tTransaction pactus.TransactionClient
 	tNetwork     pactus.NetworkClient
 	tCtx         context.Context
+	tSwitchboard = network.NewSwitchboard()
 )
 
 const (
@@ -82,16 +85,14 @@ func TestMain(m *testing.M) {
 		tConfigs[i].Logger.Levels["_pool"] = "info"
 		tConfigs[i].Sync.Firewall.BannedNets = make([]string, 0)
 		tConfigs[i].Sync.BlockPerSession = 10
-		tConfigs[i].Network.EnableMdns = true
 		tConfigs[i].Network.EnableRelay = false
 		tConfigs[i].Network.DefaultBootstrapAddrStrings = []string{}
 		tConfigs[i].Network.BootstrapAddrStrings = []string{}
-		tConfigs[i].Network.ForcePrivateNetwork = true
 		tConfigs[i].Network.NetworkKey = util.TempFilePath()
 		tConfigs[i].Network.NetworkName = "test"
-		tConfigs[i].Network.ListenAddrStrings = []string{"/ip4/127.0.0.1/tcp/0", "/ip4/127.0.0.1/udp/0/quic-v1"}
 		tConfigs[i].Network.MaxConns = 32
 		tConfigs[i].Network.PeerStorePath = util.TempFilePath()
+		tConfigs[i].Network.ReputationStorePath = util.TempFilePath()
 		tConfigs[i].HTTP.Enable = false
 		tConfigs[i].GRPC.Enable = false
 
@@ -128,31 +129,23 @@ func TestMain(m *testing.M) {
 	tGenDoc = genesis.MakeGenesis(time.Now(), accs, vals, genParams)
 
 	for i := 0; i < tTotalNodes; i++ {
-		tNodes[i], _ = node.NewNode(
+		signers := make([]signer.Signer, len(tValKeys[i]))
+		for j, key := range tValKeys[i] {
+			signers[j] = signer.NewLocalSigner(key)
+		}
+		tNodes[i], _ = node.NewMemoryNode(
 			tGenDoc, tConfigs[i],
-			tValKeys[i],
+			signers,
 			[]crypto.Address{
 				tValKeys[i][0].PublicKey().AccountAddress(),
 				tValKeys[i][1].PublicKey().AccountAddress(),
 				tValKeys[i][2].PublicKey().AccountAddress(),
-			})
+			}, tSwitchboard)
 
 		if err := tNodes[i].Start(); err != nil {
 			panic(fmt.Sprintf("Error on starting the node: %v", err))
 		}
 
-		if i == 0 {
-			// Set bootstrap address for better connectivity
-			bootstrapAddr := fmt.Sprintf("%v/p2p/%v",
-				tNodes[i].Network().HostAddrs()[0], tNodes[i].Network().SelfID())
-			fmt.Println("Bootstrap address is: " + bootstrapAddr)
-
-			tConfigs[tNodeIdx2].Network.BootstrapAddrStrings = []string{bootstrapAddr}
-			tConfigs[tNodeIdx3].Network.BootstrapAddrStrings = []string{bootstrapAddr}
-			tConfigs[tNodeIdx4].Network.BootstrapAddrStrings = []string{bootstrapAddr}
-		}
-
-		time.Sleep(1 * time.Second)
 	}
 
 	tCtx = context.Background()
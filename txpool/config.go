@@ -49,6 +49,18 @@ func (conf *Config) withdrawPoolSize() int {
 	return int(float32(conf.MaxSize) * 0.1)
 }
 
+func (conf *Config) metadataPoolSize() int {
+	return int(float32(conf.MaxSize) * 0.05)
+}
+
+func (conf *Config) keyRotationPoolSize() int {
+	return int(float32(conf.MaxSize) * 0.05)
+}
+
+func (conf *Config) anchorPoolSize() int {
+	return int(float32(conf.MaxSize) * 0.05)
+}
+
 func (conf *Config) transferPoolSize() int {
-	return int(float32(conf.MaxSize) * 0.6)
+	return int(float32(conf.MaxSize) * 0.45)
 }
@@ -11,11 +11,14 @@ func TestDefaultConfig(t *testing.T) {
 	c := DefaultConfig()
 	assert.NoError(t, c.BasicCheck())
 
-	assert.Equal(t, 600, c.transferPoolSize())
+	assert.Equal(t, 450, c.transferPoolSize())
 	assert.Equal(t, 100, c.bondPoolSize())
 	assert.Equal(t, 100, c.unbondPoolSize())
 	assert.Equal(t, 100, c.withdrawPoolSize())
 	assert.Equal(t, 100, c.sortitionPoolSize())
+	assert.Equal(t, 50, c.metadataPoolSize())
+	assert.Equal(t, 50, c.keyRotationPoolSize())
+	assert.Equal(t, 50, c.anchorPoolSize())
 	assert.Equal(t, amount.Amount(0.1e8), c.minFee())
 
 	assert.Equal(t,
@@ -23,7 +26,10 @@ func TestDefaultConfig(t *testing.T) {
 			c.bondPoolSize()+
 			c.unbondPoolSize()+
 			c.withdrawPoolSize()+
-			c.sortitionPoolSize(), c.MaxSize)
+			c.sortitionPoolSize()+
+			c.metadataPoolSize()+
+			c.keyRotationPoolSize()+
+			c.anchorPoolSize(), c.MaxSize)
 }
 
 func TestConfigBasicCheck(t *testing.T) {
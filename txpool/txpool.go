@@ -33,6 +33,9 @@ func NewTxPool(conf *Config, broadcastCh chan message.Message) TxPool {
 	pools[payload.TypeUnbond] = newPool(conf.unbondPoolSize(), 0)
 	pools[payload.TypeWithdraw] = newPool(conf.withdrawPoolSize(), conf.minFee())
 	pools[payload.TypeSortition] = newPool(conf.sortitionPoolSize(), 0)
+	pools[payload.TypeMetadata] = newPool(conf.metadataPoolSize(), conf.minFee())
+	pools[payload.TypeKeyRotation] = newPool(conf.keyRotationPoolSize(), conf.minFee())
+	pools[payload.TypeAnchor] = newPool(conf.anchorPoolSize(), conf.minFee())
 
 	pool := &txPool{
 		config:      conf,
@@ -102,11 +105,18 @@ func (p *txPool) appendTx(trx *tx.Tx) error {
 	}
 
 	if !trx.IsFreeTx() {
-		if trx.Fee() < payloadPool.estimatedFee() {
-			p.logger.Warn("low fee transaction", "tx", trx, "minFee", payloadPool.estimatedFee())
+		minFee := payloadPool.estimatedFee()
+		if trx.IsAnchorTx() {
+			// The fee of anchor transactions is scaled to the number of anchored hashes.
+			numHashes := len(trx.Payload().(*payload.AnchorPayload).Hashes)
+			minFee *= amount.Amount(numHashes)
+		}
+
+		if trx.Fee() < minFee {
+			p.logger.Warn("low fee transaction", "tx", trx, "minFee", minFee)
 
 			return AppendError{
-				Err: fmt.Errorf("low fee transaction, expected to be more than %s", payloadPool.estimatedFee()),
+				Err: fmt.Errorf("low fee transaction, expected to be more than %s", minFee),
 			}
 		}
 	}
@@ -190,6 +200,24 @@ func (p *txPool) PrepareBlockTransactions() block.Txs {
 		trxs = append(trxs, n.Data.Value)
 	}
 
+	// Appending metadata transactions
+	poolMetadata := p.pools[payload.TypeMetadata]
+	for n := poolMetadata.list.HeadNode(); n != nil; n = n.Next {
+		trxs = append(trxs, n.Data.Value)
+	}
+
+	// Appending key rotation transactions
+	poolKeyRotation := p.pools[payload.TypeKeyRotation]
+	for n := poolKeyRotation.list.HeadNode(); n != nil; n = n.Next {
+		trxs = append(trxs, n.Data.Value)
+	}
+
+	// Appending anchor transactions
+	poolAnchor := p.pools[payload.TypeAnchor]
+	for n := poolAnchor.list.HeadNode(); n != nil; n = n.Next {
+		trxs = append(trxs, n.Data.Value)
+	}
+
 	// Appending transfer transactions
 	poolTransfer := p.pools[payload.TypeTransfer]
 	for n := poolTransfer.list.HeadNode(); n != nil; n = n.Next {
@@ -224,6 +252,8 @@ func (p *txPool) Size() int {
 	return size
 }
 
+// EstimatedFee returns the estimated fee for the given payload type.
+// For anchor transactions, the returned fee is per anchored hash.
 func (p *txPool) EstimatedFee(_ amount.Amount, payloadType payload.Type) amount.Amount {
 	p.lk.RLock()
 	defer p.lk.RUnlock()
@@ -256,11 +286,14 @@ func (p *txPool) AllPendingTxs() []*tx.Tx {
 }
 
 func (p *txPool) String() string {
-	return fmt.Sprintf("{💸 %v 🔐 %v 🔓 %v 🎯 %v 🧾 %v}",
+	return fmt.Sprintf("{💸 %v 🔐 %v 🔓 %v 🎯 %v 🧾 %v 🏷️ %v 🔑 %v ⚓ %v}",
 		p.pools[payload.TypeTransfer].list.Size(),
 		p.pools[payload.TypeBond].list.Size(),
 		p.pools[payload.TypeUnbond].list.Size(),
 		p.pools[payload.TypeSortition].list.Size(),
 		p.pools[payload.TypeWithdraw].list.Size(),
+		p.pools[payload.TypeMetadata].list.Size(),
+		p.pools[payload.TypeKeyRotation].list.Size(),
+		p.pools[payload.TypeAnchor].list.Size(),
 	)
 }
@@ -5,11 +5,14 @@ import (
 	"testing"
 	"time"
 
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/hash"
 	"github.com/pactus-project/pactus/execution"
 	"github.com/pactus-project/pactus/sandbox"
 	"github.com/pactus-project/pactus/sync/bundle/message"
 	"github.com/pactus-project/pactus/types/account"
 	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/tx/payload"
 	"github.com/pactus-project/pactus/types/validator"
 	"github.com/pactus-project/pactus/util/logger"
 	"github.com/pactus-project/pactus/util/testsuite"
@@ -165,6 +168,16 @@ func TestPrepareBlockTransactions(t *testing.T) {
 	val3.AddToStake(1000e9)
 	td.sandbox.UpdateValidator(val3)
 
+	val4PubKey, _ := td.RandBLSKeyPair()
+	val4 := validator.NewValidator(val4PubKey, 0)
+	val4.AddToStake(1000e9)
+	td.sandbox.UpdateValidator(val4)
+
+	val5PubKey, _ := td.RandBLSKeyPair()
+	val5 := validator.NewValidator(val5PubKey, 0)
+	val5.AddToStake(1000e9)
+	td.sandbox.UpdateValidator(val5)
+
 	transferTx := tx.NewTransferTx(randHeight+1, acc1Addr, td.RandAccAddress(), 1e9, 100_000_000)
 
 	pub, _ := td.RandBLSKeyPair()
@@ -174,6 +187,14 @@ func TestPrepareBlockTransactions(t *testing.T) {
 
 	withdrawTx := tx.NewWithdrawTx(randHeight+4, val2.Address(), td.RandAccAddress(), 1e9, 100_000_000)
 
+	metadataTx := tx.NewMetadataTx(randHeight+5, val4.Address(), "moniker", "", "", crypto.TreasuryAddress, 100_000_000)
+
+	newPub, newPrv := td.RandBLSKeyPair()
+	proof := newPrv.SignNative(payload.KeyRotationProofBytes(val5.Address(), newPub))
+	keyRotationTx := tx.NewKeyRotationTx(randHeight+6, val5.Address(), newPub, proof, 100_000_000)
+
+	anchorTx := tx.NewAnchorTx(randHeight+7, acc1Addr, "ns", []hash.Hash{td.RandHash()}, 100_000_000)
+
 	td.sandbox.TestAcceptSortition = true
 	sortitionTx := tx.NewSortitionTx(randHeight, val3.Address(),
 		td.RandProof())
@@ -183,14 +204,41 @@ func TestPrepareBlockTransactions(t *testing.T) {
 	assert.NoError(t, td.pool.AppendTx(withdrawTx))
 	assert.NoError(t, td.pool.AppendTx(bondTx))
 	assert.NoError(t, td.pool.AppendTx(sortitionTx))
+	assert.NoError(t, td.pool.AppendTx(metadataTx))
+	assert.NoError(t, td.pool.AppendTx(keyRotationTx))
+	assert.NoError(t, td.pool.AppendTx(anchorTx))
 
 	trxs := td.pool.PrepareBlockTransactions()
-	assert.Len(t, trxs, 5)
+	assert.Len(t, trxs, 8)
 	assert.Equal(t, sortitionTx.ID(), trxs[0].ID())
 	assert.Equal(t, bondTx.ID(), trxs[1].ID())
 	assert.Equal(t, unbondTx.ID(), trxs[2].ID())
 	assert.Equal(t, withdrawTx.ID(), trxs[3].ID())
-	assert.Equal(t, transferTx.ID(), trxs[4].ID())
+	assert.Equal(t, metadataTx.ID(), trxs[4].ID())
+	assert.Equal(t, keyRotationTx.ID(), trxs[5].ID())
+	assert.Equal(t, anchorTx.ID(), trxs[6].ID())
+	assert.Equal(t, transferTx.ID(), trxs[7].ID())
+}
+
+func TestAnchorFee(t *testing.T) {
+	td := setup(t)
+
+	randHeight := td.RandHeight()
+	_ = td.sandbox.TestStore.AddTestBlock(randHeight)
+
+	senderAddr := td.RandAccAddress()
+	senderAcc := account.NewAccount(0)
+	senderAcc.AddToBalance(1000e9)
+	td.sandbox.UpdateAccount(senderAddr, senderAcc)
+
+	hashes := []hash.Hash{td.RandHash(), td.RandHash(), td.RandHash()}
+	minFee := td.pool.EstimatedFee(0, payload.TypeAnchor)
+
+	lowFeeTx := tx.NewAnchorTx(randHeight+1, senderAddr, "ns", hashes, minFee*2)
+	assert.ErrorContains(t, td.pool.AppendTx(lowFeeTx), "low fee transaction")
+
+	anchorTx := tx.NewAnchorTx(randHeight+1, senderAddr, "ns", hashes, minFee*3)
+	assert.NoError(t, td.pool.AppendTx(anchorTx))
 }
 
 func TestAppendAndBroadcast(t *testing.T) {
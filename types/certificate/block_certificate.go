@@ -37,6 +37,14 @@ func (cert *BlockCertificate) Validate(validators []*validator.Validator, blockH
 	return cert.baseCertificate.validate(validators, signBytes, require2Fp1Power)
 }
 
+// VerifySignature verifies the signature of the certificate against the public keys of the signers,
+// without checking the committers and their voting power.
+// The verified signature is remembered, so validating the certificate later
+// doesn't need to verify the same signature again.
+func (cert *BlockCertificate) VerifySignature(pubs []*bls.PublicKey, blockHash hash.Hash) error {
+	return cert.verifySignature(pubs, cert.SignBytes(blockHash))
+}
+
 func (cert *BlockCertificate) Clone() *BlockCertificate {
 	cloned := &BlockCertificate{
 		baseCertificate: baseCertificate{
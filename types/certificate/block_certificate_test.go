@@ -139,3 +139,58 @@ func TestBlockCertificateValidation(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func TestBlockCertificateVerifySignature(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	blockHash := ts.RandHash()
+	cert := certificate.NewBlockCertificate(ts.RandHeight(), ts.RandRound())
+	signBytes := cert.SignBytes(blockHash)
+	committers := []int32{0, 1, 2, 3}
+	pubs := []*bls.PublicKey{}
+	sigs := []*bls.Signature{}
+	validators := []*validator.Validator{}
+
+	for _, committer := range committers {
+		valKey := ts.RandValKey()
+		validators = append(validators, validator.NewValidator(valKey.PublicKey(), committer))
+		pubs = append(pubs, valKey.PublicKey())
+		sigs = append(sigs, valKey.Sign(signBytes))
+	}
+	cert.SetSignature(committers, []int32{3}, bls.SignatureAggregate(sigs[:3]...))
+
+	t.Run("Invalid signers", func(t *testing.T) {
+		err := cert.VerifySignature(pubs, blockHash)
+		assert.ErrorIs(t, err, crypto.ErrInvalidSignature)
+	})
+
+	t.Run("Invalid block hash", func(t *testing.T) {
+		err := cert.VerifySignature(pubs[:3], ts.RandHash())
+		assert.ErrorIs(t, err, crypto.ErrInvalidSignature)
+	})
+
+	t.Run("Ok, should return no error", func(t *testing.T) {
+		err := cert.VerifySignature(pubs[:3], blockHash)
+		assert.NoError(t, err)
+
+		assert.NoError(t, cert.Validate(validators, blockHash))
+	})
+
+	t.Run("Verified signature doesn't bypass validation", func(t *testing.T) {
+		invValidators := slices.Clone(validators)
+		invValidators[1] = validator.NewValidator(ts.RandValKey().PublicKey(), 1)
+
+		err := cert.Validate(invValidators, blockHash)
+		assert.ErrorIs(t, err, crypto.ErrInvalidSignature)
+
+		err = cert.Validate(validators, ts.RandHash())
+		assert.ErrorIs(t, err, crypto.ErrInvalidSignature)
+	})
+
+	t.Run("Changing the signature resets the verification", func(t *testing.T) {
+		cert.SetSignature(committers, []int32{3}, bls.SignatureAggregate(sigs[1:]...))
+
+		err := cert.Validate(validators, blockHash)
+		assert.ErrorIs(t, err, crypto.ErrInvalidSignature)
+	})
+}
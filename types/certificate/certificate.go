@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"sync/atomic"
 
 	"github.com/fxamacker/cbor/v2"
 	"github.com/pactus-project/pactus/crypto/bls"
@@ -22,6 +23,14 @@ type baseCertificate struct {
 	committers []int32
 	absentees  []int32
 	signature  *bls.Signature
+	// verified keeps the last verified signature, so it doesn't need to be verified again.
+	verified atomic.Pointer[verifiedSignature]
+}
+
+// verifiedSignature keeps the aggregated public key and the sign bytes of a verified signature.
+type verifiedSignature struct {
+	aggPub    *bls.PublicKey
+	signBytes []byte
 }
 
 func (cert *baseCertificate) Height() uint32 {
@@ -93,6 +102,7 @@ func (cert *baseCertificate) SetSignature(committers, absentees []int32, signatu
 	cert.committers = committers
 	cert.absentees = absentees
 	cert.signature = signature
+	cert.verified.Store(nil)
 }
 
 // SerializeSize returns the number of bytes it would take to serialize the block.
@@ -197,6 +207,7 @@ func (cert *baseCertificate) Decode(r io.Reader) error {
 	cert.committers = committers
 	cert.absentees = absentees
 	cert.signature = sig
+	cert.verified.Store(nil)
 
 	return nil
 }
@@ -249,10 +260,37 @@ func (cert *baseCertificate) validate(validators []*validator.Validator,
 	}
 
 	aggPub := bls.PublicKeyAggregate(pubs...)
+	if cert.isVerified(aggPub, signBytes) {
+		return nil
+	}
 
 	return aggPub.Verify(signBytes, cert.signature)
 }
 
+// verifySignature verifies the signature against the public keys of the signers
+// and remembers it on success.
+func (cert *baseCertificate) verifySignature(pubs []*bls.PublicKey, signBytes []byte) error {
+	aggPub := bls.PublicKeyAggregate(pubs...)
+	if err := aggPub.Verify(signBytes, cert.signature); err != nil {
+		return err
+	}
+
+	cert.verified.Store(&verifiedSignature{
+		aggPub:    aggPub,
+		signBytes: signBytes,
+	})
+
+	return nil
+}
+
+func (cert *baseCertificate) isVerified(aggPub *bls.PublicKey, signBytes []byte) bool {
+	verified := cert.verified.Load()
+
+	return verified != nil &&
+		verified.aggPub.EqualsTo(aggPub) &&
+		bytes.Equal(verified.signBytes, signBytes)
+}
+
 // AddSignature adds a new signature to the certificate.
 // It does not check the validity of the signature.
 // The caller should ensure that the signature is valid.
@@ -261,5 +299,6 @@ func (cert *baseCertificate) AddSignature(valNum int32, sig *bls.Signature) {
 	if removed {
 		cert.signature = bls.SignatureAggregate(cert.signature, sig)
 		cert.absentees = absentees
+		cert.verified.Store(nil)
 	}
 }
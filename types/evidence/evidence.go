@@ -0,0 +1,178 @@
+package evidence
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/types/vote"
+)
+
+// Evidence is a proof of misbehavior of a validator.
+// It contains two conflicting votes, signed by the same validator
+// for the same height, round and vote type.
+type Evidence struct {
+	data evidenceData
+}
+
+type evidenceData struct {
+	VoteA *vote.Vote `cbor:"1,keyasint"`
+	VoteB *vote.Vote `cbor:"2,keyasint"`
+}
+
+// NewDuplicateVoteEvidence creates a new evidence from two conflicting votes.
+// The votes are sorted by their hashes, so the evidence is the same
+// regardless of the order in which the votes were received.
+func NewDuplicateVoteEvidence(vote1, vote2 *vote.Vote) *Evidence {
+	hash1 := vote1.Hash()
+	hash2 := vote2.Hash()
+	if bytes.Compare(hash1.Bytes(), hash2.Bytes()) > 0 {
+		vote1, vote2 = vote2, vote1
+	}
+
+	return &Evidence{
+		data: evidenceData{
+			VoteA: vote1,
+			VoteB: vote2,
+		},
+	}
+}
+
+// VoteA returns the first conflicting vote.
+func (e *Evidence) VoteA() *vote.Vote {
+	return e.data.VoteA
+}
+
+// VoteB returns the second conflicting vote.
+func (e *Evidence) VoteB() *vote.Vote {
+	return e.data.VoteB
+}
+
+// Offender returns the address of the validator that signed the conflicting votes.
+func (e *Evidence) Offender() crypto.Address {
+	return e.data.VoteA.Signer()
+}
+
+// Height returns the height at which the misbehavior occurred.
+func (e *Evidence) Height() uint32 {
+	return e.data.VoteA.Height()
+}
+
+// Round returns the round at which the misbehavior occurred.
+func (e *Evidence) Round() int16 {
+	return e.data.VoteA.Round()
+}
+
+// Type returns the type of the conflicting votes.
+func (e *Evidence) Type() vote.Type {
+	return e.data.VoteA.Type()
+}
+
+// BasicCheck performs a basic check on the evidence.
+// It ensures that both votes are well-formed and conflicting.
+func (e *Evidence) BasicCheck() error {
+	if e.data.VoteA == nil || e.data.VoteB == nil {
+		return BasicCheckError{
+			Reason: "missing vote",
+		}
+	}
+	if err := e.data.VoteA.BasicCheck(); err != nil {
+		return err
+	}
+	if err := e.data.VoteB.BasicCheck(); err != nil {
+		return err
+	}
+
+	voteA := e.data.VoteA
+	voteB := e.data.VoteB
+	if voteA.Signer() != voteB.Signer() {
+		return BasicCheckError{
+			Reason: "votes are signed by different validators",
+		}
+	}
+	if voteA.Height() != voteB.Height() ||
+		voteA.Round() != voteB.Round() {
+		return BasicCheckError{
+			Reason: "votes are for different height or round",
+		}
+	}
+	if voteA.Type() != voteB.Type() {
+		return BasicCheckError{
+			Reason: "votes have different types",
+		}
+	}
+	if voteA.IsCPVote() && voteA.CPRound() != voteB.CPRound() {
+		return BasicCheckError{
+			Reason: "votes are for different change-proposer rounds",
+		}
+	}
+	// The justification of change-proposer votes is not signed,
+	// so two votes that only differ in justification are not conflicting.
+	if bytes.Equal(voteA.SignBytes(), voteB.SignBytes()) {
+		return BasicCheckError{
+			Reason: "votes are not conflicting",
+		}
+	}
+	hashA := voteA.Hash()
+	hashB := voteB.Hash()
+	if bytes.Compare(hashA.Bytes(), hashB.Bytes()) > 0 {
+		return BasicCheckError{
+			Reason: "votes are not sorted",
+		}
+	}
+
+	return nil
+}
+
+// Verify checks the signatures of both votes with the offender's public key.
+func (e *Evidence) Verify(pubKey *bls.PublicKey) error {
+	if err := e.data.VoteA.Verify(pubKey); err != nil {
+		return err
+	}
+
+	return e.data.VoteB.Verify(pubKey)
+}
+
+// Hash calculates the hash of the evidence.
+func (e *Evidence) Hash() hash.Hash {
+	bz, _ := cbor.Marshal(e.data)
+
+	return hash.CalcHash(bz)
+}
+
+// MarshalCBOR encodes the evidence into CBOR format.
+func (e *Evidence) MarshalCBOR() ([]byte, error) {
+	return cbor.Marshal(e.data)
+}
+
+// UnmarshalCBOR decodes the evidence from CBOR format.
+func (e *Evidence) UnmarshalCBOR(bs []byte) error {
+	return cbor.Unmarshal(bs, &e.data)
+}
+
+// Bytes returns the CBOR-encoded representation of the evidence.
+func (e *Evidence) Bytes() ([]byte, error) {
+	return e.MarshalCBOR()
+}
+
+// FromBytes decodes the evidence from CBOR-encoded bytes.
+func FromBytes(data []byte) (*Evidence, error) {
+	e := new(Evidence)
+	if err := e.UnmarshalCBOR(data); err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+func (e *Evidence) String() string {
+	return fmt.Sprintf("{⚠️ %s %d/%d/%s}",
+		e.Offender().ShortString(),
+		e.Height(),
+		e.Round(),
+		e.Type(),
+	)
+}
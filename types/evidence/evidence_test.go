@@ -0,0 +1,115 @@
+package evidence_test
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/types/evidence"
+	"github.com/pactus-project/pactus/types/vote"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvidenceEncoding(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	evd, valKey := ts.GenerateTestEvidence(ts.RandHeight(), ts.RandRound())
+	bs, err := evd.Bytes()
+	require.NoError(t, err)
+
+	evd2, err := evidence.FromBytes(bs)
+	require.NoError(t, err)
+	assert.Equal(t, evd.Hash(), evd2.Hash())
+	assert.NoError(t, evd2.BasicCheck())
+	assert.NoError(t, evd2.Verify(valKey.PublicKey()))
+
+	_, err = evidence.FromBytes([]byte{0x01})
+	assert.Error(t, err)
+}
+
+func TestEvidenceOrdering(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	valKey := ts.RandValKey()
+	v1 := vote.NewPrecommitVote(ts.RandHash(), 10, 1, valKey.Address())
+	v2 := vote.NewPrecommitVote(ts.RandHash(), 10, 1, valKey.Address())
+	ts.HelperSignVote(valKey, v1)
+	ts.HelperSignVote(valKey, v2)
+
+	evd1 := evidence.NewDuplicateVoteEvidence(v1, v2)
+	evd2 := evidence.NewDuplicateVoteEvidence(v2, v1)
+
+	assert.Equal(t, evd1.Hash(), evd2.Hash())
+	assert.Equal(t, valKey.Address(), evd1.Offender())
+	assert.Equal(t, uint32(10), evd1.Height())
+	assert.Equal(t, int16(1), evd1.Round())
+	assert.Equal(t, vote.VoteTypePrecommit, evd1.Type())
+}
+
+func TestEvidenceBasicCheck(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	valKey1 := ts.RandValKey()
+	valKey2 := ts.RandValKey()
+	blockHash := ts.RandHash()
+
+	signedVote := func(v *vote.Vote) *vote.Vote {
+		if v.Signer() == valKey1.Address() {
+			ts.HelperSignVote(valKey1, v)
+		} else {
+			ts.HelperSignVote(valKey2, v)
+		}
+
+		return v
+	}
+
+	base := signedVote(vote.NewPrepareVote(blockHash, 10, 1, valKey1.Address()))
+	tests := []struct {
+		name   string
+		other  *vote.Vote
+		reason string
+	}{
+		{
+			"different signers",
+			signedVote(vote.NewPrepareVote(ts.RandHash(), 10, 1, valKey2.Address())),
+			"votes are signed by different validators",
+		},
+		{
+			"different heights",
+			signedVote(vote.NewPrepareVote(ts.RandHash(), 11, 1, valKey1.Address())),
+			"votes are for different height or round",
+		},
+		{
+			"different rounds",
+			signedVote(vote.NewPrepareVote(ts.RandHash(), 10, 2, valKey1.Address())),
+			"votes are for different height or round",
+		},
+		{
+			"different types",
+			signedVote(vote.NewPrecommitVote(ts.RandHash(), 10, 1, valKey1.Address())),
+			"votes have different types",
+		},
+		{
+			"same votes",
+			signedVote(vote.NewPrepareVote(blockHash, 10, 1, valKey1.Address())),
+			"votes are not conflicting",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			evd := evidence.NewDuplicateVoteEvidence(base, tt.other)
+			err := evd.BasicCheck()
+			assert.ErrorIs(t, err, evidence.BasicCheckError{Reason: tt.reason})
+		})
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		other := signedVote(vote.NewPrepareVote(ts.RandHash(), 10, 1, valKey1.Address()))
+		evd := evidence.NewDuplicateVoteEvidence(base, other)
+
+		assert.NoError(t, evd.BasicCheck())
+		assert.NoError(t, evd.Verify(valKey1.PublicKey()))
+		assert.Error(t, evd.Verify(valKey2.PublicKey()))
+	})
+}
@@ -3,6 +3,7 @@ package tx
 import (
 	"github.com/pactus-project/pactus/crypto"
 	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/crypto/hash"
 	"github.com/pactus-project/pactus/sortition"
 	"github.com/pactus-project/pactus/types/amount"
 	"github.com/pactus-project/pactus/types/tx/payload"
@@ -73,6 +74,40 @@ func NewWithdrawTx(lockTime uint32,
 	return newTx(lockTime, pld, fee, opts...)
 }
 
+func NewMetadataTx(lockTime uint32,
+	val crypto.Address,
+	moniker, website, contact string,
+	rewardAddr crypto.Address,
+	fee amount.Amount,
+	opts ...TxOption,
+) *Tx {
+	pld := &payload.MetadataPayload{
+		Validator:     val,
+		Moniker:       moniker,
+		Website:       website,
+		Contact:       contact,
+		RewardAddress: rewardAddr,
+	}
+
+	return newTx(lockTime, pld, fee, opts...)
+}
+
+func NewKeyRotationTx(lockTime uint32,
+	val crypto.Address,
+	newPubKey *bls.PublicKey,
+	proof *bls.Signature,
+	fee amount.Amount,
+	opts ...TxOption,
+) *Tx {
+	pld := &payload.KeyRotationPayload{
+		Validator:         val,
+		NewPublicKey:      newPubKey,
+		ProofOfPossession: proof,
+	}
+
+	return newTx(lockTime, pld, fee, opts...)
+}
+
 func NewSortitionTx(lockTime uint32,
 	addr crypto.Address,
 	proof sortition.Proof,
@@ -84,3 +119,19 @@ func NewSortitionTx(lockTime uint32,
 
 	return newTx(lockTime, pld, 0)
 }
+
+func NewAnchorTx(lockTime uint32,
+	sender crypto.Address,
+	namespace string,
+	hashes []hash.Hash,
+	fee amount.Amount,
+	opts ...TxOption,
+) *Tx {
+	pld := &payload.AnchorPayload{
+		Sender:    sender,
+		Namespace: namespace,
+		Hashes:    hashes,
+	}
+
+	return newTx(lockTime, pld, fee, opts...)
+}
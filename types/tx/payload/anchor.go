@@ -0,0 +1,146 @@
+package payload
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/types/amount"
+	"github.com/pactus-project/pactus/util/encoding"
+)
+
+const (
+	MaxAnchorNamespaceLength = 32
+	MaxAnchorHashes          = 16
+)
+
+// AnchorPayload timestamps a set of document hashes on the blockchain.
+// The namespace helps applications to identify their own anchors.
+type AnchorPayload struct {
+	Sender    crypto.Address // account address that pays the fee
+	Namespace string         // application-defined namespace of the anchors
+	Hashes    []hash.Hash    // hashes of the anchored documents
+}
+
+func (*AnchorPayload) Type() Type {
+	return TypeAnchor
+}
+
+func (p *AnchorPayload) Signer() crypto.Address {
+	return p.Sender
+}
+
+func (*AnchorPayload) Value() amount.Amount {
+	return 0
+}
+
+// BasicCheck performs basic checks on the Anchor payload.
+func (p *AnchorPayload) BasicCheck() error {
+	if !p.Sender.IsAccountAddress() {
+		return BasicCheckError{
+			Reason: "sender is not an account address: " + p.Sender.String(),
+		}
+	}
+	if len(p.Namespace) > MaxAnchorNamespaceLength {
+		return BasicCheckError{
+			Reason: fmt.Sprintf("namespace length exceeded: %d", len(p.Namespace)),
+		}
+	}
+	if len(p.Hashes) == 0 {
+		return BasicCheckError{
+			Reason: "no hash to anchor",
+		}
+	}
+	if len(p.Hashes) > MaxAnchorHashes {
+		return BasicCheckError{
+			Reason: fmt.Sprintf("too many hashes: %d", len(p.Hashes)),
+		}
+	}
+	seen := make(map[hash.Hash]bool, len(p.Hashes))
+	for _, h := range p.Hashes {
+		if seen[h] {
+			return BasicCheckError{
+				Reason: "duplicated hash: " + h.String(),
+			}
+		}
+		seen[h] = true
+	}
+
+	return nil
+}
+
+func (p *AnchorPayload) SerializeSize() int {
+	return p.Sender.SerializeSize() +
+		encoding.VarStringSerializeSize(p.Namespace) +
+		encoding.VarIntSerializeSize(uint64(len(p.Hashes))) +
+		len(p.Hashes)*hash.HashSize
+}
+
+func (p *AnchorPayload) Encode(w io.Writer) error {
+	err := p.Sender.Encode(w)
+	if err != nil {
+		return err
+	}
+
+	err = encoding.WriteVarString(w, p.Namespace)
+	if err != nil {
+		return err
+	}
+
+	err = encoding.WriteVarInt(w, uint64(len(p.Hashes)))
+	if err != nil {
+		return err
+	}
+
+	for i := range p.Hashes {
+		err = encoding.WriteElement(w, &p.Hashes[i])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *AnchorPayload) Decode(r io.Reader) error {
+	err := p.Sender.Decode(r)
+	if err != nil {
+		return err
+	}
+
+	p.Namespace, err = encoding.ReadVarString(r)
+	if err != nil {
+		return err
+	}
+
+	count, err := encoding.ReadVarInt(r)
+	if err != nil {
+		return err
+	}
+	if count > MaxAnchorHashes {
+		return fmt.Errorf("too many hashes: %d", count)
+	}
+
+	p.Hashes = make([]hash.Hash, count)
+	for i := range p.Hashes {
+		err = encoding.ReadElement(r, &p.Hashes[i])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *AnchorPayload) String() string {
+	return fmt.Sprintf("{Anchor ⚓ %s %s %d",
+		p.Sender.ShortString(),
+		p.Namespace,
+		len(p.Hashes),
+	)
+}
+
+func (*AnchorPayload) Receiver() *crypto.Address {
+	return nil
+}
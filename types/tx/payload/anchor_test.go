@@ -0,0 +1,127 @@
+package payload
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/types/amount"
+	"github.com/pactus-project/pactus/util/encoding"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnchorType(t *testing.T) {
+	pld := AnchorPayload{}
+	assert.Equal(t, TypeAnchor, pld.Type())
+}
+
+func testHashes(count int) []hash.Hash {
+	hashes := make([]hash.Hash, 0, count)
+	for i := 0; i < count; i++ {
+		hashes = append(hashes, hash.CalcHash([]byte{byte(i)}))
+	}
+
+	return hashes
+}
+
+func TestAnchorEncoding(t *testing.T) {
+	pld1 := &AnchorPayload{
+		Sender:    testAccAddr,
+		Namespace: "compliance",
+		Hashes:    testHashes(3),
+	}
+	w := new(bytes.Buffer)
+	require.NoError(t, pld1.Encode(w))
+	assert.Equal(t, pld1.SerializeSize(), w.Len())
+
+	pld2 := new(AnchorPayload)
+	require.NoError(t, pld2.Decode(bytes.NewReader(w.Bytes())))
+	assert.Equal(t, pld1, pld2)
+	assert.Equal(t, pld1.Sender, pld2.Signer())
+	assert.Equal(t, amount.Amount(0), pld2.Value())
+	assert.Nil(t, pld2.Receiver())
+
+	for i := 0; i < w.Len(); i++ {
+		pld3 := new(AnchorPayload)
+		err := pld3.Decode(bytes.NewReader(w.Bytes()[:i]))
+		assert.Error(t, err, "offset %d", i)
+	}
+}
+
+func TestAnchorDecodingTooManyHashes(t *testing.T) {
+	w := new(bytes.Buffer)
+	require.NoError(t, testAccAddr.Encode(w))
+	require.NoError(t, encoding.WriteVarString(w, "ns"))
+	require.NoError(t, encoding.WriteVarInt(w, MaxAnchorHashes+1))
+
+	pld := new(AnchorPayload)
+	err := pld.Decode(bytes.NewReader(w.Bytes()))
+	assert.ErrorContains(t, err, "too many hashes")
+}
+
+func TestAnchorBasicCheck(t *testing.T) {
+	tests := []struct {
+		name string
+		pld  *AnchorPayload
+		err  error
+	}{
+		{
+			name: "invalid sender",
+			pld: &AnchorPayload{
+				Sender: testValAddr,
+				Hashes: testHashes(1),
+			},
+			err: BasicCheckError{Reason: "sender is not an account address: " + testValAddr.String()},
+		},
+		{
+			name: "long namespace",
+			pld: &AnchorPayload{
+				Sender:    testAccAddr,
+				Namespace: strings.Repeat("a", MaxAnchorNamespaceLength+1),
+				Hashes:    testHashes(1),
+			},
+			err: BasicCheckError{Reason: "namespace length exceeded: 33"},
+		},
+		{
+			name: "no hash",
+			pld: &AnchorPayload{
+				Sender: testAccAddr,
+			},
+			err: BasicCheckError{Reason: "no hash to anchor"},
+		},
+		{
+			name: "too many hashes",
+			pld: &AnchorPayload{
+				Sender: testAccAddr,
+				Hashes: testHashes(MaxAnchorHashes + 1),
+			},
+			err: BasicCheckError{Reason: "too many hashes: 17"},
+		},
+		{
+			name: "duplicated hash",
+			pld: &AnchorPayload{
+				Sender: testAccAddr,
+				Hashes: append(testHashes(2), testHashes(1)...),
+			},
+			err: BasicCheckError{Reason: "duplicated hash: " + testHashes(1)[0].String()},
+		},
+		{
+			name: "ok",
+			pld: &AnchorPayload{
+				Sender:    testAccAddr,
+				Namespace: "compliance",
+				Hashes:    testHashes(MaxAnchorHashes),
+			},
+			err: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.pld.BasicCheck()
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
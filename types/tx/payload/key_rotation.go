@@ -0,0 +1,122 @@
+package payload
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/types/amount"
+	"github.com/pactus-project/pactus/util"
+)
+
+// KeyRotationPayload binds a new consensus key to an existing validator.
+// It is signed by the current key of the validator, and the new key proves its
+// possession by signing the rotation, so no one can claim a key they don't own.
+// The validator keeps its number and stake, but its address changes to the
+// address of the new public key.
+type KeyRotationPayload struct {
+	Validator         crypto.Address // current address of the validator
+	NewPublicKey      *bls.PublicKey // new consensus key of the validator
+	ProofOfPossession *bls.Signature // signature of the new key over the rotation
+}
+
+// KeyRotationProofBytes returns the bytes that the new key should sign
+// to prove its possession for rotating the key of the given validator.
+func KeyRotationProofBytes(val crypto.Address, newPubKey *bls.PublicKey) []byte {
+	sb := util.StringToBytes(TypeKeyRotation.String())
+	sb = append(sb, val.Bytes()...)
+	sb = append(sb, newPubKey.Bytes()...)
+
+	return sb
+}
+
+func (*KeyRotationPayload) Type() Type {
+	return TypeKeyRotation
+}
+
+func (p *KeyRotationPayload) Signer() crypto.Address {
+	return p.Validator
+}
+
+func (*KeyRotationPayload) Value() amount.Amount {
+	return 0
+}
+
+// BasicCheck performs basic checks on the KeyRotation payload.
+func (p *KeyRotationPayload) BasicCheck() error {
+	if !p.Validator.IsValidatorAddress() {
+		return BasicCheckError{
+			Reason: "address is not a validator address: " + p.Validator.String(),
+		}
+	}
+	if p.NewPublicKey == nil {
+		return BasicCheckError{
+			Reason: "no new public key",
+		}
+	}
+	if p.NewPublicKey.ValidatorAddress() == p.Validator {
+		return BasicCheckError{
+			Reason: "new public key is the same as the current one",
+		}
+	}
+	if p.ProofOfPossession == nil {
+		return BasicCheckError{
+			Reason: "no proof of possession",
+		}
+	}
+	err := p.NewPublicKey.Verify(KeyRotationProofBytes(p.Validator, p.NewPublicKey), p.ProofOfPossession)
+	if err != nil {
+		return BasicCheckError{
+			Reason: "invalid proof of possession",
+		}
+	}
+
+	return nil
+}
+
+func (*KeyRotationPayload) SerializeSize() int {
+	return 21 + bls.PublicKeySize + bls.SignatureSize
+}
+
+func (p *KeyRotationPayload) Encode(w io.Writer) error {
+	err := p.Validator.Encode(w)
+	if err != nil {
+		return err
+	}
+
+	err = p.NewPublicKey.Encode(w)
+	if err != nil {
+		return err
+	}
+
+	return p.ProofOfPossession.Encode(w)
+}
+
+func (p *KeyRotationPayload) Decode(r io.Reader) error {
+	err := p.Validator.Decode(r)
+	if err != nil {
+		return err
+	}
+
+	p.NewPublicKey = new(bls.PublicKey)
+	err = p.NewPublicKey.Decode(r)
+	if err != nil {
+		return err
+	}
+
+	p.ProofOfPossession = new(bls.Signature)
+
+	return p.ProofOfPossession.Decode(r)
+}
+
+func (p *KeyRotationPayload) String() string {
+	return fmt.Sprintf("{KeyRotation 🔑 %s->%s",
+		p.Validator.ShortString(),
+		p.NewPublicKey.ValidatorAddress().ShortString(),
+	)
+}
+
+func (*KeyRotationPayload) Receiver() *crypto.Address {
+	return nil
+}
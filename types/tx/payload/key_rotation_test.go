@@ -0,0 +1,136 @@
+package payload
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/types/amount"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyRotationType(t *testing.T) {
+	pld := KeyRotationPayload{}
+	assert.Equal(t, TypeKeyRotation, pld.Type())
+}
+
+func testPrivateKey(t *testing.T, seed byte) *bls.PrivateKey {
+	t.Helper()
+
+	ikm := bytes.Repeat([]byte{seed}, 32)
+	prv, err := bls.KeyGen(ikm, nil)
+	require.NoError(t, err)
+
+	return prv
+}
+
+func testProof(prv *bls.PrivateKey, val crypto.Address) *bls.Signature {
+	return prv.SignNative(KeyRotationProofBytes(val, prv.PublicKeyNative()))
+}
+
+func TestKeyRotationEncoding(t *testing.T) {
+	prv := testPrivateKey(t, 1)
+	pld1 := &KeyRotationPayload{
+		Validator:         testValAddr,
+		NewPublicKey:      prv.PublicKeyNative(),
+		ProofOfPossession: testProof(prv, testValAddr),
+	}
+	w := new(bytes.Buffer)
+	require.NoError(t, pld1.Encode(w))
+	assert.Equal(t, pld1.SerializeSize(), w.Len())
+
+	pld2 := new(KeyRotationPayload)
+	require.NoError(t, pld2.Decode(bytes.NewReader(w.Bytes())))
+	assert.Equal(t, pld1.Validator, pld2.Validator)
+	assert.True(t, pld1.NewPublicKey.EqualsTo(pld2.NewPublicKey))
+	assert.True(t, pld1.ProofOfPossession.EqualsTo(pld2.ProofOfPossession))
+	assert.Equal(t, pld1.Validator, pld2.Signer())
+	assert.Equal(t, amount.Amount(0), pld2.Value())
+	assert.Nil(t, pld2.Receiver())
+
+	for i := 0; i < w.Len(); i++ {
+		pld3 := new(KeyRotationPayload)
+		err := pld3.Decode(bytes.NewReader(w.Bytes()[:i]))
+		assert.Error(t, err, "offset %d", i)
+	}
+}
+
+func TestKeyRotationBasicCheck(t *testing.T) {
+	prv := testPrivateKey(t, 1)
+	pub := prv.PublicKeyNative()
+	otherPrv := testPrivateKey(t, 2)
+
+	tests := []struct {
+		name string
+		pld  *KeyRotationPayload
+		err  error
+	}{
+		{
+			name: "not a validator address",
+			pld: &KeyRotationPayload{
+				Validator:    testAccAddr,
+				NewPublicKey: pub,
+			},
+			err: BasicCheckError{Reason: "address is not a validator address: " + testAccAddr.String()},
+		},
+		{
+			name: "no new public key",
+			pld: &KeyRotationPayload{
+				Validator: testValAddr,
+			},
+			err: BasicCheckError{Reason: "no new public key"},
+		},
+		{
+			name: "same public key",
+			pld: &KeyRotationPayload{
+				Validator:    pub.ValidatorAddress(),
+				NewPublicKey: pub,
+			},
+			err: BasicCheckError{Reason: "new public key is the same as the current one"},
+		},
+		{
+			name: "no proof of possession",
+			pld: &KeyRotationPayload{
+				Validator:    testValAddr,
+				NewPublicKey: pub,
+			},
+			err: BasicCheckError{Reason: "no proof of possession"},
+		},
+		{
+			name: "proof signed by another key",
+			pld: &KeyRotationPayload{
+				Validator:         testValAddr,
+				NewPublicKey:      pub,
+				ProofOfPossession: otherPrv.SignNative(KeyRotationProofBytes(testValAddr, pub)),
+			},
+			err: BasicCheckError{Reason: "invalid proof of possession"},
+		},
+		{
+			name: "proof for another validator",
+			pld: &KeyRotationPayload{
+				Validator:         testValAddr,
+				NewPublicKey:      pub,
+				ProofOfPossession: testProof(prv, testAccAddr),
+			},
+			err: BasicCheckError{Reason: "invalid proof of possession"},
+		},
+		{
+			name: "ok",
+			pld: &KeyRotationPayload{
+				Validator:         testValAddr,
+				NewPublicKey:      pub,
+				ProofOfPossession: testProof(prv, testValAddr),
+			},
+			err: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.pld.BasicCheck()
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package payload
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/types/amount"
+	"github.com/pactus-project/pactus/util/encoding"
+)
+
+const (
+	MaxMonikerLength = 64
+	MaxWebsiteLength = 128
+	MaxContactLength = 128
+)
+
+type MetadataPayload struct {
+	Validator     crypto.Address // validator address that publishes the metadata
+	Moniker       string         // human-readable name of the validator
+	Website       string         // website of the validator operator
+	Contact       string         // contact information of the validator operator
+	RewardAddress crypto.Address // hint for the reward address, treasury address if not set
+}
+
+func (*MetadataPayload) Type() Type {
+	return TypeMetadata
+}
+
+func (p *MetadataPayload) Signer() crypto.Address {
+	return p.Validator
+}
+
+func (*MetadataPayload) Value() amount.Amount {
+	return 0
+}
+
+// BasicCheck performs basic checks on the Metadata payload.
+func (p *MetadataPayload) BasicCheck() error {
+	if !p.Validator.IsValidatorAddress() {
+		return BasicCheckError{
+			Reason: "address is not a validator address: " + p.Validator.String(),
+		}
+	}
+	if !p.RewardAddress.IsAccountAddress() {
+		return BasicCheckError{
+			Reason: "reward address is not an account address: " + p.RewardAddress.String(),
+		}
+	}
+	if len(p.Moniker) > MaxMonikerLength {
+		return BasicCheckError{
+			Reason: fmt.Sprintf("moniker length exceeded: %d", len(p.Moniker)),
+		}
+	}
+	if len(p.Website) > MaxWebsiteLength {
+		return BasicCheckError{
+			Reason: fmt.Sprintf("website length exceeded: %d", len(p.Website)),
+		}
+	}
+	if len(p.Contact) > MaxContactLength {
+		return BasicCheckError{
+			Reason: fmt.Sprintf("contact length exceeded: %d", len(p.Contact)),
+		}
+	}
+
+	return nil
+}
+
+func (p *MetadataPayload) SerializeSize() int {
+	return 21 +
+		encoding.VarStringSerializeSize(p.Moniker) +
+		encoding.VarStringSerializeSize(p.Website) +
+		encoding.VarStringSerializeSize(p.Contact) +
+		p.RewardAddress.SerializeSize()
+}
+
+func (p *MetadataPayload) Encode(w io.Writer) error {
+	err := p.Validator.Encode(w)
+	if err != nil {
+		return err
+	}
+
+	err = encoding.WriteVarString(w, p.Moniker)
+	if err != nil {
+		return err
+	}
+
+	err = encoding.WriteVarString(w, p.Website)
+	if err != nil {
+		return err
+	}
+
+	err = encoding.WriteVarString(w, p.Contact)
+	if err != nil {
+		return err
+	}
+
+	return p.RewardAddress.Encode(w)
+}
+
+func (p *MetadataPayload) Decode(r io.Reader) error {
+	err := p.Validator.Decode(r)
+	if err != nil {
+		return err
+	}
+
+	p.Moniker, err = encoding.ReadVarString(r)
+	if err != nil {
+		return err
+	}
+
+	p.Website, err = encoding.ReadVarString(r)
+	if err != nil {
+		return err
+	}
+
+	p.Contact, err = encoding.ReadVarString(r)
+	if err != nil {
+		return err
+	}
+
+	return p.RewardAddress.Decode(r)
+}
+
+func (p *MetadataPayload) String() string {
+	return fmt.Sprintf("{Metadata 🏷️ %s %s",
+		p.Validator.ShortString(),
+		p.Moniker,
+	)
+}
+
+func (*MetadataPayload) Receiver() *crypto.Address {
+	return nil
+}
@@ -0,0 +1,122 @@
+package payload
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/types/amount"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetadataType(t *testing.T) {
+	pld := MetadataPayload{}
+	assert.Equal(t, TypeMetadata, pld.Type())
+}
+
+var (
+	testValAddr = crypto.Address{
+		0x01, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0A,
+		0x0B, 0x0C, 0x0D, 0x0E, 0x0F, 0x10, 0x11, 0x12, 0x13, 0x14,
+	}
+	testAccAddr = crypto.Address{
+		0x02, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0A,
+		0x0B, 0x0C, 0x0D, 0x0E, 0x0F, 0x10, 0x11, 0x12, 0x13, 0x14,
+	}
+)
+
+func TestMetadataEncoding(t *testing.T) {
+	pld1 := &MetadataPayload{
+		Validator:     testValAddr,
+		Moniker:       "moniker",
+		Website:       "https://pactus.org",
+		Contact:       "info@pactus.org",
+		RewardAddress: testAccAddr,
+	}
+	w := new(bytes.Buffer)
+	require.NoError(t, pld1.Encode(w))
+	assert.Equal(t, pld1.SerializeSize(), w.Len())
+
+	pld2 := new(MetadataPayload)
+	require.NoError(t, pld2.Decode(bytes.NewReader(w.Bytes())))
+	assert.Equal(t, pld1, pld2)
+	assert.Equal(t, pld1.Validator, pld2.Signer())
+	assert.Equal(t, amount.Amount(0), pld2.Value())
+	assert.Nil(t, pld2.Receiver())
+
+	for i := 0; i < w.Len(); i++ {
+		pld3 := new(MetadataPayload)
+		err := pld3.Decode(bytes.NewReader(w.Bytes()[:i]))
+		assert.Error(t, err, "offset %d", i)
+	}
+}
+
+func TestMetadataBasicCheck(t *testing.T) {
+	tests := []struct {
+		name string
+		pld  *MetadataPayload
+		err  error
+	}{
+		{
+			name: "not a validator address",
+			pld: &MetadataPayload{
+				Validator: testAccAddr,
+			},
+			err: BasicCheckError{Reason: "address is not a validator address: "},
+		},
+		{
+			name: "reward address is a validator address",
+			pld: &MetadataPayload{
+				Validator:     testValAddr,
+				RewardAddress: testValAddr,
+			},
+			err: BasicCheckError{Reason: "reward address is not an account address: "},
+		},
+		{
+			name: "long moniker",
+			pld: &MetadataPayload{
+				Validator: testValAddr,
+				Moniker:   strings.Repeat("a", MaxMonikerLength+1),
+			},
+			err: BasicCheckError{Reason: "moniker length exceeded: 65"},
+		},
+		{
+			name: "long website",
+			pld: &MetadataPayload{
+				Validator: testValAddr,
+				Website:   strings.Repeat("a", MaxWebsiteLength+1),
+			},
+			err: BasicCheckError{Reason: "website length exceeded: 129"},
+		},
+		{
+			name: "long contact",
+			pld: &MetadataPayload{
+				Validator: testValAddr,
+				Contact:   strings.Repeat("a", MaxContactLength+1),
+			},
+			err: BasicCheckError{Reason: "contact length exceeded: 129"},
+		},
+		{
+			name: "no reward address",
+			pld: &MetadataPayload{
+				Validator:     testValAddr,
+				Moniker:       strings.Repeat("a", MaxMonikerLength),
+				RewardAddress: crypto.TreasuryAddress,
+			},
+			err: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.pld.BasicCheck()
+			if tt.err == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.err.Error())
+			}
+		})
+	}
+}
@@ -11,11 +11,14 @@ import (
 type Type uint8
 
 const (
-	TypeTransfer  = Type(1)
-	TypeBond      = Type(2)
-	TypeSortition = Type(3)
-	TypeUnbond    = Type(4)
-	TypeWithdraw  = Type(5)
+	TypeTransfer    = Type(1)
+	TypeBond        = Type(2)
+	TypeSortition   = Type(3)
+	TypeUnbond      = Type(4)
+	TypeWithdraw    = Type(5)
+	TypeMetadata    = Type(6)
+	TypeKeyRotation = Type(7)
+	TypeAnchor      = Type(8)
 )
 
 func (t Type) String() string {
@@ -30,6 +33,12 @@ func (t Type) String() string {
 		return "withdraw"
 	case TypeSortition:
 		return "sortition"
+	case TypeMetadata:
+		return "metadata"
+	case TypeKeyRotation:
+		return "key-rotation"
+	case TypeAnchor:
+		return "anchor"
 	}
 
 	return fmt.Sprintf("%d", t)
@@ -348,6 +348,12 @@ func (tx *Tx) Decode(r io.Reader) error {
 		tx.data.Payload = new(payload.WithdrawPayload)
 	case payload.TypeSortition:
 		tx.data.Payload = new(payload.SortitionPayload)
+	case payload.TypeMetadata:
+		tx.data.Payload = new(payload.MetadataPayload)
+	case payload.TypeKeyRotation:
+		tx.data.Payload = new(payload.KeyRotationPayload)
+	case payload.TypeAnchor:
+		tx.data.Payload = new(payload.AnchorPayload)
 
 	default:
 		return InvalidPayloadTypeError{
@@ -434,6 +440,18 @@ func (tx *Tx) IsWithdrawTx() bool {
 	return tx.Payload().Type() == payload.TypeWithdraw
 }
 
+func (tx *Tx) IsMetadataTx() bool {
+	return tx.Payload().Type() == payload.TypeMetadata
+}
+
+func (tx *Tx) IsKeyRotationTx() bool {
+	return tx.Payload().Type() == payload.TypeKeyRotation
+}
+
+func (tx *Tx) IsAnchorTx() bool {
+	return tx.Payload().Type() == payload.TypeAnchor
+}
+
 // StripPublicKey removes the public key from the transaction.
 // It is an alias function for `SetPublicKey(nil)`.
 func (tx *Tx) StripPublicKey() {
@@ -0,0 +1,78 @@
+package validator
+
+import (
+	"bytes"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/util/encoding"
+)
+
+// Metadata holds the descriptive information that a validator publishes on-chain.
+// It is not part of the validator's state and does not affect its hash.
+type Metadata struct {
+	Moniker       string
+	Website       string
+	Contact       string
+	RewardAddress crypto.Address // hint for the reward address, treasury address if not set
+}
+
+// MetadataFromBytes constructs validator metadata from a byte array.
+func MetadataFromBytes(data []byte) (*Metadata, error) {
+	meta := new(Metadata)
+	r := bytes.NewReader(data)
+
+	var err error
+	meta.Moniker, err = encoding.ReadVarString(r)
+	if err != nil {
+		return nil, err
+	}
+	meta.Website, err = encoding.ReadVarString(r)
+	if err != nil {
+		return nil, err
+	}
+	meta.Contact, err = encoding.ReadVarString(r)
+	if err != nil {
+		return nil, err
+	}
+	if err := meta.RewardAddress.Decode(r); err != nil {
+		return nil, err
+	}
+
+	return meta, nil
+}
+
+// SerializeSize returns the size in bytes required to serialize the metadata.
+func (meta *Metadata) SerializeSize() int {
+	return encoding.VarStringSerializeSize(meta.Moniker) +
+		encoding.VarStringSerializeSize(meta.Website) +
+		encoding.VarStringSerializeSize(meta.Contact) +
+		meta.RewardAddress.SerializeSize()
+}
+
+// Bytes returns the serialized byte representation of the metadata.
+func (meta *Metadata) Bytes() ([]byte, error) {
+	w := bytes.NewBuffer(make([]byte, 0, meta.SerializeSize()))
+
+	if err := encoding.WriteVarString(w, meta.Moniker); err != nil {
+		return nil, err
+	}
+	if err := encoding.WriteVarString(w, meta.Website); err != nil {
+		return nil, err
+	}
+	if err := encoding.WriteVarString(w, meta.Contact); err != nil {
+		return nil, err
+	}
+	if err := meta.RewardAddress.Encode(w); err != nil {
+		return nil, err
+	}
+
+	return w.Bytes(), nil
+}
+
+// Clone creates a deep copy of the metadata.
+func (meta *Metadata) Clone() *Metadata {
+	cloned := new(Metadata)
+	*cloned = *meta
+
+	return cloned
+}
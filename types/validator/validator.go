@@ -135,6 +135,12 @@ func (val *Validator) UpdateUnbondingHeight(height uint32) {
 	val.data.UnbondingHeight = height
 }
 
+// UpdatePublicKey replaces the consensus key of the validator.
+// The address of the validator changes accordingly, but the number and stake remain the same.
+func (val *Validator) UpdatePublicKey(pub *bls.PublicKey) {
+	val.data.PublicKey = pub
+}
+
 // Hash calculates and returns the hash of the validator.
 func (val *Validator) Hash() hash.Hash {
 	bs, err := val.Bytes()
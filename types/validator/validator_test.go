@@ -100,6 +100,20 @@ func TestSubtractFromStake(t *testing.T) {
 	assert.Equal(t, stake-1, val.Stake())
 }
 
+func TestUpdatePublicKey(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	val, _ := ts.GenerateTestValidator(100)
+	stake := val.Stake()
+	pub, _ := ts.RandBLSKeyPair()
+	val.UpdatePublicKey(pub)
+
+	assert.Equal(t, pub, val.PublicKey())
+	assert.Equal(t, pub.ValidatorAddress(), val.Address())
+	assert.Equal(t, int32(100), val.Number())
+	assert.Equal(t, stake, val.Stake())
+}
+
 func TestClone(t *testing.T) {
 	ts := testsuite.NewTestSuite(t)
 
@@ -7,6 +7,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"runtime"
 	"strings"
 )
 
@@ -42,6 +43,57 @@ func WriteFile(filename string, data []byte) error {
 	return nil
 }
 
+// WriteFileAtomic writes the data to a temporary file, flushes it to the disk and
+// renames it to the given filename, so the file is either fully written or untouched.
+func WriteFileAtomic(filename string, data []byte) error {
+	dir := filepath.Dir(filename)
+	if err := Mkdir(dir); err != nil {
+		return err
+	}
+
+	tmpFilename := filename + ".tmp"
+	file, err := os.OpenFile(tmpFilename, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(data); err != nil {
+		_ = file.Close()
+
+		return err
+	}
+	if err := file.Sync(); err != nil {
+		_ = file.Close()
+
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpFilename, filename); err != nil {
+		return err
+	}
+
+	return syncDir(dir)
+}
+
+// syncDir flushes the directory entry, making the rename durable.
+// Windows doesn't support syncing directories.
+func syncDir(dir string) error {
+	if runtime.GOOS == "windows" {
+		return nil
+	}
+
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = d.Close()
+	}()
+
+	return d.Sync()
+}
+
 func Mkdir(dir string) error {
 	// create the directory
 	if err := os.MkdirAll(dir, 0o750); err != nil {
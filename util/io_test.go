@@ -21,6 +21,17 @@ func TestWriteFile(t *testing.T) {
 	assert.NoError(t, WriteFile(p+"/another-folder/d.dat", d))
 }
 
+func TestWriteFileAtomic(t *testing.T) {
+	p := filepath.Join(TempDirPath(), "another-folder", "d.dat")
+	assert.NoError(t, WriteFileAtomic(p, []byte("data-1")))
+	assert.NoError(t, WriteFileAtomic(p, []byte("data-2")))
+
+	o, err := ReadFile(p)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("data-2"), o)
+	assert.False(t, PathExists(p+".tmp"))
+}
+
 func TestEmptyPath(t *testing.T) {
 	p := TempDirPath()
 	assert.Equal(t, p, MakeAbs(p))
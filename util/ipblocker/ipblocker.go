@@ -2,15 +2,31 @@ package ipblocker
 
 import (
 	"net"
+	"sync"
+	"time"
 )
 
+// Ban is a banned network. A ban without expiry time is permanent.
+type Ban struct {
+	Net         *net.IPNet
+	BannedUntil time.Time
+	Reason      string
+}
+
+// IsExpired checks if the ban is expired at the given time.
+func (b *Ban) IsExpired(now time.Time) bool {
+	return !b.BannedUntil.IsZero() && !now.Before(b.BannedUntil)
+}
+
 type IPBlocker struct {
-	cidrs []*net.IPNet
+	lk sync.RWMutex
+
+	cidrs []*Ban
 }
 
 func New(bannedNets []string) (*IPBlocker, error) {
 	ipBlocker := &IPBlocker{
-		cidrs: make([]*net.IPNet, 0),
+		cidrs: make([]*Ban, 0),
 	}
 
 	for _, cidr := range bannedNets {
@@ -18,21 +34,111 @@ func New(bannedNets []string) (*IPBlocker, error) {
 		if err != nil {
 			return nil, err
 		}
-		ipBlocker.cidrs = append(ipBlocker.cidrs, ipNet)
+		ipBlocker.cidrs = append(ipBlocker.cidrs, &Ban{Net: ipNet})
 	}
 
 	return ipBlocker, nil
 }
 
+// ParseNet parses a CIDR or a single IP address.
+// A single IP address is treated as a network with a full mask.
+func ParseNet(target string) (*net.IPNet, error) {
+	if ip := net.ParseIP(target); ip != nil {
+		bits := net.IPv6len * 8
+		if ip4 := ip.To4(); ip4 != nil {
+			ip = ip4
+			bits = net.IPv4len * 8
+		}
+
+		return &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}, nil
+	}
+
+	_, ipNet, err := net.ParseCIDR(target)
+	if err != nil {
+		return nil, err
+	}
+
+	return ipNet, nil
+}
+
+// Ban bans the network until the given time. A zero time bans the network permanently.
+// Banning a network that is banned before updates its expiry time and reason.
+func (i *IPBlocker) Ban(target string, until time.Time, reason string) error {
+	ipNet, err := ParseNet(target)
+	if err != nil {
+		return err
+	}
+
+	i.lk.Lock()
+	defer i.lk.Unlock()
+
+	for _, ban := range i.cidrs {
+		if ban.Net.String() == ipNet.String() {
+			ban.BannedUntil = until
+			ban.Reason = reason
+
+			return nil
+		}
+	}
+	i.cidrs = append(i.cidrs, &Ban{Net: ipNet, BannedUntil: until, Reason: reason})
+
+	return nil
+}
+
+// Unban removes the ban of the network. It returns false if the network is not banned.
+func (i *IPBlocker) Unban(target string) bool {
+	ipNet, err := ParseNet(target)
+	if err != nil {
+		return false
+	}
+
+	i.lk.Lock()
+	defer i.lk.Unlock()
+
+	for n, ban := range i.cidrs {
+		if ban.Net.String() == ipNet.String() {
+			i.cidrs = append(i.cidrs[:n], i.cidrs[n+1:]...)
+
+			return true
+		}
+	}
+
+	return false
+}
+
+// Bans returns the networks that are banned now. The expired bans are removed.
+func (i *IPBlocker) Bans() []Ban {
+	i.lk.Lock()
+	defer i.lk.Unlock()
+
+	now := time.Now()
+	bans := make([]Ban, 0, len(i.cidrs))
+	active := i.cidrs[:0]
+	for _, ban := range i.cidrs {
+		if ban.IsExpired(now) {
+			continue
+		}
+		active = append(active, ban)
+		bans = append(bans, *ban)
+	}
+	i.cidrs = active
+
+	return bans
+}
+
 func (i *IPBlocker) IsBanned(ip string) bool {
 	parsedIP := net.ParseIP(ip)
 	if parsedIP == nil {
 		return false
 	}
 
+	i.lk.RLock()
+	defer i.lk.RUnlock()
+
+	now := time.Now()
 	// TODO: if scaled cidrs and ips items we can improve using trie or radix tree
-	for _, cidr := range i.cidrs {
-		if cidr.Contains(parsedIP) {
+	for _, ban := range i.cidrs {
+		if ban.Net.Contains(parsedIP) && !ban.IsExpired(now) {
 			return true
 		}
 	}
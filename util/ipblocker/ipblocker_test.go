@@ -2,6 +2,7 @@ package ipblocker
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -77,3 +78,55 @@ func TestIsBlocked(t *testing.T) {
 		})
 	}
 }
+
+func TestParseNet(t *testing.T) {
+	ipNet, err := ParseNet("192.168.1.1")
+	assert.NoError(t, err)
+	assert.Equal(t, "192.168.1.1/32", ipNet.String())
+
+	ipNet, err = ParseNet("2001:db8::1")
+	assert.NoError(t, err)
+	assert.Equal(t, "2001:db8::1/128", ipNet.String())
+
+	ipNet, err = ParseNet("10.1.2.3/8")
+	assert.NoError(t, err)
+	assert.Equal(t, "10.0.0.0/8", ipNet.String())
+
+	_, err = ParseNet("invalid-net")
+	assert.Error(t, err)
+}
+
+func TestBanAndUnban(t *testing.T) {
+	ipBlocker, err := New([]string{"10.0.0.0/8"})
+	assert.NoError(t, err)
+
+	assert.Error(t, ipBlocker.Ban("invalid-net", time.Time{}, ""))
+
+	assert.NoError(t, ipBlocker.Ban("192.168.1.1", time.Now().Add(time.Hour), "spam"))
+	assert.NoError(t, ipBlocker.Ban("172.16.0.0/12", time.Now().Add(-time.Second), "expired"))
+
+	assert.True(t, ipBlocker.IsBanned("10.1.1.1"))
+	assert.True(t, ipBlocker.IsBanned("192.168.1.1"))
+	assert.False(t, ipBlocker.IsBanned("192.168.1.2"))
+	assert.False(t, ipBlocker.IsBanned("172.16.0.1"))
+
+	bans := ipBlocker.Bans()
+	assert.Len(t, bans, 2)
+	assert.Equal(t, "10.0.0.0/8", bans[0].Net.String())
+	assert.True(t, bans[0].BannedUntil.IsZero())
+	assert.Equal(t, "192.168.1.1/32", bans[1].Net.String())
+	assert.Equal(t, "spam", bans[1].Reason)
+
+	// Banning again updates the ban.
+	assert.NoError(t, ipBlocker.Ban("192.168.1.1/32", time.Time{}, "abuse"))
+	bans = ipBlocker.Bans()
+	assert.Len(t, bans, 2)
+	assert.True(t, bans[1].BannedUntil.IsZero())
+	assert.Equal(t, "abuse", bans[1].Reason)
+
+	assert.True(t, ipBlocker.Unban("192.168.1.1"))
+	assert.False(t, ipBlocker.Unban("192.168.1.1"))
+	assert.False(t, ipBlocker.Unban("invalid-net"))
+	assert.False(t, ipBlocker.IsBanned("192.168.1.1"))
+	assert.Len(t, ipBlocker.Bans(), 1)
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/pactus-project/pactus/types/amount"
 	"github.com/pactus-project/pactus/types/block"
 	"github.com/pactus-project/pactus/types/certificate"
+	"github.com/pactus-project/pactus/types/evidence"
 	"github.com/pactus-project/pactus/types/proposal"
 	"github.com/pactus-project/pactus/types/tx"
 	"github.com/pactus-project/pactus/types/validator"
@@ -341,13 +342,14 @@ func (ts *TestSuite) NewBlockMaker() *BlockMaker {
 	txs.Append(tx5)
 
 	return &BlockMaker{
-		Version:  1,
-		Txs:      txs,
-		Proposer: ts.RandValAddress(),
-		Time:     time.Now(),
-		PrevHash: ts.RandHash(),
-		Seed:     ts.RandSeed(),
-		PrevCert: nil,
+		Version:   1,
+		Txs:       txs,
+		Proposer:  ts.RandValAddress(),
+		Time:      time.Now(),
+		StateHash: ts.RandHash(),
+		PrevHash:  ts.RandHash(),
+		Seed:      ts.RandSeed(),
+		PrevCert:  nil,
 	}
 }
 
@@ -423,7 +425,7 @@ func (ts *TestSuite) GenerateTestBlock(height uint32, options ...func(bm *BlockM
 		opt(bm)
 	}
 
-	header := block.NewHeader(bm.Version, bm.Time, bm.PrevHash, bm.PrevHash, bm.Seed, bm.Proposer)
+	header := block.NewHeader(bm.Version, bm.Time, bm.StateHash, bm.PrevHash, bm.Seed, bm.Proposer)
 	blk := block.NewBlock(header, bm.PrevCert, bm.Txs)
 
 	blockCert := ts.GenerateTestBlockCertificate(height)
@@ -588,6 +590,19 @@ func (ts *TestSuite) GenerateTestWithdrawTx(options ...func(tm *TransactionMaker
 	return trx
 }
 
+// GenerateTestAnchorTx generates an anchor transaction for the given hashes for testing purposes.
+func (ts *TestSuite) GenerateTestAnchorTx(hashes []hash.Hash, options ...func(tm *TransactionMaker)) *tx.Tx {
+	tm := ts.NewTransactionMaker()
+
+	for _, opt := range options {
+		opt(tm)
+	}
+	trx := tx.NewAnchorTx(tm.LockTime, tm.PubKey.AccountAddress(), ts.RandString(8), hashes, tm.Fee)
+	ts.HelperSignTransaction(tm.PrvKey, trx)
+
+	return trx
+}
+
 // GenerateTestPrecommitVote generates a precommit vote for testing purposes.
 func (ts *TestSuite) GenerateTestPrecommitVote(height uint32, round int16) (*vote.Vote, *bls.ValidatorKey) {
 	valKey := ts.RandValKey()
@@ -612,6 +627,17 @@ func (ts *TestSuite) GenerateTestPrepareVote(height uint32, round int16) (*vote.
 	return v, valKey
 }
 
+// GenerateTestEvidence generates a duplicate vote evidence for testing purposes.
+func (ts *TestSuite) GenerateTestEvidence(height uint32, round int16) (*evidence.Evidence, *bls.ValidatorKey) {
+	valKey := ts.RandValKey()
+	v1 := vote.NewPrepareVote(ts.RandHash(), height, round, valKey.Address())
+	v2 := vote.NewPrepareVote(ts.RandHash(), height, round, valKey.Address())
+	ts.HelperSignVote(valKey, v1)
+	ts.HelperSignVote(valKey, v2)
+
+	return evidence.NewDuplicateVoteEvidence(v1, v2), valKey
+}
+
 // GenerateTestCommittee generates a committee for testing purposes.
 // All committee members have the same power.
 func (ts *TestSuite) GenerateTestCommittee(num int) (committee.Committee, []*bls.ValidatorKey) {
@@ -47,9 +47,9 @@ func setup(t *testing.T) *testData {
 	}
 	mockState := state.MockingState(ts)
 	gRPCServer := grpc.NewServer(
-		grpcConf, mockState,
+		grpcConf, mockState, nil,
 		nil, nil,
-		nil, wallet.NewWalletManager(walletMgrConf),
+		nil, nil, wallet.NewWalletManager(walletMgrConf),
 	)
 
 	assert.NoError(t, gRPCServer.StartServer())
@@ -0,0 +1,51 @@
+package watchdog
+
+import (
+	"time"
+
+	"github.com/pactus-project/pactus/crypto"
+)
+
+// Kind is the kind of the availability alerts.
+type Kind uint8
+
+const (
+	KindNotSynced    = Kind(1)
+	KindAbsent       = Kind(2)
+	KindNoRecentVote = Kind(3)
+	KindLowScore     = Kind(4)
+)
+
+var kinds = []Kind{KindNotSynced, KindAbsent, KindNoRecentVote, KindLowScore}
+
+func (k Kind) String() string {
+	switch k {
+	case KindNotSynced:
+		return "not-synced"
+	case KindAbsent:
+		return "absent"
+	case KindNoRecentVote:
+		return "no-recent-vote"
+	case KindLowScore:
+		return "low-availability-score"
+	default:
+		return "unknown"
+	}
+}
+
+// Alert is raised when a validator is at risk of missing its duties,
+// and it is resolved once the validator recovers.
+type Alert struct {
+	Validator crypto.Address
+	Kind      Kind
+	// Active is true when the alert is raised and false when it is resolved.
+	Active bool
+	Height uint32
+	Time   time.Time
+	Score  float64
+	// Absences is the number of consecutive certificates that the validator is absent from.
+	Absences int
+	// LastVoteTime is zero if the validator hasn't voted since the node started.
+	LastVoteTime time.Time
+	Message      string
+}
@@ -0,0 +1,76 @@
+package watchdog
+
+import "time"
+
+type Config struct {
+	Enable bool `toml:"enable"`
+
+	// CheckInterval is the interval between checking the availability of the validators.
+	CheckInterval time.Duration `toml:"check_interval"`
+
+	// MaxAbsences is the number of consecutive certificates that a validator can be absent from,
+	// before raising an alert.
+	MaxAbsences int `toml:"max_absences"`
+
+	// MaxVoteDelay is the maximum time since the last vote of a validator in the committee,
+	// before raising an alert.
+	MaxVoteDelay time.Duration `toml:"max_vote_delay"`
+
+	// MaxBlockDelay is the maximum age of the last block. Older than that, the node is not synced.
+	MaxBlockDelay time.Duration `toml:"max_block_delay"`
+
+	// ScoreMargin raises an alert when the availability score is less than
+	// the minimum availability score plus this margin,
+	// before the proposals of the validator are refused by the other validators.
+	ScoreMargin float64 `toml:"score_margin"`
+
+	// EnableMetrics provides the watchdog metrics for the Prometheus software.
+	EnableMetrics bool `toml:"enable_metrics"`
+}
+
+func DefaultConfig() *Config {
+	return &Config{
+		Enable:        true,
+		CheckInterval: 10 * time.Second,
+		MaxAbsences:   3,
+		MaxVoteDelay:  2 * time.Minute,
+		MaxBlockDelay: time.Minute,
+		ScoreMargin:   0.1,
+		EnableMetrics: false,
+	}
+}
+
+// BasicCheck performs basic checks on the configuration.
+func (conf *Config) BasicCheck() error {
+	if !conf.Enable {
+		return nil
+	}
+
+	if conf.CheckInterval <= 0 {
+		return ConfigError{
+			Reason: "check interval must be greater than zero",
+		}
+	}
+	if conf.MaxAbsences <= 0 {
+		return ConfigError{
+			Reason: "maximum absences must be greater than zero",
+		}
+	}
+	if conf.MaxVoteDelay <= 0 {
+		return ConfigError{
+			Reason: "maximum vote delay must be greater than zero",
+		}
+	}
+	if conf.MaxBlockDelay <= 0 {
+		return ConfigError{
+			Reason: "maximum block delay must be greater than zero",
+		}
+	}
+	if conf.ScoreMargin < 0 || conf.ScoreMargin > 1 {
+		return ConfigError{
+			Reason: "score margin can't be negative or more than 1",
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package watchdog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigBasicCheck(t *testing.T) {
+	testCases := []struct {
+		name        string
+		expectedErr error
+		updateFn    func(c *Config)
+	}{
+		{
+			name: "Invalid CheckInterval",
+			expectedErr: ConfigError{
+				Reason: "check interval must be greater than zero",
+			},
+			updateFn: func(c *Config) {
+				c.CheckInterval = 0
+			},
+		},
+		{
+			name: "Invalid MaxAbsences",
+			expectedErr: ConfigError{
+				Reason: "maximum absences must be greater than zero",
+			},
+			updateFn: func(c *Config) {
+				c.MaxAbsences = 0
+			},
+		},
+		{
+			name: "Invalid MaxVoteDelay",
+			expectedErr: ConfigError{
+				Reason: "maximum vote delay must be greater than zero",
+			},
+			updateFn: func(c *Config) {
+				c.MaxVoteDelay = -1
+			},
+		},
+		{
+			name: "Invalid MaxBlockDelay",
+			expectedErr: ConfigError{
+				Reason: "maximum block delay must be greater than zero",
+			},
+			updateFn: func(c *Config) {
+				c.MaxBlockDelay = 0
+			},
+		},
+		{
+			name: "Invalid ScoreMargin",
+			expectedErr: ConfigError{
+				Reason: "score margin can't be negative or more than 1",
+			},
+			updateFn: func(c *Config) {
+				c.ScoreMargin = -0.1
+			},
+		},
+		{
+			name: "Disabled watchdog",
+			updateFn: func(c *Config) {
+				c.Enable = false
+				c.CheckInterval = 0
+			},
+		},
+		{
+			name:     "DefaultConfig",
+			updateFn: func(*Config) {},
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			conf := DefaultConfig()
+			tc.updateFn(conf)
+			err := conf.BasicCheck()
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr,
+					"Expected error not matched for test %d-%s, expected: %s, got: %s", i, tc.name, tc.expectedErr, err)
+			} else {
+				assert.NoError(t, err, "Expected no error for test %d-%s, get: %s", i, tc.name, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,10 @@
+package watchdog
+
+// ConfigError is returned when the watchdog configuration is invalid.
+type ConfigError struct {
+	Reason string
+}
+
+func (e ConfigError) Error() string {
+	return e.Reason
+}
@@ -0,0 +1,18 @@
+package watchdog
+
+type Reader interface {
+	// ActiveAlerts returns the alerts that are raised and not resolved yet.
+	ActiveAlerts() []*Alert
+
+	// Subscribe returns a channel that receives the alerts when they are raised or resolved,
+	// and a function to cancel the subscription.
+	// The channel is closed when the subscription is canceled or the watchdog stops.
+	Subscribe() (<-chan *Alert, func())
+}
+
+type Watchdog interface {
+	Reader
+
+	Start() error
+	Stop()
+}
@@ -0,0 +1,89 @@
+package watchdog
+
+import (
+	"sync"
+	"time"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	metricsNamespace = "pactus"
+	metricsSubsystem = "watchdog"
+)
+
+var (
+	syncedMetric = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "synced",
+		Help:      "Whether the last block is recent enough to consider the node synced.",
+	})
+
+	availabilityScoreMetric = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "availability_score",
+		Help:      "The availability score of the validator.",
+	}, []string{"validator"})
+
+	absencesMetric = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "absences",
+		Help:      "Number of consecutive certificates that the validator is absent from.",
+	}, []string{"validator"})
+
+	voteDelayMetric = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "vote_delay_seconds",
+		Help:      "Time since the last vote of the validator while it is in the committee.",
+	}, []string{"validator"})
+
+	alertMetric = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "alert",
+		Help:      "Whether the alert of the validator is active.",
+	}, []string{"validator", "kind"})
+
+	registerMetricsOnce sync.Once
+)
+
+// registerMetrics registers the watchdog metrics in the default Prometheus registerer.
+func registerMetrics() {
+	registerMetricsOnce.Do(func() {
+		prometheus.MustRegister(
+			syncedMetric,
+			availabilityScoreMetric,
+			absencesMetric,
+			voteDelayMetric,
+			alertMetric,
+		)
+	})
+}
+
+func updateSyncedMetric(synced bool) {
+	syncedMetric.Set(boolToFloat(synced))
+}
+
+func updateValidatorMetrics(addr crypto.Address, score float64, absences int, voteDelay time.Duration) {
+	label := addr.String()
+	availabilityScoreMetric.WithLabelValues(label).Set(score)
+	absencesMetric.WithLabelValues(label).Set(float64(absences))
+	voteDelayMetric.WithLabelValues(label).Set(voteDelay.Seconds())
+}
+
+func updateAlertMetric(alert *Alert) {
+	alertMetric.WithLabelValues(alert.Validator.String(), alert.Kind.String()).Set(boolToFloat(alert.Active))
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,59 @@
+package watchdog
+
+import "sync"
+
+var _ Watchdog = &MockWatchdog{}
+
+type MockWatchdog struct {
+	lk sync.Mutex
+
+	Alerts      []*Alert
+	subscribers []chan *Alert
+}
+
+func MockingWatchdog() *MockWatchdog {
+	return &MockWatchdog{
+		Alerts: make([]*Alert, 0),
+	}
+}
+
+func (*MockWatchdog) Start() error {
+	return nil
+}
+
+func (m *MockWatchdog) Stop() {
+	m.lk.Lock()
+	defer m.lk.Unlock()
+
+	for _, ch := range m.subscribers {
+		close(ch)
+	}
+	m.subscribers = nil
+}
+
+func (m *MockWatchdog) ActiveAlerts() []*Alert {
+	m.lk.Lock()
+	defer m.lk.Unlock()
+
+	return m.Alerts
+}
+
+func (m *MockWatchdog) Subscribe() (<-chan *Alert, func()) {
+	m.lk.Lock()
+	defer m.lk.Unlock()
+
+	ch := make(chan *Alert, subscriberBufferSize)
+	m.subscribers = append(m.subscribers, ch)
+
+	return ch, func() {}
+}
+
+// Publish sends the alert to the subscribers.
+func (m *MockWatchdog) Publish(alert *Alert) {
+	m.lk.Lock()
+	defer m.lk.Unlock()
+
+	for _, ch := range m.subscribers {
+		ch <- alert
+	}
+}
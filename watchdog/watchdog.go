@@ -0,0 +1,342 @@
+package watchdog
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/pactus-project/pactus/consensus"
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/state"
+	"github.com/pactus-project/pactus/types/certificate"
+	"github.com/pactus-project/pactus/util/logger"
+	"github.com/pactus-project/pactus/www/nanomsg/event"
+)
+
+const (
+	// maxScannedHeights is the maximum number of certificates scanned in each check.
+	maxScannedHeights = 100
+
+	// subscriberBufferSize is the capacity of the subscriber channels.
+	// Alerts are dropped for the subscribers that can't keep up.
+	subscriberBufferSize = 16
+)
+
+// validatorStatus keeps the availability status of a validator running on this node.
+type validatorStatus struct {
+	cons             consensus.Reader
+	address          crypto.Address
+	absences         int
+	inCommitteeSince time.Time
+	alerts           map[Kind]*Alert
+}
+
+type watchdog struct {
+	lk sync.RWMutex
+
+	ctx           context.Context
+	cancel        context.CancelFunc
+	config        *Config
+	state         state.Facade
+	minScore      float64
+	validators    []*validatorStatus
+	checkedHeight uint32
+	subscribers   map[int]chan *Alert
+	nextSubID     int
+	eventCh       chan event.Event
+	logger        *logger.SubLogger
+}
+
+// NewWatchdog creates a new watchdog for the validators running on this node.
+// The minScore is the minimum availability score that the proposals are accepted.
+// The eventCh is optional and can be nil.
+func NewWatchdog(conf *Config, st state.Facade, consMgr consensus.ManagerReader,
+	minScore float64, eventCh chan event.Event,
+) Watchdog {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	validators := make([]*validatorStatus, 0)
+	for _, cons := range consMgr.Instances() {
+		validators = append(validators, &validatorStatus{
+			cons:    cons,
+			address: cons.ConsensusKey().ValidatorAddress(),
+			alerts:  make(map[Kind]*Alert),
+		})
+	}
+
+	w := &watchdog{
+		ctx:         ctx,
+		cancel:      cancel,
+		config:      conf,
+		state:       st,
+		minScore:    minScore,
+		validators:  validators,
+		subscribers: make(map[int]chan *Alert),
+		eventCh:     eventCh,
+	}
+	w.logger = logger.NewSubLogger("_watchdog", nil)
+
+	return w
+}
+
+func (w *watchdog) Start() error {
+	if !w.config.Enable {
+		return nil
+	}
+
+	if w.config.EnableMetrics {
+		registerMetrics()
+	}
+
+	go w.checkLoop()
+
+	return nil
+}
+
+func (w *watchdog) Stop() {
+	w.cancel()
+
+	w.lk.Lock()
+	defer w.lk.Unlock()
+
+	for id, ch := range w.subscribers {
+		close(ch)
+		delete(w.subscribers, id)
+	}
+}
+
+func (w *watchdog) checkLoop() {
+	ticker := time.NewTicker(w.config.CheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-w.ctx.Done():
+			return
+
+		case <-ticker.C:
+			w.check(time.Now())
+		}
+	}
+}
+
+func (w *watchdog) ActiveAlerts() []*Alert {
+	w.lk.RLock()
+	defer w.lk.RUnlock()
+
+	alerts := make([]*Alert, 0)
+	for _, vs := range w.validators {
+		for _, kind := range kinds {
+			if alert, ok := vs.alerts[kind]; ok {
+				cloned := *alert
+				alerts = append(alerts, &cloned)
+			}
+		}
+	}
+
+	return alerts
+}
+
+func (w *watchdog) Subscribe() (<-chan *Alert, func()) {
+	w.lk.Lock()
+	defer w.lk.Unlock()
+
+	id := w.nextSubID
+	w.nextSubID++
+	ch := make(chan *Alert, subscriberBufferSize)
+	w.subscribers[id] = ch
+
+	cancel := func() {
+		w.lk.Lock()
+		defer w.lk.Unlock()
+
+		if ch, ok := w.subscribers[id]; ok {
+			close(ch)
+			delete(w.subscribers, id)
+		}
+	}
+
+	return ch, cancel
+}
+
+// check updates the availability status of the validators and raises or resolves the alerts.
+func (w *watchdog) check(now time.Time) {
+	w.lk.Lock()
+	defer w.lk.Unlock()
+
+	lastHeight := w.state.LastBlockHeight()
+	blockDelay := now.Sub(w.state.LastBlockTime())
+	synced := blockDelay <= w.config.MaxBlockDelay
+	updateSyncedMetric(synced)
+
+	w.scanCertificates(lastHeight)
+
+	for _, vs := range w.validators {
+		w.checkValidator(vs, lastHeight, synced, blockDelay, now)
+	}
+}
+
+// scanCertificates counts the consecutive absences of the validators
+// in the certificates that are committed since the last check.
+func (w *watchdog) scanCertificates(lastHeight uint32) {
+	from := w.checkedHeight + 1
+	if lastHeight > maxScannedHeights && from <= lastHeight-maxScannedHeights {
+		from = lastHeight - maxScannedHeights + 1
+	}
+	w.checkedHeight = lastHeight
+
+	numbers := make([]int32, len(w.validators))
+	for i, vs := range w.validators {
+		numbers[i] = -1
+		if val := w.state.ValidatorByAddress(vs.address); val != nil {
+			numbers[i] = val.Number()
+		}
+	}
+
+	for height := from; height <= lastHeight; height++ {
+		cert := w.certificate(height, lastHeight)
+		if cert == nil {
+			continue
+		}
+
+		for i, vs := range w.validators {
+			switch {
+			case numbers[i] < 0:
+				vs.absences = 0
+
+			case slices.Contains(cert.Absentees(), numbers[i]):
+				vs.absences++
+
+			case slices.Contains(cert.Committers(), numbers[i]):
+				vs.absences = 0
+			}
+		}
+	}
+}
+
+// certificate returns the certificate of the block at the given height.
+// The certificate is kept in the next block, except for the last block.
+func (w *watchdog) certificate(height, lastHeight uint32) *certificate.BlockCertificate {
+	if height == lastHeight {
+		return w.state.LastCertificate()
+	}
+
+	cb := w.state.CommittedBlock(height + 1)
+	if cb == nil {
+		return nil
+	}
+	blk, err := cb.ToBlock()
+	if err != nil {
+		w.logger.Warn("unable to decode block", "height", height+1, "error", err)
+
+		return nil
+	}
+
+	return blk.PrevCertificate()
+}
+
+func (w *watchdog) checkValidator(vs *validatorStatus, lastHeight uint32,
+	synced bool, blockDelay time.Duration, now time.Time,
+) {
+	inCommittee := w.state.IsInCommittee(vs.address)
+	if !inCommittee {
+		vs.inCommitteeSince = time.Time{}
+	} else if vs.inCommitteeSince.IsZero() {
+		vs.inCommitteeSince = now
+	}
+
+	score := 1.0
+	val := w.state.ValidatorByAddress(vs.address)
+	if val != nil {
+		score = w.state.AvailabilityScore(val.Number())
+	}
+
+	// The vote delay is counted since the validator joined the committee, or since the node started.
+	lastVoteTime := vs.cons.LastVoteTime()
+	voteDelay := time.Duration(0)
+	if inCommittee {
+		voteDelay = now.Sub(vs.inCommitteeSince)
+		if lastVoteTime.After(vs.inCommitteeSince) {
+			voteDelay = now.Sub(lastVoteTime)
+		}
+	}
+
+	updateValidatorMetrics(vs.address, score, vs.absences, voteDelay)
+
+	makeAlert := func(kind Kind, msg string) *Alert {
+		return &Alert{
+			Validator:    vs.address,
+			Kind:         kind,
+			Height:       lastHeight,
+			Time:         now,
+			Score:        score,
+			Absences:     vs.absences,
+			LastVoteTime: lastVoteTime,
+			Message:      msg,
+		}
+	}
+
+	w.updateAlert(vs, !synced, makeAlert(KindNotSynced,
+		fmt.Sprintf("the node is not synced, the last block is committed %s ago",
+			blockDelay.Round(time.Second))))
+
+	w.updateAlert(vs, vs.absences >= w.config.MaxAbsences, makeAlert(KindAbsent,
+		fmt.Sprintf("the validator is absent from the last %d certificates", vs.absences)))
+
+	w.updateAlert(vs, voteDelay > w.config.MaxVoteDelay, makeAlert(KindNoRecentVote,
+		fmt.Sprintf("the validator is in the committee but hasn't voted in the last %s",
+			voteDelay.Round(time.Second))))
+
+	lowScoreMsg := fmt.Sprintf("the availability score %.4f is close to the minimum score %.4f",
+		score, w.minScore)
+	if score < w.minScore {
+		lowScoreMsg = fmt.Sprintf("the availability score %.4f is below the minimum score %.4f, "+
+			"the proposals of the validator are refused", score, w.minScore)
+	}
+	w.updateAlert(vs, val != nil && score < w.minScore+w.config.ScoreMargin,
+		makeAlert(KindLowScore, lowScoreMsg))
+}
+
+// updateAlert raises the alert if the condition holds, or resolves it otherwise.
+// An active alert is kept up to date, but it is published only when it is raised or resolved.
+func (w *watchdog) updateAlert(vs *validatorStatus, raised bool, alert *Alert) {
+	_, active := vs.alerts[alert.Kind]
+
+	switch {
+	case raised:
+		alert.Active = true
+		vs.alerts[alert.Kind] = alert
+		if !active {
+			w.logger.Warn("validator alert raised",
+				"validator", alert.Validator, "kind", alert.Kind, "message", alert.Message)
+			w.publish(alert)
+		}
+
+	case active:
+		delete(vs.alerts, alert.Kind)
+		alert.Message = fmt.Sprintf("%s alert is resolved", alert.Kind)
+		w.logger.Info("validator alert resolved",
+			"validator", alert.Validator, "kind", alert.Kind)
+		w.publish(alert)
+	}
+}
+
+func (w *watchdog) publish(alert *Alert) {
+	updateAlertMetric(alert)
+
+	if w.eventCh != nil {
+		w.eventCh <- event.CreateValidatorAlertEvent(alert.Validator,
+			alert.Height, uint8(alert.Kind), alert.Active)
+	}
+
+	for _, ch := range w.subscribers {
+		cloned := *alert
+		select {
+		case ch <- &cloned:
+		default:
+			w.logger.Debug("subscriber is slow, alert dropped", "kind", alert.Kind)
+		}
+	}
+}
@@ -0,0 +1,286 @@
+package watchdog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pactus-project/pactus/consensus"
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/state"
+	"github.com/pactus-project/pactus/types/certificate"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/pactus-project/pactus/www/nanomsg/event"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testMinScore = 0.666667
+
+// testState overrides the availability scores of the mocked state.
+type testState struct {
+	*state.MockState
+
+	scores map[int32]float64
+}
+
+func (s *testState) AvailabilityScore(valNum int32) float64 {
+	if score, ok := s.scores[valNum]; ok {
+		return score
+	}
+
+	return 1
+}
+
+type testData struct {
+	*testsuite.TestSuite
+
+	state     *testState
+	consMocks []*consensus.MockConsensus
+	valAddrs  []crypto.Address
+	eventCh   chan event.Event
+	watchdog  *watchdog
+	now       time.Time
+}
+
+func setup(t *testing.T, conf *Config) *testData {
+	t.Helper()
+
+	if conf == nil {
+		conf = DefaultConfig()
+	}
+
+	ts := testsuite.NewTestSuite(t)
+	mockState := state.MockingState(ts)
+	st := &testState{
+		MockState: mockState,
+		scores:    make(map[int32]float64),
+	}
+
+	// The first two validators of the committee are running on this node.
+	valKeys := []*bls.ValidatorKey{mockState.TestValKeys[0], mockState.TestValKeys[1]}
+	valAddrs := make([]crypto.Address, 0, len(valKeys))
+	for _, val := range mockState.TestCommittee.Validators()[:2] {
+		mockState.TestStore.UpdateValidator(val)
+		valAddrs = append(valAddrs, val.Address())
+	}
+	consMgr, consMocks := consensus.MockingManager(ts, mockState, valKeys)
+
+	eventCh := make(chan event.Event, 100)
+	w := NewWatchdog(conf, st, consMgr, testMinScore, eventCh).(*watchdog)
+
+	td := &testData{
+		TestSuite: ts,
+		state:     st,
+		consMocks: consMocks,
+		valAddrs:  valAddrs,
+		eventCh:   eventCh,
+		watchdog:  w,
+		now:       time.Now(),
+	}
+	td.commitBlock()
+
+	return td
+}
+
+// commitBlock commits a new block at the current time, with the given absentees in its certificate.
+func (td *testData) commitBlock(absentees ...int32) {
+	height := td.state.LastBlockHeight() + 1
+	blk, _ := td.GenerateTestBlock(height,
+		testsuite.BlockWithPrevCert(td.state.LastCertificate()),
+		testsuite.BlockWithTime(td.now))
+
+	committers := make([]int32, 0)
+	for _, val := range td.state.CommitteeValidators() {
+		committers = append(committers, val.Number())
+	}
+	cert := certificate.NewBlockCertificate(height, 0)
+	cert.SetSignature(committers, absentees, td.RandBLSSignature())
+
+	td.state.TestStore.SaveBlock(blk, cert)
+}
+
+func (td *testData) check() {
+	td.watchdog.check(td.now)
+}
+
+func (td *testData) elapse(d time.Duration) {
+	td.now = td.now.Add(d)
+}
+
+func (td *testData) activeAlert(addr crypto.Address, kind Kind) *Alert {
+	for _, alert := range td.watchdog.ActiveAlerts() {
+		if alert.Validator == addr && alert.Kind == kind {
+			return alert
+		}
+	}
+
+	return nil
+}
+
+func (td *testData) drainEvents() int {
+	count := 0
+	for {
+		select {
+		case <-td.eventCh:
+			count++
+		default:
+			return count
+		}
+	}
+}
+
+func TestNoAlert(t *testing.T) {
+	td := setup(t, nil)
+
+	td.check()
+
+	assert.Empty(t, td.watchdog.ActiveAlerts())
+	assert.Zero(t, td.drainEvents())
+}
+
+func TestNotSynced(t *testing.T) {
+	td := setup(t, nil)
+
+	td.elapse(2 * time.Minute)
+	td.check()
+
+	for _, addr := range td.valAddrs {
+		alert := td.activeAlert(addr, KindNotSynced)
+		require.NotNil(t, alert)
+		assert.True(t, alert.Active)
+		assert.Contains(t, alert.Message, "not synced")
+	}
+	assert.Equal(t, 2, td.drainEvents())
+
+	// Still not synced, the alerts are not published again.
+	td.elapse(time.Minute)
+	td.check()
+	assert.Zero(t, td.drainEvents())
+
+	td.commitBlock()
+	td.check()
+	assert.Empty(t, td.watchdog.ActiveAlerts())
+	assert.Equal(t, 2, td.drainEvents())
+}
+
+func TestAbsent(t *testing.T) {
+	td := setup(t, nil)
+
+	absentee := td.state.ValidatorByAddress(td.valAddrs[0]).Number()
+	td.commitBlock(absentee)
+	td.commitBlock(absentee)
+	td.check()
+	assert.Nil(t, td.activeAlert(td.valAddrs[0], KindAbsent))
+
+	// More than one certificate is scanned in each check.
+	td.commitBlock(absentee)
+	td.commitBlock(absentee)
+	td.check()
+
+	alert := td.activeAlert(td.valAddrs[0], KindAbsent)
+	require.NotNil(t, alert)
+	assert.Equal(t, 4, alert.Absences)
+	assert.Equal(t, td.state.LastBlockHeight(), alert.Height)
+	assert.Nil(t, td.activeAlert(td.valAddrs[1], KindAbsent))
+
+	td.commitBlock()
+	td.check()
+	assert.Nil(t, td.activeAlert(td.valAddrs[0], KindAbsent))
+}
+
+func TestNoRecentVote(t *testing.T) {
+	conf := DefaultConfig()
+	conf.MaxBlockDelay = time.Hour
+	td := setup(t, conf)
+
+	td.check()
+	td.elapse(conf.MaxVoteDelay + time.Second)
+	td.check()
+
+	for _, addr := range td.valAddrs {
+		alert := td.activeAlert(addr, KindNoRecentVote)
+		require.NotNil(t, alert)
+		assert.True(t, alert.LastVoteTime.IsZero())
+	}
+
+	td.consMocks[0].LastVote = td.now
+	td.elapse(time.Second)
+	td.check()
+
+	assert.Nil(t, td.activeAlert(td.valAddrs[0], KindNoRecentVote))
+	assert.NotNil(t, td.activeAlert(td.valAddrs[1], KindNoRecentVote))
+}
+
+func TestLowScore(t *testing.T) {
+	td := setup(t, nil)
+
+	valNum := td.state.ValidatorByAddress(td.valAddrs[0]).Number()
+	td.state.scores[valNum] = 0.7
+	td.check()
+
+	alert := td.activeAlert(td.valAddrs[0], KindLowScore)
+	require.NotNil(t, alert)
+	assert.Equal(t, 0.7, alert.Score)
+	assert.Contains(t, alert.Message, "close to the minimum")
+	assert.Equal(t, 1, td.drainEvents())
+
+	// The active alert is updated, without publishing it again.
+	td.state.scores[valNum] = 0.5
+	td.check()
+
+	alert = td.activeAlert(td.valAddrs[0], KindLowScore)
+	require.NotNil(t, alert)
+	assert.Equal(t, 0.5, alert.Score)
+	assert.Contains(t, alert.Message, "proposals of the validator are refused")
+	assert.Zero(t, td.drainEvents())
+
+	td.state.scores[valNum] = 0.9
+	td.check()
+
+	assert.Nil(t, td.activeAlert(td.valAddrs[0], KindLowScore))
+	assert.Equal(t, 1, td.drainEvents())
+}
+
+func TestSubscribe(t *testing.T) {
+	td := setup(t, nil)
+
+	ch1, cancel1 := td.watchdog.Subscribe()
+	ch2, _ := td.watchdog.Subscribe()
+
+	valNum := td.state.ValidatorByAddress(td.valAddrs[1]).Number()
+	td.state.scores[valNum] = 0.1
+	td.check()
+
+	alert := <-ch1
+	assert.Equal(t, td.valAddrs[1], alert.Validator)
+	assert.Equal(t, KindLowScore, alert.Kind)
+	assert.True(t, alert.Active)
+	assert.Equal(t, alert, <-ch2)
+
+	cancel1()
+	_, ok := <-ch1
+	assert.False(t, ok)
+
+	td.state.scores[valNum] = 1
+	td.check()
+
+	alert = <-ch2
+	assert.False(t, alert.Active)
+
+	td.watchdog.Stop()
+	_, ok = <-ch2
+	assert.False(t, ok)
+}
+
+func TestEventCh(t *testing.T) {
+	td := setup(t, nil)
+
+	valNum := td.state.ValidatorByAddress(td.valAddrs[0]).Number()
+	td.state.scores[valNum] = 0.1
+	td.check()
+
+	e := <-td.eventCh
+	assert.Equal(t, event.CreateValidatorAlertEvent(td.valAddrs[0],
+		td.state.LastBlockHeight(), uint8(KindLowScore), true), e)
+}
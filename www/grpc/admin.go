@@ -0,0 +1,176 @@
+package grpc
+
+import (
+	"context"
+	"encoding/hex"
+	"net"
+	"time"
+
+	lp2ppeer "github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr/net"
+	"github.com/pactus-project/pactus/sync/peerset/peer"
+	peerstatus "github.com/pactus-project/pactus/sync/peerset/peer/status"
+	"github.com/pactus-project/pactus/util/ipblocker"
+	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type adminServer struct {
+	*Server
+}
+
+func newAdminServer(server *Server) *adminServer {
+	return &adminServer{
+		Server: server,
+	}
+}
+
+func (s *adminServer) ConnectPeer(_ context.Context,
+	req *pactus.ConnectPeerRequest,
+) (*pactus.ConnectPeerResponse, error) {
+	if err := s.net.ConnectPeer(req.Address); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "unable to connect: %v", err)
+	}
+
+	return &pactus.ConnectPeerResponse{}, nil
+}
+
+func (s *adminServer) DisconnectPeer(_ context.Context,
+	req *pactus.DisconnectPeerRequest,
+) (*pactus.DisconnectPeerResponse, error) {
+	pid, err := parsePeerID(req.PeerId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid peer ID: %v", err)
+	}
+
+	s.net.CloseConnection(pid)
+
+	return &pactus.DisconnectPeerResponse{}, nil
+}
+
+func (s *adminServer) BanPeer(_ context.Context,
+	req *pactus.BanPeerRequest,
+) (*pactus.BanPeerResponse, error) {
+	duration := time.Duration(req.Duration) * time.Second
+
+	if ipNet, err := ipblocker.ParseNet(req.Target); err == nil {
+		if err := s.net.BanNet(ipNet.String(), duration, req.Reason); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "unable to ban: %v", err)
+		}
+
+		for _, pid := range s.peersInNet(ipNet) {
+			s.sync.PeerSet().UpdateStatus(pid, peerstatus.StatusBanned)
+		}
+
+		return &pactus.BanPeerResponse{}, nil
+	}
+
+	pid, err := parsePeerID(req.Target)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid target: %v", err)
+	}
+
+	s.net.BanPeer(pid, duration, req.Reason)
+	if s.sync.PeerSet().GetPeer(pid) != nil {
+		s.sync.PeerSet().UpdateStatus(pid, peerstatus.StatusBanned)
+	}
+
+	return &pactus.BanPeerResponse{}, nil
+}
+
+func (s *adminServer) UnbanPeer(_ context.Context,
+	req *pactus.UnbanPeerRequest,
+) (*pactus.UnbanPeerResponse, error) {
+	if ipNet, err := ipblocker.ParseNet(req.Target); err == nil {
+		if !s.net.UnbanNet(ipNet.String()) {
+			return nil, status.Errorf(codes.NotFound, "network is not banned")
+		}
+
+		for _, pid := range s.peersInNet(ipNet) {
+			s.clearBannedStatus(pid)
+		}
+
+		return &pactus.UnbanPeerResponse{}, nil
+	}
+
+	pid, err := parsePeerID(req.Target)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid target: %v", err)
+	}
+
+	if !s.net.UnbanPeer(pid) {
+		return nil, status.Errorf(codes.NotFound, "peer is not banned")
+	}
+	s.clearBannedStatus(pid)
+
+	return &pactus.UnbanPeerResponse{}, nil
+}
+
+func (s *adminServer) ListBans(_ context.Context,
+	_ *pactus.ListBansRequest,
+) (*pactus.ListBansResponse, error) {
+	bans := s.net.Bans()
+	infos := make([]*pactus.BanInfo, 0, len(bans))
+	for _, ban := range bans {
+		info := &pactus.BanInfo{
+			Target: ban.Target,
+			Reason: ban.Reason,
+		}
+		if !ban.BannedUntil.IsZero() {
+			info.BannedUntil = ban.BannedUntil.Unix()
+		}
+		infos = append(infos, info)
+	}
+
+	return &pactus.ListBansResponse{Bans: infos}, nil
+}
+
+// peersInNet returns the peers in the peer set whose address is inside the network.
+func (s *adminServer) peersInNet(ipNet *net.IPNet) []peer.ID {
+	pids := make([]peer.ID, 0)
+	s.sync.PeerSet().IteratePeers(func(p *peer.Peer) bool {
+		ma, err := multiaddr.NewMultiaddr(p.Address)
+		if err != nil {
+			return false
+		}
+		ip, err := manet.ToIP(ma)
+		if err != nil {
+			return false
+		}
+		if ipNet.Contains(ip) {
+			pids = append(pids, p.PeerID)
+		}
+
+		return false
+	})
+
+	return pids
+}
+
+// clearBannedStatus marks the unbanned peer as disconnected, unless it is still banned.
+func (s *adminServer) clearBannedStatus(pid peer.ID) {
+	if s.net.IsPeerBanned(pid) {
+		return
+	}
+
+	if s.sync.PeerSet().GetPeerStatus(pid).IsBanned() {
+		s.sync.PeerSet().UpdateStatus(pid, peerstatus.StatusDisconnected)
+	}
+}
+
+// parsePeerID parses a peer ID in base58 format, or the hex format used by the network service.
+func parsePeerID(str string) (peer.ID, error) {
+	pid, err := lp2ppeer.Decode(str)
+	if err == nil {
+		return pid, nil
+	}
+
+	data, hexErr := hex.DecodeString(str)
+	if hexErr != nil {
+		return "", err
+	}
+
+	return lp2ppeer.IDFromBytes(data)
+}
@@ -0,0 +1,156 @@
+package grpc
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+
+	"github.com/pactus-project/pactus/sync/peerset/peer/status"
+	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
+	"github.com/stretchr/testify/assert"
+)
+
+func adminTestConfig() *Config {
+	conf := testConfig()
+	conf.EnableAdmin = true
+
+	return conf
+}
+
+func TestAdminConfig(t *testing.T) {
+	conf := DefaultConfig()
+	conf.EnableAdmin = true
+	assert.Error(t, conf.BasicCheck())
+
+	conf.BasicAuth = "user:$2y$10$5Kjd955BDWLouqckHzBjKuCF6hFOUD61lhm8QpjDVHTUwMIrYUdq2"
+	assert.NoError(t, conf.BasicCheck())
+}
+
+func TestAdminDisabled(t *testing.T) {
+	td := setup(t, nil)
+	conn, client := td.adminClient(t)
+
+	_, err := client.ListBans(context.Background(), &pactus.ListBansRequest{})
+	assert.Error(t, err)
+
+	assert.Nil(t, conn.Close(), "Error closing connection")
+	td.StopServer()
+}
+
+func TestConnectPeer(t *testing.T) {
+	td := setup(t, adminTestConfig())
+	conn, client := td.adminClient(t)
+
+	t.Run("Invalid address", func(t *testing.T) {
+		_, err := client.ConnectPeer(context.Background(),
+			&pactus.ConnectPeerRequest{Address: "/ip4/1.2.3.4/tcp/21888"})
+		assert.Error(t, err)
+	})
+
+	t.Run("Should connect", func(t *testing.T) {
+		addr := "/ip4/1.2.3.4/tcp/21888/p2p/" + td.RandPeerID().String()
+		_, err := client.ConnectPeer(context.Background(),
+			&pactus.ConnectPeerRequest{Address: addr})
+		assert.NoError(t, err)
+		assert.Contains(t, td.mockNet.Connected, addr)
+	})
+
+	assert.Nil(t, conn.Close(), "Error closing connection")
+	td.StopServer()
+}
+
+func TestDisconnectPeer(t *testing.T) {
+	td := setup(t, adminTestConfig())
+	conn, client := td.adminClient(t)
+
+	t.Run("Invalid peer ID", func(t *testing.T) {
+		_, err := client.DisconnectPeer(context.Background(),
+			&pactus.DisconnectPeerRequest{PeerId: "invalid"})
+		assert.Error(t, err)
+	})
+
+	t.Run("Should accept hex peer ID", func(t *testing.T) {
+		pid := td.RandPeerID()
+		_, err := client.DisconnectPeer(context.Background(),
+			&pactus.DisconnectPeerRequest{PeerId: hex.EncodeToString([]byte(pid))})
+		assert.NoError(t, err)
+	})
+
+	assert.Nil(t, conn.Close(), "Error closing connection")
+	td.StopServer()
+}
+
+func TestBanAndUnbanPeer(t *testing.T) {
+	td := setup(t, adminTestConfig())
+	conn, client := td.adminClient(t)
+
+	ps := td.mockSync.PeerSet()
+	pid := td.RandPeerID()
+	ps.UpdateStatus(pid, status.StatusConnected)
+
+	t.Run("Invalid target", func(t *testing.T) {
+		_, err := client.BanPeer(context.Background(),
+			&pactus.BanPeerRequest{Target: "invalid"})
+		assert.Error(t, err)
+	})
+
+	t.Run("Ban peer", func(t *testing.T) {
+		_, err := client.BanPeer(context.Background(),
+			&pactus.BanPeerRequest{Target: pid.String(), Duration: 3600, Reason: "spamming"})
+		assert.NoError(t, err)
+		assert.True(t, td.mockNet.IsPeerBanned(pid))
+		assert.Equal(t, status.StatusBanned, ps.GetPeerStatus(pid))
+	})
+
+	t.Run("Ban network", func(t *testing.T) {
+		other := td.RandPeerID()
+		ps.UpdateAddress(other, "/ip4/8.8.8.8/tcp/21888", "inbound")
+		ps.UpdateStatus(other, status.StatusConnected)
+
+		_, err := client.BanPeer(context.Background(),
+			&pactus.BanPeerRequest{Target: "8.8.8.0/24", Reason: "spamming"})
+		assert.NoError(t, err)
+		assert.Equal(t, status.StatusBanned, ps.GetPeerStatus(other))
+
+		_, err = client.UnbanPeer(context.Background(),
+			&pactus.UnbanPeerRequest{Target: "8.8.8.0/24"})
+		assert.NoError(t, err)
+		assert.Equal(t, status.StatusDisconnected, ps.GetPeerStatus(other))
+	})
+
+	t.Run("List bans", func(t *testing.T) {
+		_, err := client.BanPeer(context.Background(),
+			&pactus.BanPeerRequest{Target: "1.2.3.4", Duration: 60})
+		assert.NoError(t, err)
+
+		res, err := client.ListBans(context.Background(), &pactus.ListBansRequest{})
+		assert.NoError(t, err)
+
+		targets := make([]string, 0, len(res.Bans))
+		for _, ban := range res.Bans {
+			targets = append(targets, ban.Target)
+		}
+		assert.ElementsMatch(t, []string{pid.String(), "1.2.3.4/32"}, targets)
+	})
+
+	t.Run("Unban peer", func(t *testing.T) {
+		_, err := client.UnbanPeer(context.Background(),
+			&pactus.UnbanPeerRequest{Target: pid.String()})
+		assert.NoError(t, err)
+		assert.False(t, td.mockNet.IsPeerBanned(pid))
+		assert.Equal(t, status.StatusDisconnected, ps.GetPeerStatus(pid))
+	})
+
+	t.Run("Unban a peer that is not banned", func(t *testing.T) {
+		_, err := client.UnbanPeer(context.Background(),
+			&pactus.UnbanPeerRequest{Target: pid.String()})
+		assert.Error(t, err)
+
+		_, err = client.UnbanPeer(context.Background(),
+			&pactus.UnbanPeerRequest{Target: "5.6.7.8"})
+		assert.Error(t, err)
+	})
+
+	assert.Nil(t, conn.Close(), "Error closing connection")
+	td.StopServer()
+}
@@ -4,11 +4,16 @@ import (
 	"context"
 	"encoding/hex"
 
+	"github.com/pactus-project/pactus/consensus"
 	"github.com/pactus-project/pactus/crypto"
 	"github.com/pactus-project/pactus/crypto/hash"
+	"github.com/pactus-project/pactus/sortition"
+	"github.com/pactus-project/pactus/store"
 	"github.com/pactus-project/pactus/types/account"
+	"github.com/pactus-project/pactus/types/evidence"
 	"github.com/pactus-project/pactus/types/validator"
 	"github.com/pactus-project/pactus/types/vote"
+	"github.com/pactus-project/pactus/watchdog"
 	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -44,6 +49,7 @@ func (s *blockchainServer) GetBlockchainInfo(_ context.Context,
 		PruningHeight:       s.state.PruningHeight(),
 		LastBlockTime:       s.state.LastBlockTime().Unix(),
 		CommitteeValidators: cv,
+		HistoryHeight:       s.state.HistoryHeight(),
 	}, nil
 }
 
@@ -267,9 +273,303 @@ func (s *blockchainServer) GetTxPoolContent(_ context.Context,
 	}, nil
 }
 
+func (s *blockchainServer) GetEvidence(_ context.Context,
+	req *pactus.GetEvidenceRequest,
+) (*pactus.GetEvidenceResponse, error) {
+	var offender *crypto.Address
+	if req.Offender != "" {
+		addr, err := crypto.AddressFromString(req.Offender)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid validator address: %v", err.Error())
+		}
+		offender = &addr
+	}
+
+	result := make([]*pactus.EvidenceInfo, 0)
+	for _, evd := range s.evdPool.AllEvidence() {
+		if offender != nil && evd.Offender() != *offender {
+			continue
+		}
+		result = append(result, s.evidenceToProto(evd))
+	}
+
+	return &pactus.GetEvidenceResponse{
+		Evidence: result,
+	}, nil
+}
+
+func (s *blockchainServer) GetValidatorRewards(_ context.Context,
+	req *pactus.GetValidatorRewardsRequest,
+) (*pactus.GetValidatorRewardsResponse, error) {
+	addr, err := crypto.AddressFromString(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %v", err.Error())
+	}
+	if req.ToHeight != 0 && req.FromHeight > req.ToHeight {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid height range")
+	}
+	historyHeight := s.state.HistoryHeight()
+	if (req.FromHeight != 0 && req.FromHeight < historyHeight) ||
+		(req.ToHeight != 0 && req.ToHeight < historyHeight) {
+		return nil, status.Errorf(codes.OutOfRange, "rewards are recorded from height %d", historyHeight)
+	}
+
+	var info *store.RewardInfo
+	if addr.IsValidatorAddress() {
+		val := s.state.ValidatorByAddress(addr)
+		if val == nil {
+			return nil, status.Errorf(codes.NotFound, "validator not found")
+		}
+		info = s.state.ValidatorRewards(val.Number(), req.FromHeight, req.ToHeight)
+	} else {
+		info = s.state.RewardAddressRewards(addr, req.FromHeight, req.ToHeight)
+	}
+
+	if info == nil {
+		return nil, status.Errorf(codes.Internal, "unable to retrieve the rewards")
+	}
+
+	return &pactus.GetValidatorRewardsResponse{
+		BlocksProposed: info.BlocksProposed,
+		BlockRewards:   info.BlockRewards.ToNanoPAC(),
+		Fees:           info.Fees.ToNanoPAC(),
+		TotalRewards:   (info.BlockRewards + info.Fees).ToNanoPAC(),
+		HistoryHeight:  historyHeight,
+	}, nil
+}
+
+func (s *blockchainServer) GetCommittee(_ context.Context,
+	req *pactus.GetCommitteeRequest,
+) (*pactus.GetCommitteeResponse, error) {
+	height := req.Height
+	if height == 0 {
+		height = s.state.LastBlockHeight() + 1
+	}
+	if historyHeight := s.state.HistoryHeight(); height < historyHeight {
+		return nil, status.Errorf(codes.OutOfRange, "committee history is recorded from height %d", historyHeight)
+	}
+
+	info := s.state.CommitteeInfo(height)
+	if info == nil {
+		return nil, status.Errorf(codes.NotFound, "committee not found")
+	}
+
+	members := make([]*pactus.CommitteeMemberInfo, 0, len(info.Members))
+	for _, m := range info.Members {
+		members = append(members, s.committeeMemberToProto(m))
+	}
+	left := make([]*pactus.CommitteeMemberInfo, 0, len(info.Left))
+	for _, m := range info.Left {
+		left = append(left, s.committeeMemberToProto(m))
+	}
+
+	return &pactus.GetCommitteeResponse{
+		Height:        height,
+		ChangedHeight: info.Height,
+		Members:       members,
+		Left:          left,
+	}, nil
+}
+
+func (s *blockchainServer) GetSortitionEstimate(_ context.Context,
+	req *pactus.GetSortitionEstimateRequest,
+) (*pactus.GetSortitionEstimateResponse, error) {
+	res := &pactus.GetSortitionEstimateResponse{}
+	totalPower := s.state.TotalPower()
+
+	if req.Address != "" {
+		addr, err := crypto.AddressFromString(req.Address)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid address: %v", err.Error())
+		}
+		val := s.state.ValidatorByAddress(addr)
+		if val == nil {
+			return nil, status.Errorf(codes.NotFound, "validator not found")
+		}
+
+		res.Power = val.Power()
+		res.InCommittee = s.state.IsInCommittee(addr)
+
+		// The sortition transaction is accepted once the bonding period is over.
+		currentHeight := s.state.LastBlockHeight() + 1
+		bondedHeight := val.LastBondingHeight() + s.state.Params().BondInterval
+		if currentHeight < bondedHeight {
+			res.BondingBlocks = bondedHeight - currentHeight
+		}
+	} else {
+		// The stake of a new validator is added to the total power.
+		res.Power = req.Stake
+		res.BondingBlocks = s.state.Params().BondInterval
+		totalPower += req.Stake
+	}
+
+	if res.Power <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "validator has no power")
+	}
+
+	res.TotalPower = totalPower
+	res.ProbabilityPerBlock = sortition.SelectionProbability(res.Power, totalPower)
+	res.ExpectedBlocks = float64(res.BondingBlocks) + sortition.ExpectedBlocks(res.Power, totalPower)
+	res.ExpectedSeconds = res.ExpectedBlocks * s.state.Params().BlockInterval().Seconds()
+
+	return res, nil
+}
+
+func (s *blockchainServer) committeeMemberToProto(m store.CommitteeMember) *pactus.CommitteeMemberInfo {
+	info := &pactus.CommitteeMemberInfo{
+		ValidatorNumber: m.ValidatorNumber,
+		JoinedHeight:    m.JoinedHeight,
+		LeftHeight:      m.LeftHeight,
+	}
+	if val := s.state.ValidatorByNumber(m.ValidatorNumber); val != nil {
+		info.ValidatorAddress = val.Address().String()
+	}
+
+	return info
+}
+
+func (s *blockchainServer) GetConsensusHistory(_ context.Context,
+	req *pactus.GetConsensusHistoryRequest,
+) (*pactus.GetConsensusHistoryResponse, error) {
+	count := int(req.Count)
+	if count == 0 {
+		count = 10
+	}
+
+	instances := make([]*pactus.ConsensusHistory, 0)
+	for _, cons := range s.consMgr.Instances() {
+		stats := cons.History(count)
+		heights := make([]*pactus.ConsensusHeightStats, 0, len(stats))
+		for _, hs := range stats {
+			heights = append(heights, s.heightStatsToProto(hs))
+		}
+
+		instances = append(instances,
+			&pactus.ConsensusHistory{
+				Address: cons.ConsensusKey().ValidatorAddress().String(),
+				Heights: heights,
+			})
+	}
+
+	return &pactus.GetConsensusHistoryResponse{Instances: instances}, nil
+}
+
+func (s *blockchainServer) GetValidatorAlerts(_ context.Context,
+	_ *pactus.GetValidatorAlertsRequest,
+) (*pactus.GetValidatorAlertsResponse, error) {
+	alerts := make([]*pactus.ValidatorAlert, 0)
+	for _, alert := range s.watchdog.ActiveAlerts() {
+		alerts = append(alerts, s.alertToProto(alert))
+	}
+
+	return &pactus.GetValidatorAlertsResponse{Alerts: alerts}, nil
+}
+
+func (s *blockchainServer) WatchValidatorAlerts(_ *pactus.WatchValidatorAlertsRequest,
+	stream pactus.Blockchain_WatchValidatorAlertsServer,
+) error {
+	// Subscribing before sending the active alerts, so no alert is missed in between.
+	alertCh, cancel := s.watchdog.Subscribe()
+	defer cancel()
+
+	for _, alert := range s.watchdog.ActiveAlerts() {
+		if err := stream.Send(s.alertToProto(alert)); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+
+		case alert, ok := <-alertCh:
+			if !ok {
+				return status.Errorf(codes.Unavailable, "watchdog is stopped")
+			}
+			if err := stream.Send(s.alertToProto(alert)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (*blockchainServer) alertToProto(alert *watchdog.Alert) *pactus.ValidatorAlert {
+	lastVoteTime := int64(0)
+	if !alert.LastVoteTime.IsZero() {
+		lastVoteTime = alert.LastVoteTime.Unix()
+	}
+
+	return &pactus.ValidatorAlert{
+		ValidatorAddress:  alert.Validator.String(),
+		Kind:              pactus.ValidatorAlertKind(alert.Kind),
+		Active:            alert.Active,
+		Height:            alert.Height,
+		Time:              alert.Time.Unix(),
+		AvailabilityScore: alert.Score,
+		Absences:          int32(alert.Absences),
+		LastVoteTime:      lastVoteTime,
+		Message:           alert.Message,
+	}
+}
+
+func (*blockchainServer) heightStatsToProto(hs *consensus.HeightStats) *pactus.ConsensusHeightStats {
+	phaseDurations := make(map[string]int64, len(hs.PhaseDurations))
+	for phase, d := range hs.PhaseDurations {
+		phaseDurations[phase] = d.Milliseconds()
+	}
+	timeouts := make(map[string]int32, len(hs.Timeouts))
+	for target, n := range hs.Timeouts {
+		timeouts[target] = int32(n)
+	}
+	receivedVotes := make(map[string]int32, len(hs.ReceivedVotes))
+	for typ, n := range hs.ReceivedVotes {
+		receivedVotes[typ] = int32(n)
+	}
+
+	return &pactus.ConsensusHeightStats{
+		Height:                 hs.Height,
+		Rounds:                 int32(hs.Rounds),
+		Committed:              hs.Committed,
+		StartTime:              hs.StartTime.Unix(),
+		Duration:               hs.Duration.Milliseconds(),
+		PhaseDurations:         phaseDurations,
+		Timeouts:               timeouts,
+		ReceivedVotes:          receivedVotes,
+		SentQueryVotes:         int32(hs.SentQueryVotes),
+		SentQueryProposals:     int32(hs.SentQueryProposals),
+		ReceivedQueryVotes:     int32(hs.ReceivedQueryVotes),
+		ReceivedQueryProposals: int32(hs.ReceivedQueryProposals),
+	}
+}
+
+func (s *blockchainServer) evidenceToProto(evd *evidence.Evidence) *pactus.EvidenceInfo {
+	data, _ := evd.Bytes()
+
+	return &pactus.EvidenceInfo{
+		Hash:     evd.Hash().String(),
+		Data:     hex.EncodeToString(data),
+		Offender: evd.Offender().String(),
+		Height:   evd.Height(),
+		Round:    int32(evd.Round()),
+		VoteA:    s.voteToProto(evd.VoteA()),
+		VoteB:    s.voteToProto(evd.VoteB()),
+	}
+}
+
 func (s *blockchainServer) validatorToProto(val *validator.Validator) *pactus.ValidatorInfo {
 	data, _ := val.Bytes()
 
+	var metadata *pactus.ValidatorMetadata
+	if meta := s.state.ValidatorMetadata(val.Address()); meta != nil {
+		metadata = &pactus.ValidatorMetadata{
+			Moniker:       meta.Moniker,
+			Website:       meta.Website,
+			Contact:       meta.Contact,
+			RewardAddress: meta.RewardAddress.String(),
+		}
+	}
+
 	return &pactus.ValidatorInfo{
 		Hash:                val.Hash().String(),
 		Data:                hex.EncodeToString(data),
@@ -281,6 +581,7 @@ func (s *blockchainServer) validatorToProto(val *validator.Validator) *pactus.Va
 		LastSortitionHeight: val.LastSortitionHeight(),
 		UnbondingHeight:     val.UnbondingHeight(),
 		AvailabilityScore:   s.state.AvailabilityScore(val.Number()),
+		Metadata:            metadata,
 	}
 }
 
@@ -4,9 +4,16 @@ import (
 	"context"
 	"encoding/hex"
 	"testing"
+	"time"
 
+	"github.com/pactus-project/pactus/consensus"
+	"github.com/pactus-project/pactus/types/validator"
+	"github.com/pactus-project/pactus/watchdog"
 	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestGetBlock(t *testing.T) {
@@ -153,6 +160,7 @@ func TestGetBlockHeight(t *testing.T) {
 func TestGetBlockchainInfo(t *testing.T) {
 	td := setup(t, nil)
 	conn, client := td.blockchainClient(t)
+	td.mockState.TestStore.SetHistoryHeight(1)
 
 	t.Run("Should return the last block height", func(t *testing.T) {
 		res, err := client.GetBlockchainInfo(context.Background(),
@@ -163,6 +171,7 @@ func TestGetBlockchainInfo(t *testing.T) {
 		assert.NotEmpty(t, res.LastBlockHash)
 		assert.Zero(t, res.PruningHeight)
 		assert.False(t, res.IsPruned)
+		assert.Equal(t, uint32(1), res.HistoryHeight)
 	})
 
 	assert.Nil(t, conn.Close(), "Error closing connection")
@@ -234,6 +243,27 @@ func TestGetValidator(t *testing.T) {
 		assert.NoError(t, err)
 		assert.NotNil(t, res)
 		assert.Equal(t, val1.PublicKey().String(), res.GetValidator().PublicKey)
+		assert.Nil(t, res.GetValidator().Metadata)
+	})
+
+	t.Run("Should return validator with metadata", func(t *testing.T) {
+		meta := &validator.Metadata{
+			Moniker:       "moniker",
+			Website:       "https://pactus.org",
+			Contact:       "info@pactus.org",
+			RewardAddress: td.RandAccAddress(),
+		}
+		td.mockState.TestStore.UpdateValidatorMetadata(val1.Address(), meta)
+
+		res, err := client.GetValidator(context.Background(),
+			&pactus.GetValidatorRequest{Address: val1.Address().String()})
+
+		assert.NoError(t, err)
+		require.NotNil(t, res.GetValidator().Metadata)
+		assert.Equal(t, meta.Moniker, res.GetValidator().Metadata.Moniker)
+		assert.Equal(t, meta.Website, res.GetValidator().Metadata.Website)
+		assert.Equal(t, meta.Contact, res.GetValidator().Metadata.Contact)
+		assert.Equal(t, meta.RewardAddress.String(), res.GetValidator().Metadata.RewardAddress)
 	})
 
 	assert.Nil(t, conn.Close(), "Error closing connection")
@@ -356,3 +386,368 @@ func TestConsensusInfo(t *testing.T) {
 	assert.Nil(t, conn.Close(), "Error closing connection")
 	td.StopServer()
 }
+
+func TestGetEvidence(t *testing.T) {
+	td := setup(t, nil)
+	conn, client := td.blockchainClient(t)
+
+	evd1, _ := td.GenerateTestEvidence(100, 2)
+	evd2, _ := td.GenerateTestEvidence(101, 0)
+	assert.NoError(t, td.mockEvdPool.AddEvidence(evd1))
+	assert.NoError(t, td.mockEvdPool.AddEvidence(evd2))
+
+	t.Run("Should return error for non-parsable address", func(t *testing.T) {
+		res, err := client.GetEvidence(context.Background(),
+			&pactus.GetEvidenceRequest{Offender: "invalid"})
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should return all evidence", func(t *testing.T) {
+		res, err := client.GetEvidence(context.Background(),
+			&pactus.GetEvidenceRequest{})
+
+		assert.NoError(t, err)
+		assert.Len(t, res.Evidence, 2)
+	})
+
+	t.Run("Should filter evidence by offender", func(t *testing.T) {
+		res, err := client.GetEvidence(context.Background(),
+			&pactus.GetEvidenceRequest{Offender: evd1.Offender().String()})
+
+		assert.NoError(t, err)
+		require.Len(t, res.Evidence, 1)
+
+		info := res.Evidence[0]
+		assert.Equal(t, evd1.Hash().String(), info.Hash)
+		assert.Equal(t, evd1.Offender().String(), info.Offender)
+		assert.Equal(t, uint32(100), info.Height)
+		assert.Equal(t, int32(2), info.Round)
+		assert.Equal(t, pactus.VoteType_VOTE_PREPARE, info.VoteA.Type)
+		assert.Equal(t, evd1.VoteB().BlockHash().String(), info.VoteB.BlockHash)
+	})
+
+	assert.Nil(t, conn.Close(), "Error closing connection")
+	td.StopServer()
+}
+
+func TestGetValidatorRewards(t *testing.T) {
+	td := setup(t, nil)
+	conn, client := td.blockchainClient(t)
+
+	val := td.mockState.TestStore.AddTestValidator()
+	rewardAddr := td.RandAccAddress()
+	td.mockState.TestStore.SetHistoryHeight(5)
+	td.mockState.TestStore.SaveReward(10, val.Number(), rewardAddr, 1e9, 100)
+	td.mockState.TestStore.SaveReward(20, val.Number(), rewardAddr, 1e9, 200)
+
+	t.Run("Should return error for non-parsable address", func(t *testing.T) {
+		res, err := client.GetValidatorRewards(context.Background(),
+			&pactus.GetValidatorRewardsRequest{Address: "invalid"})
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should return error for invalid height range", func(t *testing.T) {
+		res, err := client.GetValidatorRewards(context.Background(),
+			&pactus.GetValidatorRewardsRequest{
+				Address:    val.Address().String(),
+				FromHeight: 20,
+				ToHeight:   10,
+			})
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should return error for range before the history height", func(t *testing.T) {
+		res, err := client.GetValidatorRewards(context.Background(),
+			&pactus.GetValidatorRewardsRequest{
+				Address:    val.Address().String(),
+				FromHeight: 4,
+			})
+
+		assert.Equal(t, codes.OutOfRange, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should return error for unknown validator", func(t *testing.T) {
+		res, err := client.GetValidatorRewards(context.Background(),
+			&pactus.GetValidatorRewardsRequest{Address: td.RandValAddress().String()})
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should return validator rewards", func(t *testing.T) {
+		res, err := client.GetValidatorRewards(context.Background(),
+			&pactus.GetValidatorRewardsRequest{Address: val.Address().String()})
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint32(2), res.BlocksProposed)
+		assert.Equal(t, int64(2e9), res.BlockRewards)
+		assert.Equal(t, int64(300), res.Fees)
+		assert.Equal(t, int64(2e9+300), res.TotalRewards)
+		assert.Equal(t, uint32(5), res.HistoryHeight)
+	})
+
+	t.Run("Should return reward address rewards in range", func(t *testing.T) {
+		res, err := client.GetValidatorRewards(context.Background(),
+			&pactus.GetValidatorRewardsRequest{
+				Address:    rewardAddr.String(),
+				FromHeight: 15,
+			})
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint32(1), res.BlocksProposed)
+		assert.Equal(t, int64(1e9), res.BlockRewards)
+		assert.Equal(t, int64(200), res.Fees)
+	})
+
+	assert.Nil(t, conn.Close(), "Error closing connection")
+	td.StopServer()
+}
+
+func TestGetConsensusHistory(t *testing.T) {
+	td := setup(t, nil)
+	conn, client := td.blockchainClient(t)
+
+	for height := uint32(1); height <= 12; height++ {
+		td.consMocks[0].Stats = append(td.consMocks[0].Stats, &consensus.HeightStats{
+			Height:         height,
+			Rounds:         1,
+			Committed:      true,
+			StartTime:      time.Unix(int64(height)*10, 0),
+			Duration:       10 * time.Second,
+			PhaseDurations: map[string]time.Duration{"prepare": 2 * time.Second},
+			Timeouts:       map[string]int{"query-proposal": 1},
+			ReceivedVotes:  map[string]int{"PREPARE": 3},
+			SentQueryVotes: 2,
+		})
+	}
+
+	t.Run("Should return the last 10 heights by default", func(t *testing.T) {
+		res, err := client.GetConsensusHistory(context.Background(), &pactus.GetConsensusHistoryRequest{})
+		require.NoError(t, err)
+
+		require.Len(t, res.Instances, 2)
+		assert.Empty(t, res.Instances[1].Heights)
+
+		heights := res.Instances[0].Heights
+		require.Len(t, heights, 10)
+		assert.Equal(t, uint32(3), heights[0].Height)
+		assert.Equal(t, uint32(12), heights[9].Height)
+		assert.Equal(t, int32(1), heights[9].Rounds)
+		assert.True(t, heights[9].Committed)
+		assert.Equal(t, int64(120), heights[9].StartTime)
+		assert.Equal(t, int64(10000), heights[9].Duration)
+		assert.Equal(t, int64(2000), heights[9].PhaseDurations["prepare"])
+		assert.Equal(t, int32(1), heights[9].Timeouts["query-proposal"])
+		assert.Equal(t, int32(3), heights[9].ReceivedVotes["PREPARE"])
+		assert.Equal(t, int32(2), heights[9].SentQueryVotes)
+	})
+
+	t.Run("Should return the requested number of heights", func(t *testing.T) {
+		res, err := client.GetConsensusHistory(context.Background(),
+			&pactus.GetConsensusHistoryRequest{Count: 2})
+		require.NoError(t, err)
+
+		heights := res.Instances[0].Heights
+		require.Len(t, heights, 2)
+		assert.Equal(t, uint32(11), heights[0].Height)
+	})
+
+	assert.Nil(t, conn.Close(), "Error closing connection")
+	td.StopServer()
+}
+
+func TestGetValidatorAlerts(t *testing.T) {
+	td := setup(t, nil)
+	conn, client := td.blockchainClient(t)
+
+	t.Run("Should return no alert", func(t *testing.T) {
+		res, err := client.GetValidatorAlerts(context.Background(), &pactus.GetValidatorAlertsRequest{})
+		require.NoError(t, err)
+		assert.Empty(t, res.Alerts)
+	})
+
+	t.Run("Should return the active alerts", func(t *testing.T) {
+		valAddr := td.RandValAddress()
+		td.mockWatchdog.Alerts = []*watchdog.Alert{
+			{
+				Validator: valAddr,
+				Kind:      watchdog.KindAbsent,
+				Active:    true,
+				Height:    100,
+				Time:      time.Unix(1000, 0),
+				Score:     0.9,
+				Absences:  4,
+				Message:   "absent",
+			},
+		}
+
+		res, err := client.GetValidatorAlerts(context.Background(), &pactus.GetValidatorAlertsRequest{})
+		require.NoError(t, err)
+		require.Len(t, res.Alerts, 1)
+
+		alert := res.Alerts[0]
+		assert.Equal(t, valAddr.String(), alert.ValidatorAddress)
+		assert.Equal(t, pactus.ValidatorAlertKind_ALERT_ABSENT, alert.Kind)
+		assert.True(t, alert.Active)
+		assert.Equal(t, uint32(100), alert.Height)
+		assert.Equal(t, int64(1000), alert.Time)
+		assert.Equal(t, 0.9, alert.AvailabilityScore)
+		assert.Equal(t, int32(4), alert.Absences)
+		assert.Zero(t, alert.LastVoteTime)
+		assert.Equal(t, "absent", alert.Message)
+	})
+
+	assert.Nil(t, conn.Close(), "Error closing connection")
+	td.StopServer()
+}
+
+func TestWatchValidatorAlerts(t *testing.T) {
+	td := setup(t, nil)
+	conn, client := td.blockchainClient(t)
+
+	valAddr := td.RandValAddress()
+	td.mockWatchdog.Alerts = []*watchdog.Alert{
+		{Validator: valAddr, Kind: watchdog.KindNotSynced, Active: true},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := client.WatchValidatorAlerts(ctx, &pactus.WatchValidatorAlertsRequest{})
+	require.NoError(t, err)
+
+	// The active alerts are sent first.
+	alert, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, pactus.ValidatorAlertKind_ALERT_NOT_SYNCED, alert.Kind)
+	assert.True(t, alert.Active)
+
+	td.mockWatchdog.Publish(&watchdog.Alert{
+		Validator:    valAddr,
+		Kind:         watchdog.KindNotSynced,
+		Active:       false,
+		LastVoteTime: time.Unix(2000, 0),
+	})
+
+	alert, err = stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, valAddr.String(), alert.ValidatorAddress)
+	assert.False(t, alert.Active)
+	assert.Equal(t, int64(2000), alert.LastVoteTime)
+
+	cancel()
+	assert.Nil(t, conn.Close(), "Error closing connection")
+	td.StopServer()
+}
+
+func TestGetCommittee(t *testing.T) {
+	td := setup(t, nil)
+	conn, client := td.blockchainClient(t)
+
+	val1 := td.mockState.TestStore.AddTestValidator()
+	val2 := td.mockState.TestStore.AddTestValidator()
+	td.mockState.TestStore.SetHistoryHeight(5)
+	td.mockState.TestStore.SaveCommittee(5, []int32{val1.Number()})
+	td.mockState.TestStore.SaveCommittee(td.mockState.LastBlockHeight()+1, []int32{val1.Number(), val2.Number()})
+
+	t.Run("Should return error for unrecorded height", func(t *testing.T) {
+		res, err := client.GetCommittee(context.Background(),
+			&pactus.GetCommitteeRequest{Height: 4})
+
+		assert.Equal(t, codes.OutOfRange, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should return the committee at a height", func(t *testing.T) {
+		res, err := client.GetCommittee(context.Background(),
+			&pactus.GetCommitteeRequest{Height: 7})
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint32(7), res.Height)
+		assert.Equal(t, uint32(5), res.ChangedHeight)
+		require.Len(t, res.Members, 1)
+		assert.Equal(t, val1.Address().String(), res.Members[0].ValidatorAddress)
+		assert.Equal(t, val1.Number(), res.Members[0].ValidatorNumber)
+		assert.Equal(t, uint32(5), res.Members[0].JoinedHeight)
+	})
+
+	t.Run("Should return the committee of the next block", func(t *testing.T) {
+		res, err := client.GetCommittee(context.Background(),
+			&pactus.GetCommitteeRequest{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, td.mockState.LastBlockHeight()+1, res.Height)
+		assert.Len(t, res.Members, 2)
+	})
+
+	assert.Nil(t, conn.Close(), "Error closing connection")
+	td.StopServer()
+}
+
+func TestGetSortitionEstimate(t *testing.T) {
+	td := setup(t, nil)
+	conn, client := td.blockchainClient(t)
+
+	val := td.mockState.TestStore.AddTestValidator()
+	totalPower := td.mockState.TotalPower()
+
+	t.Run("Should return error for invalid address", func(t *testing.T) {
+		res, err := client.GetSortitionEstimate(context.Background(),
+			&pactus.GetSortitionEstimateRequest{Address: "invalid"})
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should return error for unknown validator", func(t *testing.T) {
+		res, err := client.GetSortitionEstimate(context.Background(),
+			&pactus.GetSortitionEstimateRequest{Address: td.RandValAddress().String()})
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should return error for zero stake", func(t *testing.T) {
+		res, err := client.GetSortitionEstimate(context.Background(),
+			&pactus.GetSortitionEstimateRequest{})
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should estimate for a validator", func(t *testing.T) {
+		res, err := client.GetSortitionEstimate(context.Background(),
+			&pactus.GetSortitionEstimateRequest{Address: val.Address().String()})
+
+		assert.NoError(t, err)
+		assert.Equal(t, val.Power(), res.Power)
+		assert.Equal(t, totalPower, res.TotalPower)
+		assert.False(t, res.InCommittee)
+		assert.InDelta(t, float64(val.Power())/float64(totalPower), res.ProbabilityPerBlock, 1e-9)
+		assert.InDelta(t, float64(res.BondingBlocks)+float64(totalPower)/float64(val.Power()),
+			res.ExpectedBlocks, 1e-6)
+		assert.InDelta(t, res.ExpectedBlocks*td.mockState.Params().BlockInterval().Seconds(),
+			res.ExpectedSeconds, 1e-6)
+	})
+
+	t.Run("Should estimate for a stake", func(t *testing.T) {
+		stake := totalPower / 3
+		res, err := client.GetSortitionEstimate(context.Background(),
+			&pactus.GetSortitionEstimateRequest{Stake: stake})
+
+		assert.NoError(t, err)
+		assert.Equal(t, stake, res.Power)
+		assert.Equal(t, totalPower+stake, res.TotalPower)
+		assert.Equal(t, td.mockState.Params().BondInterval, res.BondingBlocks)
+		assert.InDelta(t, float64(res.BondingBlocks)+float64(totalPower+stake)/float64(stake),
+			res.ExpectedBlocks, 1e-6)
+	})
+
+	assert.Nil(t, conn.Close(), "Error closing connection")
+	td.StopServer()
+}
@@ -1,10 +1,15 @@
 package grpc
 
-import "github.com/pactus-project/pactus/util/htpasswd"
+import (
+	"errors"
+
+	"github.com/pactus-project/pactus/util/htpasswd"
+)
 
 type Config struct {
 	Enable       bool          `toml:"enable"`
 	EnableWallet bool          `toml:"enable_wallet"`
+	EnableAdmin  bool          `toml:"enable_admin"`
 	Listen       string        `toml:"listen"`
 	BasicAuth    string        `toml:"basic_auth"`
 	Gateway      GatewayConfig `toml:"gateway"`
@@ -27,6 +32,10 @@ func DefaultConfig() *Config {
 }
 
 func (c *Config) BasicCheck() error {
+	if c.EnableAdmin && c.BasicAuth == "" {
+		return errors.New("admin service requires basic auth")
+	}
+
 	if c.BasicAuth != "" {
 		if _, _, err := htpasswd.ExtractBasicAuth(c.BasicAuth); err != nil {
 			return err
@@ -72,6 +72,10 @@ func (s *Server) startGateway(grpcAddr string) error {
 	if err != nil {
 		return err
 	}
+	err = pactus.RegisterAdminHandler(s.ctx, gwMux, conn)
+	if err != nil {
+		return err
+	}
 
 	oa, err := s.getOpenAPIHandler()
 	if err != nil {
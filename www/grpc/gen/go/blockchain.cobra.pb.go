@@ -9,6 +9,7 @@ import (
 	cobra "github.com/spf13/cobra"
 	grpc "google.golang.org/grpc"
 	proto "google.golang.org/protobuf/proto"
+	io "io"
 )
 
 func BlockchainClientCommand(options ...client.Option) *cobra.Command {
@@ -31,6 +32,13 @@ func BlockchainClientCommand(options ...client.Option) *cobra.Command {
 		_BlockchainGetValidatorAddressesCommand(cfg),
 		_BlockchainGetPublicKeyCommand(cfg),
 		_BlockchainGetTxPoolContentCommand(cfg),
+		_BlockchainGetEvidenceCommand(cfg),
+		_BlockchainGetValidatorRewardsCommand(cfg),
+		_BlockchainGetConsensusHistoryCommand(cfg),
+		_BlockchainGetValidatorAlertsCommand(cfg),
+		_BlockchainWatchValidatorAlertsCommand(cfg),
+		_BlockchainGetCommitteeCommand(cfg),
+		_BlockchainGetSortitionEstimateCommand(cfg),
 	)
 	return cmd
 }
@@ -491,3 +499,308 @@ func _BlockchainGetTxPoolContentCommand(cfg *client.Config) *cobra.Command {
 
 	return cmd
 }
+
+func _BlockchainGetEvidenceCommand(cfg *client.Config) *cobra.Command {
+	req := &GetEvidenceRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("GetEvidence"),
+		Short: "GetEvidence RPC client",
+		Long:  "GetEvidence retrieves the double-sign evidence collected in the evidence\n pool.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Blockchain"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Blockchain", "GetEvidence"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewBlockchainClient(cc)
+				v := &GetEvidenceRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.GetEvidence(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	cmd.PersistentFlags().StringVar(&req.Offender, cfg.FlagNamer("Offender"), "", "The address of the offending validator. Empty means all validators.")
+
+	return cmd
+}
+
+func _BlockchainGetValidatorRewardsCommand(cfg *client.Config) *cobra.Command {
+	req := &GetValidatorRewardsRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("GetValidatorRewards"),
+		Short: "GetValidatorRewards RPC client",
+		Long:  "GetValidatorRewards retrieves the rewards earned by a validator, or paid to\n a reward address, within an optional height range.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Blockchain"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Blockchain", "GetValidatorRewards"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewBlockchainClient(cc)
+				v := &GetValidatorRewardsRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.GetValidatorRewards(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	cmd.PersistentFlags().StringVar(&req.Address, cfg.FlagNamer("Address"), "", "The validator address, or the account address that receives the rewards.")
+	cmd.PersistentFlags().Uint32Var(&req.FromHeight, cfg.FlagNamer("FromHeight"), 0, "The first block height of the range, inclusive. Zero means from the\n first recorded height. A height before the first recorded height is\n rejected.")
+	cmd.PersistentFlags().Uint32Var(&req.ToHeight, cfg.FlagNamer("ToHeight"), 0, "The last block height of the range, inclusive. Zero means up to the last\n block.")
+
+	return cmd
+}
+
+func _BlockchainGetConsensusHistoryCommand(cfg *client.Config) *cobra.Command {
+	req := &GetConsensusHistoryRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("GetConsensusHistory"),
+		Short: "GetConsensusHistory RPC client",
+		Long:  "GetConsensusHistory retrieves the consensus statistics of the recent\n heights, such as the rounds, the time spent in each phase and the timeouts.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Blockchain"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Blockchain", "GetConsensusHistory"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewBlockchainClient(cc)
+				v := &GetConsensusHistoryRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.GetConsensusHistory(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	cmd.PersistentFlags().Uint32Var(&req.Count, cfg.FlagNamer("Count"), 0, "The number of recent heights to retrieve, including the current height.\n Zero means 10 heights.")
+
+	return cmd
+}
+
+func _BlockchainGetValidatorAlertsCommand(cfg *client.Config) *cobra.Command {
+	req := &GetValidatorAlertsRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("GetValidatorAlerts"),
+		Short: "GetValidatorAlerts RPC client",
+		Long:  "GetValidatorAlerts retrieves the active availability alerts of the\n validators running on this node.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Blockchain"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Blockchain", "GetValidatorAlerts"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewBlockchainClient(cc)
+				v := &GetValidatorAlertsRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.GetValidatorAlerts(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	return cmd
+}
+
+func _BlockchainWatchValidatorAlertsCommand(cfg *client.Config) *cobra.Command {
+	req := &WatchValidatorAlertsRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("WatchValidatorAlerts"),
+		Short: "WatchValidatorAlerts RPC client",
+		Long:  "WatchValidatorAlerts streams the availability alerts of the validators\n running on this node. The active alerts are sent first, followed by the\n alerts that are raised or resolved afterwards.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Blockchain"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Blockchain", "WatchValidatorAlerts"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewBlockchainClient(cc)
+				v := &WatchValidatorAlertsRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				stm, err := cli.WatchValidatorAlerts(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				for {
+					res, err := stm.Recv()
+					if err != nil {
+						if err == io.EOF {
+							break
+						}
+						return err
+					}
+					if err = out(res); err != nil {
+						return err
+					}
+				}
+				return nil
+
+			})
+		},
+	}
+
+	return cmd
+}
+
+func _BlockchainGetCommitteeCommand(cfg *client.Config) *cobra.Command {
+	req := &GetCommitteeRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("GetCommittee"),
+		Short: "GetCommittee RPC client",
+		Long:  "GetCommittee retrieves the committee members that were in charge of the\n block at a specific height, with the heights they joined the committee.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Blockchain"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Blockchain", "GetCommittee"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewBlockchainClient(cc)
+				v := &GetCommitteeRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.GetCommittee(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	cmd.PersistentFlags().Uint32Var(&req.Height, cfg.FlagNamer("Height"), 0, "The block height. Zero means the committee of the next block. A height\n before the first recorded committee is rejected.")
+
+	return cmd
+}
+
+func _BlockchainGetSortitionEstimateCommand(cfg *client.Config) *cobra.Command {
+	req := &GetSortitionEstimateRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("GetSortitionEstimate"),
+		Short: "GetSortitionEstimate RPC client",
+		Long:  "GetSortitionEstimate estimates the number of blocks until a validator, or\n a validator with a specific stake, is selected to join the committee.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Blockchain"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Blockchain", "GetSortitionEstimate"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewBlockchainClient(cc)
+				v := &GetSortitionEstimateRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.GetSortitionEstimate(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	cmd.PersistentFlags().StringVar(&req.Address, cfg.FlagNamer("Address"), "", "The address of the validator. If it is empty, the stake is used.")
+	cmd.PersistentFlags().Int64Var(&req.Stake, cfg.FlagNamer("Stake"), 0, "The stake of a validator in NanoPAC, used when the address is empty.")
+
+	return cmd
+}
@@ -30,6 +30,13 @@ const (
 	Blockchain_GetValidatorAddresses_FullMethodName = "/pactus.Blockchain/GetValidatorAddresses"
 	Blockchain_GetPublicKey_FullMethodName          = "/pactus.Blockchain/GetPublicKey"
 	Blockchain_GetTxPoolContent_FullMethodName      = "/pactus.Blockchain/GetTxPoolContent"
+	Blockchain_GetEvidence_FullMethodName           = "/pactus.Blockchain/GetEvidence"
+	Blockchain_GetValidatorRewards_FullMethodName   = "/pactus.Blockchain/GetValidatorRewards"
+	Blockchain_GetConsensusHistory_FullMethodName   = "/pactus.Blockchain/GetConsensusHistory"
+	Blockchain_GetValidatorAlerts_FullMethodName    = "/pactus.Blockchain/GetValidatorAlerts"
+	Blockchain_WatchValidatorAlerts_FullMethodName  = "/pactus.Blockchain/WatchValidatorAlerts"
+	Blockchain_GetCommittee_FullMethodName          = "/pactus.Blockchain/GetCommittee"
+	Blockchain_GetSortitionEstimate_FullMethodName  = "/pactus.Blockchain/GetSortitionEstimate"
 )
 
 // BlockchainClient is the client API for Blockchain service.
@@ -65,6 +72,28 @@ type BlockchainClient interface {
 	GetPublicKey(ctx context.Context, in *GetPublicKeyRequest, opts ...grpc.CallOption) (*GetPublicKeyResponse, error)
 	// GetTxPoolContent retrieves current transactions in the transaction pool.
 	GetTxPoolContent(ctx context.Context, in *GetTxPoolContentRequest, opts ...grpc.CallOption) (*GetTxPoolContentResponse, error)
+	// GetEvidence retrieves the double-sign evidence collected in the evidence
+	// pool.
+	GetEvidence(ctx context.Context, in *GetEvidenceRequest, opts ...grpc.CallOption) (*GetEvidenceResponse, error)
+	// GetValidatorRewards retrieves the rewards earned by a validator, or paid to
+	// a reward address, within an optional height range.
+	GetValidatorRewards(ctx context.Context, in *GetValidatorRewardsRequest, opts ...grpc.CallOption) (*GetValidatorRewardsResponse, error)
+	// GetConsensusHistory retrieves the consensus statistics of the recent
+	// heights, such as the rounds, the time spent in each phase and the timeouts.
+	GetConsensusHistory(ctx context.Context, in *GetConsensusHistoryRequest, opts ...grpc.CallOption) (*GetConsensusHistoryResponse, error)
+	// GetValidatorAlerts retrieves the active availability alerts of the
+	// validators running on this node.
+	GetValidatorAlerts(ctx context.Context, in *GetValidatorAlertsRequest, opts ...grpc.CallOption) (*GetValidatorAlertsResponse, error)
+	// WatchValidatorAlerts streams the availability alerts of the validators
+	// running on this node. The active alerts are sent first, followed by the
+	// alerts that are raised or resolved afterwards.
+	WatchValidatorAlerts(ctx context.Context, in *WatchValidatorAlertsRequest, opts ...grpc.CallOption) (Blockchain_WatchValidatorAlertsClient, error)
+	// GetCommittee retrieves the committee members that were in charge of the
+	// block at a specific height, with the heights they joined the committee.
+	GetCommittee(ctx context.Context, in *GetCommitteeRequest, opts ...grpc.CallOption) (*GetCommitteeResponse, error)
+	// GetSortitionEstimate estimates the number of blocks until a validator, or
+	// a validator with a specific stake, is selected to join the committee.
+	GetSortitionEstimate(ctx context.Context, in *GetSortitionEstimateRequest, opts ...grpc.CallOption) (*GetSortitionEstimateResponse, error)
 }
 
 type blockchainClient struct {
@@ -185,6 +214,99 @@ func (c *blockchainClient) GetTxPoolContent(ctx context.Context, in *GetTxPoolCo
 	return out, nil
 }
 
+func (c *blockchainClient) GetEvidence(ctx context.Context, in *GetEvidenceRequest, opts ...grpc.CallOption) (*GetEvidenceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEvidenceResponse)
+	err := c.cc.Invoke(ctx, Blockchain_GetEvidence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blockchainClient) GetValidatorRewards(ctx context.Context, in *GetValidatorRewardsRequest, opts ...grpc.CallOption) (*GetValidatorRewardsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetValidatorRewardsResponse)
+	err := c.cc.Invoke(ctx, Blockchain_GetValidatorRewards_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blockchainClient) GetConsensusHistory(ctx context.Context, in *GetConsensusHistoryRequest, opts ...grpc.CallOption) (*GetConsensusHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetConsensusHistoryResponse)
+	err := c.cc.Invoke(ctx, Blockchain_GetConsensusHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blockchainClient) GetValidatorAlerts(ctx context.Context, in *GetValidatorAlertsRequest, opts ...grpc.CallOption) (*GetValidatorAlertsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetValidatorAlertsResponse)
+	err := c.cc.Invoke(ctx, Blockchain_GetValidatorAlerts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blockchainClient) WatchValidatorAlerts(ctx context.Context, in *WatchValidatorAlertsRequest, opts ...grpc.CallOption) (Blockchain_WatchValidatorAlertsClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Blockchain_ServiceDesc.Streams[0], Blockchain_WatchValidatorAlerts_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &blockchainWatchValidatorAlertsClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Blockchain_WatchValidatorAlertsClient interface {
+	Recv() (*ValidatorAlert, error)
+	grpc.ClientStream
+}
+
+type blockchainWatchValidatorAlertsClient struct {
+	grpc.ClientStream
+}
+
+func (x *blockchainWatchValidatorAlertsClient) Recv() (*ValidatorAlert, error) {
+	m := new(ValidatorAlert)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *blockchainClient) GetCommittee(ctx context.Context, in *GetCommitteeRequest, opts ...grpc.CallOption) (*GetCommitteeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCommitteeResponse)
+	err := c.cc.Invoke(ctx, Blockchain_GetCommittee_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blockchainClient) GetSortitionEstimate(ctx context.Context, in *GetSortitionEstimateRequest, opts ...grpc.CallOption) (*GetSortitionEstimateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSortitionEstimateResponse)
+	err := c.cc.Invoke(ctx, Blockchain_GetSortitionEstimate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BlockchainServer is the server API for Blockchain service.
 // All implementations should embed UnimplementedBlockchainServer
 // for forward compatibility
@@ -218,6 +340,28 @@ type BlockchainServer interface {
 	GetPublicKey(context.Context, *GetPublicKeyRequest) (*GetPublicKeyResponse, error)
 	// GetTxPoolContent retrieves current transactions in the transaction pool.
 	GetTxPoolContent(context.Context, *GetTxPoolContentRequest) (*GetTxPoolContentResponse, error)
+	// GetEvidence retrieves the double-sign evidence collected in the evidence
+	// pool.
+	GetEvidence(context.Context, *GetEvidenceRequest) (*GetEvidenceResponse, error)
+	// GetValidatorRewards retrieves the rewards earned by a validator, or paid to
+	// a reward address, within an optional height range.
+	GetValidatorRewards(context.Context, *GetValidatorRewardsRequest) (*GetValidatorRewardsResponse, error)
+	// GetConsensusHistory retrieves the consensus statistics of the recent
+	// heights, such as the rounds, the time spent in each phase and the timeouts.
+	GetConsensusHistory(context.Context, *GetConsensusHistoryRequest) (*GetConsensusHistoryResponse, error)
+	// GetValidatorAlerts retrieves the active availability alerts of the
+	// validators running on this node.
+	GetValidatorAlerts(context.Context, *GetValidatorAlertsRequest) (*GetValidatorAlertsResponse, error)
+	// WatchValidatorAlerts streams the availability alerts of the validators
+	// running on this node. The active alerts are sent first, followed by the
+	// alerts that are raised or resolved afterwards.
+	WatchValidatorAlerts(*WatchValidatorAlertsRequest, Blockchain_WatchValidatorAlertsServer) error
+	// GetCommittee retrieves the committee members that were in charge of the
+	// block at a specific height, with the heights they joined the committee.
+	GetCommittee(context.Context, *GetCommitteeRequest) (*GetCommitteeResponse, error)
+	// GetSortitionEstimate estimates the number of blocks until a validator, or
+	// a validator with a specific stake, is selected to join the committee.
+	GetSortitionEstimate(context.Context, *GetSortitionEstimateRequest) (*GetSortitionEstimateResponse, error)
 }
 
 // UnimplementedBlockchainServer should be embedded to have forward compatible implementations.
@@ -257,6 +401,27 @@ func (UnimplementedBlockchainServer) GetPublicKey(context.Context, *GetPublicKey
 func (UnimplementedBlockchainServer) GetTxPoolContent(context.Context, *GetTxPoolContentRequest) (*GetTxPoolContentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTxPoolContent not implemented")
 }
+func (UnimplementedBlockchainServer) GetEvidence(context.Context, *GetEvidenceRequest) (*GetEvidenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEvidence not implemented")
+}
+func (UnimplementedBlockchainServer) GetValidatorRewards(context.Context, *GetValidatorRewardsRequest) (*GetValidatorRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorRewards not implemented")
+}
+func (UnimplementedBlockchainServer) GetConsensusHistory(context.Context, *GetConsensusHistoryRequest) (*GetConsensusHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConsensusHistory not implemented")
+}
+func (UnimplementedBlockchainServer) GetValidatorAlerts(context.Context, *GetValidatorAlertsRequest) (*GetValidatorAlertsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorAlerts not implemented")
+}
+func (UnimplementedBlockchainServer) WatchValidatorAlerts(*WatchValidatorAlertsRequest, Blockchain_WatchValidatorAlertsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchValidatorAlerts not implemented")
+}
+func (UnimplementedBlockchainServer) GetCommittee(context.Context, *GetCommitteeRequest) (*GetCommitteeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommittee not implemented")
+}
+func (UnimplementedBlockchainServer) GetSortitionEstimate(context.Context, *GetSortitionEstimateRequest) (*GetSortitionEstimateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSortitionEstimate not implemented")
+}
 
 // UnsafeBlockchainServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to BlockchainServer will
@@ -467,6 +632,135 @@ func _Blockchain_GetTxPoolContent_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Blockchain_GetEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEvidenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockchainServer).GetEvidence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Blockchain_GetEvidence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockchainServer).GetEvidence(ctx, req.(*GetEvidenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Blockchain_GetValidatorRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetValidatorRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockchainServer).GetValidatorRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Blockchain_GetValidatorRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockchainServer).GetValidatorRewards(ctx, req.(*GetValidatorRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Blockchain_GetConsensusHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConsensusHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockchainServer).GetConsensusHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Blockchain_GetConsensusHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockchainServer).GetConsensusHistory(ctx, req.(*GetConsensusHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Blockchain_GetValidatorAlerts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetValidatorAlertsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockchainServer).GetValidatorAlerts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Blockchain_GetValidatorAlerts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockchainServer).GetValidatorAlerts(ctx, req.(*GetValidatorAlertsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Blockchain_WatchValidatorAlerts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchValidatorAlertsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BlockchainServer).WatchValidatorAlerts(m, &blockchainWatchValidatorAlertsServer{ServerStream: stream})
+}
+
+type Blockchain_WatchValidatorAlertsServer interface {
+	Send(*ValidatorAlert) error
+	grpc.ServerStream
+}
+
+type blockchainWatchValidatorAlertsServer struct {
+	grpc.ServerStream
+}
+
+func (x *blockchainWatchValidatorAlertsServer) Send(m *ValidatorAlert) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Blockchain_GetCommittee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommitteeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockchainServer).GetCommittee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Blockchain_GetCommittee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockchainServer).GetCommittee(ctx, req.(*GetCommitteeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Blockchain_GetSortitionEstimate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSortitionEstimateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockchainServer).GetSortitionEstimate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Blockchain_GetSortitionEstimate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockchainServer).GetSortitionEstimate(ctx, req.(*GetSortitionEstimateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Blockchain_ServiceDesc is the grpc.ServiceDesc for Blockchain service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -518,7 +812,37 @@ var Blockchain_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTxPoolContent",
 			Handler:    _Blockchain_GetTxPoolContent_Handler,
 		},
+		{
+			MethodName: "GetEvidence",
+			Handler:    _Blockchain_GetEvidence_Handler,
+		},
+		{
+			MethodName: "GetValidatorRewards",
+			Handler:    _Blockchain_GetValidatorRewards_Handler,
+		},
+		{
+			MethodName: "GetConsensusHistory",
+			Handler:    _Blockchain_GetConsensusHistory_Handler,
+		},
+		{
+			MethodName: "GetValidatorAlerts",
+			Handler:    _Blockchain_GetValidatorAlerts_Handler,
+		},
+		{
+			MethodName: "GetCommittee",
+			Handler:    _Blockchain_GetCommittee_Handler,
+		},
+		{
+			MethodName: "GetSortitionEstimate",
+			Handler:    _Blockchain_GetSortitionEstimate_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchValidatorAlerts",
+			Handler:       _Blockchain_WatchValidatorAlerts_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "blockchain.proto",
 }
@@ -223,5 +223,124 @@ func (s *BlockchainJsonRPC) Methods() map[string]func(ctx context.Context, messa
 
 			return s.client.GetTxPoolContent(metadata.NewOutgoingContext(ctx, jrpcData.Headers), req)
 		},
+
+		"pactus.blockchain.get_evidence": func(ctx context.Context, data json.RawMessage) (any, error) {
+			req := new(GetEvidenceRequest)
+
+			var jrpcData paramsAndHeadersBlockchain
+
+			if err := json.Unmarshal(data, &jrpcData); err != nil {
+				return nil, err
+			}
+
+			err := protojson.Unmarshal(jrpcData.Params, req)
+			if err != nil {
+				return nil, err
+			}
+
+			return s.client.GetEvidence(metadata.NewOutgoingContext(ctx, jrpcData.Headers), req)
+		},
+
+		"pactus.blockchain.get_validator_rewards": func(ctx context.Context, data json.RawMessage) (any, error) {
+			req := new(GetValidatorRewardsRequest)
+
+			var jrpcData paramsAndHeadersBlockchain
+
+			if err := json.Unmarshal(data, &jrpcData); err != nil {
+				return nil, err
+			}
+
+			err := protojson.Unmarshal(jrpcData.Params, req)
+			if err != nil {
+				return nil, err
+			}
+
+			return s.client.GetValidatorRewards(metadata.NewOutgoingContext(ctx, jrpcData.Headers), req)
+		},
+
+		"pactus.blockchain.get_consensus_history": func(ctx context.Context, data json.RawMessage) (any, error) {
+			req := new(GetConsensusHistoryRequest)
+
+			var jrpcData paramsAndHeadersBlockchain
+
+			if err := json.Unmarshal(data, &jrpcData); err != nil {
+				return nil, err
+			}
+
+			err := protojson.Unmarshal(jrpcData.Params, req)
+			if err != nil {
+				return nil, err
+			}
+
+			return s.client.GetConsensusHistory(metadata.NewOutgoingContext(ctx, jrpcData.Headers), req)
+		},
+
+		"pactus.blockchain.get_validator_alerts": func(ctx context.Context, data json.RawMessage) (any, error) {
+			req := new(GetValidatorAlertsRequest)
+
+			var jrpcData paramsAndHeadersBlockchain
+
+			if err := json.Unmarshal(data, &jrpcData); err != nil {
+				return nil, err
+			}
+
+			err := protojson.Unmarshal(jrpcData.Params, req)
+			if err != nil {
+				return nil, err
+			}
+
+			return s.client.GetValidatorAlerts(metadata.NewOutgoingContext(ctx, jrpcData.Headers), req)
+		},
+
+		"pactus.blockchain.watch_validator_alerts": func(ctx context.Context, data json.RawMessage) (any, error) {
+			req := new(WatchValidatorAlertsRequest)
+
+			var jrpcData paramsAndHeadersBlockchain
+
+			if err := json.Unmarshal(data, &jrpcData); err != nil {
+				return nil, err
+			}
+
+			err := protojson.Unmarshal(jrpcData.Params, req)
+			if err != nil {
+				return nil, err
+			}
+
+			return s.client.WatchValidatorAlerts(metadata.NewOutgoingContext(ctx, jrpcData.Headers), req)
+		},
+
+		"pactus.blockchain.get_committee": func(ctx context.Context, data json.RawMessage) (any, error) {
+			req := new(GetCommitteeRequest)
+
+			var jrpcData paramsAndHeadersBlockchain
+
+			if err := json.Unmarshal(data, &jrpcData); err != nil {
+				return nil, err
+			}
+
+			err := protojson.Unmarshal(jrpcData.Params, req)
+			if err != nil {
+				return nil, err
+			}
+
+			return s.client.GetCommittee(metadata.NewOutgoingContext(ctx, jrpcData.Headers), req)
+		},
+
+		"pactus.blockchain.get_sortition_estimate": func(ctx context.Context, data json.RawMessage) (any, error) {
+			req := new(GetSortitionEstimateRequest)
+
+			var jrpcData paramsAndHeadersBlockchain
+
+			if err := json.Unmarshal(data, &jrpcData); err != nil {
+				return nil, err
+			}
+
+			err := protojson.Unmarshal(jrpcData.Params, req)
+			if err != nil {
+				return nil, err
+			}
+
+			return s.client.GetSortitionEstimate(metadata.NewOutgoingContext(ctx, jrpcData.Headers), req)
+		},
 	}
 }
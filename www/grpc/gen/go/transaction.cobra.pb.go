@@ -27,6 +27,10 @@ func TransactionClientCommand(options ...client.Option) *cobra.Command {
 		_TransactionGetRawBondTransactionCommand(cfg),
 		_TransactionGetRawUnbondTransactionCommand(cfg),
 		_TransactionGetRawWithdrawTransactionCommand(cfg),
+		_TransactionGetRawMetadataTransactionCommand(cfg),
+		_TransactionGetRawKeyRotationTransactionCommand(cfg),
+		_TransactionGetRawAnchorTransactionCommand(cfg),
+		_TransactionGetAnchorCommand(cfg),
 	)
 	return cmd
 }
@@ -345,3 +349,188 @@ func _TransactionGetRawWithdrawTransactionCommand(cfg *client.Config) *cobra.Com
 
 	return cmd
 }
+
+func _TransactionGetRawMetadataTransactionCommand(cfg *client.Config) *cobra.Command {
+	req := &GetRawMetadataTransactionRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("GetRawMetadataTransaction"),
+		Short: "GetRawMetadataTransaction RPC client",
+		Long:  "GetRawMetadataTransaction retrieves raw details of a metadata transaction.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Transaction"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Transaction", "GetRawMetadataTransaction"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewTransactionClient(cc)
+				v := &GetRawMetadataTransactionRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.GetRawMetadataTransaction(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	cmd.PersistentFlags().Uint32Var(&req.LockTime, cfg.FlagNamer("LockTime"), 0, "The lock time for the transaction. If not set, defaults to the last block\n height.")
+	cmd.PersistentFlags().StringVar(&req.ValidatorAddress, cfg.FlagNamer("ValidatorAddress"), "", "The address of the validator that publishes the metadata.")
+	cmd.PersistentFlags().StringVar(&req.Moniker, cfg.FlagNamer("Moniker"), "", "The human-readable name of the validator.")
+	cmd.PersistentFlags().StringVar(&req.Website, cfg.FlagNamer("Website"), "", "The website of the validator operator.")
+	cmd.PersistentFlags().StringVar(&req.Contact, cfg.FlagNamer("Contact"), "", "The contact information of the validator operator.")
+	cmd.PersistentFlags().StringVar(&req.RewardAddress, cfg.FlagNamer("RewardAddress"), "", "The account address suggested for receiving rewards.")
+	cmd.PersistentFlags().Int64Var(&req.Fee, cfg.FlagNamer("Fee"), 0, "The transaction fee in NanoPAC. If not set, it is set to the estimated fee.")
+	cmd.PersistentFlags().StringVar(&req.Memo, cfg.FlagNamer("Memo"), "", "A memo string for the transaction.")
+
+	return cmd
+}
+
+func _TransactionGetRawKeyRotationTransactionCommand(cfg *client.Config) *cobra.Command {
+	req := &GetRawKeyRotationTransactionRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("GetRawKeyRotationTransaction"),
+		Short: "GetRawKeyRotationTransaction RPC client",
+		Long:  "GetRawKeyRotationTransaction retrieves raw details of a key rotation\n transaction.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Transaction"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Transaction", "GetRawKeyRotationTransaction"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewTransactionClient(cc)
+				v := &GetRawKeyRotationTransactionRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.GetRawKeyRotationTransaction(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	cmd.PersistentFlags().Uint32Var(&req.LockTime, cfg.FlagNamer("LockTime"), 0, "The lock time for the transaction. If not set, defaults to the last block\n height.")
+	cmd.PersistentFlags().StringVar(&req.ValidatorAddress, cfg.FlagNamer("ValidatorAddress"), "", "The address of the validator that rotates its key.")
+	cmd.PersistentFlags().StringVar(&req.NewPublicKey, cfg.FlagNamer("NewPublicKey"), "", "The new public key of the validator.")
+	cmd.PersistentFlags().Int64Var(&req.Fee, cfg.FlagNamer("Fee"), 0, "The transaction fee in NanoPAC. If not set, it is set to the estimated fee.")
+	cmd.PersistentFlags().StringVar(&req.Memo, cfg.FlagNamer("Memo"), "", "A memo string for the transaction.")
+	cmd.PersistentFlags().StringVar(&req.ProofOfPossession, cfg.FlagNamer("ProofOfPossession"), "", "The signature of the new key over the rotation, proving its possession.\n The signed message is \"key-rotation\" followed by the validator address\n and the new public key bytes.")
+
+	return cmd
+}
+
+func _TransactionGetRawAnchorTransactionCommand(cfg *client.Config) *cobra.Command {
+	req := &GetRawAnchorTransactionRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("GetRawAnchorTransaction"),
+		Short: "GetRawAnchorTransaction RPC client",
+		Long:  "GetRawAnchorTransaction retrieves raw details of an anchor transaction.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Transaction"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Transaction", "GetRawAnchorTransaction"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewTransactionClient(cc)
+				v := &GetRawAnchorTransactionRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.GetRawAnchorTransaction(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	cmd.PersistentFlags().Uint32Var(&req.LockTime, cfg.FlagNamer("LockTime"), 0, "The lock time for the transaction. If not set, defaults to the last block\n height.")
+	cmd.PersistentFlags().StringVar(&req.Sender, cfg.FlagNamer("Sender"), "", "The sender's account address that pays the fee.")
+	cmd.PersistentFlags().StringVar(&req.Namespace, cfg.FlagNamer("Namespace"), "", "The application-defined namespace of the anchors.")
+	cmd.PersistentFlags().StringSliceVar(&req.Hashes, cfg.FlagNamer("Hashes"), nil, "The hashes to anchor.")
+	cmd.PersistentFlags().Int64Var(&req.Fee, cfg.FlagNamer("Fee"), 0, "The transaction fee in NanoPAC. If not set, it is set to the estimated fee.")
+	cmd.PersistentFlags().StringVar(&req.Memo, cfg.FlagNamer("Memo"), "", "A memo string for the transaction.")
+
+	return cmd
+}
+
+func _TransactionGetAnchorCommand(cfg *client.Config) *cobra.Command {
+	req := &GetAnchorRequest{}
+
+	cmd := &cobra.Command{
+		Use:   cfg.CommandNamer("GetAnchor"),
+		Short: "GetAnchor RPC client",
+		Long:  "GetAnchor retrieves the block height and time at which a hash was first\n anchored.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cfg.UseEnvVars {
+				if err := flag.SetFlagsFromEnv(cmd.Parent().PersistentFlags(), true, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Transaction"); err != nil {
+					return err
+				}
+				if err := flag.SetFlagsFromEnv(cmd.PersistentFlags(), false, cfg.EnvVarNamer, cfg.EnvVarPrefix, "Transaction", "GetAnchor"); err != nil {
+					return err
+				}
+			}
+			return client.RoundTrip(cmd.Context(), cfg, func(cc grpc.ClientConnInterface, in iocodec.Decoder, out iocodec.Encoder) error {
+				cli := NewTransactionClient(cc)
+				v := &GetAnchorRequest{}
+
+				if err := in(v); err != nil {
+					return err
+				}
+				proto.Merge(v, req)
+
+				res, err := cli.GetAnchor(cmd.Context(), v)
+
+				if err != nil {
+					return err
+				}
+
+				return out(res)
+
+			})
+		},
+	}
+
+	cmd.PersistentFlags().StringVar(&req.Hash, cfg.FlagNamer("Hash"), "", "The anchored hash to retrieve. The anchors before the history height are\n not available on a node that is restored from a state snapshot.")
+
+	return cmd
+}
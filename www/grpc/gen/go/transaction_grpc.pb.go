@@ -19,13 +19,17 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	Transaction_GetTransaction_FullMethodName            = "/pactus.Transaction/GetTransaction"
-	Transaction_CalculateFee_FullMethodName              = "/pactus.Transaction/CalculateFee"
-	Transaction_BroadcastTransaction_FullMethodName      = "/pactus.Transaction/BroadcastTransaction"
-	Transaction_GetRawTransferTransaction_FullMethodName = "/pactus.Transaction/GetRawTransferTransaction"
-	Transaction_GetRawBondTransaction_FullMethodName     = "/pactus.Transaction/GetRawBondTransaction"
-	Transaction_GetRawUnbondTransaction_FullMethodName   = "/pactus.Transaction/GetRawUnbondTransaction"
-	Transaction_GetRawWithdrawTransaction_FullMethodName = "/pactus.Transaction/GetRawWithdrawTransaction"
+	Transaction_GetTransaction_FullMethodName               = "/pactus.Transaction/GetTransaction"
+	Transaction_CalculateFee_FullMethodName                 = "/pactus.Transaction/CalculateFee"
+	Transaction_BroadcastTransaction_FullMethodName         = "/pactus.Transaction/BroadcastTransaction"
+	Transaction_GetRawTransferTransaction_FullMethodName    = "/pactus.Transaction/GetRawTransferTransaction"
+	Transaction_GetRawBondTransaction_FullMethodName        = "/pactus.Transaction/GetRawBondTransaction"
+	Transaction_GetRawUnbondTransaction_FullMethodName      = "/pactus.Transaction/GetRawUnbondTransaction"
+	Transaction_GetRawWithdrawTransaction_FullMethodName    = "/pactus.Transaction/GetRawWithdrawTransaction"
+	Transaction_GetRawMetadataTransaction_FullMethodName    = "/pactus.Transaction/GetRawMetadataTransaction"
+	Transaction_GetRawKeyRotationTransaction_FullMethodName = "/pactus.Transaction/GetRawKeyRotationTransaction"
+	Transaction_GetRawAnchorTransaction_FullMethodName      = "/pactus.Transaction/GetRawAnchorTransaction"
+	Transaction_GetAnchor_FullMethodName                    = "/pactus.Transaction/GetAnchor"
 )
 
 // TransactionClient is the client API for Transaction service.
@@ -51,6 +55,16 @@ type TransactionClient interface {
 	GetRawUnbondTransaction(ctx context.Context, in *GetRawUnbondTransactionRequest, opts ...grpc.CallOption) (*GetRawTransactionResponse, error)
 	// GetRawWithdrawTransaction retrieves raw details of a withdraw transaction.
 	GetRawWithdrawTransaction(ctx context.Context, in *GetRawWithdrawTransactionRequest, opts ...grpc.CallOption) (*GetRawTransactionResponse, error)
+	// GetRawMetadataTransaction retrieves raw details of a metadata transaction.
+	GetRawMetadataTransaction(ctx context.Context, in *GetRawMetadataTransactionRequest, opts ...grpc.CallOption) (*GetRawTransactionResponse, error)
+	// GetRawKeyRotationTransaction retrieves raw details of a key rotation
+	// transaction.
+	GetRawKeyRotationTransaction(ctx context.Context, in *GetRawKeyRotationTransactionRequest, opts ...grpc.CallOption) (*GetRawTransactionResponse, error)
+	// GetRawAnchorTransaction retrieves raw details of an anchor transaction.
+	GetRawAnchorTransaction(ctx context.Context, in *GetRawAnchorTransactionRequest, opts ...grpc.CallOption) (*GetRawTransactionResponse, error)
+	// GetAnchor retrieves the block height and time at which a hash was first
+	// anchored.
+	GetAnchor(ctx context.Context, in *GetAnchorRequest, opts ...grpc.CallOption) (*GetAnchorResponse, error)
 }
 
 type transactionClient struct {
@@ -131,6 +145,46 @@ func (c *transactionClient) GetRawWithdrawTransaction(ctx context.Context, in *G
 	return out, nil
 }
 
+func (c *transactionClient) GetRawMetadataTransaction(ctx context.Context, in *GetRawMetadataTransactionRequest, opts ...grpc.CallOption) (*GetRawTransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRawTransactionResponse)
+	err := c.cc.Invoke(ctx, Transaction_GetRawMetadataTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionClient) GetRawKeyRotationTransaction(ctx context.Context, in *GetRawKeyRotationTransactionRequest, opts ...grpc.CallOption) (*GetRawTransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRawTransactionResponse)
+	err := c.cc.Invoke(ctx, Transaction_GetRawKeyRotationTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionClient) GetRawAnchorTransaction(ctx context.Context, in *GetRawAnchorTransactionRequest, opts ...grpc.CallOption) (*GetRawTransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRawTransactionResponse)
+	err := c.cc.Invoke(ctx, Transaction_GetRawAnchorTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionClient) GetAnchor(ctx context.Context, in *GetAnchorRequest, opts ...grpc.CallOption) (*GetAnchorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAnchorResponse)
+	err := c.cc.Invoke(ctx, Transaction_GetAnchor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TransactionServer is the server API for Transaction service.
 // All implementations should embed UnimplementedTransactionServer
 // for forward compatibility
@@ -154,6 +208,16 @@ type TransactionServer interface {
 	GetRawUnbondTransaction(context.Context, *GetRawUnbondTransactionRequest) (*GetRawTransactionResponse, error)
 	// GetRawWithdrawTransaction retrieves raw details of a withdraw transaction.
 	GetRawWithdrawTransaction(context.Context, *GetRawWithdrawTransactionRequest) (*GetRawTransactionResponse, error)
+	// GetRawMetadataTransaction retrieves raw details of a metadata transaction.
+	GetRawMetadataTransaction(context.Context, *GetRawMetadataTransactionRequest) (*GetRawTransactionResponse, error)
+	// GetRawKeyRotationTransaction retrieves raw details of a key rotation
+	// transaction.
+	GetRawKeyRotationTransaction(context.Context, *GetRawKeyRotationTransactionRequest) (*GetRawTransactionResponse, error)
+	// GetRawAnchorTransaction retrieves raw details of an anchor transaction.
+	GetRawAnchorTransaction(context.Context, *GetRawAnchorTransactionRequest) (*GetRawTransactionResponse, error)
+	// GetAnchor retrieves the block height and time at which a hash was first
+	// anchored.
+	GetAnchor(context.Context, *GetAnchorRequest) (*GetAnchorResponse, error)
 }
 
 // UnimplementedTransactionServer should be embedded to have forward compatible implementations.
@@ -181,6 +245,18 @@ func (UnimplementedTransactionServer) GetRawUnbondTransaction(context.Context, *
 func (UnimplementedTransactionServer) GetRawWithdrawTransaction(context.Context, *GetRawWithdrawTransactionRequest) (*GetRawTransactionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRawWithdrawTransaction not implemented")
 }
+func (UnimplementedTransactionServer) GetRawMetadataTransaction(context.Context, *GetRawMetadataTransactionRequest) (*GetRawTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRawMetadataTransaction not implemented")
+}
+func (UnimplementedTransactionServer) GetRawKeyRotationTransaction(context.Context, *GetRawKeyRotationTransactionRequest) (*GetRawTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRawKeyRotationTransaction not implemented")
+}
+func (UnimplementedTransactionServer) GetRawAnchorTransaction(context.Context, *GetRawAnchorTransactionRequest) (*GetRawTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRawAnchorTransaction not implemented")
+}
+func (UnimplementedTransactionServer) GetAnchor(context.Context, *GetAnchorRequest) (*GetAnchorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAnchor not implemented")
+}
 
 // UnsafeTransactionServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to TransactionServer will
@@ -319,6 +395,78 @@ func _Transaction_GetRawWithdrawTransaction_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Transaction_GetRawMetadataTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRawMetadataTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServer).GetRawMetadataTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transaction_GetRawMetadataTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServer).GetRawMetadataTransaction(ctx, req.(*GetRawMetadataTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transaction_GetRawKeyRotationTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRawKeyRotationTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServer).GetRawKeyRotationTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transaction_GetRawKeyRotationTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServer).GetRawKeyRotationTransaction(ctx, req.(*GetRawKeyRotationTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transaction_GetRawAnchorTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRawAnchorTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServer).GetRawAnchorTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transaction_GetRawAnchorTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServer).GetRawAnchorTransaction(ctx, req.(*GetRawAnchorTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transaction_GetAnchor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAnchorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServer).GetAnchor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transaction_GetAnchor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServer).GetAnchor(ctx, req.(*GetAnchorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Transaction_ServiceDesc is the grpc.ServiceDesc for Transaction service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -354,6 +502,22 @@ var Transaction_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRawWithdrawTransaction",
 			Handler:    _Transaction_GetRawWithdrawTransaction_Handler,
 		},
+		{
+			MethodName: "GetRawMetadataTransaction",
+			Handler:    _Transaction_GetRawMetadataTransaction_Handler,
+		},
+		{
+			MethodName: "GetRawKeyRotationTransaction",
+			Handler:    _Transaction_GetRawKeyRotationTransaction_Handler,
+		},
+		{
+			MethodName: "GetRawAnchorTransaction",
+			Handler:    _Transaction_GetRawAnchorTransaction_Handler,
+		},
+		{
+			MethodName: "GetAnchor",
+			Handler:    _Transaction_GetAnchor_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "transaction.proto",
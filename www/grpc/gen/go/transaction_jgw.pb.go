@@ -155,5 +155,73 @@ func (s *TransactionJsonRPC) Methods() map[string]func(ctx context.Context, mess
 
 			return s.client.GetRawWithdrawTransaction(metadata.NewOutgoingContext(ctx, jrpcData.Headers), req)
 		},
+
+		"pactus.transaction.get_raw_metadata_transaction": func(ctx context.Context, data json.RawMessage) (any, error) {
+			req := new(GetRawMetadataTransactionRequest)
+
+			var jrpcData paramsAndHeadersTransaction
+
+			if err := json.Unmarshal(data, &jrpcData); err != nil {
+				return nil, err
+			}
+
+			err := protojson.Unmarshal(jrpcData.Params, req)
+			if err != nil {
+				return nil, err
+			}
+
+			return s.client.GetRawMetadataTransaction(metadata.NewOutgoingContext(ctx, jrpcData.Headers), req)
+		},
+
+		"pactus.transaction.get_raw_key_rotation_transaction": func(ctx context.Context, data json.RawMessage) (any, error) {
+			req := new(GetRawKeyRotationTransactionRequest)
+
+			var jrpcData paramsAndHeadersTransaction
+
+			if err := json.Unmarshal(data, &jrpcData); err != nil {
+				return nil, err
+			}
+
+			err := protojson.Unmarshal(jrpcData.Params, req)
+			if err != nil {
+				return nil, err
+			}
+
+			return s.client.GetRawKeyRotationTransaction(metadata.NewOutgoingContext(ctx, jrpcData.Headers), req)
+		},
+
+		"pactus.transaction.get_raw_anchor_transaction": func(ctx context.Context, data json.RawMessage) (any, error) {
+			req := new(GetRawAnchorTransactionRequest)
+
+			var jrpcData paramsAndHeadersTransaction
+
+			if err := json.Unmarshal(data, &jrpcData); err != nil {
+				return nil, err
+			}
+
+			err := protojson.Unmarshal(jrpcData.Params, req)
+			if err != nil {
+				return nil, err
+			}
+
+			return s.client.GetRawAnchorTransaction(metadata.NewOutgoingContext(ctx, jrpcData.Headers), req)
+		},
+
+		"pactus.transaction.get_anchor": func(ctx context.Context, data json.RawMessage) (any, error) {
+			req := new(GetAnchorRequest)
+
+			var jrpcData paramsAndHeadersTransaction
+
+			if err := json.Unmarshal(data, &jrpcData); err != nil {
+				return nil, err
+			}
+
+			err := protojson.Unmarshal(jrpcData.Params, req)
+			if err != nil {
+				return nil, err
+			}
+
+			return s.client.GetAnchor(metadata.NewOutgoingContext(ctx, jrpcData.Headers), req)
+		},
 	}
 }
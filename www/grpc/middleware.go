@@ -15,20 +15,48 @@ func BasicAuth(storedCredential string) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (
 		any, error,
 	) {
-		user, password, err := htpasswd.ExtractBasicAuthFromContext(ctx)
-		if err != nil {
-			return nil, status.Error(codes.Unauthenticated, "failed to extract basic auth from header")
+		if err := checkBasicAuth(ctx, storedCredential); err != nil {
+			return nil, err
 		}
 
-		if err := htpasswd.CompareBasicAuth(storedCredential, user, password); err != nil {
-			return nil, status.Error(codes.Unauthenticated, "username or password is invalid")
+		return handler(ctx, req)
+	}
+}
+
+// StreamBasicAuth is the basic authentication interceptor for the streaming methods.
+func StreamBasicAuth(storedCredential string) grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := checkBasicAuth(stream.Context(), storedCredential); err != nil {
+			return err
 		}
 
-		return handler(ctx, req)
+		return handler(srv, stream)
 	}
 }
 
+func checkBasicAuth(ctx context.Context, storedCredential string) error {
+	user, password, err := htpasswd.ExtractBasicAuthFromContext(ctx)
+	if err != nil {
+		return status.Error(codes.Unauthenticated, "failed to extract basic auth from header")
+	}
+
+	if err := htpasswd.CompareBasicAuth(storedCredential, user, password); err != nil {
+		return status.Error(codes.Unauthenticated, "username or password is invalid")
+	}
+
+	return nil
+}
+
 func (s *Server) Recovery() grpc.UnaryServerInterceptor {
+	return rec.UnaryServerInterceptor(s.recoveryOptions()...)
+}
+
+// StreamRecovery is the recovery interceptor for the streaming methods.
+func (s *Server) StreamRecovery() grpc.StreamServerInterceptor {
+	return rec.StreamServerInterceptor(s.recoveryOptions()...)
+}
+
+func (s *Server) recoveryOptions() []rec.Option {
 	recovery := func(p any) (err error) {
 		err = status.Errorf(codes.Unknown, "%v", p)
 		stackTrace := debug.Stack()
@@ -40,9 +68,8 @@ func (s *Server) Recovery() grpc.UnaryServerInterceptor {
 
 		return err
 	}
-	opts := []rec.Option{
+
+	return []rec.Option{
 		rec.WithRecoveryHandler(recovery),
 	}
-
-	return rec.UnaryServerInterceptor(opts...)
 }
@@ -71,6 +71,31 @@ func TestBasicAuth(t *testing.T) {
 	}
 }
 
+type mockServerStream struct {
+	grpc.ServerStream
+
+	ctx context.Context
+}
+
+func (m *mockServerStream) Context() context.Context {
+	return m.ctx
+}
+
+func TestStreamBasicAuth(t *testing.T) {
+	interceptor := StreamBasicAuth("user:$2y$10$5Kjd955BDWLouqckHzBjKuCF6hFOUD61lhm8QpjDVHTUwMIrYUdq2")
+	handler := func(any, grpc.ServerStream) error { return nil }
+
+	auth := "Basic " + base64.StdEncoding.EncodeToString([]byte("user:password"))
+	md := metadata.New(map[string]string{"authorization": auth})
+	stream := &mockServerStream{ctx: metadata.NewIncomingContext(context.Background(), md)}
+	err := interceptor(nil, stream, &grpc.StreamServerInfo{}, handler)
+	assert.NoError(t, err)
+
+	stream = &mockServerStream{ctx: context.Background()}
+	err = interceptor(nil, stream, &grpc.StreamServerInfo{}, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
 func TestGrpcRecovery(t *testing.T) {
 	s := setup(t, nil)
 
@@ -79,3 +104,14 @@ func TestGrpcRecovery(t *testing.T) {
 	_, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{}, mockUnaryPanicHandler)
 	assert.Equal(t, codes.Unknown, status.Code(err))
 }
+
+func TestGrpcStreamRecovery(t *testing.T) {
+	s := setup(t, nil)
+
+	interceptor := s.server.StreamRecovery()
+	handler := func(any, grpc.ServerStream) error { panic("panic happen!!!") }
+
+	stream := &mockServerStream{ctx: context.Background()}
+	err := interceptor(nil, stream, &grpc.StreamServerInfo{}, handler)
+	assert.Equal(t, codes.Unknown, status.Code(err))
+}
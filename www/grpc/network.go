@@ -116,6 +116,16 @@ func (s *networkServer) GetNetworkInfo(_ context.Context,
 		receivedBytes[int32(msgType)] = bytes
 	}
 
+	sentSavedBytes := make(map[int32]int64)
+	for msgType, bytes := range ps.SentSavedBytes() {
+		sentSavedBytes[int32(msgType)] = bytes
+	}
+
+	receivedSavedBytes := make(map[int32]int64)
+	for msgType, bytes := range ps.ReceivedSavedBytes() {
+		receivedSavedBytes[int32(msgType)] = bytes
+	}
+
 	return &pactus.GetNetworkInfoResponse{
 		TotalSentBytes:      ps.TotalSentBytes(),
 		TotalReceivedBytes:  ps.TotalReceivedBytes(),
@@ -124,5 +134,7 @@ func (s *networkServer) GetNetworkInfo(_ context.Context,
 		ConnectedPeers:      peerInfos,
 		SentBytes:           sentBytes,
 		ReceivedBytes:       receivedBytes,
+		SentSavedBytes:      sentSavedBytes,
+		ReceivedSavedBytes:  receivedSavedBytes,
 	}, nil
 }
@@ -5,11 +5,13 @@ import (
 	"net"
 
 	"github.com/pactus-project/pactus/consensus"
+	"github.com/pactus-project/pactus/evidencepool"
 	"github.com/pactus-project/pactus/network"
 	"github.com/pactus-project/pactus/state"
 	"github.com/pactus-project/pactus/sync"
 	"github.com/pactus-project/pactus/util/logger"
 	"github.com/pactus-project/pactus/wallet"
+	"github.com/pactus-project/pactus/watchdog"
 	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
 	"google.golang.org/grpc"
 )
@@ -22,16 +24,18 @@ type Server struct {
 	address   string
 	grpc      *grpc.Server
 	state     state.Facade
+	evdPool   evidencepool.Reader
 	net       network.Network
 	sync      sync.Synchronizer
 	consMgr   consensus.ManagerReader
+	watchdog  watchdog.Reader
 	walletMgr *wallet.Manager
 	logger    *logger.SubLogger
 }
 
-func NewServer(conf *Config, st state.Facade, syn sync.Synchronizer,
+func NewServer(conf *Config, st state.Facade, evdPool evidencepool.Reader, syn sync.Synchronizer,
 	n network.Network, consMgr consensus.ManagerReader,
-	walletMgr *wallet.Manager,
+	wd watchdog.Reader, walletMgr *wallet.Manager,
 ) *Server {
 	ctx, cancel := context.WithCancel(context.Background())
 
@@ -40,9 +44,11 @@ func NewServer(conf *Config, st state.Facade, syn sync.Synchronizer,
 		cancel:    cancel,
 		config:    conf,
 		state:     st,
+		evdPool:   evdPool,
 		sync:      syn,
 		net:       n,
 		consMgr:   consMgr,
+		watchdog:  wd,
 		walletMgr: walletMgr,
 		logger:    logger.NewSubLogger("_grpc", nil),
 	}
@@ -67,14 +73,19 @@ func (s *Server) StartServer() error {
 
 func (s *Server) startListening(listener net.Listener) error {
 	opts := make([]grpc.UnaryServerInterceptor, 0)
+	streamOpts := make([]grpc.StreamServerInterceptor, 0)
 
 	if s.config.BasicAuth != "" {
 		opts = append(opts, BasicAuth(s.config.BasicAuth))
+		streamOpts = append(streamOpts, StreamBasicAuth(s.config.BasicAuth))
 	}
 
 	opts = append(opts, s.Recovery())
+	streamOpts = append(streamOpts, s.StreamRecovery())
 
-	grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(opts...))
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(opts...),
+		grpc.ChainStreamInterceptor(streamOpts...))
 
 	blockchainServer := newBlockchainServer(s)
 	transactionServer := newTransactionServer(s)
@@ -92,6 +103,12 @@ func (s *Server) startListening(listener net.Listener) error {
 		pactus.RegisterWalletServer(grpcServer, walletServer)
 	}
 
+	if s.config.EnableAdmin {
+		adminServer := newAdminServer(s)
+
+		pactus.RegisterAdminServer(grpcServer, adminServer)
+	}
+
 	s.listener = listener
 	s.address = listener.Addr().String()
 	s.grpc = grpcServer
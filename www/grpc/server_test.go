@@ -9,6 +9,7 @@ import (
 
 	"github.com/pactus-project/pactus/consensus"
 	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/evidencepool"
 	"github.com/pactus-project/pactus/genesis"
 	"github.com/pactus-project/pactus/network"
 	"github.com/pactus-project/pactus/state"
@@ -16,6 +17,7 @@ import (
 	"github.com/pactus-project/pactus/util"
 	"github.com/pactus-project/pactus/util/testsuite"
 	"github.com/pactus-project/pactus/wallet"
+	"github.com/pactus-project/pactus/watchdog"
 	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -29,8 +31,11 @@ type testData struct {
 
 	mockState     *state.MockState
 	mockSync      *sync.MockSync
+	mockNet       *network.MockNetwork
 	consMocks     []*consensus.MockConsensus
 	mockConsMgr   consensus.Manager
+	mockEvdPool   *evidencepool.MockEvidencePool
+	mockWatchdog  *watchdog.MockWatchdog
 	defaultWallet *wallet.Wallet
 	listener      *bufconn.Listener
 	server        *Server
@@ -66,6 +71,8 @@ func setup(t *testing.T, conf *Config) *testData {
 	mockNet := network.MockingNetwork(ts, ts.RandPeerID())
 	mockSync := sync.MockingSync(ts)
 	mockConsMgr, consMocks := consensus.MockingManager(ts, mockState, valKeys)
+	evdPool := evidencepool.MockingEvidencePool()
+	mockWatchdog := watchdog.MockingWatchdog()
 
 	mockState.CommitTestBlocks(10)
 
@@ -80,9 +87,9 @@ func setup(t *testing.T, conf *Config) *testData {
 	mockWalletMgrConf.ChainType = mockState.Genesis().ChainType()
 
 	server := NewServer(
-		conf, mockState,
+		conf, mockState, evdPool,
 		mockSync, mockNet,
-		mockConsMgr, wallet.NewWalletManager(mockWalletMgrConf),
+		mockConsMgr, mockWatchdog, wallet.NewWalletManager(mockWalletMgrConf),
 	)
 	err = server.startListening(listener)
 	assert.NoError(t, err)
@@ -91,8 +98,11 @@ func setup(t *testing.T, conf *Config) *testData {
 		TestSuite:     ts,
 		mockState:     mockState,
 		mockSync:      mockSync,
+		mockNet:       mockNet,
 		consMocks:     consMocks,
 		mockConsMgr:   mockConsMgr,
+		mockEvdPool:   evdPool,
+		mockWatchdog:  mockWatchdog,
 		defaultWallet: defaultWallet,
 		server:        server,
 		listener:      listener,
@@ -152,6 +162,17 @@ func (td *testData) walletClient(t *testing.T) (*grpc.ClientConn, pactus.WalletC
 	return conn, pactus.NewWalletClient(conn)
 }
 
+func (td *testData) adminClient(t *testing.T) (*grpc.ClientConn, pactus.AdminClient) {
+	t.Helper()
+
+	conn, err := grpc.NewClient("passthrough://bufnet",
+		grpc.WithContextDialer(td.bufDialer),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.NoError(t, err)
+
+	return conn, pactus.NewAdminClient(conn)
+}
+
 func (td *testData) utilClient(t *testing.T) (*grpc.ClientConn, pactus.UtilsClient) {
 	t.Helper()
 
@@ -62,6 +62,33 @@ func (s *transactionServer) GetTransaction(_ context.Context,
 	return res, nil
 }
 
+func (s *transactionServer) GetAnchor(_ context.Context,
+	req *pactus.GetAnchorRequest,
+) (*pactus.GetAnchorResponse, error) {
+	h, err := hash.FromString(req.Hash)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid hash: %v", err.Error())
+	}
+
+	anc := s.state.CommittedAnchor(h)
+	if anc == nil {
+		if s.state.IsPruned() {
+			// The node might be restored from a state snapshot.
+			return nil, status.Errorf(codes.NotFound,
+				"anchor not found, the anchors before height %d might not be available", s.state.HistoryHeight())
+		}
+
+		return nil, status.Errorf(codes.NotFound, "anchor not found")
+	}
+
+	return &pactus.GetAnchorResponse{
+		Hash:          anc.AnchorHash.String(),
+		TransactionId: anc.TxID.String(),
+		BlockHeight:   anc.Height,
+		BlockTime:     anc.BlockTime,
+	}, nil
+}
+
 func (s *transactionServer) BroadcastTransaction(_ context.Context,
 	req *pactus.BroadcastTransactionRequest,
 ) (*pactus.BroadcastTransactionResponse, error) {
@@ -219,6 +246,111 @@ func (s *transactionServer) GetRawWithdrawTransaction(_ context.Context,
 	}, nil
 }
 
+func (s *transactionServer) GetRawMetadataTransaction(_ context.Context,
+	req *pactus.GetRawMetadataTransactionRequest,
+) (*pactus.GetRawTransactionResponse, error) {
+	validatorAddr, err := crypto.AddressFromString(req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	rewardAddr := crypto.TreasuryAddress
+	if req.RewardAddress != "" {
+		rewardAddr, err = crypto.AddressFromString(req.RewardAddress)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	fee := amount.Amount(req.Fee)
+	if fee == 0 {
+		fee = s.state.CalculateFee(0, payload.TypeMetadata)
+	}
+	lockTime := s.getLockTime(req.LockTime)
+
+	metadataTx := tx.NewMetadataTx(lockTime, validatorAddr,
+		req.Moniker, req.Website, req.Contact, rewardAddr, fee, tx.WithMemo(req.Memo))
+	rawTx, err := metadataTx.Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	return &pactus.GetRawTransactionResponse{
+		RawTransaction: hex.EncodeToString(rawTx),
+	}, nil
+}
+
+func (s *transactionServer) GetRawKeyRotationTransaction(_ context.Context,
+	req *pactus.GetRawKeyRotationTransactionRequest,
+) (*pactus.GetRawTransactionResponse, error) {
+	validatorAddr, err := crypto.AddressFromString(req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	newPublicKey, err := bls.PublicKeyFromString(req.NewPublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	proof, err := bls.SignatureFromString(req.ProofOfPossession)
+	if err != nil {
+		return nil, err
+	}
+
+	fee := amount.Amount(req.Fee)
+	if fee == 0 {
+		fee = s.state.CalculateFee(0, payload.TypeKeyRotation)
+	}
+	lockTime := s.getLockTime(req.LockTime)
+
+	keyRotationTx := tx.NewKeyRotationTx(lockTime, validatorAddr,
+		newPublicKey, proof, fee, tx.WithMemo(req.Memo))
+	rawTx, err := keyRotationTx.Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	return &pactus.GetRawTransactionResponse{
+		RawTransaction: hex.EncodeToString(rawTx),
+	}, nil
+}
+
+func (s *transactionServer) GetRawAnchorTransaction(_ context.Context,
+	req *pactus.GetRawAnchorTransactionRequest,
+) (*pactus.GetRawTransactionResponse, error) {
+	sender, err := crypto.AddressFromString(req.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	hashes := make([]hash.Hash, 0, len(req.Hashes))
+	for _, str := range req.Hashes {
+		h, err := hash.FromString(str)
+		if err != nil {
+			return nil, err
+		}
+		hashes = append(hashes, h)
+	}
+
+	fee := amount.Amount(req.Fee)
+	if fee == 0 {
+		// The fee of anchor transactions is calculated per anchored hash.
+		fee = s.state.CalculateFee(0, payload.TypeAnchor) * amount.Amount(len(hashes))
+	}
+	lockTime := s.getLockTime(req.LockTime)
+
+	anchorTx := tx.NewAnchorTx(lockTime, sender, req.Namespace, hashes, fee, tx.WithMemo(req.Memo))
+	rawTx, err := anchorTx.Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	return &pactus.GetRawTransactionResponse{
+		RawTransaction: hex.EncodeToString(rawTx),
+	}, nil
+}
+
 func (s *transactionServer) getFee(f int64, amt amount.Amount) amount.Amount {
 	fee := amount.Amount(f)
 	if fee == 0 {
@@ -298,6 +430,39 @@ func transactionToProto(trx *tx.Tx) *pactus.TransactionInfo {
 				Amount: pld.Amount.ToNanoPAC(),
 			},
 		}
+	case payload.TypeMetadata:
+		pld := trx.Payload().(*payload.MetadataPayload)
+		transaction.Payload = &pactus.TransactionInfo_Metadata{
+			Metadata: &pactus.PayloadMetadata{
+				Validator:     pld.Validator.String(),
+				Moniker:       pld.Moniker,
+				Website:       pld.Website,
+				Contact:       pld.Contact,
+				RewardAddress: pld.RewardAddress.String(),
+			},
+		}
+	case payload.TypeKeyRotation:
+		pld := trx.Payload().(*payload.KeyRotationPayload)
+		transaction.Payload = &pactus.TransactionInfo_KeyRotation{
+			KeyRotation: &pactus.PayloadKeyRotation{
+				Validator:         pld.Validator.String(),
+				NewPublicKey:      pld.NewPublicKey.String(),
+				ProofOfPossession: pld.ProofOfPossession.String(),
+			},
+		}
+	case payload.TypeAnchor:
+		pld := trx.Payload().(*payload.AnchorPayload)
+		hashes := make([]string, 0, len(pld.Hashes))
+		for _, h := range pld.Hashes {
+			hashes = append(hashes, h.String())
+		}
+		transaction.Payload = &pactus.TransactionInfo_Anchor{
+			Anchor: &pactus.PayloadAnchor{
+				Sender:    pld.Sender.String(),
+				Namespace: pld.Namespace,
+				Hashes:    hashes,
+			},
+		}
 	default:
 		logger.Error("payload type not defined", "type", trx.Payload().Type())
 	}
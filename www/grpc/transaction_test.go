@@ -5,9 +5,12 @@ import (
 	"encoding/hex"
 	"testing"
 
+	"github.com/pactus-project/pactus/crypto/hash"
 	"github.com/pactus-project/pactus/types/amount"
+	"github.com/pactus-project/pactus/types/block"
 	"github.com/pactus-project/pactus/types/tx"
 	"github.com/pactus-project/pactus/types/tx/payload"
+	"github.com/pactus-project/pactus/util/testsuite"
 	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
 	"github.com/stretchr/testify/assert"
 )
@@ -84,6 +87,77 @@ func TestGetTransaction(t *testing.T) {
 	td.StopServer()
 }
 
+func TestGetAnchor(t *testing.T) {
+	td := setup(t, nil)
+	conn, client := td.transactionClient(t)
+
+	anchoredHash := td.RandHash()
+	anchorTx := td.GenerateTestAnchorTx([]hash.Hash{anchoredHash})
+	blk, cert := td.GenerateTestBlock(1, testsuite.BlockWithTransactions(block.Txs{anchorTx}))
+	td.mockState.TestStore.SaveBlock(blk, cert)
+
+	t.Run("Should return the anchor", func(t *testing.T) {
+		res, err := client.GetAnchor(context.Background(),
+			&pactus.GetAnchorRequest{
+				Hash: anchoredHash.String(),
+			})
+
+		assert.NoError(t, err)
+		assert.Equal(t, anchoredHash.String(), res.Hash)
+		assert.Equal(t, anchorTx.ID().String(), res.TransactionId)
+		assert.Equal(t, uint32(1), res.BlockHeight)
+		assert.Equal(t, blk.Header().UnixTime(), res.BlockTime)
+	})
+
+	t.Run("Should return error for unknown hash", func(t *testing.T) {
+		res, err := client.GetAnchor(context.Background(),
+			&pactus.GetAnchorRequest{
+				Hash: td.RandHash().String(),
+			})
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should report the missing anchors on a restored node", func(t *testing.T) {
+		td.mockState.TestStore.MarkPruned()
+		td.mockState.TestStore.SetHistoryHeight(100)
+
+		res, err := client.GetAnchor(context.Background(),
+			&pactus.GetAnchorRequest{
+				Hash: td.RandHash().String(),
+			})
+
+		assert.ErrorContains(t, err, "anchors before height 100 might not be available")
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should return error for invalid hash", func(t *testing.T) {
+		res, err := client.GetAnchor(context.Background(),
+			&pactus.GetAnchorRequest{
+				Hash: "invalid",
+			})
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("Should return anchor transaction info", func(t *testing.T) {
+		res, err := client.GetTransaction(context.Background(),
+			&pactus.GetTransactionRequest{
+				Id:        anchorTx.ID().String(),
+				Verbosity: pactus.TransactionVerbosity_TRANSACTION_INFO,
+			})
+
+		assert.NoError(t, err)
+		pld := res.Transaction.Payload.(*pactus.TransactionInfo_Anchor)
+		assert.Equal(t, []string{anchoredHash.String()}, pld.Anchor.Hashes)
+	})
+
+	assert.Nil(t, conn.Close(), "Error closing connection")
+	td.StopServer()
+}
+
 func TestSendRawTransaction(t *testing.T) {
 	td := setup(t, nil)
 	conn, client := td.transactionClient(t)
@@ -214,6 +288,127 @@ func TestGetRawTransaction(t *testing.T) {
 		assert.Equal(t, expectedFee, decodedTrx.Fee())
 	})
 
+	t.Run("Metadata", func(t *testing.T) {
+		valAddr := td.RandValAddress()
+		rewardAddr := td.RandAccAddress()
+		res, err := client.GetRawMetadataTransaction(context.Background(),
+			&pactus.GetRawMetadataTransactionRequest{
+				ValidatorAddress: valAddr.String(),
+				Moniker:          "moniker",
+				Website:          "https://pactus.org",
+				RewardAddress:    rewardAddr.String(),
+				Memo:             td.RandString(32),
+			})
+
+		assert.NoError(t, err)
+		assert.NotEmpty(t, res.RawTransaction)
+
+		decodedTrx, err := tx.FromBytes(td.DecodingHex(res.RawTransaction))
+		assert.NoError(t, err)
+		expectedLockTime := td.mockState.LastBlockHeight()
+		expectedFee := td.mockState.CalculateFee(0, payload.TypeMetadata)
+
+		pld := decodedTrx.Payload().(*payload.MetadataPayload)
+		assert.Equal(t, valAddr, pld.Validator)
+		assert.Equal(t, "moniker", pld.Moniker)
+		assert.Equal(t, "https://pactus.org", pld.Website)
+		assert.Empty(t, pld.Contact)
+		assert.Equal(t, rewardAddr, pld.RewardAddress)
+		assert.Equal(t, expectedLockTime, decodedTrx.LockTime())
+		assert.Equal(t, expectedFee, decodedTrx.Fee())
+	})
+
+	t.Run("KeyRotation", func(t *testing.T) {
+		valAddr := td.RandValAddress()
+		newPub, newPrv := td.RandBLSKeyPair()
+		proof := newPrv.SignNative(payload.KeyRotationProofBytes(valAddr, newPub))
+		res, err := client.GetRawKeyRotationTransaction(context.Background(),
+			&pactus.GetRawKeyRotationTransactionRequest{
+				ValidatorAddress:  valAddr.String(),
+				NewPublicKey:      newPub.String(),
+				ProofOfPossession: proof.String(),
+				Memo:              td.RandString(32),
+			})
+
+		assert.NoError(t, err)
+		assert.NotEmpty(t, res.RawTransaction)
+
+		decodedTrx, err := tx.FromBytes(td.DecodingHex(res.RawTransaction))
+		assert.NoError(t, err)
+		expectedLockTime := td.mockState.LastBlockHeight()
+		expectedFee := td.mockState.CalculateFee(0, payload.TypeKeyRotation)
+
+		pld := decodedTrx.Payload().(*payload.KeyRotationPayload)
+		assert.Equal(t, valAddr, pld.Validator)
+		assert.Equal(t, newPub.String(), pld.NewPublicKey.String())
+		assert.Equal(t, proof.String(), pld.ProofOfPossession.String())
+		assert.NoError(t, pld.BasicCheck())
+		assert.Equal(t, expectedLockTime, decodedTrx.LockTime())
+		assert.Equal(t, expectedFee, decodedTrx.Fee())
+	})
+
+	t.Run("Anchor", func(t *testing.T) {
+		sender := td.RandAccAddress()
+		hashes := []hash.Hash{td.RandHash(), td.RandHash()}
+		res, err := client.GetRawAnchorTransaction(context.Background(),
+			&pactus.GetRawAnchorTransactionRequest{
+				Sender:    sender.String(),
+				Namespace: "compliance",
+				Hashes:    []string{hashes[0].String(), hashes[1].String()},
+				Memo:      td.RandString(32),
+			})
+
+		assert.NoError(t, err)
+		assert.NotEmpty(t, res.RawTransaction)
+
+		decodedTrx, err := tx.FromBytes(td.DecodingHex(res.RawTransaction))
+		assert.NoError(t, err)
+		expectedLockTime := td.mockState.LastBlockHeight()
+		expectedFee := td.mockState.CalculateFee(0, payload.TypeAnchor) * 2
+
+		pld := decodedTrx.Payload().(*payload.AnchorPayload)
+		assert.Equal(t, sender, pld.Sender)
+		assert.Equal(t, "compliance", pld.Namespace)
+		assert.Equal(t, hashes, pld.Hashes)
+		assert.Equal(t, expectedLockTime, decodedTrx.LockTime())
+		assert.Equal(t, expectedFee, decodedTrx.Fee())
+	})
+
+	t.Run("Anchor, invalid hash", func(t *testing.T) {
+		res, err := client.GetRawAnchorTransaction(context.Background(),
+			&pactus.GetRawAnchorTransactionRequest{
+				Sender: td.RandAccAddress().String(),
+				Hashes: []string{"invalid"},
+			})
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("KeyRotation, invalid public key", func(t *testing.T) {
+		res, err := client.GetRawKeyRotationTransaction(context.Background(),
+			&pactus.GetRawKeyRotationTransactionRequest{
+				ValidatorAddress: td.RandValAddress().String(),
+				NewPublicKey:     "invalid",
+			})
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("KeyRotation, invalid proof of possession", func(t *testing.T) {
+		newPub, _ := td.RandBLSKeyPair()
+		res, err := client.GetRawKeyRotationTransaction(context.Background(),
+			&pactus.GetRawKeyRotationTransactionRequest{
+				ValidatorAddress:  td.RandValAddress().String(),
+				NewPublicKey:      newPub.String(),
+				ProofOfPossession: "invalid",
+			})
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
 	assert.Nil(t, conn.Close(), "Error closing connection")
 	td.StopServer()
 }
@@ -217,6 +217,13 @@ func (*Server) writeValidatorTable(val *pactus.ValidatorInfo) *tableMaker {
 	tm.addRowInt("UnbondingHeight", int(val.UnbondingHeight))
 	tm.addRowDouble("AvailabilityScore", val.AvailabilityScore)
 	tm.addRowString("Hash", val.Hash)
+	if val.Metadata != nil {
+		tm.addRowString("--- Metadata", "---")
+		tm.addRowString("Moniker", val.Metadata.Moniker)
+		tm.addRowString("Website", val.Metadata.Website)
+		tm.addRowString("Contact", val.Metadata.Contact)
+		tm.addRowAccAddress("RewardAddress", val.Metadata.RewardAddress)
+	}
 
 	return tm
 }
@@ -8,12 +8,14 @@ import (
 
 	"github.com/pactus-project/pactus/consensus"
 	"github.com/pactus-project/pactus/crypto/bls"
+	"github.com/pactus-project/pactus/evidencepool"
 	"github.com/pactus-project/pactus/network"
 	"github.com/pactus-project/pactus/state"
 	"github.com/pactus-project/pactus/sync"
 	"github.com/pactus-project/pactus/util"
 	"github.com/pactus-project/pactus/util/testsuite"
 	"github.com/pactus-project/pactus/wallet"
+	"github.com/pactus-project/pactus/watchdog"
 	"github.com/pactus-project/pactus/www/grpc"
 	"github.com/stretchr/testify/assert"
 )
@@ -66,9 +68,9 @@ func setup(t *testing.T) *testData {
 	}
 
 	gRPCServer := grpc.NewServer(
-		grpcConf, mockState,
+		grpcConf, mockState, evidencepool.MockingEvidencePool(),
 		mockSync, mockNet,
-		mockConsMgr, wallet.NewWalletManager(walletMgrConf),
+		mockConsMgr, watchdog.MockingWatchdog(), wallet.NewWalletManager(walletMgrConf),
 	)
 	assert.NoError(t, gRPCServer.StartServer())
 
@@ -1,6 +1,7 @@
 package http
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/gorilla/mux"
@@ -69,6 +70,28 @@ func txToTable(trx *pactus.TransactionInfo, tm *tableMaker) {
 		tm.addRowAccAddress("Receiver", pld.To)
 		tm.addRowAmount("Amount", amount.Amount(pld.Amount))
 
+	case pactus.PayloadType_METADATA_PAYLOAD:
+		pld := trx.Payload.(*pactus.TransactionInfo_Metadata).Metadata
+		tm.addRowValAddress("Validator", pld.Validator)
+		tm.addRowString("Moniker", pld.Moniker)
+		tm.addRowString("Website", pld.Website)
+		tm.addRowString("Contact", pld.Contact)
+		tm.addRowAccAddress("RewardAddress", pld.RewardAddress)
+
+	case pactus.PayloadType_KEY_ROTATION_PAYLOAD:
+		pld := trx.Payload.(*pactus.TransactionInfo_KeyRotation).KeyRotation
+		tm.addRowValAddress("Validator", pld.Validator)
+		tm.addRowString("NewPublicKey", pld.NewPublicKey)
+		tm.addRowString("ProofOfPossession", pld.ProofOfPossession)
+
+	case pactus.PayloadType_ANCHOR_PAYLOAD:
+		pld := trx.Payload.(*pactus.TransactionInfo_Anchor).Anchor
+		tm.addRowAccAddress("Sender", pld.Sender)
+		tm.addRowString("Namespace", pld.Namespace)
+		for i, h := range pld.Hashes {
+			tm.addRowString(fmt.Sprintf("Hash %d", i+1), h)
+		}
+
 	case pactus.PayloadType_UNKNOWN:
 		tm.addRowValAddress("error", "unknown payload type")
 	}
@@ -59,9 +59,11 @@ func (s *Server) StartServer(grpcServer string) error {
 	transactionService := pactus.RegisterTransactionJsonRPC(grpcConn)
 	walletService := pactus.RegisterWalletJsonRPC(grpcConn)
 	utilsService := pactus.RegisterUtilsJsonRPC(grpcConn)
+	adminService := pactus.RegisterAdminJsonRPC(grpcConn)
 
 	server := jrpc.NewServer()
-	server.RegisterServices(blockchainService, networkService, transactionService, walletService, utilsService)
+	server.RegisterServices(blockchainService, networkService, transactionService, walletService, utilsService,
+		adminService)
 
 	listener, err := net.Listen("tcp", s.config.Listen)
 	if err != nil {
@@ -11,9 +11,11 @@ import (
 )
 
 const (
-	TopicBlock         = uint16(0x0101)
-	TopicTransaction   = uint16(0x0201)
-	TopicAccountChange = uint16(0x0301)
+	TopicBlock          = uint16(0x0101)
+	TopicTransaction    = uint16(0x0201)
+	TopicAccountChange  = uint16(0x0301)
+	TopicEvidence       = uint16(0x0401)
+	TopicValidatorAlert = uint16(0x0501)
 )
 
 type Event []byte
@@ -59,3 +61,31 @@ func CreateAccountChangeEvent(accountAddr crypto.Address, height uint32) Event {
 
 	return w.Bytes()
 }
+
+// CreateEvidenceEvent creates an event when a new misbehavior evidence is found.
+// The evidence event structure is like :
+// <topic_id><offender_address><height><sequence_number>.
+func CreateEvidenceEvent(offender crypto.Address, height uint32) Event {
+	buf := make([]byte, 0, 42)
+	w := bytes.NewBuffer(buf)
+	err := encoding.WriteElements(w, TopicEvidence, offender, height)
+	if err != nil {
+		logger.Error("error on encoding event in new evidence", "error", err)
+	}
+
+	return w.Bytes()
+}
+
+// CreateValidatorAlertEvent creates an event when an availability alert of a validator is raised or resolved.
+// The validator alert event structure is like :
+// <topic_id><validator_address><height><alert_kind><active><sequence_number>.
+func CreateValidatorAlertEvent(valAddr crypto.Address, height uint32, kind uint8, active bool) Event {
+	buf := make([]byte, 0, 44)
+	w := bytes.NewBuffer(buf)
+	err := encoding.WriteElements(w, TopicValidatorAlert, valAddr, height, kind, active)
+	if err != nil {
+		logger.Error("error on encoding event in validator alert", "error", err)
+	}
+
+	return w.Bytes()
+}
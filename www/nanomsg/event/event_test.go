@@ -39,3 +39,24 @@ func TestCreateAccountChangeEvent(t *testing.T) {
 		0x3, 0x5a, 0xcc, 0x28, 0x54, 0x1c, 0x6a, 0xba, 0x6c, 0x9a, 0xad, 0x34, 0x21, 0x0, 0x0,
 	}, e)
 }
+
+func TestCreateEvidenceEvent(t *testing.T) {
+	addr, _ := crypto.AddressFromString("pc1p0hrct7eflrpw4ccrttxzs4qud2axex4dcdzdfr")
+	height := uint32(0x2134)
+	e := CreateEvidenceEvent(addr, height)
+	assert.Equal(t, Event{
+		0x01, 0x04, 0x1, 0x7d, 0xc7, 0x85, 0xfb, 0x29, 0xf8, 0xc2, 0xea, 0xe3,
+		0x3, 0x5a, 0xcc, 0x28, 0x54, 0x1c, 0x6a, 0xba, 0x6c, 0x9a, 0xad, 0x34, 0x21, 0x0, 0x0,
+	}, e)
+}
+
+func TestCreateValidatorAlertEvent(t *testing.T) {
+	addr, _ := crypto.AddressFromString("pc1p0hrct7eflrpw4ccrttxzs4qud2axex4dcdzdfr")
+	height := uint32(0x2134)
+	e := CreateValidatorAlertEvent(addr, height, 2, true)
+	assert.Equal(t, Event{
+		0x01, 0x05, 0x1, 0x7d, 0xc7, 0x85, 0xfb, 0x29, 0xf8, 0xc2, 0xea, 0xe3,
+		0x3, 0x5a, 0xcc, 0x28, 0x54, 0x1c, 0x6a, 0xba, 0x6c, 0x9a, 0xad, 0x34, 0x21, 0x0, 0x0,
+		0x2, 0x1,
+	}, e)
+}